@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply FILE",
+	Short: "Apply a file of changes atomically",
+	Long: `Applies a YAML (or JSON) list of create/edit/move/delete operations as one
+transaction. Operations use the same shape as batch --stdin:
+
+  - op: create
+    args: [Write docs]
+    flags: {priority: high, tags: [docs]}
+  - op: move
+    args: ["12", done]
+
+Every operation is validated before anything is written. The board is then
+snapshotted, and if any operation fails every change — task files, config,
+and activity log — is rolled back. Use "-" to read the file from stdin and
+--dry-run to run everything and roll back regardless.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runApply,
+}
+
+func init() {
+	applyCmd.Flags().Bool("dry-run", false, "run every operation, then roll the board back")
+	rootCmd.AddCommand(applyCmd)
+}
+
+// applyResult is the JSON output of apply.
+type applyResult struct {
+	Applied int             `json:"applied"`
+	DryRun  bool            `json:"dry_run"`
+	Results []batchOpResult `json:"results"`
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	ops, err := readApplyOps(args[0])
+	if err != nil {
+		return err
+	}
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+
+	for i, op := range ops {
+		if _, _, err := prepareBatchOp(op); err != nil {
+			return applyOpError(i, op, err, false)
+		}
+	}
+
+	snap, err := board.TakeSnapshot(append([]string{cfg.Dir()}, cfg.TasksPaths()...)...)
+	if err != nil {
+		return err
+	}
+	// Log sinks and git see the changes only once they are kept.
+	release := board.HoldMutations(cfg.Dir())
+	kept := false
+	defer func() { release(kept) }()
+	results := make([]batchOpResult, 0, len(ops))
+	for i, op := range ops {
+		var t *task.Task
+		var id int
+		warnings, err := collectWarnings(func() error {
+			var err error
+			t, id, err = runBatchOp(cfg, op)
+			return err
+		})
+		if err != nil {
+			if restoreErr := snap.Restore(); restoreErr != nil {
+				return fmt.Errorf("op %d (%s) failed: %w; rollback also failed: %w", i+1, op.Op, err, restoreErr)
+			}
+			return applyOpError(i, op, err, true)
+		}
+		if t != nil {
+			id = t.ID
+		}
+		r := newBatchResult(id, nil)
+		r.Warnings = warnings
+		results = append(results, batchOpResult{Op: op.Op, BatchResult: r, Task: t})
+	}
+
+	if dryRun {
+		if err := snap.Restore(); err != nil {
+			return err
+		}
+	}
+	kept = !dryRun
+	return outputApplyResult(applyResult{Applied: len(results), DryRun: dryRun, Results: results})
+}
+
+// readApplyOps reads the operation list from path, or stdin for "-".
+// Unknown fields are rejected so typos do not silently drop changes.
+func readApplyOps(path string) ([]batchOp, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path) //nolint:gosec // user-supplied changes file
+	}
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "reading changes: %v", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var ops []batchOp
+	if err := dec.Decode(&ops); err != nil && !errors.Is(err, io.EOF) {
+		return nil, clierr.Newf(clierr.InvalidInput, "parsing changes: %v", err)
+	}
+	if len(ops) == 0 {
+		return nil, clierr.New(clierr.InvalidInput, "changes file has no operations")
+	}
+	return ops, nil
+}
+
+// applyOpError reports which operation failed, keeping its error code so
+// the exit code still reflects the error class.
+func applyOpError(i int, op batchOp, err error, rolledBack bool) error {
+	suffix := "; nothing was changed"
+	if rolledBack {
+		suffix = "; all changes were rolled back"
+	}
+	var cliErr *clierr.Error
+	if !errors.As(err, &cliErr) {
+		return fmt.Errorf("op %d (%s): %w%s", i+1, op.Op, err, suffix)
+	}
+	details := map[string]any{"op": i + 1, "rolled_back": rolledBack}
+	for k, v := range cliErr.Details {
+		details[k] = v
+	}
+	return clierr.Newf(cliErr.Code, "op %d (%s): %s%s", i+1, op.Op, cliErr.Message, suffix).WithDetails(details)
+}
+
+func outputApplyResult(r applyResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, r)
+	}
+	if r.DryRun {
+		output.Messagef(os.Stdout, "Dry run: %d operations would apply (board unchanged)", r.Applied)
+		return nil
+	}
+	output.Messagef(os.Stdout, "Applied %d operations", r.Applied)
+	return nil
+}
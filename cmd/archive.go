@@ -28,12 +28,15 @@ func init() {
 }
 
 func runArchive(_ *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig()
+	ids, err := parseIDs(args[0])
 	if err != nil {
 		return err
 	}
@@ -57,14 +60,14 @@ func archiveSingleTask(cfg *config.Config, id int) error {
 		if outputFormat() == output.FormatJSON {
 			return output.JSON(os.Stdout, moveResult{Task: t, Changed: false})
 		}
-		output.Messagef(os.Stdout, "Task #%d is already archived", t.ID)
+		output.Messagef(os.Stdout, "Task %s is already archived", output.FormatID(t.ID))
 		return nil
 	}
 
 	if outputFormat() == output.FormatJSON {
 		return output.JSON(os.Stdout, moveResult{Task: t, Changed: true})
 	}
-	output.Messagef(os.Stdout, "Archived task #%d: %s", id, t.Title)
+	output.Messagef(os.Stdout, "Archived task %s: %s", output.FormatID(id), t.Title)
 	return nil
 }
 
@@ -74,12 +77,12 @@ func executeArchive(cfg *config.Config, id int) error {
 }
 
 func executeArchiveCore(cfg *config.Config, id int) (*task.Task, string, error) {
-	path, err := task.FindByID(cfg.TasksPath(), id)
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return nil, "", err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return nil, "", err
 	}
@@ -101,5 +104,6 @@ func executeArchiveCore(cfg *config.Config, id int) (*task.Task, string, error)
 	}
 
 	logActivity(cfg, "move", id, oldStatus+" -> "+targetStatus)
+	applyOnUnblock(cfg, t, oldStatus)
 	return t, oldStatus, nil
 }
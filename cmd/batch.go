@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var batchCmd = &cobra.Command{
+	Use:   "batch --stdin",
+	Short: "Run many create/edit/move/delete operations in one call",
+	Long: `Reads a JSON array of operations from stdin and runs them in order in a
+single process, with one board lock and one config load. Each operation
+names the command and gives its positional args and flags exactly as on the
+command line:
+
+  [
+    {"op": "create", "args": ["Write docs"], "flags": {"priority": "high", "tags": ["docs"]}},
+    {"op": "move", "args": ["12", "done"]},
+    {"op": "edit", "args": ["7"], "flags": {"assignee": "alice"}},
+    {"op": "delete", "args": ["3"]}
+  ]
+
+Every operation is attempted; a failure does not stop the rest. One result
+per operation is printed, and the exit code is 1 if any operation failed.`,
+	Args: cobra.NoArgs,
+	RunE: runBatchStdin,
+}
+
+func init() {
+	batchCmd.Flags().Bool("stdin", false, "read the JSON array of operations from stdin")
+	rootCmd.AddCommand(batchCmd)
+}
+
+// batchOp is one operation read by batch --stdin.
+type batchOp struct {
+	Op    string         `json:"op" yaml:"op"`
+	Args  []string       `json:"args" yaml:"args"`
+	Flags map[string]any `json:"flags" yaml:"flags"`
+}
+
+// batchOpResult is the outcome of one batch --stdin operation.
+type batchOpResult struct {
+	Op string `json:"op"`
+	output.BatchResult
+	Task *task.Task `json:"task,omitempty"`
+}
+
+// batchCommands maps batch op names to the commands whose flags they accept.
+func batchCommands() map[string]*cobra.Command {
+	return map[string]*cobra.Command{
+		"create": createCmd,
+		"edit":   editCmd,
+		"move":   moveCmd,
+		"delete": deleteCmd,
+	}
+}
+
+func runBatchStdin(cmd *cobra.Command, _ []string) error {
+	if useStdin, _ := cmd.Flags().GetBool("stdin"); !useStdin {
+		return clierr.New(clierr.InvalidInput, "batch reads operations from stdin; pass --stdin")
+	}
+	ops, err := readBatchOps(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+
+	results := make([]batchOpResult, 0, len(ops))
+	for _, op := range ops {
+		results = append(results, executeBatchOp(cfg, op))
+	}
+	return reportBatchOps(results)
+}
+
+// readBatchOps decodes the JSON operation array. Unknown fields are
+// rejected so typos do not silently drop flags.
+func readBatchOps(r io.Reader) ([]batchOp, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var ops []batchOp
+	if err := dec.Decode(&ops); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "reading batch operations: %v", err)
+	}
+	return ops, nil
+}
+
+func executeBatchOp(cfg *config.Config, op batchOp) batchOpResult {
+	var t *task.Task
+	var id int
+	warnings, err := collectWarnings(func() error {
+		var err error
+		t, id, err = runBatchOp(cfg, op)
+		return err
+	})
+	if t != nil {
+		id = t.ID
+	}
+	r := newBatchResult(id, err)
+	r.Warnings = warnings
+	return batchOpResult{Op: op.Op, BatchResult: r, Task: t}
+}
+
+// prepareBatchOp checks op and applies its flags to the command it names.
+// It returns that command and, for edit, move, and delete, the task ID.
+func prepareBatchOp(op batchOp) (*cobra.Command, int, error) {
+	c, ok := batchCommands()[op.Op]
+	if !ok {
+		return nil, 0, clierr.Newf(clierr.InvalidInput, "unknown op %q (want create, edit, move, or delete)", op.Op)
+	}
+	if err := setBatchFlags(c, op.Flags); err != nil {
+		return nil, 0, err
+	}
+	if op.Op == "edit" {
+		if err := applyPatchFlag(c); err != nil {
+			return nil, 0, err
+		}
+	}
+	if c.Args != nil {
+		if err := c.Args(c, op.Args); err != nil {
+			return nil, 0, clierr.Newf(clierr.InvalidInput, "%s: %v", op.Op, err)
+		}
+	}
+	if op.Op == "create" {
+		return c, 0, nil
+	}
+	id, err := batchOpID(op)
+	if err != nil {
+		return nil, 0, err
+	}
+	return c, id, nil
+}
+
+// runBatchOp prepares op and runs the core of its command. It returns the
+// affected task when there is one, and the task ID parsed from the args so
+// failures can still be attributed.
+func runBatchOp(cfg *config.Config, op batchOp) (*task.Task, int, error) {
+	c, id, err := prepareBatchOp(op)
+	if err != nil {
+		return nil, 0, err
+	}
+	setLogActorFromClaim(c)
+	switch op.Op {
+	case "create":
+		t, err := executeCreate(cfg, c, op.Args)
+		return t, 0, err
+	case "edit":
+		t, newPath, err := executeEdit(cfg, id, c)
+		if t != nil {
+			t.File = newPath
+		}
+		return t, id, err
+	case "move":
+		t, _, err := executeMove(cfg, id, c, op.Args)
+		return t, id, err
+	default: // delete
+		return nil, id, executeDelete(cfg, id, childrenRefuse)
+	}
+}
+
+// batchOpID parses the single task ID an edit, move, or delete op targets.
+func batchOpID(op batchOp) (int, error) {
+	if err := checkIDSyntax(op.Args[0]); err != nil {
+		return 0, err
+	}
+	ids, err := parseIDs(op.Args[0])
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) != 1 {
+		return 0, clierr.Newf(clierr.InvalidInput, "%s: one task ID per operation", op.Op)
+	}
+	return ids[0], nil
+}
+
+// setBatchFlags resets c's own flags to their defaults and then applies
+// flags, so no value leaks from one operation into the next.
+func setBatchFlags(c *cobra.Command, flags map[string]any) error {
+	local := c.LocalFlags()
+	local.VisitAll(func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			_ = sv.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	})
+	for name, v := range flags {
+		f := local.Lookup(name)
+		if f == nil {
+			return clierr.Newf(clierr.InvalidInput, "%s: unknown flag %q", c.Name(), name)
+		}
+		if err := setBatchFlag(f, v); err != nil {
+			return clierr.Newf(clierr.InvalidInput, "%s: flag %q: %v", c.Name(), name, err)
+		}
+		f.Changed = true
+	}
+	return nil
+}
+
+func setBatchFlag(f *pflag.Flag, v any) error {
+	switch v := v.(type) {
+	case string:
+		return f.Value.Set(v)
+	case bool:
+		return f.Value.Set(strconv.FormatBool(v))
+	case int:
+		return f.Value.Set(strconv.Itoa(v))
+	case float64:
+		return f.Value.Set(strconv.FormatFloat(v, 'f', -1, 64))
+	case []any:
+		sv, ok := f.Value.(pflag.SliceValue)
+		if !ok {
+			return fmt.Errorf("takes a single value, got a list")
+		}
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, fmt.Sprint(item))
+		}
+		return sv.Replace(items)
+	}
+	return fmt.Errorf("unsupported value %v", v)
+}
+
+func reportBatchOps(results []batchOpResult) error {
+	anyFailed := false
+	for _, r := range results {
+		if !r.OK {
+			anyFailed = true
+		}
+	}
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		if err := output.JSON(os.Stdout, results); err != nil {
+			return err
+		}
+	default:
+		succeeded := 0
+		for i, r := range results {
+			if r.OK {
+				succeeded++
+				continue
+			}
+			target := ""
+			if r.ID != 0 {
+				target = " task " + output.FormatID(r.ID)
+			}
+			fmt.Fprintf(os.Stderr, "Error: op %d (%s%s): %s\n", i+1, r.Op, target, r.Error)
+		}
+		output.Messagef(os.Stdout, "Completed %d/%d operations", succeeded, len(results))
+	}
+
+	if anyFailed {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/external"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var blockCmd = &cobra.Command{
+	Use:   "block ID",
+	Short: "Mark a task as blocked",
+	Long: `Marks a task as blocked with a reason and, optionally, the date it is expected
+to be unblocked (--until, YYYY-MM-DD or relative such as +1w or "next friday").
+
+--on records the external item the task waits for, such as a GitHub issue
+or pull request URL, OWNER/REPO#N, or a ticket key. It is kept apart from
+depends_on, which only names tasks on this board; unblock --external polls
+GitHub items and unblocks the tasks whose item has closed.
+
+A task with an --until date shows up in list --due-within reports. Once the
+date has passed and the task is still blocked, list and show warn that the
+block needs review: unblock the task, or run block again with a new --until.
+On a task that is already blocked, the flags left out keep their values.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlock,
+}
+
+var unblockCmd = &cobra.Command{
+	Use:   "unblock [ID]",
+	Short: "Clear a task's block",
+	Long: `Clears a task's block: its reason, expected unblock date, and external item.
+
+--external instead polls the external item (blocked_on) of every blocked
+task and unblocks those whose item has closed. GitHub issues and pull
+requests are polled; other references, such as ticket keys, are skipped.
+GITHUB_TOKEN, when set, authenticates the requests for private repositories
+and higher rate limits. Run it from cron or CI to unblock tasks as their
+external blockers close.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUnblock,
+}
+
+func init() {
+	blockCmd.Flags().String("reason", "", "why the task is blocked")
+	blockCmd.Flags().String("until", "", "date the task is expected to be unblocked")
+	blockCmd.Flags().String("on", "", "external item the task waits for (URL or ticket)")
+	blockCmd.Flags().String("claim", "", "claim name, required if the task is claimed")
+	unblockCmd.Flags().String("claim", "", "claim name, required if the task is claimed")
+	unblockCmd.Flags().Bool("external", false, "unblock every task whose external item has closed")
+	unblockCmd.Flags().Bool("dry-run", false, "with --external, show what would be unblocked")
+	rootCmd.AddCommand(blockCmd, unblockCmd)
+}
+
+func runBlock(cmd *cobra.Command, args []string) error {
+	reason, _ := cmd.Flags().GetString("reason")
+	untilStr, _ := cmd.Flags().GetString("until")
+	on, _ := cmd.Flags().GetString("on")
+	on = strings.TrimSpace(on)
+	var until *date.Date
+	if untilStr != "" {
+		d, err := date.ParseNatural(untilStr)
+		if err != nil {
+			return task.ValidateDate("until", untilStr, err)
+		}
+		if d.Before(date.Today().Time) {
+			return clierr.Newf(clierr.InvalidInput, "--until %s is in the past", d)
+		}
+		until = &d
+	}
+
+	return updateBlock(cmd, args[0], func(t *task.Task) error {
+		if t.Blocked && (until != nil || on != "") {
+			reason = cmp.Or(reason, t.BlockReason)
+		}
+		if reason == "" {
+			return clierr.New(clierr.InvalidInput, "block reason is required (use --reason REASON)")
+		}
+		if t.Blocked {
+			until = cmp.Or(until, t.BlockedUntil)
+			on = cmp.Or(on, t.BlockedOn)
+		}
+		task.Block(t, reason, until)
+		t.BlockedOn = on
+		return nil
+	})
+}
+
+func runUnblock(cmd *cobra.Command, args []string) error {
+	if external, _ := cmd.Flags().GetBool("external"); external {
+		if len(args) > 0 {
+			return clierr.New(clierr.InvalidInput, "--external cannot be combined with an ID")
+		}
+		return runUnblockExternal(cmd)
+	}
+	if len(args) == 0 {
+		return clierr.New(clierr.InvalidInput, "task ID is required (or use --external)")
+	}
+	return updateBlock(cmd, args[0], func(t *task.Task) error {
+		if !t.Blocked {
+			return clierr.Newf(clierr.NoChanges, "task %s is not blocked", output.FormatID(t.ID))
+		}
+		task.Unblock(t)
+		return nil
+	})
+}
+
+// updateBlock applies change to the task named by arg after checking its
+// claim, then writes and logs the result.
+func updateBlock(cmd *cobra.Command, arg string, change func(*task.Task) error) error {
+	if err := checkIDSyntax(arg); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	id, err := parseID(arg)
+	if err != nil {
+		return err
+	}
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
+	if err != nil {
+		return err
+	}
+	t, err := readTask(cfg, path)
+	if err != nil {
+		return err
+	}
+	claimant, _ := cmd.Flags().GetString("claim")
+	if err := checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
+		return err
+	}
+
+	wasBlocked := t.Blocked
+	if err := change(t); err != nil {
+		return err
+	}
+	t.Updated = time.Now()
+	if err := task.Write(path, t); err != nil {
+		return fmt.Errorf("writing task: %w", err)
+	}
+	logBlockChange(cfg, t, wasBlocked)
+
+	if outputFormat() == output.FormatJSON {
+		t.File = path
+		return output.JSON(os.Stdout, t)
+	}
+	switch {
+	case !t.Blocked:
+		output.Messagef(os.Stdout, "Unblocked task %s: %s", output.FormatID(t.ID), t.Title)
+	case t.BlockedUntil != nil:
+		output.Messagef(os.Stdout, "Blocked task %s until %s: %s", output.FormatID(t.ID), t.BlockedUntil, t.BlockReason)
+	default:
+		output.Messagef(os.Stdout, "Blocked task %s: %s", output.FormatID(t.ID), t.BlockReason)
+	}
+	return nil
+}
+
+// logBlockChange logs a block, an unblock, or a change to a block's reason
+// or date.
+func logBlockChange(cfg *config.Config, t *task.Task, wasBlocked bool) {
+	detail := t.BlockReason
+	if t.BlockedUntil != nil {
+		detail += " (until " + t.BlockedUntil.String() + ")"
+	}
+	if t.BlockedOn != "" {
+		detail += " [on " + t.BlockedOn + "]"
+	}
+	switch {
+	case !t.Blocked:
+		logActivity(cfg, "unblock", t.ID, t.Title)
+	case wasBlocked:
+		logActivity(cfg, "edit", t.ID, "block: "+detail)
+	default:
+		logActivity(cfg, "block", t.ID, detail)
+	}
+}
+
+// warnBlockReviews prompts a review of each task still blocked after the
+// date it was expected to be unblocked.
+func warnBlockReviews(tasks []*task.Task) {
+	today := date.Today()
+	for _, t := range tasks {
+		if task.BlockReviewDue(t, today) {
+			warnf("task %s was expected to be unblocked by %s; review it (unblock %d, or block %d --until DATE)\n",
+				output.FormatID(t.ID), t.BlockedUntil, t.ID, t.ID)
+		}
+	}
+}
+
+// externalTimeout bounds each request made while polling external items.
+const externalTimeout = 30 * time.Second
+
+// githubAPIEnv overrides the GitHub API base URL external items are polled
+// from, for GitHub Enterprise and tests.
+const githubAPIEnv = "KANBAN_GITHUB_API"
+
+// externalCheck is one polled task in the output of unblock --external.
+type externalCheck struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	BlockedOn string `json:"blocked_on"`
+	Closed    bool   `json:"closed"`
+	Unblocked bool   `json:"unblocked"`
+	Skipped   bool   `json:"skipped,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// unblockExternalResult is the JSON output of unblock --external.
+type unblockExternalResult struct {
+	Checked []externalCheck `json:"checked"`
+	DryRun  bool            `json:"dry_run,omitempty"`
+}
+
+func runUnblockExternal(cmd *cobra.Command) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	client := newExternalClient()
+	res := unblockExternalResult{Checked: []externalCheck{}, DryRun: dryRun}
+	for _, t := range tasks {
+		if !t.Blocked || t.BlockedOn == "" {
+			continue
+		}
+		res.Checked = append(res.Checked, checkExternal(cmd.Context(), cfg, client, t, dryRun))
+	}
+	return printUnblockExternalResult(res)
+}
+
+// checkExternal polls t's external item and, unless dryRun, unblocks t if
+// the item has closed.
+func checkExternal(ctx context.Context, cfg *config.Config, client *external.Client, t *task.Task, dryRun bool) externalCheck {
+	check := externalCheck{ID: t.ID, Title: t.Title, BlockedOn: t.BlockedOn}
+	closed, err := client.Closed(ctx, t.BlockedOn)
+	switch {
+	case errors.Is(err, external.ErrUnsupported):
+		verbosef(verboseDecisions, "unblock: skipping #%d, cannot poll %s", t.ID, t.BlockedOn)
+		check.Skipped = true
+		return check
+	case err != nil:
+		warnf("task %s: %v\n", output.FormatID(t.ID), err)
+		check.Error = err.Error()
+		return check
+	}
+	verbosef(verboseDecisions, "unblock: #%d waits on %s, closed=%t", t.ID, t.BlockedOn, closed)
+	check.Closed = closed
+	if !closed || dryRun {
+		return check
+	}
+	task.Unblock(t)
+	t.Updated = time.Now()
+	if err := task.Write(t.File, t); err != nil {
+		warnf("task %s: writing task: %v\n", output.FormatID(t.ID), err)
+		check.Error = fmt.Sprintf("writing task: %v", err)
+		return check
+	}
+	logActivity(cfg, "unblock", t.ID, check.BlockedOn+" closed")
+	check.Unblocked = true
+	return check
+}
+
+// newExternalClient returns a client for the GitHub API, or the one named
+// by KANBAN_GITHUB_API. GITHUB_TOKEN is only sent to GitHub itself.
+func newExternalClient() *external.Client {
+	c := &external.Client{
+		HTTP: &http.Client{Timeout: externalTimeout},
+		API:  external.DefaultGitHubAPI,
+	}
+	if api := os.Getenv(githubAPIEnv); api != "" {
+		c.API = api
+	} else {
+		c.Token = os.Getenv("GITHUB_TOKEN")
+	}
+	return c
+}
+
+func printUnblockExternalResult(res unblockExternalResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	closed := 0
+	for _, c := range res.Checked {
+		if !c.Closed {
+			continue
+		}
+		closed++
+		verb := "Unblocked"
+		switch {
+		case res.DryRun:
+			verb = "Would unblock"
+		case !c.Unblocked:
+			verb = "Failed to unblock"
+		}
+		fmt.Fprintf(os.Stdout, "%s task %s: %s (%s closed)\n", verb, output.FormatID(c.ID), c.Title, c.BlockedOn)
+	}
+	output.Messagef(os.Stdout, "Checked %d external blocker(s), %d closed", len(res.Checked), closed)
+	return nil
+}
@@ -27,7 +27,8 @@ var boardCmd = &cobra.Command{
 	Aliases: []string{"summary"},
 	Short:   "Show board summary",
 	Long: `Displays a summary of the board: task counts per status, WIP utilization,
-blocked and overdue counts, and priority distribution.
+blocked and overdue counts, priority distribution, and the progress of each
+goal in goals.yml.
 
 Use --watch to keep the display live-updating. The board re-renders automatically
 whenever task files change on disk (e.g., from another terminal or an AI agent).
@@ -38,7 +39,8 @@ Press Ctrl+C to stop.`,
 func init() {
 	rootCmd.AddCommand(boardCmd)
 	boardCmd.Flags().BoolVarP(&flagWatch, "watch", "w", false, "live-update the board on file changes")
-	boardCmd.Flags().String("group-by", "", "group board by field ("+strings.Join(board.ValidGroupByFields(), ", ")+")")
+	boardCmd.Flags().String("group-by", "", "group board by one field, or two as a matrix like status,assignee ("+
+		strings.Join(board.ValidGroupByFields(), ", ")+")")
 }
 
 func runBoard(cmd *cobra.Command, _ []string) error {
@@ -48,9 +50,8 @@ func runBoard(cmd *cobra.Command, _ []string) error {
 	}
 
 	groupBy, _ := cmd.Flags().GetString("group-by")
-	if groupBy != "" && !slices.Contains(board.ValidGroupByFields(), groupBy) {
-		return clierr.Newf(clierr.InvalidGroupBy, "invalid --group-by field %q; valid: %s",
-			groupBy, strings.Join(board.ValidGroupByFields(), ", "))
+	if err := validateBoardGroupBy(groupBy); err != nil {
+		return err
 	}
 
 	// Render once.
@@ -66,7 +67,7 @@ func runBoard(cmd *cobra.Command, _ []string) error {
 }
 
 func renderBoard(cfg *config.Config, groupBy string) error {
-	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return err
 	}
@@ -88,6 +89,11 @@ func renderBoard(cfg *config.Config, groupBy string) error {
 	}
 
 	summary := board.Summary(cfg, activeTasks, time.Now())
+	goals, err := cfg.Goals()
+	if err != nil {
+		return err
+	}
+	summary.Goals = board.GoalRollup(cfg, goals, tasks) // archived tasks still count
 
 	format := outputFormat()
 	if format == output.FormatJSON {
@@ -102,7 +108,33 @@ func renderBoard(cfg *config.Config, groupBy string) error {
 	return nil
 }
 
+// validateBoardGroupBy checks a --group-by value of one field or two
+// distinct comma-separated fields.
+func validateBoardGroupBy(groupBy string) error {
+	if groupBy == "" {
+		return nil
+	}
+	fields := strings.Split(groupBy, ",")
+	if len(fields) > 2 { //nolint:mnd // rows and columns
+		return clierr.Newf(clierr.InvalidGroupBy, "--group-by takes at most two fields, got %q", groupBy)
+	}
+	for _, f := range fields {
+		if !slices.Contains(board.ValidGroupByFields(), f) {
+			return clierr.Newf(clierr.InvalidGroupBy, "invalid --group-by field %q; valid: %s",
+				f, strings.Join(board.ValidGroupByFields(), ", "))
+		}
+	}
+	if len(fields) == 2 && fields[0] == fields[1] {
+		return clierr.Newf(clierr.InvalidGroupBy, "--group-by fields must differ, got %q", groupBy)
+	}
+	return nil
+}
+
 func renderGroupedBoard(cfg *config.Config, tasks []*task.Task, groupBy string) error {
+	if rowField, colField, ok := strings.Cut(groupBy, ","); ok {
+		return renderMatrixBoard(cfg, tasks, rowField, colField)
+	}
+
 	grouped := board.GroupBy(tasks, groupBy, cfg)
 
 	if outputFormat() == output.FormatJSON {
@@ -113,34 +145,60 @@ func renderGroupedBoard(cfg *config.Config, tasks []*task.Task, groupBy string)
 	return nil
 }
 
+func renderMatrixBoard(cfg *config.Config, tasks []*task.Task, rowField, colField string) error {
+	matrix := board.GroupByMatrix(tasks, rowField, colField, cfg, time.Now())
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, matrix)
+	case output.FormatCompact:
+		output.MatrixCompact(os.Stdout, matrix)
+	default:
+		output.MatrixTable(os.Stdout, matrix)
+	}
+	return nil
+}
+
 func watchBoard(cfg *config.Config, groupBy string) error {
-	// Watch both the tasks directory and the config file's directory.
-	watchPaths := []string{cfg.TasksPath(), cfg.Dir()}
+	subs, err := newSubscriptionRunner(cfg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "Watching for changes... (Ctrl+C to stop)")
+	return watchBoardFiles(cfg, func(ctx context.Context, freshCfg *config.Config) {
+		clearScreen()
+		if renderErr := renderBoard(freshCfg, groupBy); renderErr != nil {
+			warnf("rendering board: %v\n", renderErr)
+		}
+		subs.check(ctx, freshCfg)
+	})
+}
+
+// watchBoardFiles calls onChange with a freshly loaded config whenever task
+// files or the config change, until interrupted.
+func watchBoardFiles(cfg *config.Config, onChange func(context.Context, *config.Config)) error {
+	// Watch the tasks directories and the config file's directory.
+	watchPaths := append(cfg.ExistingTasksPaths(), cfg.Dir())
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
 	w, err := watcher.New(watchPaths, func() {
-		clearScreen()
 		// Re-load config in case statuses/WIP limits changed.
 		freshCfg, loadErr := config.Load(cfg.Dir())
 		if loadErr != nil {
-			fmt.Fprintf(os.Stderr, "Warning: reloading config: %v\n", loadErr)
+			warnf("reloading config: %v\n", loadErr)
 			freshCfg = cfg
 		}
-		if renderErr := renderBoard(freshCfg, groupBy); renderErr != nil {
-			fmt.Fprintf(os.Stderr, "Warning: rendering board: %v\n", renderErr)
-		}
+		onChange(ctx, freshCfg)
 	})
 	if err != nil {
 		return fmt.Errorf("starting file watcher: %w", err)
 	}
 	defer w.Close()
 
-	fmt.Fprintln(os.Stderr, "Watching for changes... (Ctrl+C to stop)")
-
 	w.Run(ctx, func(watchErr error) {
-		fmt.Fprintf(os.Stderr, "Warning: file watcher: %v\n", watchErr)
+		warnf("file watcher: %v\n", watchErr)
 	})
 
 	return nil
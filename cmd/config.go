@@ -1,17 +1,23 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
 	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
 var configCmd = &cobra.Command{
@@ -46,11 +52,17 @@ type configAccessor struct {
 	get      func(*config.Config) any
 	set      func(*config.Config, string) error
 	writable bool
+	// apply, if set, brings the board in line with a new value before the
+	// config is saved, e.g. moving files. It gets the config before and
+	// after the change.
+	apply func(prev, next *config.Config) error
 }
 
 func configAccessors() map[string]configAccessor {
 	accessors := baseConfigAccessors()
 	addExtendedConfigAccessors(accessors)
+	addLintConfigAccessors(accessors)
+	addReadyConfigAccessors(accessors)
 	return accessors
 }
 
@@ -66,6 +78,26 @@ func baseConfigAccessors() map[string]configAccessor {
 			set:      func(c *config.Config, v string) error { c.Board.Description = v; return nil },
 			writable: true,
 		},
+		"board.readonly": {
+			get: func(c *config.Config) any { return c.Board.ReadOnly },
+			set: func(c *config.Config, v string) error {
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return clierr.Newf(clierr.InvalidInput, "invalid board.readonly %q: must be true or false", v)
+				}
+				c.Board.ReadOnly = b
+				return nil
+			},
+			writable: true,
+		},
+		"board.id_prefix": {
+			get: func(c *config.Config) any { return c.Board.IDPrefix },
+			set: func(c *config.Config, v string) error {
+				c.Board.IDPrefix = v
+				return nil // validation checks the prefix
+			},
+			writable: true,
+		},
 		"statuses": {
 			get: func(c *config.Config) any { return c.StatusNames() },
 		},
@@ -97,7 +129,10 @@ func baseConfigAccessors() map[string]configAccessor {
 			writable: true,
 		},
 		"tasks_dir": {
-			get: func(c *config.Config) any { return c.TasksDir },
+			get:      func(c *config.Config) any { return c.TasksDir },
+			set:      setTasksDir,
+			writable: true,
+			apply:    moveTasksDir,
 		},
 		"next_id": {
 			get: func(c *config.Config) any { return c.NextID },
@@ -133,6 +168,14 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 		},
 		writable: true,
 	}
+	accessors["defaults.auto_status"] = configAccessor{
+		get: func(c *config.Config) any { return c.AutoStatusOrder() },
+		set: func(c *config.Config, v string) error {
+			c.Defaults.AutoStatus = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
 	accessors["claim_timeout"] = configAccessor{
 		get: func(c *config.Config) any { return c.ClaimTimeout },
 		set: func(c *config.Config, v string) error {
@@ -177,6 +220,408 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 	accessors["tui.age_thresholds"] = configAccessor{
 		get: func(c *config.Config) any { return c.TUI.AgeThresholds },
 	}
+	accessors["tui.keys"] = configAccessor{
+		get: func(c *config.Config) any { return c.TUIKeys() },
+	}
+	accessors["tui.collapsed_columns"] = configAccessor{
+		get: func(c *config.Config) any { return c.TUI.CollapsedColumns },
+		set: func(c *config.Config, v string) error {
+			c.TUI.CollapsedColumns = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
+	accessors["dependencies.on_unblock"] = configAccessor{
+		get: func(c *config.Config) any { return c.Dependencies.OnUnblock },
+		set: func(c *config.Config, v string) error {
+			c.Dependencies.OnUnblock = strings.Join(strings.Fields(v), " ")
+			return nil
+		},
+		writable: true,
+	}
+	accessors["display.timezone"] = configAccessor{
+		get: func(c *config.Config) any { return c.Display.Timezone },
+		set: func(c *config.Config, v string) error {
+			c.Display.Timezone = v
+			return nil // validation checks the zone name
+		},
+		writable: true,
+	}
+	accessors["calendar.weekends"] = configAccessor{
+		get: func(c *config.Config) any {
+			if len(c.Calendar.Weekends) == 0 {
+				return config.DefaultWeekends
+			}
+			return c.Calendar.Weekends
+		},
+		set: func(c *config.Config, v string) error {
+			c.Calendar.Weekends = splitConfigList(strings.ToLower(v))
+			return nil // validation checks the day names
+		},
+		writable: true,
+	}
+	accessors["calendar.holidays"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Calendar.Holidays == nil {
+				return []string{}
+			}
+			return c.Calendar.Holidays
+		},
+		set: func(c *config.Config, v string) error {
+			c.Calendar.Holidays = splitConfigList(v)
+			return nil // validation checks the dates
+		},
+		writable: true,
+	}
+	accessors["security.recipients"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Security.Recipients == nil {
+				return []string{}
+			}
+			return c.Security.Recipients
+		},
+		set: func(c *config.Config, v string) error {
+			c.Security.Recipients = splitConfigList(v)
+			return nil // validation rejects mixed age/GPG recipients
+		},
+		writable: true,
+	}
+	accessors["redact.patterns"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Redact.Patterns == nil {
+				return []string{}
+			}
+			return c.Redact.Patterns
+		},
+		set: func(c *config.Config, v string) error {
+			// Patterns containing commas must be set in config.yml.
+			c.Redact.Patterns = splitConfigList(v)
+			return nil // validation compiles the patterns
+		},
+		writable: true,
+	}
+	accessors["redact.fields"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Redact.Fields == nil {
+				return []string{}
+			}
+			return c.Redact.Fields
+		},
+		set: func(c *config.Config, v string) error {
+			c.Redact.Fields = splitConfigList(strings.ToLower(v))
+			return nil // validation checks the field names
+		},
+		writable: true,
+	}
+	accessors["log.sinks"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Log.Sinks == nil {
+				return []config.LogSink{}
+			}
+			return c.Log.Sinks
+		},
+	}
+	accessors["log.ops"] = configAccessor{
+		get: func(c *config.Config) any { return c.Log.Ops },
+		set: func(c *config.Config, v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid log.ops %q: must be true or false", v)
+			}
+			c.Log.Ops = b
+			return nil
+		},
+		writable: true,
+	}
+	accessors["subscriptions"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Subscriptions == nil {
+				return []config.Subscription{}
+			}
+			return c.Subscriptions
+		},
+	}
+	accessors["tags.styles"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Tags.Styles == nil {
+				return map[string]config.TagStyle{}
+			}
+			return c.Tags.Styles
+		},
+		set: func(c *config.Config, v string) error {
+			styles, err := parseTagStyles(v)
+			if err != nil {
+				return err
+			}
+			c.Tags.Styles = styles
+			return nil // validation checks the colors
+		},
+		writable: true,
+	}
+	accessors["estimates.hours_per_day"] = configAccessor{
+		get: func(c *config.Config) any { return c.EstimateHoursPerDay() },
+		set: func(c *config.Config, v string) error {
+			h, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid estimates.hours_per_day %q: must be a number", v)
+			}
+			c.Estimates.HoursPerDay = h
+			return nil // validation handles range check
+		},
+		writable: true,
+	}
+	accessors["tasks_dirs"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.TasksDirs == nil {
+				return []string{}
+			}
+			return c.TasksDirs
+		},
+		set: func(c *config.Config, v string) error {
+			c.TasksDirs = splitConfigList(v)
+			return nil // validation checks the paths
+		},
+		writable: true,
+		apply:    checkDroppedWorkstreams,
+	}
+	accessors["require_estimate_for"] = configAccessor{
+		get: func(c *config.Config) any { return c.RequireEstimateFor },
+		set: func(c *config.Config, v string) error {
+			c.RequireEstimateFor = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
+	accessors["automation.assume_yes"] = configAccessor{
+		get: func(c *config.Config) any { return c.Automation.AssumeYes },
+		set: func(c *config.Config, v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid automation.assume_yes %q: must be true or false", v)
+			}
+			c.Automation.AssumeYes = b
+			return nil
+		},
+		writable: true,
+	}
+	accessors["git.auto_commit"] = configAccessor{
+		get: func(c *config.Config) any { return c.Git.AutoCommit },
+		set: func(c *config.Config, v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid git.auto_commit %q: must be true or false", v)
+			}
+			c.Git.AutoCommit = b
+			return nil
+		},
+		writable: true,
+	}
+	accessors["timestamps.start_on"] = configAccessor{
+		get: func(c *config.Config) any { return c.Timestamps.StartOn },
+		set: func(c *config.Config, v string) error {
+			c.Timestamps.StartOn = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
+	accessors["timestamps.complete_on"] = configAccessor{
+		get: func(c *config.Config) any { return c.Timestamps.CompleteOn },
+		set: func(c *config.Config, v string) error {
+			c.Timestamps.CompleteOn = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
+	accessors["files.naming"] = configAccessor{
+		get: func(c *config.Config) any { return c.FileNaming() },
+		set: func(c *config.Config, v string) error {
+			c.Files.Naming = v
+			return nil // validation checks the scheme
+		},
+		writable: true,
+	}
+	accessors["files.slug_length"] = configAccessor{
+		get: func(c *config.Config) any { return c.SlugLength() },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid files.slug_length %q: must be an integer", v)
+			}
+			c.Files.SlugLength = n
+			return nil // validation handles range check
+		},
+		writable: true,
+	}
+}
+
+func addLintConfigAccessors(accessors map[string]configAccessor) {
+	accessors["lint.title_max_length"] = configAccessor{
+		get: func(c *config.Config) any { return c.LintTitleMaxLength() },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid lint.title_max_length %q: must be an integer", v)
+			}
+			c.Lint.TitleMaxLength = n
+			return nil // validation handles range check
+		},
+		writable: true,
+	}
+	accessors["lint.required_tags"] = configAccessor{
+		get: func(c *config.Config) any { return c.Lint.RequiredTags },
+		set: func(c *config.Config, v string) error {
+			c.Lint.RequiredTags = splitConfigList(v)
+			return nil
+		},
+		writable: true,
+	}
+	accessors["lint.body_required_from"] = configAccessor{
+		get: func(c *config.Config) any { return c.LintBodyRequiredFrom() },
+		set: func(c *config.Config, v string) error {
+			c.Lint.BodyRequiredFrom = v
+			return nil // validation checks the priority
+		},
+		writable: true,
+	}
+	accessors["lint.forbidden_words"] = configAccessor{
+		get: func(c *config.Config) any { return c.Lint.ForbiddenWords },
+		set: func(c *config.Config, v string) error {
+			c.Lint.ForbiddenWords = splitConfigList(v)
+			return nil
+		},
+		writable: true,
+	}
+}
+
+func addReadyConfigAccessors(accessors map[string]configAccessor) {
+	accessors["ready.pull_from"] = configAccessor{
+		get: func(c *config.Config) any { return c.ReadyPullFrom() },
+		set: func(c *config.Config, v string) error {
+			c.Ready.PullFrom = splitConfigList(v)
+			return nil // validation checks the statuses
+		},
+		writable: true,
+	}
+	accessors["ready.target"] = configAccessor{
+		get: func(c *config.Config) any { return c.Ready.Target },
+		set: func(c *config.Config, v string) error {
+			c.Ready.Target = v
+			return nil // validation checks the status
+		},
+		writable: true,
+	}
+}
+
+// splitConfigList parses a comma-separated config value into its trimmed,
+// non-empty items.
+func splitConfigList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseTagStyles parses "tag=color[:icon],..." into tag styles, e.g.
+// "bug=196:🐛,security=#ff0000,docs=:📝". An empty value clears all styles.
+// setTasksDir sets tasks_dir, which must be a subdirectory of the kanban
+// directory.
+func setTasksDir(c *config.Config, v string) error {
+	clean := filepath.Clean(filepath.FromSlash(strings.TrimSpace(v)))
+	if !filepath.IsLocal(clean) || clean == "." {
+		return clierr.Newf(clierr.InvalidInput,
+			"invalid tasks_dir %q; use a subdirectory of the kanban directory, or mv-board to move the board", v)
+	}
+	c.TasksDir = filepath.ToSlash(clean)
+	return nil
+}
+
+// moveTasksDir moves the task files from the old tasks_dir to the new one.
+func moveTasksDir(prev, next *config.Config) error {
+	from, to := prev.TasksPath(), next.TasksPath()
+	if from == to {
+		return nil
+	}
+	if _, err := os.Stat(from); errors.Is(err, os.ErrNotExist) {
+		verbosef(verboseDecisions, "tasks_dir: %s does not exist, nothing to move", from)
+		return nil
+	}
+	if board.Within(to, from) || board.Within(from, to) {
+		return clierr.Newf(clierr.InvalidInput, "tasks_dir %q overlaps the current %q", next.TasksDir, prev.TasksDir)
+	}
+	if err := relocateDir(from, to); err != nil {
+		return err
+	}
+	verbosef(verboseDecisions, "tasks_dir: moved %s to %s", from, to)
+	return nil
+}
+
+// checkDroppedWorkstreams refuses to drop a tasks_dirs entry whose
+// directory still holds tasks, since they would vanish from the board.
+func checkDroppedWorkstreams(prev, next *config.Config) error {
+	kept := next.TasksPaths()
+	for _, dir := range prev.TasksPaths()[1:] {
+		if slices.Contains(kept, dir) {
+			continue
+		}
+		tasks, _, err := task.ReadAllLenient(dir)
+		if err != nil {
+			return err
+		}
+		if len(tasks) > 0 {
+			return clierr.Newf(clierr.InvalidInput,
+				"workstream %q still has %d task(s) in %s; move them first", config.WorkstreamName(dir), len(tasks), dir)
+		}
+	}
+	return nil
+}
+
+func parseTagStyles(v string) (map[string]config.TagStyle, error) {
+	items := splitConfigList(v)
+	if len(items) == 0 {
+		return nil, nil
+	}
+	styles := make(map[string]config.TagStyle, len(items))
+	for _, item := range items {
+		tag, spec, ok := strings.Cut(item, "=")
+		tag = strings.TrimSpace(tag)
+		if !ok || tag == "" {
+			return nil, clierr.Newf(clierr.InvalidInput,
+				"invalid tags.styles entry %q: expected tag=color[:icon]", item)
+		}
+		color, icon, _ := strings.Cut(strings.TrimSpace(spec), ":")
+		styles[tag] = config.TagStyle{Color: strings.TrimSpace(color), Icon: strings.TrimSpace(icon)}
+	}
+	return styles, nil
+}
+
+// formatTagStyles renders tag styles in the "tag=color[:icon]" form accepted
+// by config set, sorted by tag.
+func formatTagStyles(styles map[string]config.TagStyle) string {
+	if len(styles) == 0 {
+		return "--"
+	}
+	tags := make([]string, 0, len(styles))
+	for tag := range styles {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	parts := make([]string, len(tags))
+	for i, tag := range tags {
+		parts[i] = tag + "=" + styles[tag].Color
+		if styles[tag].Icon != "" {
+			parts[i] += ":" + styles[tag].Icon
+		}
+	}
+	return strings.Join(parts, ", ")
 }
 
 // allConfigKeys returns config keys in display order.
@@ -185,18 +630,49 @@ func allConfigKeys() []string {
 		"version",
 		"board.name",
 		"board.description",
+		"board.readonly",
+		"board.id_prefix",
 		"tasks_dir",
+		"tasks_dirs",
 		"statuses",
 		"priorities",
 		"defaults.status",
 		"defaults.priority",
 		"defaults.class",
+		"defaults.auto_status",
 		"wip_limits",
 		"claim_timeout",
 		"classes",
 		"tui.title_lines",
 		"tui.hide_empty_columns",
 		"tui.age_thresholds",
+		"tui.keys",
+		"tui.collapsed_columns",
+		"dependencies.on_unblock",
+		"estimates.hours_per_day",
+		"require_estimate_for",
+		"display.timezone",
+		"calendar.weekends",
+		"calendar.holidays",
+		"tags.styles",
+		"security.recipients",
+		"redact.patterns",
+		"redact.fields",
+		"log.sinks",
+		"log.ops",
+		"subscriptions",
+		"automation.assume_yes",
+		"timestamps.start_on",
+		"timestamps.complete_on",
+		"files.naming",
+		"files.slug_length",
+		"lint.title_max_length",
+		"lint.required_tags",
+		"lint.body_required_from",
+		"lint.forbidden_words",
+		"ready.pull_from",
+		"ready.target",
+		"git.auto_commit",
 		"next_id",
 	}
 }
@@ -263,7 +739,14 @@ func runConfigSet(_ *cobra.Command, args []string) error {
 	if !acc.writable {
 		return clierr.Newf(clierr.InvalidInput, "config key %q is read-only", key)
 	}
+	// board.readonly stays settable on a read-only board so it can be lifted.
+	if key != "board.readonly" || flagReadOnly {
+		if err := checkWritable(cfg); err != nil {
+			return err
+		}
+	}
 
+	prev := *cfg
 	if err := acc.set(cfg, value); err != nil {
 		return err
 	}
@@ -271,6 +754,18 @@ func runConfigSet(_ *cobra.Command, args []string) error {
 	if err := cfg.Validate(); err != nil {
 		return err
 	}
+	if acc.apply != nil {
+		// Hold the board lock until the config is saved so no command sees
+		// files that have moved under the old setting.
+		unlock, err := filelock.Lock(filepath.Join(cfg.Dir(), ".lock"))
+		if err != nil {
+			return fmt.Errorf("acquiring lock: %w", err)
+		}
+		defer unlock() //nolint:errcheck // best-effort unlock on exit
+		if err := acc.apply(&prev, cfg); err != nil {
+			return err
+		}
+	}
 
 	if err := cfg.Save(); err != nil {
 		return fmt.Errorf("saving config: %w", err)
@@ -288,6 +783,8 @@ func formatConfigValue(val any) string {
 	switch v := val.(type) {
 	case []string:
 		return strings.Join(v, ", ")
+	case map[string]config.TagStyle:
+		return formatTagStyles(v)
 	case map[string]int:
 		if len(v) == 0 {
 			return "--"
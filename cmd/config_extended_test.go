@@ -221,3 +221,20 @@ func TestConfigAccessors_GetReadOnlyValues(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigAccessors_SetTasksDir(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	acc := configAccessors()["tasks_dir"]
+
+	if err := acc.set(cfg, "./work/tasks/"); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if cfg.TasksDir != "work/tasks" {
+		t.Errorf("tasks_dir = %q, want %q", cfg.TasksDir, "work/tasks")
+	}
+	for _, bad := range []string{"", ".", "..", "../elsewhere", "/abs/tasks"} {
+		if err := acc.set(cfg, bad); err == nil {
+			t.Errorf("set(%q) succeeded, want error", bad)
+		}
+	}
+}
@@ -27,18 +27,49 @@ func TestAllConfigKeys_ExpectedCoverage(t *testing.T) {
 		"version",
 		"board.name",
 		"board.description",
+		"board.readonly",
+		"board.id_prefix",
 		"tasks_dir",
+		"tasks_dirs",
 		"statuses",
 		"priorities",
 		"defaults.status",
 		"defaults.priority",
 		"defaults.class",
+		"defaults.auto_status",
 		"wip_limits",
 		"claim_timeout",
 		"classes",
 		"tui.title_lines",
 		"tui.hide_empty_columns",
 		"tui.age_thresholds",
+		"tui.keys",
+		"tui.collapsed_columns",
+		"dependencies.on_unblock",
+		"estimates.hours_per_day",
+		"require_estimate_for",
+		"display.timezone",
+		"calendar.weekends",
+		"calendar.holidays",
+		"tags.styles",
+		"security.recipients",
+		"redact.patterns",
+		"redact.fields",
+		"log.sinks",
+		"log.ops",
+		"subscriptions",
+		"automation.assume_yes",
+		"timestamps.start_on",
+		"timestamps.complete_on",
+		"files.naming",
+		"files.slug_length",
+		"lint.title_max_length",
+		"lint.required_tags",
+		"lint.body_required_from",
+		"lint.forbidden_words",
+		"ready.pull_from",
+		"ready.target",
+		"git.auto_commit",
 		"next_id",
 	}
 
@@ -206,10 +237,28 @@ func TestConfigAccessors_SetTUIHideEmptyColumns_Invalid(t *testing.T) {
 	}
 }
 
+func TestConfigAccessors_SetEstimatesHoursPerDay(t *testing.T) {
+	accessors := configAccessors()
+	cfg := config.NewDefault("Test")
+
+	if got := accessors["estimates.hours_per_day"].get(cfg); got != float64(config.DefaultEstimateHoursPerDay) {
+		t.Errorf("default estimates.hours_per_day = %v, want %d", got, config.DefaultEstimateHoursPerDay)
+	}
+	if err := accessors["estimates.hours_per_day"].set(cfg, "6"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Estimates.HoursPerDay != 6 {
+		t.Errorf("estimates.hours_per_day = %v, want 6", cfg.Estimates.HoursPerDay)
+	}
+	if err := accessors["estimates.hours_per_day"].set(cfg, "six"); err == nil {
+		t.Error("expected error for non-numeric hours_per_day")
+	}
+}
+
 func TestConfigAccessors_ReadOnlyKeys(t *testing.T) {
 	accessors := configAccessors()
 	readOnlyKeys := []string{
-		"statuses", "priorities", "tasks_dir", "next_id", "version",
+		"statuses", "priorities", "next_id", "version",
 		"wip_limits", "classes", "tui.age_thresholds",
 	}
 
@@ -230,6 +279,7 @@ func TestConfigAccessors_WritableKeys(t *testing.T) {
 	writableKeys := []string{
 		"board.name", "board.description", "defaults.status", "defaults.priority",
 		"defaults.class", "claim_timeout", "tui.title_lines", "tui.hide_empty_columns",
+		"tasks_dir", "tasks_dirs",
 	}
 
 	for _, key := range writableKeys {
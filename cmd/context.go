@@ -8,6 +8,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
@@ -22,24 +24,41 @@ in CLAUDE.md, AGENTS.md, or other context files.
 
 Use --write-to to write the context to a file. If the file already contains
 a kanban-md context block (delimited by HTML comment markers), only that
-block is replaced — other content is preserved.`,
+block is replaced — other content is preserved.
+
+With --include-bodies each item carries its task's body, so briefings hold
+acceptance criteria inline; --max-body-chars cuts long bodies short.
+
+Matches of redact.patterns and values of redact.fields from the board config
+are replaced with [redacted].`,
 	RunE: runContext,
 }
 
 func init() {
 	contextCmd.Flags().String("write-to", "", "write context to file (create or update in-place)")
-	contextCmd.Flags().StringSlice("sections", nil, "comma-separated section filter (in-progress,blocked,overdue,recently-completed)")
-	contextCmd.Flags().Int("days", defaultContextDays, "recently completed lookback in days")
+	contextCmd.Flags().StringSlice("sections", nil,
+		"comma-separated section filter (in-progress,blocked,overdue,recently-completed; also ready,due-soon)")
+	contextCmd.Flags().Int("days", defaultContextDays, "recently completed lookback and due soon lookahead in days")
+	contextCmd.Flags().Bool("include-bodies", false, "include each task's body")
+	contextCmd.Flags().Int("max-body-chars", 0, "cut bodies to this many characters (0 = no limit; needs --include-bodies)")
 	rootCmd.AddCommand(contextCmd)
 }
 
 func runContext(cmd *cobra.Command, _ []string) error {
+	includeBodies, _ := cmd.Flags().GetBool("include-bodies")
+	maxBodyChars, _ := cmd.Flags().GetInt("max-body-chars")
+	if maxBodyChars < 0 {
+		return clierr.New(clierr.InvalidInput, "--max-body-chars must be >= 0")
+	}
+	if maxBodyChars > 0 && !includeBodies {
+		return clierr.New(clierr.InvalidInput, "--max-body-chars needs --include-bodies")
+	}
 	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return fmt.Errorf("reading tasks: %w", err)
 	}
@@ -53,12 +72,24 @@ func runContext(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
+	if includeBodies {
+		// Private bodies are decrypted for display only; nothing is written back.
+		crypt.Reveal(tasks...)
+	}
+	redactor, err := board.NewRedactor(cfg)
+	if err != nil {
+		return err
+	}
+	tasks = redactor.Tasks(tasks)
+
 	sections, _ := cmd.Flags().GetStringSlice("sections")
 	days, _ := cmd.Flags().GetInt("days")
 
 	opts := board.ContextOptions{
-		Sections: sections,
-		Days:     days,
+		Sections:      sections,
+		Days:          days,
+		IncludeBodies: includeBodies,
+		MaxBodyChars:  maxBodyChars,
 	}
 
 	data := board.GenerateContext(cfg, tasks, opts, time.Now())
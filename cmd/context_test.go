@@ -18,6 +18,8 @@ func newContextCmd() *cobra.Command {
 	cmd.Flags().String("write-to", "", "")
 	cmd.Flags().StringSlice("sections", nil, "")
 	cmd.Flags().Int("days", defaultContextDays, "")
+	cmd.Flags().Bool("include-bodies", false, "")
+	cmd.Flags().Int("max-body-chars", 0, "")
 	return cmd
 }
 
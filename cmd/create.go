@@ -1,16 +1,22 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/date"
@@ -26,14 +32,19 @@ var createCmd = &cobra.Command{
 	Long: `Creates a new task file with the given title and optional fields.
 
 Title can be provided as a positional argument or via --title flag.
-Body/description can be provided via --body or --description flag.`,
+Body/description can be provided via --body or --description flag.
+
+With --json-stdin, the task is read from stdin as JSON in the shape show
+--json prints. Fields the board assigns (id, uid, created, updated, started,
+completed, file) and computed ones are ignored, so a shown task can be fed
+back in. Flags and a positional title override the JSON.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runCreate,
 }
 
 func init() {
 	createCmd.Flags().String("title", "", "task title (alternative to positional argument)")
-	createCmd.Flags().String("status", "", "task status (default from config)")
+	createCmd.Flags().String("status", "", "task status (default from config), or 'auto' for the first status with WIP room")
 	createCmd.Flags().String("priority", "", "task priority (default from config)")
 	createCmd.Flags().String("assignee", "", "task assignee")
 	createCmd.Flags().StringSlice("tags", nil, "comma-separated tags")
@@ -46,17 +57,30 @@ func init() {
 		}
 		return pflag.NormalizedName(name)
 	})
-	createCmd.Flags().String("due", "", "due date (YYYY-MM-DD)")
+	createCmd.Flags().String("due", "", "due date (YYYY-MM-DD, or e.g. tomorrow, next friday, +2w)")
 	createCmd.Flags().String("estimate", "", "time estimate (e.g. 4h, 2d)")
-	createCmd.Flags().Int("parent", 0, "parent task ID")
-	createCmd.Flags().IntSlice("depends-on", nil, "dependency task IDs (comma-separated)")
+	createCmd.Flags().String("parent", "", "parent task ID")
+	createCmd.Flags().StringSlice("depends-on", nil, "dependency task IDs (comma-separated)")
 	createCmd.Flags().String("body", "", "task body/description (markdown)")
+	createCmd.Flags().String("template", "", "start the body from a template in config templates")
+	createCmd.Flags().Bool("private", false, "encrypt the task body to security.recipients")
 	createCmd.Flags().String("class", "", "class of service (expedite, fixed-date, standard, intangible)")
 	createCmd.Flags().String("claim", "", "claim task for an agent (use 'agent-name' to generate)")
+	createCmd.Flags().String("workstream", "", "create the task in this workstream (a tasks_dirs entry)")
+	createCmd.Flags().String("for-path", "", "repository path the task is about; applies the matching owners rule")
+	createCmd.Flags().Bool("json-stdin", false, "read the task as JSON from stdin (the shape show --json prints)")
 	rootCmd.AddCommand(createCmd)
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
+	var in *createInput
+	if jsonStdin, _ := cmd.Flags().GetBool("json-stdin"); jsonStdin {
+		var err error
+		if in, err = readCreateJSON(os.Stdin); err != nil {
+			return err
+		}
+	}
+
 	// Acquire an exclusive lock to prevent concurrent creates from
 	// reading the same next_id and generating duplicate task IDs.
 	dir, err := resolveDir()
@@ -69,27 +93,42 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	}
 	defer unlock() //nolint:errcheck // best-effort unlock on exit
 
-	cfg, err := loadConfig()
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
 
-	// Defense-in-depth: scan existing task files to find the actual max ID.
-	// If NextID is stale (crash, manual edit, concurrent TUI create), bump it.
-	maxID, err := task.MaxIDFromFiles(cfg.TasksPath())
+	t, err := executeCreateFrom(cfg, cmd, args, in)
 	if err != nil {
-		return fmt.Errorf("scanning task files: %w", err)
-	}
-	if maxID >= cfg.NextID {
-		cfg.NextID = maxID + 1
+		return err
 	}
-	title, err := resolveCreateTitle(cmd, args)
+	return outputCreateResult(t, t.File)
+}
+
+// executeCreate performs the core create: build, validate, write, bump
+// next_id, log. The caller must hold the board lock.
+func executeCreate(cfg *config.Config, cmd *cobra.Command, args []string) (*task.Task, error) {
+	return executeCreateFrom(cfg, cmd, args, nil)
+}
+
+// executeCreateFrom is executeCreate starting from the fields of in, if any,
+// which flags then override.
+func executeCreateFrom(cfg *config.Config, cmd *cobra.Command, args []string, in *createInput) (*task.Task, error) {
+	// Defense-in-depth: NextID may be stale after a crash or manual edit.
+	err := board.SyncNextID(cfg)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var title string
+	if in != nil && in.task.Title != "" && len(args) == 0 && !cmd.Flags().Changed("title") {
+		title = in.task.Title
+	} else if title, err = resolveCreateTitle(cmd, args); err != nil {
+		return nil, err
 	}
 	now := time.Now()
 	t := &task.Task{
 		ID:       cfg.NextID,
+		UID:      task.NewUID(),
 		Title:    title,
 		Status:   cfg.Defaults.Status,
 		Priority: cfg.Defaults.Priority,
@@ -97,46 +136,80 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		Created:  now,
 		Updated:  now,
 	}
+	tasksDir, err := applyCreateWorkstream(cmd, t, cfg, in)
+	if err != nil {
+		return nil, err
+	}
 
+	if in != nil {
+		if err := applyCreateInput(t, in, cfg); err != nil {
+			return nil, err
+		}
+	}
 	if err := applyCreateFlags(cmd, t, cfg); err != nil {
-		return err
+		return nil, err
+	}
+	if err := applyOwnerDefaults(cmd, t, cfg, in.given()); err != nil {
+		return nil, err
+	}
+	if err := applyTagDefaults(cmd, t, cfg, in.given()); err != nil {
+		return nil, err
+	}
+	if isAutoStatus(cmd, cfg) {
+		if t.Status, err = resolveAutoStatus(cfg, t); err != nil {
+			return nil, err
+		}
 	}
 
+	if err := checkArchiveGuard(cfg, t.Status); err != nil {
+		return nil, err
+	}
 	// Validate dependency references.
 	if err := validateDeps(cfg, t); err != nil {
-		return err
+		return nil, err
+	}
+	task.RecordStatus(t, t.Status, t.Created)
+	task.RecordAssigned(t, t.Created)
+	task.ApplyChecklist(t, cfg)
+	if private, _ := cmd.Flags().GetBool("private"); private || t.Private {
+		if err := sealBody(cfg, t); err != nil {
+			return nil, err
+		}
 	}
 
 	// Check WIP limit for the target status (class-aware).
 	if t.Class != "" && len(cfg.Classes) > 0 {
 		if err := enforceWIPLimitForClass(cfg, t, "", t.Status); err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		if err := enforceWIPLimit(cfg, "", t.Status); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// Generate filename and write.
-	slug := task.GenerateSlug(title)
-	filename := task.GenerateFilename(t.ID, slug)
-	path := filepath.Join(cfg.TasksPath(), filename)
+	path := filepath.Join(tasksDir, task.Filename(cfg, t))
 	t.File = path
 
+	if err := os.MkdirAll(tasksDir, tasksDirMode); err != nil {
+		return nil, fmt.Errorf("creating tasks directory: %w", err)
+	}
 	if err := task.Write(path, t); err != nil {
-		return fmt.Errorf("writing task: %w", err)
+		return nil, fmt.Errorf("writing task: %w", err)
 	}
 
 	// Increment next_id and save config.
 	cfg.NextID++
 	if err := cfg.Save(); err != nil {
-		return fmt.Errorf("saving config: %w", err)
+		return nil, fmt.Errorf("saving config: %w", err)
 	}
 
 	logActivity(cfg, "create", t.ID, t.Title)
-
-	return outputCreateResult(t, path)
+	if t.ClaimedBy != "" {
+		logActivity(cfg, "claim", t.ID, t.ClaimedBy)
+	}
+	return t, nil
 }
 
 func outputCreateResult(t *task.Task, path string) error {
@@ -144,7 +217,7 @@ func outputCreateResult(t *task.Task, path string) error {
 		return output.JSON(os.Stdout, t)
 	}
 
-	output.Messagef(os.Stdout, "Created task #%d: %s", t.ID, t.Title)
+	output.Messagef(os.Stdout, "Created task %s: %s", output.FormatID(t.ID), t.Title)
 	output.Messagef(os.Stdout, "  File: %s", path)
 	output.Messagef(os.Stdout, "  Status: %s | Priority: %s", t.Status, t.Priority)
 	if t.Assignee != "" {
@@ -186,7 +259,7 @@ func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) erro
 		t.Tags = v
 	}
 	if v, _ := cmd.Flags().GetString("due"); v != "" {
-		d, err := date.Parse(v)
+		d, err := date.ParseNatural(v)
 		if err != nil {
 			return task.FormatDueDate(v, err)
 		}
@@ -196,11 +269,18 @@ func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) erro
 		t.Estimate = v
 	}
 	if cmd.Flags().Changed("parent") {
-		v, _ := cmd.Flags().GetInt("parent")
+		v, err := idFlag(cmd, "parent")
+		if err != nil {
+			return err
+		}
 		t.Parent = &v
 	}
-	if v, _ := cmd.Flags().GetIntSlice("depends-on"); len(v) > 0 {
-		t.DependsOn = v
+	deps, err := idListFlag(cmd, "depends-on")
+	if err != nil {
+		return err
+	}
+	if len(deps) > 0 {
+		t.DependsOn = deps
 	}
 	if v, _ := cmd.Flags().GetString("body"); v != "" {
 		t.Body = v
@@ -213,9 +293,147 @@ func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) erro
 	return nil
 }
 
+// tasksDirMode is the mode of workstream directories create makes.
+const tasksDirMode = 0o750
+
+// applyCreateWorkstream puts t in the workstream from --workstream or the
+// JSON input and applies that workstream's defaults over the board
+// defaults. It returns the directory the task file goes in.
+func applyCreateWorkstream(cmd *cobra.Command, t *task.Task, cfg *config.Config, in *createInput) (string, error) {
+	ws, _ := cmd.Flags().GetString("workstream")
+	if ws == "" && in != nil {
+		ws = in.task.Workstream
+	}
+	dir, err := workstreamPath(cfg, ws)
+	if err != nil {
+		return "", err
+	}
+	t.Workstream = ws
+	d := cfg.WorkstreamDefaults[ws]
+	if d.Status != "" {
+		t.Status = d.Status
+	}
+	if d.Priority != "" {
+		t.Priority = d.Priority
+	}
+	if d.Class != "" {
+		t.Class = d.Class
+	}
+	if d.Assignee != "" {
+		t.Assignee = d.Assignee
+	}
+	return dir, nil
+}
+
+// applyOwnerDefaults applies the owners rule matching --for-path: its
+// assignee unless one was given, and its tags added to the task's. The
+// added tags then pick up their tag defaults.
+func applyOwnerDefaults(cmd *cobra.Command, t *task.Task, cfg *config.Config, given map[string]bool) error {
+	p, _ := cmd.Flags().GetString("for-path")
+	if p == "" {
+		return nil
+	}
+	rel, err := ownerPath(cfg, p)
+	if err != nil {
+		return err
+	}
+	rule, ok := cfg.OwnerFor(rel)
+	if !ok {
+		verbosef(verboseDecisions, "owners: no rule matches %s", rel)
+		return nil
+	}
+	verbosef(verboseDecisions, "owners: %s matches %q", rel, rule.Path)
+	if rule.Assignee != "" && !cmd.Flags().Changed("assignee") && !given["assignee"] {
+		t.Assignee = rule.Assignee
+	}
+	for _, tag := range rule.Tags {
+		if !slices.Contains(t.Tags, tag) {
+			t.Tags = append(t.Tags, tag)
+		}
+	}
+	return nil
+}
+
+// ownerPath turns p into a slash-separated path relative to the repository
+// root, the directory holding the kanban directory. Relative paths are
+// already taken to be relative to it, as in CODEOWNERS.
+func ownerPath(cfg *config.Config, p string) (string, error) {
+	root := filepath.Dir(cfg.Dir())
+	rel := filepath.Clean(p)
+	if filepath.IsAbs(p) {
+		rel, _ = filepath.Rel(root, p) // on error rel is "", which is not local
+	}
+	if !filepath.IsLocal(rel) {
+		return "", clierr.Newf(clierr.InvalidInput, "--for-path %s is outside the repository", p).
+			WithDetails(map[string]any{"path": p, "root": root})
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// applyTagDefaults fills fields from config tag_defaults for the task's
+// tags, then the body from --template or a tag's template. Explicit flags
+// and the given JSON fields always win; among tags, the first one listed
+// sets a field.
+func applyTagDefaults(cmd *cobra.Command, t *task.Task, cfg *config.Config, given map[string]bool) error {
+	filled := map[string]bool{}
+	fill := func(flag, value string, field *string) {
+		if value != "" && !filled[flag] && !cmd.Flags().Changed(flag) && !given[flag] {
+			*field = value
+			filled[flag] = true
+		}
+	}
+	template, _ := cmd.Flags().GetString("template")
+	explicitTemplate := template != ""
+	for _, tag := range t.Tags {
+		d, ok := cfg.TagDefaults[tag]
+		if !ok {
+			continue
+		}
+		fill("priority", d.Priority, &t.Priority)
+		fill("class", d.Class, &t.Class)
+		fill("assignee", d.Assignee, &t.Assignee)
+		if template == "" {
+			template = d.Template
+		}
+	}
+	if _, ok := cfg.Templates[template]; explicitTemplate && !ok {
+		return clierr.Newf(clierr.InvalidInput, "unknown template %q", template).
+			WithDetails(map[string]any{"templates": slices.Sorted(maps.Keys(cfg.Templates))})
+	}
+	if template != "" && !cmd.Flags().Changed("body") && !given["body"] {
+		t.Body = cfg.Templates[template]
+	}
+	return nil
+}
+
+// autoStatusKeyword selects WIP-aware placement with create --status. A
+// configured status of the same name takes precedence.
+const autoStatusKeyword = "auto"
+
+func isAutoStatus(cmd *cobra.Command, cfg *config.Config) bool {
+	v, _ := cmd.Flags().GetString("status")
+	return v == autoStatusKeyword && config.IndexOf(cfg.StatusNames(), v) < 0
+}
+
+// resolveAutoStatus picks the first status in the auto search order with
+// room under its WIP limit. Classes that bypass column limits take the
+// first status outright.
+func resolveAutoStatus(cfg *config.Config, t *task.Task) (string, error) {
+	if cc := cfg.ClassByName(t.Class); cc != nil && cc.BypassColumnWIP {
+		if order := cfg.AutoStatusOrder(); len(order) > 0 {
+			return order[0], nil
+		}
+	}
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return "", fmt.Errorf("reading tasks for WIP check: %w", err)
+	}
+	return board.AutoStatus(cfg, board.CountByStatus(allTasks))
+}
+
 // applyCreateValidatedFlags handles flags that require validation against config.
 func applyCreateValidatedFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) error {
-	if v, _ := cmd.Flags().GetString("status"); v != "" {
+	if v, _ := cmd.Flags().GetString("status"); v != "" && !isAutoStatus(cmd, cfg) {
 		if err := task.ValidateStatus(v, cfg.StatusNames()); err != nil {
 			return err
 		}
@@ -235,3 +453,105 @@ func applyCreateValidatedFlags(cmd *cobra.Command, t *task.Task, cfg *config.Con
 	}
 	return nil
 }
+
+// createInput is a task read by create --json-stdin, with the JSON fields
+// it set.
+type createInput struct {
+	task   *task.Task
+	fields map[string]bool
+}
+
+// given returns the JSON fields in set, or none for a nil input.
+func (in *createInput) given() map[string]bool {
+	if in == nil {
+		return nil
+	}
+	return in.fields
+}
+
+// createJSONIgnored are the fields of show --json that create --json-stdin
+// skips: the board assigns them or computes them.
+var createJSONIgnored = []string{
+	"id", "uid", "created", "updated", "started", "completed", "reopened", "assigned", "file",
+	"blocked_by_dependency", "ready", "progress", "status_history", "focus_until", "worklog",
+}
+
+// readCreateJSON decodes a task from r. Ignored fields are dropped; any
+// other field a task does not have is an error.
+func readCreateJSON(r io.Reader) (*createInput, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading stdin: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid task JSON on stdin: %v", err)
+	}
+	for _, k := range createJSONIgnored {
+		delete(fields, k)
+	}
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var t task.Task
+	if err := dec.Decode(&t); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid task JSON on stdin: %v", err)
+	}
+	in := &createInput{task: &t, fields: make(map[string]bool, len(fields))}
+	for k := range fields {
+		in.fields[k] = true
+	}
+	return in, nil
+}
+
+// applyCreateInput copies the fields of a --json-stdin task onto t,
+// validating those checked against the config.
+func applyCreateInput(t *task.Task, in *createInput, cfg *config.Config) error {
+	src := in.task
+	if src.Status != "" {
+		if err := task.ValidateStatus(src.Status, cfg.StatusNames()); err != nil {
+			return err
+		}
+		t.Status = src.Status
+	}
+	if src.Priority != "" {
+		if err := task.ValidatePriority(src.Priority, cfg.Priorities); err != nil {
+			return err
+		}
+		t.Priority = src.Priority
+	}
+	if src.Class != "" {
+		if err := task.ValidateClass(src.Class, cfg.ClassNames()); err != nil {
+			return err
+		}
+		t.Class = src.Class
+	}
+	t.Assignee = src.Assignee
+	t.Reviewer = src.Reviewer
+	t.Tags = src.Tags
+	t.Due = src.Due
+	t.Estimate = src.Estimate
+	t.Parent = src.Parent
+	t.DependsOn = src.DependsOn
+	t.Blocked = src.Blocked
+	t.BlockReason = src.BlockReason
+	t.BlockedUntil = src.BlockedUntil
+	t.BlockedOn = src.BlockedOn
+	t.Branch = src.Branch
+	t.Worktree = src.Worktree
+	t.Private = src.Private
+	t.Body = src.Body
+	if src.ClaimedBy != "" {
+		t.ClaimedBy = src.ClaimedBy
+		t.ClaimedAt = src.ClaimedAt
+		if t.ClaimedAt == nil {
+			now := time.Now()
+			t.ClaimedAt = &now
+		}
+	}
+	return nil
+}
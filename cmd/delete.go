@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,28 +23,59 @@ var deleteCmd = &cobra.Command{
 	Aliases: []string{"rm"},
 	Short:   "Delete a task",
 	Long: `Soft-deletes a task by moving it to archived status. Prompts for confirmation in interactive mode.
-Multiple IDs can be provided as a comma-separated list (requires --yes).`,
+Multiple IDs can be provided as a comma-separated list (requires --yes).
+
+A task with children cannot be deleted on its own: use --cascade to archive
+its whole subtree with it, or --orphan to clear the children's parent.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDelete,
 }
 
 func init() {
 	deleteCmd.Flags().BoolP("yes", "y", false, "skip confirmation prompt")
+	deleteCmd.Flags().Bool("cascade", false, "also archive the task's children and their descendants")
+	deleteCmd.Flags().Bool("orphan", false, "clear the parent of the task's children")
+	deleteCmd.MarkFlagsMutuallyExclusive("cascade", "orphan")
 	rootCmd.AddCommand(deleteCmd)
 }
 
+// childPolicy says what delete does with the children of a task.
+type childPolicy int
+
+const (
+	childrenRefuse  childPolicy = iota // fail while the task has children
+	childrenCascade                    // archive the whole subtree
+	childrenOrphan                     // clear the children's parent
+)
+
+// deleteChildPolicy returns the child policy the delete flags select.
+func deleteChildPolicy(cmd *cobra.Command) childPolicy {
+	if cascade, _ := cmd.Flags().GetBool("cascade"); cascade {
+		return childrenCascade
+	}
+	if orphan, _ := cmd.Flags().GetBool("orphan"); orphan {
+		return childrenOrphan
+	}
+	return childrenRefuse
+}
+
 func runDelete(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig()
+	ids, err := parseIDs(args[0])
 	if err != nil {
 		return err
 	}
 
 	yes, _ := cmd.Flags().GetBool("yes")
+	yes = yes || assumeYes(cfg)
+	policy := deleteChildPolicy(cmd)
 
 	// Batch mode requires --yes.
 	if len(ids) > 1 && !yes {
@@ -53,23 +85,34 @@ func runDelete(cmd *cobra.Command, args []string) error {
 
 	// Single ID: preserve exact current behavior.
 	if len(ids) == 1 {
-		return deleteSingleTask(cfg, ids[0], yes)
+		return deleteSingleTask(cfg, ids[0], yes, policy)
 	}
 
 	// Batch mode (yes is guaranteed true here).
 	return runBatch(ids, func(id int) error {
-		return executeDelete(cfg, id)
+		return executeDelete(cfg, id, policy)
 	})
 }
 
+// assumeYes reports whether automation.assume_yes answers confirmations.
+// It only applies when stdin is not a terminal, so people at a terminal
+// still get the prompt.
+func assumeYes(cfg *config.Config) bool {
+	if !cfg.Automation.AssumeYes || isInteractive() {
+		return false
+	}
+	verbosef(verboseDecisions, "confirmation: assumed yes (automation.assume_yes, stdin is not a terminal)")
+	return true
+}
+
 // deleteSingleTask handles a single task delete with confirmation and output.
-func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
-	path, err := task.FindByID(cfg.TasksPath(), id)
+func deleteSingleTask(cfg *config.Config, id int, yes bool, policy childPolicy) error {
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return err
 	}
@@ -78,9 +121,13 @@ func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
 	if err = checkClaim(t, "", cfg.ClaimTimeoutDuration()); err != nil {
 		return err
 	}
+	affected, err := planChildren(cfg, t, policy)
+	if err != nil {
+		return err
+	}
 
 	// Warn if other tasks reference this one as a dependency or parent.
-	warnDependents(cfg.TasksPath(), t.ID)
+	warnDependents(cfg.TasksPaths(), t.ID)
 
 	// Require confirmation in TTY mode unless --yes.
 	if !yes {
@@ -88,7 +135,11 @@ func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
 			return clierr.New(clierr.ConfirmationReq,
 				"cannot prompt for confirmation (not a terminal); use --yes")
 		}
-		fmt.Fprintf(os.Stderr, "Delete task #%d %q? [y/N] ", t.ID, t.Title)
+		prompt := fmt.Sprintf("Delete task %s %q", output.FormatID(t.ID), t.Title)
+		if policy == childrenCascade && len(affected) > 0 {
+			prompt += fmt.Sprintf(" and %d task(s) below it", len(affected))
+		}
+		fmt.Fprintf(os.Stderr, "%s? [y/N] ", prompt)
 		reader := bufio.NewReader(os.Stdin)
 		answer, _ := reader.ReadString('\n')
 		answer = strings.TrimSpace(strings.ToLower(answer))
@@ -98,30 +149,57 @@ func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
 		}
 	}
 
+	if err := applyChildren(cfg, t, affected, policy); err != nil {
+		return err
+	}
 	if err := softDeleteAndLog(cfg, path, t); err != nil {
 		return err
 	}
+	return printDeleteResult(t, affected, policy)
+}
 
+// printDeleteResult reports a deleted task, with the tasks its child policy
+// archived or detached.
+func printDeleteResult(t *task.Task, affected []*task.Task, policy childPolicy) error {
+	ids := make([]int, 0, len(affected))
+	for _, a := range affected {
+		ids = append(ids, a.ID)
+	}
 	if outputFormat() == output.FormatJSON {
-		return output.JSON(os.Stdout, map[string]interface{}{
+		result := map[string]interface{}{
 			"status": "deleted",
 			"id":     t.ID,
 			"title":  t.Title,
-		})
+		}
+		switch {
+		case len(ids) == 0:
+		case policy == childrenCascade:
+			result["archived"] = ids
+		case policy == childrenOrphan:
+			result["orphaned"] = ids
+		}
+		return output.JSON(os.Stdout, result)
 	}
 
-	output.Messagef(os.Stdout, "Deleted task #%d: %s", t.ID, t.Title)
+	output.Messagef(os.Stdout, "Deleted task %s: %s", output.FormatID(t.ID), t.Title)
+	switch {
+	case len(ids) == 0:
+	case policy == childrenCascade:
+		output.Messagef(os.Stdout, "Also archived %d task(s) below it: %s", len(ids), output.FormatIDList(ids))
+	case policy == childrenOrphan:
+		output.Messagef(os.Stdout, "Cleared the parent of %d task(s): %s", len(ids), output.FormatIDList(ids))
+	}
 	return nil
 }
 
-// executeDelete performs the core delete: find, read, claim check, warn dependents, remove, log.
-func executeDelete(cfg *config.Config, id int) error {
-	path, err := task.FindByID(cfg.TasksPath(), id)
+// executeDelete performs the core delete: find, read, claim check, children, warn dependents, remove, log.
+func executeDelete(cfg *config.Config, id int, policy childPolicy) error {
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return err
 	}
@@ -129,11 +207,73 @@ func executeDelete(cfg *config.Config, id int) error {
 	if err = checkClaim(t, "", cfg.ClaimTimeoutDuration()); err != nil {
 		return err
 	}
+	affected, err := planChildren(cfg, t, policy)
+	if err != nil {
+		return err
+	}
 
-	warnDependents(cfg.TasksPath(), t.ID)
+	warnDependents(cfg.TasksPaths(), t.ID)
+	if err := applyChildren(cfg, t, affected, policy); err != nil {
+		return err
+	}
 	return softDeleteAndLog(cfg, path, t)
 }
 
+// planChildren returns the tasks deleting t affects under policy: the
+// children to detach, or the whole subtree to archive. Refusing fails when
+// t has children; cascading fails when any task in the subtree is claimed
+// by someone else. Nothing is written.
+func planChildren(cfg *config.Config, t *task.Task, policy childPolicy) ([]*task.Task, error) {
+	if t.Status == config.ArchivedStatus {
+		return nil, nil
+	}
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return nil, err
+	}
+	switch policy {
+	case childrenCascade:
+		subtree := board.Descendants(allTasks, t.ID)
+		for _, d := range subtree {
+			if err := checkClaim(d, "", cfg.ClaimTimeoutDuration()); err != nil {
+				return nil, err
+			}
+		}
+		return subtree, nil
+	case childrenOrphan:
+		return board.Children(allTasks, t.ID), nil
+	default:
+		if kids := board.Children(allTasks, t.ID); len(kids) > 0 {
+			ids := make([]int, 0, len(kids))
+			for _, k := range kids {
+				ids = append(ids, k.ID)
+			}
+			return nil, task.ValidateHasChildren(t.ID, ids)
+		}
+		return nil, nil
+	}
+}
+
+// applyChildren archives or detaches the tasks planChildren returned.
+func applyChildren(cfg *config.Config, t *task.Task, affected []*task.Task, policy childPolicy) error {
+	now := time.Now()
+	for _, a := range affected {
+		if policy == childrenCascade {
+			if err := softDeleteAndLog(cfg, a.File, a); err != nil {
+				return fmt.Errorf("archiving task #%d: %w", a.ID, err)
+			}
+			continue
+		}
+		a.Parent = nil
+		a.Updated = now
+		if err := task.Write(a.File, a); err != nil {
+			return fmt.Errorf("writing task #%d: %w", a.ID, err)
+		}
+		logActivity(cfg, "reparent", a.ID, "#"+strconv.Itoa(t.ID)+" -> none")
+	}
+	return nil
+}
+
 // softDeleteAndLog archives the task and logs the delete action.
 func softDeleteAndLog(cfg *config.Config, path string, t *task.Task) error {
 	if t.Status == config.ArchivedStatus {
@@ -153,9 +293,9 @@ func softDeleteAndLog(cfg *config.Config, path string, t *task.Task) error {
 	return nil
 }
 
-func warnDependents(tasksDir string, id int) {
-	dependents := board.FindDependents(tasksDir, id)
+func warnDependents(tasksDirs []string, id int) {
+	dependents := board.FindDependentsIn(tasksDirs, id)
 	for _, msg := range dependents {
-		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+		warnf("%s\n", msg)
 	}
 }
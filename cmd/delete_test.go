@@ -44,7 +44,7 @@ func TestExecuteDelete_BasicDelete(t *testing.T) {
 		Updated:  now,
 	})
 
-	err = executeDelete(cfg, 1)
+	err = executeDelete(cfg, 1, childrenRefuse)
 	if err != nil {
 		t.Fatalf("executeDelete error: %v", err)
 	}
@@ -70,7 +70,7 @@ func TestExecuteDelete_TaskNotFound(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = executeDelete(cfg, 999)
+	err = executeDelete(cfg, 999, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error for non-existent task")
 	}
@@ -96,7 +96,7 @@ func TestExecuteDelete_ClaimedByOtherAgent(t *testing.T) {
 	})
 
 	// executeDelete passes empty claimant, so any active claim blocks delete.
-	err = executeDelete(cfg, 1)
+	err = executeDelete(cfg, 1, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error for claimed task")
 	}
@@ -128,7 +128,7 @@ func TestExecuteDelete_ExpiredClaimAllows(t *testing.T) {
 		Updated:   past,
 	})
 
-	err = executeDelete(cfg, 1)
+	err = executeDelete(cfg, 1, childrenRefuse)
 	if err != nil {
 		t.Fatalf("expected expired claim to allow delete, got: %v", err)
 	}
@@ -156,7 +156,7 @@ func TestDeleteSingleTask_WithYesTableOutput(t *testing.T) {
 	setFlags(t, false, true, false)
 	r, w := captureStdout(t)
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	got := drainPipe(t, r, w)
 
 	if err != nil {
@@ -187,7 +187,7 @@ func TestDeleteSingleTask_WithYesJSONOutput(t *testing.T) {
 	setFlags(t, true, false, false)
 	r, w := captureStdout(t)
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	got := drainPipe(t, r, w)
 
 	if err != nil {
@@ -208,7 +208,7 @@ func TestDeleteSingleTask_TaskNotFound(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = deleteSingleTask(cfg, 999, true)
+	err = deleteSingleTask(cfg, 999, true, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error for non-existent task")
 	}
@@ -233,7 +233,7 @@ func TestDeleteSingleTask_ClaimedTaskBlocked(t *testing.T) {
 		Updated:   now,
 	})
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error for claimed task")
 	}
@@ -264,7 +264,7 @@ func TestDeleteSingleTask_NonTTYRequiresYes(t *testing.T) {
 	})
 
 	// Without --yes and in non-TTY (test environment), should fail.
-	err = deleteSingleTask(cfg, 1, false)
+	err = deleteSingleTask(cfg, 1, false, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error for non-TTY without --yes")
 	}
@@ -372,7 +372,7 @@ func TestWarnDependents_NoDependents(t *testing.T) {
 	})
 
 	rErr, wErr := captureStderr(t)
-	warnDependents(cfg.TasksPath(), 1)
+	warnDependents(cfg.TasksPaths(), 1)
 	got := drainPipe(t, rErr, wErr)
 
 	if containsSubstring(got, "Warning") {
@@ -408,7 +408,7 @@ func TestWarnDependents_WithParentReference(t *testing.T) {
 	})
 
 	rErr, wErr := captureStderr(t)
-	warnDependents(cfg.TasksPath(), 1)
+	warnDependents(cfg.TasksPaths(), 1)
 	got := drainPipe(t, rErr, wErr)
 
 	if !containsSubstring(got, "Warning") {
@@ -446,7 +446,7 @@ func TestWarnDependents_WithDependsOnReference(t *testing.T) {
 	})
 
 	rErr, wErr := captureStderr(t)
-	warnDependents(cfg.TasksPath(), 1)
+	warnDependents(cfg.TasksPaths(), 1)
 	got := drainPipe(t, rErr, wErr)
 
 	if !containsSubstring(got, "Warning") {
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var depsCmd = &cobra.Command{
+	Use:   "deps",
+	Short: "Analyze the task dependency graph",
+	Long:  `Commands for inspecting dependencies between tasks.`,
+}
+
+var depsCriticalPathCmd = &cobra.Command{
+	Use:   "critical-path",
+	Short: "Show the longest chain of remaining work",
+	Long: `Reports the chain of unfinished tasks, linked by depends_on, with the largest
+total estimate. These are the items that gate overall delivery.
+
+Estimates use working time: 1d = estimates.hours_per_day (default 8h), 1w = 5d. Tasks without a parseable
+estimate count as zero and are listed as unestimated.`,
+	Args: cobra.NoArgs,
+	RunE: runDepsCriticalPath,
+}
+
+func init() {
+	depsCmd.AddCommand(depsCriticalPathCmd)
+	rootCmd.AddCommand(depsCmd)
+}
+
+func runDepsCriticalPath(_ *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	cp := board.ComputeCriticalPath(cfg, tasks)
+
+	format := outputFormat()
+	if format == output.FormatJSON {
+		return output.JSON(os.Stdout, cp)
+	}
+	if format == output.FormatCompact {
+		output.CriticalPathCompact(os.Stdout, cp)
+		return nil
+	}
+
+	output.CriticalPathTable(os.Stdout, cp)
+	return nil
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show how the board changed since a point in time",
+	Long: `Reconstructs the board as it was at --since from the activity log and
+shows, for each column, how many tasks it held then and now and which tasks
+entered or left it: created, moved in from or out to another column, or
+deleted.
+
+--since is a period back from now (7d, 2w, 36h) or a date (YYYY-MM-DD or
+relative, e.g. "last monday"). Only the net change shows, so a task that
+left a column and came back is not listed. The activity log keeps its most
+recent entries only; when it no longer reaches back to --since, a warning
+says the diff is incomplete. For the individual log entries in a period,
+use log diff.`,
+	Args: cobra.NoArgs,
+	RunE: runDiff,
+}
+
+func init() {
+	diffCmd.Flags().String("since", "7d", "period back from now or date to compare with (e.g. 7d, 2w, 2026-03-01)")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	sinceStr, _ := cmd.Flags().GetString("since")
+	since, err := parseDiffSince(sinceStr, time.Now())
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+	log, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{})
+	if err != nil {
+		return err
+	}
+
+	d := board.DiffBoard(cfg, tasks, log, since)
+	if d.Incomplete {
+		warnf("the activity log does not reach back to %s; older changes are missing\n", since.Format("2006-01-02"))
+	}
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, d)
+	case output.FormatCompact:
+		output.DiffCompact(os.Stdout, d)
+	default:
+		output.DiffTable(os.Stdout, d)
+	}
+	return nil
+}
+
+// parseDiffSince parses --since as a period back from now or as a date.
+func parseDiffSince(s string, now time.Time) (time.Time, error) {
+	if d, err := parseIdleDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	d, err := date.ParseNatural(s)
+	if err != nil {
+		return time.Time{}, clierr.Newf(clierr.InvalidInput, "invalid --since %q (use e.g. 7d, 2w, or a date)", s)
+	}
+	if !d.Before(now) {
+		return time.Time{}, clierr.Newf(clierr.InvalidInput, "--since %s is not in the past", s)
+	}
+	return d.Time, nil
+}
@@ -660,13 +660,12 @@ func TestSoftDeleteAndLog_DependentWarning(t *testing.T) {
 
 	now := time.Now()
 	writeDeleteTask(t, cfg, &task.Task{
-		ID: 1, Title: "parent", Status: "backlog",
+		ID: 1, Title: "dependency", Status: "backlog",
 		Priority: "medium", Created: now, Updated: now,
 	})
-	parentID := 1
 	writeDeleteTask(t, cfg, &task.Task{
-		ID: 2, Title: "child", Status: "backlog",
-		Priority: "medium", Parent: &parentID, Created: now, Updated: now,
+		ID: 2, Title: "dependent", Status: "backlog",
+		Priority: "medium", DependsOn: []int{1}, Created: now, Updated: now,
 	})
 
 	// deleteSingleTask exercises warnDependents + softDeleteAndLog together.
@@ -674,7 +673,7 @@ func TestSoftDeleteAndLog_DependentWarning(t *testing.T) {
 	r, w := captureStdout(t)
 	rErr, wErr := captureStderr(t)
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	_ = drainPipe(t, r, w)
 	stderr := drainPipe(t, rErr, wErr)
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
 	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
@@ -20,7 +21,12 @@ var editCmd = &cobra.Command{
 	Use:   "edit ID[,ID,...]",
 	Short: "Edit a task",
 	Long: `Modifies fields of an existing task. Only specified fields are changed.
-Multiple IDs can be provided as a comma-separated list.`,
+Multiple IDs can be provided as a comma-separated list.
+
+--patch takes the changes as one JSON object keyed by the field names of
+show --json, e.g. {"priority":"high","tags":{"add":["urgent"]}}. null clears
+a field; tags and depends_on take {"add": [...], "remove": [...]}. A patch
+is checked exactly like the equivalent flags.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runEdit,
 }
@@ -30,41 +36,55 @@ func init() {
 	editCmd.Flags().String("status", "", "new status")
 	editCmd.Flags().String("priority", "", "new priority")
 	editCmd.Flags().String("assignee", "", "new assignee")
+	editCmd.Flags().String("reviewer", "", "set reviewer (\"me\" for $KANBAN_USER or $USER)")
+	editCmd.Flags().Bool("clear-reviewer", false, "clear reviewer")
 	editCmd.Flags().StringSlice("add-tag", nil, "add tags")
 	editCmd.Flags().StringSlice("remove-tag", nil, "remove tags")
-	editCmd.Flags().String("due", "", "new due date (YYYY-MM-DD)")
+	editCmd.Flags().String("due", "", "new due date (YYYY-MM-DD, or e.g. tomorrow, next friday, +2w)")
 	editCmd.Flags().Bool("clear-due", false, "clear due date")
 	editCmd.Flags().String("estimate", "", "new time estimate")
 	editCmd.Flags().String("body", "", "new body text (replaces entire body)")
 	editCmd.Flags().StringP("append-body", "a", "", "append text to task body")
 	editCmd.Flags().BoolP("timestamp", "t", false, "prefix a timestamp line when appending")
-	editCmd.Flags().String("started", "", "set started date (YYYY-MM-DD)")
+	editCmd.Flags().Bool("private", false, "encrypt the task body to security.recipients")
+	editCmd.Flags().String("started", "", "set started date (YYYY-MM-DD or relative, e.g. yesterday)")
 	editCmd.Flags().Bool("clear-started", false, "clear started timestamp")
-	editCmd.Flags().String("completed", "", "set completed date (YYYY-MM-DD)")
+	editCmd.Flags().String("completed", "", "set completed date (YYYY-MM-DD or relative, e.g. today)")
 	editCmd.Flags().Bool("clear-completed", false, "clear completed timestamp")
-	editCmd.Flags().Int("parent", 0, "set parent task ID")
+	editCmd.Flags().String("parent", "", "set parent task ID")
 	editCmd.Flags().Bool("clear-parent", false, "clear parent")
-	editCmd.Flags().IntSlice("add-dep", nil, "add dependency task IDs")
-	editCmd.Flags().IntSlice("remove-dep", nil, "remove dependency task IDs")
+	editCmd.Flags().StringSlice("add-dep", nil, "add dependency task IDs")
+	editCmd.Flags().StringSlice("remove-dep", nil, "remove dependency task IDs")
 	editCmd.Flags().String("block", "", "mark task as blocked with reason")
 	editCmd.Flags().Bool("unblock", false, "clear blocked state")
 	editCmd.Flags().String("claim", "", "claim task for an agent")
 	editCmd.Flags().Bool("release", false, "release claim on task")
+	editCmd.Flags().Bool("force", false, "change --status even if children are incomplete or a required estimate is missing")
 	editCmd.Flags().String("class", "", "set class of service")
+	editCmd.Flags().String("goal", "", "link the task to a goal (a goals.yml entry)")
+	editCmd.Flags().Bool("clear-goal", false, "clear goal")
 	editCmd.Flags().String("branch", "", "set git branch name")
 	editCmd.Flags().Bool("clear-branch", false, "clear branch field")
 	editCmd.Flags().String("worktree", "", "set worktree path")
 	editCmd.Flags().Bool("clear-worktree", false, "clear worktree field")
+	editCmd.Flags().String("workstream", "", `move the task to this workstream ("" for tasks_dir)`)
+	editCmd.Flags().String("patch", "", "apply changes given as a JSON object (see long help)")
 	rootCmd.AddCommand(editCmd)
 }
 
 func runEdit(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
+	if err := applyPatchFlag(cmd); err != nil {
+		return err
+	}
 
-	cfg, err := loadConfig()
+	ids, err := parseIDs(args[0])
 	if err != nil {
 		return err
 	}
@@ -93,19 +113,19 @@ func editSingleTask(cfg *config.Config, id int, cmd *cobra.Command) error {
 		return output.JSON(os.Stdout, t)
 	}
 
-	output.Messagef(os.Stdout, "Updated task #%d: %s", t.ID, t.Title)
+	output.Messagef(os.Stdout, "Updated task %s: %s", output.FormatID(t.ID), t.Title)
 	return nil
 }
 
 // executeEdit performs the core edit: find, read, apply, validate, write, log.
 // Returns the modified task and its new file path.
 func executeEdit(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task, string, error) {
-	path, err := task.FindByID(cfg.TasksPath(), id)
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return nil, "", err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return nil, "", err
 	}
@@ -123,23 +143,40 @@ func executeEdit(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task, st
 	if err != nil {
 		return nil, "", err
 	}
+	oldWorkstream := t.Workstream
+	if cmd.Flags().Changed("workstream") {
+		if t.Workstream, err = workstreamFlag(cmd, cfg); err != nil {
+			return nil, "", err
+		}
+		changed = changed || t.Workstream != oldWorkstream
+	}
 
 	if !changed {
 		return nil, "", clierr.New(clierr.NoChanges, "no changes specified")
 	}
 
-	if err = validateEditPost(cfg, t, oldStatus, claimant); err != nil {
+	force, _ := cmd.Flags().GetBool("force")
+	if err = validateEditPost(cfg, t, oldStatus, claimant, force); err != nil {
 		return nil, "", err
 	}
+	if t.Status != oldStatus {
+		task.ApplyChecklist(t, cfg)
+	}
 
 	t.Updated = time.Now()
 
-	newPath, err := writeAndRename(path, t, oldTitle)
+	newPath, err := writeAndRename(cfg, path, t, oldTitle)
 	if err != nil {
 		return nil, "", err
 	}
+	if t.Workstream != oldWorkstream {
+		if newPath, err = moveToWorkstream(cfg, newPath, t.Workstream); err != nil {
+			return nil, "", err
+		}
+	}
 
 	logEditActivity(cfg, t, wasBlocked, wasClaimedBy)
+	applyOnUnblock(cfg, t, oldStatus)
 	return t, newPath, nil
 }
 
@@ -175,8 +212,10 @@ func applyEditChanges(cmd *cobra.Command, t *task.Task, cfg *config.Config, clai
 	return changed, nil
 }
 
-// validateEditPost runs post-edit validations: deps, require_claim for new status, WIP limits.
-func validateEditPost(cfg *config.Config, t *task.Task, oldStatus, claimant string) error {
+// validateEditPost runs post-edit validations: deps, require_claim,
+// require_estimate_for and require_reviewer for the new status, open
+// children of a completed parent, WIP limits.
+func validateEditPost(cfg *config.Config, t *task.Task, oldStatus, claimant string, force bool) error {
 	if err := validateDeps(cfg, t); err != nil {
 		return err
 	}
@@ -184,6 +223,20 @@ func validateEditPost(cfg *config.Config, t *task.Task, oldStatus, claimant stri
 	if t.Status != oldStatus && cfg.StatusRequiresClaim(t.Status) && claimant == "" {
 		return task.ValidateClaimRequired(t.Status)
 	}
+	if t.Status != oldStatus {
+		if err := checkArchiveGuard(cfg, t.Status); err != nil {
+			return err
+		}
+		if err := checkEstimate(cfg, t, t.Status, force); err != nil {
+			return err
+		}
+		if err := checkReviewer(cfg, t, t.Status); err != nil {
+			return err
+		}
+		if err := checkChildrenComplete(cfg, t, t.Status, force); err != nil {
+			return err
+		}
+	}
 	// Check WIP limit if status changed (class-aware).
 	if t.Status != oldStatus {
 		if t.Class != "" && len(cfg.Classes) > 0 {
@@ -195,12 +248,10 @@ func validateEditPost(cfg *config.Config, t *task.Task, oldStatus, claimant stri
 }
 
 // writeAndRename writes the task and renames the file if the title changed.
-func writeAndRename(path string, t *task.Task, oldTitle string) (string, error) {
+func writeAndRename(cfg *config.Config, path string, t *task.Task, oldTitle string) (string, error) {
 	newPath := path
 	if t.Title != oldTitle {
-		slug := task.GenerateSlug(t.Title)
-		filename := task.GenerateFilename(t.ID, slug)
-		newPath = filepath.Join(filepath.Dir(path), filename)
+		newPath = filepath.Join(filepath.Dir(path), task.Filename(cfg, t))
 	}
 
 	if err := task.Write(newPath, t); err != nil {
@@ -215,6 +266,23 @@ func writeAndRename(path string, t *task.Task, oldTitle string) (string, error)
 	return newPath, nil
 }
 
+// moveToWorkstream moves the task file at path into workstream ws and
+// returns its new path.
+func moveToWorkstream(cfg *config.Config, path, ws string) (string, error) {
+	dir, err := workstreamPath(cfg, ws)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, tasksDirMode); err != nil {
+		return "", fmt.Errorf("creating tasks directory: %w", err)
+	}
+	newPath := filepath.Join(dir, filepath.Base(path))
+	if err := os.Rename(path, newPath); err != nil {
+		return "", fmt.Errorf("moving task to workstream: %w", err)
+	}
+	return newPath, nil
+}
+
 // logEditActivity logs the edit and any block/unblock/claim/release transitions.
 func logEditActivity(cfg *config.Config, t *task.Task, wasBlocked bool, wasClaimedBy string) {
 	logActivity(cfg, "edit", t.ID, t.Title)
@@ -260,6 +328,11 @@ func applyEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) (bool,
 	if err != nil {
 		return false, err
 	}
+	if c, goalErr := applyGoalFlags(cmd, t, cfg); goalErr != nil {
+		return false, goalErr
+	} else if c {
+		changed = true
+	}
 
 	// Apply grouped flag helpers, each returning (bool, error).
 	for _, fn := range []func(*cobra.Command, *task.Task) (bool, error){
@@ -267,6 +340,7 @@ func applyEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) (bool,
 		applyTagDueFlags,
 		applyDepFlags,
 		applyBlockFlags,
+		applyReviewerEditFlags,
 	} {
 		c, fnErr := fn(cmd, t)
 		if fnErr != nil {
@@ -292,6 +366,7 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 			return false, err
 		}
 		t.Status = v
+		task.RecordStatus(t, v, time.Now())
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("priority"); v != "" {
@@ -303,6 +378,7 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 	}
 	if v, _ := cmd.Flags().GetString("assignee"); v != "" {
 		t.Assignee = v
+		task.RecordAssigned(t, time.Now())
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("estimate"); v != "" {
@@ -316,13 +392,23 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 	}
 	if bodySet {
 		v, _ := cmd.Flags().GetString("body")
-		t.Body = v
+		if err := editBody(cfg, t, func(string) string { return v }); err != nil {
+			return false, err
+		}
 		changed = true
 	}
 	if appendSet {
 		v, _ := cmd.Flags().GetString("append-body")
 		ts, _ := cmd.Flags().GetBool("timestamp")
-		t.Body = appendBody(t.Body, v, ts)
+		if err := editBody(cfg, t, func(body string) string { return appendBody(body, v, ts) }); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if v, _ := cmd.Flags().GetBool("private"); v && !t.Private {
+		if err := sealBody(cfg, t); err != nil {
+			return false, err
+		}
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("class"); v != "" {
@@ -381,7 +467,7 @@ func applyTimestampFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 
 	if startedSet {
 		v, _ := cmd.Flags().GetString("started")
-		d, err := date.Parse(v)
+		d, err := date.ParseNatural(v)
 		if err != nil {
 			return false, task.ValidateDate("started", v, err)
 		}
@@ -395,7 +481,7 @@ func applyTimestampFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 	}
 	if completedSet {
 		v, _ := cmd.Flags().GetString("completed")
-		d, err := date.Parse(v)
+		d, err := date.ParseNatural(v)
 		if err != nil {
 			return false, task.ValidateDate("completed", v, err)
 		}
@@ -423,7 +509,7 @@ func applyTagDueFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("due"); v != "" {
-		d, err := date.Parse(v)
+		d, err := date.ParseNatural(v)
 		if err != nil {
 			return false, task.FormatDueDate(v, err)
 		}
@@ -448,7 +534,10 @@ func applyDepFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 		return false, clierr.New(clierr.StatusConflict, "cannot use --parent and --clear-parent together")
 	}
 	if parentSet {
-		v, _ := cmd.Flags().GetInt("parent")
+		v, err := idFlag(cmd, "parent")
+		if err != nil {
+			return false, err
+		}
 		t.Parent = &v
 		changed = true
 	}
@@ -457,12 +546,20 @@ func applyDepFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 		changed = true
 	}
 
-	if v, _ := cmd.Flags().GetIntSlice("add-dep"); len(v) > 0 {
-		t.DependsOn = appendUniqueInts(t.DependsOn, v...)
+	add, err := idListFlag(cmd, "add-dep")
+	if err != nil {
+		return false, err
+	}
+	if len(add) > 0 {
+		t.DependsOn = appendUniqueInts(t.DependsOn, add...)
 		changed = true
 	}
-	if v, _ := cmd.Flags().GetIntSlice("remove-dep"); len(v) > 0 {
-		t.DependsOn = removeInts(t.DependsOn, v...)
+	remove, err := idListFlag(cmd, "remove-dep")
+	if err != nil {
+		return false, err
+	}
+	if len(remove) > 0 {
+		t.DependsOn = removeInts(t.DependsOn, remove...)
 		changed = true
 	}
 
@@ -509,13 +606,11 @@ func applyBlockFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 		if blockReason == "" {
 			return false, clierr.New(clierr.InvalidInput, "block reason is required (use --block REASON)")
 		}
-		t.Blocked = true
-		t.BlockReason = blockReason
+		task.Block(t, blockReason, nil)
 		return true, nil
 	}
 	if unblock {
-		t.Blocked = false
-		t.BlockReason = ""
+		task.Unblock(t)
 		return true, nil
 	}
 	return false, nil
@@ -568,3 +663,30 @@ func appendBody(existing, text string, addTimestamp bool) string {
 
 	return b.String()
 }
+
+// editBody sets the body of t to fn applied to its plaintext body. Private
+// bodies are decrypted first and encrypted again afterwards.
+func editBody(cfg *config.Config, t *task.Task, fn func(body string) string) error {
+	if !t.Private {
+		t.Body = fn(t.Body)
+		return nil
+	}
+	plain, err := crypt.Open(t)
+	if err != nil {
+		return clierr.Newf(clierr.InvalidInput, "cannot decrypt body of private task #%d: %v", t.ID, err)
+	}
+	t.Body = fn(plain)
+	return sealBody(cfg, t)
+}
+
+// sealBody encrypts the body of t to security.recipients and marks it private.
+func sealBody(cfg *config.Config, t *task.Task) error {
+	if len(cfg.Security.Recipients) == 0 {
+		return clierr.New(clierr.InvalidInput,
+			"private tasks need security.recipients (kanban-md config set security.recipients KEY)")
+	}
+	if err := crypt.Seal(t, cfg.Security.Recipients); err != nil {
+		return fmt.Errorf("encrypting body of task #%d: %w", t.ID, err)
+	}
+	return nil
+}
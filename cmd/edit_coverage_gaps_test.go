@@ -203,7 +203,7 @@ func TestValidateEditPost_RequireClaimOnStatusChangeViaCfg(t *testing.T) {
 
 	tk := &task.Task{ID: 1, Status: statusInProgress}
 
-	err := validateEditPost(cfg, tk, "backlog", "")
+	err := validateEditPost(cfg, tk, "backlog", "", false)
 	if err == nil {
 		t.Fatal("expected require_claim error on status change")
 	}
@@ -231,7 +231,7 @@ func TestValidateEditPost_ClassAwareWIPLimit(t *testing.T) {
 
 	tk := &task.Task{ID: 1, Status: statusInProgress, Class: "expedite"}
 
-	err = validateEditPost(cfg, tk, "backlog", "")
+	err = validateEditPost(cfg, tk, "backlog", "", false)
 	if err == nil {
 		t.Fatal("expected class WIP limit error")
 	}
@@ -282,7 +282,7 @@ func TestDeleteSingleTask_TaskReadError(t *testing.T) {
 		t.Fatal(writeErr)
 	}
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error from malformed task file")
 	}
@@ -298,7 +298,7 @@ func TestDeleteSingleTask_NonTTYWithoutYes(t *testing.T) {
 
 	// In tests, stdin is a pipe (not a TTY), so this should return
 	// ConfirmationReq without --yes.
-	err = deleteSingleTask(cfg, 1, false)
+	err = deleteSingleTask(cfg, 1, false, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected confirmation error in non-TTY mode")
 	}
@@ -322,7 +322,7 @@ func TestDeleteSingleTask_JSONOutput(t *testing.T) {
 	setFlags(t, true, false, false)
 	r, w := captureStdout(t)
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	got := drainPipe(t, r, w)
 
 	if err != nil {
@@ -351,7 +351,7 @@ func TestExecuteDelete_TaskReadError(t *testing.T) {
 		t.Fatal(writeErr)
 	}
 
-	err = executeDelete(cfg, 1)
+	err = executeDelete(cfg, 1, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected error from malformed task file")
 	}
@@ -445,7 +445,7 @@ func TestDeleteSingleTask_SoftDeleteWriteError(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = os.Chmod(path, 0o600) })
 
-	err = deleteSingleTask(cfg, 1, true)
+	err = deleteSingleTask(cfg, 1, true, childrenRefuse)
 	if err == nil {
 		t.Fatal("expected write error from softDeleteAndLog")
 	}
@@ -117,7 +117,7 @@ func TestValidateEditPost_RequireClaimOnStatusChange(t *testing.T) {
 	// in-progress has require_claim: true in defaults.
 	tk := &task.Task{ID: 1, Status: "in-progress"}
 
-	err := validateEditPost(cfg, tk, "backlog", "") // no claimant
+	err := validateEditPost(cfg, tk, "backlog", "", false) // no claimant
 	if err == nil {
 		t.Fatal("expected error for require_claim violation on status change")
 	}
@@ -144,7 +144,7 @@ func TestValidateEditPost_WIPLimitExceeded(t *testing.T) {
 	}
 
 	tk := &task.Task{ID: 2, Status: "todo"}
-	vErr := validateEditPost(cfg, tk, "backlog", "")
+	vErr := validateEditPost(cfg, tk, "backlog", "", false)
 	if vErr == nil {
 		t.Fatal("expected WIP limit error")
 	}
@@ -159,7 +159,7 @@ func TestValidateEditPost_SelfReferenceDepFails(t *testing.T) {
 
 	// Task depends on itself — should fail dep validation.
 	tk := &task.Task{ID: 1, Status: "backlog", DependsOn: []int{1}}
-	vErr := validateEditPost(cfg, tk, "backlog", "")
+	vErr := validateEditPost(cfg, tk, "backlog", "", false)
 	if vErr == nil {
 		t.Fatal("expected error for self-referencing dependency")
 	}
@@ -171,7 +171,7 @@ func TestValidateEditPost_StatusUnchangedNoWIPCheck(t *testing.T) {
 
 	// Same status — WIP check should not trigger.
 	tk := &task.Task{ID: 1, Status: "backlog"}
-	err := validateEditPost(cfg, tk, "backlog", "")
+	err := validateEditPost(cfg, tk, "backlog", "", false)
 	if err != nil {
 		t.Errorf("expected no error when status unchanged, got: %v", err)
 	}
@@ -183,7 +183,7 @@ func TestWriteAndRename_WriteError(t *testing.T) {
 	badPath := filepath.Join(t.TempDir(), "nonexistent", "dir", "task.md")
 	tk := &task.Task{ID: 1, Title: "test", Status: "backlog", Priority: "medium"}
 
-	_, err := writeAndRename(badPath, tk, "test")
+	_, err := writeAndRename(config.NewDefault("Test"), badPath, tk, "test")
 	if err == nil {
 		t.Fatal("expected error when write fails")
 	}
@@ -207,7 +207,7 @@ func TestWriteAndRename_RemoveError(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err := writeAndRename(oldPath, tk, "old title")
+	_, err := writeAndRename(config.NewDefault("Test"), oldPath, tk, "old title")
 	if err == nil {
 		t.Fatal("expected error when remove fails")
 	}
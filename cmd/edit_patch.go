@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+)
+
+// patchStringFields maps the string fields of an edit --patch to their flag
+// and, for those that can be cleared with null, their clear flag.
+var patchStringFields = map[string]struct{ flag, clear string }{
+	"title":      {"title", ""},
+	"status":     {"status", ""},
+	"priority":   {"priority", ""},
+	"assignee":   {"assignee", ""},
+	"reviewer":   {"reviewer", "clear-reviewer"},
+	"estimate":   {"estimate", ""},
+	"body":       {"body", ""},
+	"class":      {"class", ""},
+	"goal":       {"goal", "clear-goal"},
+	"branch":     {"branch", "clear-branch"},
+	"worktree":   {"worktree", "clear-worktree"},
+	"due":        {"due", "clear-due"},
+	"started":    {"started", "clear-started"},
+	"completed":  {"completed", "clear-completed"},
+	"workstream": {"workstream", ""},
+}
+
+// patchListFields maps the list fields of an edit --patch to their add and
+// remove flags.
+var patchListFields = map[string]struct{ add, remove string }{
+	"tags":       {"add-tag", "remove-tag"},
+	"depends_on": {"add-dep", "remove-dep"},
+}
+
+// patchOtherFields are the fields with their own handling in patchFlag.
+var patchOtherFields = []string{"parent", "blocked", "block_reason", "claimed_by", "private"}
+
+// patchFlags turns an edit --patch object into the edit flags it stands
+// for, so a patch goes through exactly the validation the flags do. Keys
+// use the field names of show --json. null clears a field; list fields
+// take {"add": [...], "remove": [...]}.
+func patchFlags(patch string) (map[string]string, error) {
+	var fields map[string]json.RawMessage
+	dec := json.NewDecoder(strings.NewReader(patch))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid --patch: %v", err)
+	}
+	if len(fields) == 0 {
+		return nil, clierr.New(clierr.InvalidInput, "--patch is empty")
+	}
+
+	flags := make(map[string]string)
+	for key, raw := range fields {
+		if err := patchFlag(flags, key, raw, fields); err != nil {
+			return nil, err
+		}
+	}
+	return flags, nil
+}
+
+func patchFlag(flags map[string]string, key string, raw json.RawMessage, fields map[string]json.RawMessage) error {
+	isNull := bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+	if f, ok := patchStringFields[key]; ok {
+		if isNull {
+			if f.clear == "" {
+				return clierr.Newf(clierr.InvalidInput, "--patch cannot clear %q", key)
+			}
+			flags[f.clear] = "true"
+			return nil
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil || v == "" {
+			return clierr.Newf(clierr.InvalidInput, "--patch %q must be a non-empty string", key)
+		}
+		flags[f.flag] = v
+		return nil
+	}
+	if f, ok := patchListFields[key]; ok {
+		return patchList(flags, key, raw, f.add, f.remove)
+	}
+
+	switch key {
+	case "parent":
+		if isNull {
+			flags["clear-parent"] = "true"
+			return nil
+		}
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				return clierr.New(clierr.InvalidInput, `--patch "parent" must be a task ID or null`)
+			}
+			n = json.Number(s)
+		}
+		flags["parent"] = n.String()
+	case "blocked":
+		var v bool
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return clierr.New(clierr.InvalidInput, `--patch "blocked" must be true or false`)
+		}
+		if !v {
+			flags["unblock"] = "true"
+		} else if _, ok := fields["block_reason"]; !ok {
+			return clierr.New(clierr.InvalidInput, `--patch "blocked": true needs a "block_reason"`)
+		}
+	case "block_reason":
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil || v == "" {
+			return clierr.New(clierr.InvalidInput, `--patch "block_reason" must be a non-empty string`)
+		}
+		flags["block"] = v
+	case "claimed_by":
+		if isNull {
+			flags["release"] = "true"
+			return nil
+		}
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil || v == "" {
+			return clierr.New(clierr.InvalidInput, `--patch "claimed_by" must be a name or null`)
+		}
+		flags["claim"] = v
+	case "private":
+		var v bool
+		if err := json.Unmarshal(raw, &v); err != nil || !v {
+			return clierr.New(clierr.InvalidInput, `--patch "private" can only be set to true`)
+		}
+		flags["private"] = "true"
+	default:
+		return clierr.Newf(clierr.InvalidInput, "--patch has unknown field %q", key).
+			WithDetails(map[string]any{"fields": patchFieldNames()})
+	}
+	return nil
+}
+
+// patchList turns {"add": [...], "remove": [...]} into the add and remove
+// flags of a list field.
+func patchList(flags map[string]string, key string, raw json.RawMessage, addFlag, removeFlag string) error {
+	var ops map[string][]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&ops); err != nil {
+		return clierr.Newf(clierr.InvalidInput, `--patch %q must be {"add": [...], "remove": [...]}`, key)
+	}
+	for op, items := range ops {
+		var flag string
+		switch op {
+		case "add":
+			flag = addFlag
+		case "remove":
+			flag = removeFlag
+		default:
+			return clierr.Newf(clierr.InvalidInput, `--patch %q takes "add" and "remove", not %q`, key, op)
+		}
+		values := make([]string, len(items))
+		for i, item := range items {
+			switch v := item.(type) {
+			case string:
+				values[i] = v
+			case json.Number:
+				values[i] = v.String()
+			default:
+				return clierr.Newf(clierr.InvalidInput, "--patch %q.%s has a value that is not a string or number", key, op)
+			}
+		}
+		if len(values) > 0 {
+			flags[flag] = strings.Join(values, ",")
+		}
+	}
+	return nil
+}
+
+// patchFieldNames lists the fields edit --patch accepts.
+func patchFieldNames() []string {
+	names := slices.Clone(patchOtherFields)
+	for k := range patchStringFields {
+		names = append(names, k)
+	}
+	for k := range patchListFields {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// applyPatchFlag sets the flags an edit --patch stands for. A field given
+// both in the patch and as a flag is an error rather than a silent pick.
+func applyPatchFlag(cmd *cobra.Command) error {
+	patch, _ := cmd.Flags().GetString("patch")
+	if patch == "" {
+		return nil
+	}
+	flags, err := patchFlags(patch)
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(flags)) {
+		if cmd.Flags().Changed(name) {
+			return clierr.Newf(clierr.InvalidInput, "--patch and --%s both set the same field; use one", name)
+		}
+		if err := cmd.Flags().Set(name, flags[name]); err != nil {
+			return clierr.Newf(clierr.InvalidInput, "--patch: --%s %s: %v", name, strconv.Quote(flags[name]), err)
+		}
+	}
+	return nil
+}
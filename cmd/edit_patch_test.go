@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+)
+
+func TestPatchFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+		want  map[string]string
+	}{
+		{"string field", `{"priority":"high"}`, map[string]string{"priority": "high"}},
+		{"list add and remove", `{"tags":{"add":["a","b"],"remove":["c"]}}`,
+			map[string]string{"add-tag": "a,b", "remove-tag": "c"}},
+		{"numeric deps", `{"depends_on":{"add":[3,4]}}`, map[string]string{"add-dep": "3,4"}},
+		{"null clears", `{"due":null,"parent":null,"claimed_by":null}`,
+			map[string]string{"clear-due": "true", "clear-parent": "true", "release": "true"}},
+		{"block", `{"blocked":true,"block_reason":"waiting on API"}`, map[string]string{"block": "waiting on API"}},
+		{"unblock", `{"blocked":false}`, map[string]string{"unblock": "true"}},
+		{"parent", `{"parent":7}`, map[string]string{"parent": "7"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := patchFlags(tt.patch)
+			if err != nil {
+				t.Fatalf("patchFlags(%s) error: %v", tt.patch, err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("patchFlags(%s) = %v, want %v", tt.patch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchFlagsRejects(t *testing.T) {
+	for _, patch := range []string{
+		`not json`,
+		`{}`,
+		`{"priorty":"high"}`,
+		`{"title":null}`,
+		`{"tags":["a"]}`,
+		`{"tags":{"set":["a"]}}`,
+		`{"blocked":true}`,
+		`{"private":false}`,
+	} {
+		_, err := patchFlags(patch)
+		var cliErr *clierr.Error
+		if !errors.As(err, &cliErr) || cliErr.Code != clierr.InvalidInput {
+			t.Errorf("patchFlags(%s) error = %v, want INVALID_INPUT", patch, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var estimateCmd = &cobra.Command{
+	Use:   "estimate",
+	Short: "Summarize task estimates",
+	Long:  `Commands for rolling up task estimates.`,
+}
+
+var estimateReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Sum remaining estimates by tag, epic, assignee, or status",
+	Long: `Sums the estimates of all unfinished tasks, grouped by a field.
+
+Estimates such as 30m, 4h, 2d, or 1w are converted to hours. A day counts as
+estimates.hours_per_day working hours (default 8) and a week as 5 days.
+Tasks without a parseable estimate are counted separately as unestimated.
+
+Grouping by tag counts a task under each of its tags. Grouping by epic uses
+the top-level ancestor of each task's parent chain.`,
+	Args: cobra.NoArgs,
+	RunE: runEstimateReport,
+}
+
+func init() {
+	estimateReportCmd.Flags().String("by", "status",
+		"group by field ("+strings.Join(board.ValidEstimateGroupByFields(), ", ")+")")
+	estimateReportCmd.Flags().Float64("hours-per-day", 0, "working hours per estimated day (overrides config)")
+	estimateCmd.AddCommand(estimateReportCmd)
+	rootCmd.AddCommand(estimateCmd)
+}
+
+func runEstimateReport(cmd *cobra.Command, _ []string) error {
+	by, _ := cmd.Flags().GetString("by")
+	if !slices.Contains(board.ValidEstimateGroupByFields(), by) {
+		return clierr.Newf(clierr.InvalidGroupBy, "invalid --by field %q; valid: %s",
+			by, strings.Join(board.ValidEstimateGroupByFields(), ", "))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("hours-per-day") {
+		h, _ := cmd.Flags().GetFloat64("hours-per-day")
+		cfg.Estimates.HoursPerDay = h
+		if err := cfg.Validate(); err != nil {
+			return clierr.New(clierr.InvalidInput, "invalid --hours-per-day: must be between 0 and 24")
+		}
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	report := board.ComputeEstimateReport(cfg, tasks, by)
+
+	format := outputFormat()
+	if format == output.FormatJSON {
+		return output.JSON(os.Stdout, report)
+	}
+	if format == output.FormatCompact {
+		output.EstimateReportCompact(os.Stdout, report)
+		return nil
+	}
+
+	output.EstimateReportTable(os.Stdout, report)
+	return nil
+}
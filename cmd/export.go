@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
+	"github.com/antopolskiy/kanban-md/internal/export"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the board for sharing",
+	Long: `Renders the board as a standalone document for people who don't use the
+CLI. Archived tasks are left out.
+
+Exports are meant to leave the board: matches of redact.patterns and values
+of redact.fields are replaced with [redacted], and the bodies of private
+tasks are never decrypted.`,
+}
+
+var exportHTMLCmd = &cobra.Command{
+	Use:   "html",
+	Short: "Export the board as a static HTML page",
+	Long: `Writes a self-contained HTML page with one column per status and one card
+per task. Clicking a card shows its details and body. The page loads nothing
+from elsewhere, so it can be emailed or attached as is.`,
+	Args: cobra.NoArgs,
+	RunE: runExportHTML,
+}
+
+var exportSVGCmd = &cobra.Command{
+	Use:   "svg",
+	Short: "Export the board as an SVG image",
+	Long: `Draws the board layout as an SVG image for docs and slides: one column
+per status with its task count and WIP limit in the header, and one card per
+task. Column headers turn amber at the WIP limit and red over it.
+
+With --png the image is rasterized to PNG by rsvg-convert or ImageMagick,
+whichever is installed.`,
+	Args: cobra.NoArgs,
+	RunE: runExportSVG,
+}
+
+var exportMarkdownCmd = &cobra.Command{
+	Use:     "markdown",
+	Aliases: []string{"md"},
+	Short:   "Export the board as a markdown document",
+	Long: `Writes one markdown document with a section per status and a table of
+each task's ID, title, priority, assignee, due date, and tags. Bodies are
+left out, so the result pastes cleanly into wikis and pull requests.`,
+	Args: cobra.NoArgs,
+	RunE: runExportMarkdown,
+}
+
+func init() {
+	exportCmd.PersistentFlags().String("out", "", "write to this file instead of stdout")
+	exportCmd.PersistentFlags().String("swimlane", "", "split columns into lanes by field ("+
+		strings.Join(swimlaneFields(), ", ")+")")
+	exportSVGCmd.Flags().Bool("png", false, "rasterize to PNG (needs rsvg-convert or ImageMagick)")
+	exportCmd.AddCommand(exportHTMLCmd, exportSVGCmd, exportMarkdownCmd)
+	rootCmd.AddCommand(exportCmd)
+}
+
+func runExportHTML(cmd *cobra.Command, _ []string) error {
+	b, err := buildExport(cmd)
+	if err != nil {
+		return err
+	}
+	return writeExport(cmd, func(w io.Writer) error { return export.HTML(w, b) })
+}
+
+func runExportSVG(cmd *cobra.Command, _ []string) error {
+	b, err := buildExport(cmd)
+	if err != nil {
+		return err
+	}
+	if png, _ := cmd.Flags().GetBool("png"); !png {
+		return writeExport(cmd, func(w io.Writer) error { return export.SVG(w, b) })
+	}
+
+	var svg bytes.Buffer
+	if err := export.SVG(&svg, b); err != nil {
+		return err
+	}
+	img, err := export.PNG(svg.Bytes())
+	if err != nil {
+		return fmt.Errorf("rasterizing PNG: %w", err)
+	}
+	return writeExport(cmd, func(w io.Writer) error {
+		_, err := w.Write(img)
+		return err
+	})
+}
+
+func runExportMarkdown(cmd *cobra.Command, _ []string) error {
+	b, err := buildExport(cmd)
+	if err != nil {
+		return err
+	}
+	return writeExport(cmd, func(w io.Writer) error { return export.Markdown(w, b) })
+}
+
+// swimlaneFields are the group-by fields that make sense as lanes.
+func swimlaneFields() []string {
+	var fields []string
+	for _, f := range board.ValidGroupByFields() {
+		if f != "status" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// buildExport lays out the board with redaction applied and private bodies
+// left sealed.
+func buildExport(cmd *cobra.Command) (export.Board, error) {
+	swimlane, _ := cmd.Flags().GetString("swimlane")
+	if swimlane != "" && !slices.Contains(swimlaneFields(), swimlane) {
+		return export.Board{}, clierr.Newf(clierr.InvalidGroupBy, "invalid --swimlane field %q; valid: %s",
+			swimlane, strings.Join(swimlaneFields(), ", "))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return export.Board{}, err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return export.Board{}, fmt.Errorf("reading tasks: %w", err)
+	}
+	printWarnings(warnings)
+
+	shared, err := shareTasks(cfg, tasks)
+	if err != nil {
+		return export.Board{}, err
+	}
+	return export.Build(cfg, shared, swimlane, time.Now()), nil
+}
+
+// shareTasks returns copies of tasks fit to leave the board: redacted, with
+// private bodies left sealed. The copies must never be written back.
+func shareTasks(cfg *config.Config, tasks []*task.Task) ([]*task.Task, error) {
+	redactor, err := board.NewRedactor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	shared := make([]*task.Task, len(tasks))
+	for i, t := range tasks {
+		c := redactor.Task(t)
+		if c.Private {
+			c.Body = crypt.Redacted
+		}
+		shared[i] = c
+	}
+	return shared, nil
+}
+
+// writeExport renders to the --out file, or to stdout without one.
+func writeExport(cmd *cobra.Command, render func(io.Writer) error) error {
+	out, _ := cmd.Flags().GetString("out")
+	if out == "" {
+		return render(os.Stdout)
+	}
+	f, err := os.Create(out) //nolint:gosec // user-chosen output path
+	if err != nil {
+		return fmt.Errorf("creating export file: %w", err)
+	}
+	if err := render(f); err != nil {
+		f.Close()
+		return fmt.Errorf("writing export: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing export: %w", err)
+	}
+	output.Messagef(os.Stdout, "Exported board to %s", out)
+	return nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/notify"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// defaultFocus is the length of a focus session when --for is not given.
+const defaultFocus = 25 * time.Minute
+
+var focusCmd = &cobra.Command{
+	Use:   "focus ID",
+	Short: "Claim a task and run a focus timer on it",
+	Long: `Claims a task and counts down a focus session on it. When the timer ends,
+or is stopped early with Ctrl-C, the focused interval is added to the
+task's worklog and the activity log. While the timer runs, the TUI status
+bar counts down with it.
+
+The claim goes to --claim, or else to KANBAN_AGENT or the OS user. With
+--notify, a desktop notification marks the end of the session.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFocus,
+}
+
+func init() {
+	focusCmd.Flags().Duration("for", defaultFocus, "length of the focus session (e.g. 25m, 1h30m)")
+	focusCmd.Flags().String("claim", "", "claim the task for this name (default: KANBAN_AGENT or the OS user)")
+	focusCmd.Flags().Bool("notify", false, "show a desktop notification when the session ends")
+	rootCmd.AddCommand(focusCmd)
+}
+
+func runFocus(cmd *cobra.Command, args []string) error {
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	length, _ := cmd.Flags().GetDuration("for")
+	if length <= 0 {
+		return clierr.New(clierr.InvalidInput, "--for must be a positive duration, e.g. 25m")
+	}
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	claimant, _ := cmd.Flags().GetString("claim")
+	if claimant == "" {
+		claimant = board.DefaultActor()
+	}
+
+	start := time.Now()
+	t, err := startFocus(cfg, id, claimant, start, start.Add(length))
+	if err != nil {
+		return err
+	}
+	end := waitFocus(t, *t.FocusUntil)
+	if t, err = finishFocus(cfg, id, claimant, start, end); err != nil {
+		return err
+	}
+
+	if n, _ := cmd.Flags().GetBool("notify"); n {
+		msg := fmt.Sprintf("%s %s: %s focused", output.FormatID(t.ID), t.Title, output.FormatDuration(end.Sub(start)))
+		if err := notify.Desktop("Focus session over", msg); err != nil {
+			warnf("%v\n", err)
+		}
+	}
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, "Focused on task %s for %s (worked %s in total)",
+		output.FormatID(t.ID), output.FormatDuration(end.Sub(start)), output.FormatDuration(task.Worked(t)))
+	return nil
+}
+
+// startFocus claims task id for claimant and starts its focus timer.
+func startFocus(cfg *config.Config, id int, claimant string, now, until time.Time) (*task.Task, error) {
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := readTask(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.IsTerminalStatus(t.Status) {
+		return nil, clierr.Newf(clierr.InvalidInput, "task %s is %s; focus on a task that is not finished",
+			output.FormatID(id), t.Status)
+	}
+	if err := checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
+		return nil, err
+	}
+
+	newClaim := t.ClaimedBy != claimant
+	t.ClaimedBy = claimant
+	t.ClaimedAt = &now
+	t.FocusUntil = &until
+	t.Updated = now
+	if err := task.Write(path, t); err != nil {
+		return nil, fmt.Errorf("writing task: %w", err)
+	}
+	if newClaim {
+		logActivity(cfg, "claim", id, claimant)
+	}
+	return t, nil
+}
+
+// waitFocus waits until the focus timer ends or the user stops it, drawing
+// a countdown when stderr is a terminal, and returns when the focus ended.
+func waitFocus(t *task.Task, until time.Time) time.Time {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	timer := time.NewTimer(time.Until(until))
+	defer timer.Stop()
+
+	var tick <-chan time.Time
+	live := !flagQuiet && term.IsTerminal(int(os.Stderr.Fd())) //nolint:gosec // Fd returns uintptr, int cast is safe for terminal check
+	if live {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		tick = ticker.C
+		drawCountdown(t, time.Until(until))
+	}
+	defer func() {
+		if live {
+			fmt.Fprint(os.Stderr, "\r\033[K")
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return time.Now()
+		case <-timer.C:
+			return time.Now()
+		case <-tick:
+			drawCountdown(t, time.Until(until))
+		}
+	}
+}
+
+// drawCountdown redraws the countdown line on stderr.
+func drawCountdown(t *task.Task, left time.Duration) {
+	left = max(left.Round(time.Second), 0)
+	mins, secs := int(left/time.Minute), int(left%time.Minute/time.Second)
+	fmt.Fprintf(os.Stderr, "\r\033[KFocus %02d:%02d  %s %s (Ctrl-C to stop)", mins, secs, output.FormatID(t.ID), t.Title)
+}
+
+// finishFocus records the focus session from start to end in the worklog
+// of task id, rereading it since it may have changed meanwhile.
+func finishFocus(cfg *config.Config, id int, claimant string, start, end time.Time) (*task.Task, error) {
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := readTask(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	task.RecordWork(t, start, end, claimant)
+	t.Updated = end
+	if err := task.Write(path, t); err != nil {
+		return nil, fmt.Errorf("writing task: %w", err)
+	}
+	logActivity(cfg, "focus", id, end.Sub(start).Round(time.Second).String())
+	return t, nil
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// applyGoalFlags handles edit's --goal and --clear-goal. A goal must be
+// listed in the board's goals.yml.
+func applyGoalFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) (bool, error) {
+	goalSet := cmd.Flags().Changed("goal")
+	clearGoal, _ := cmd.Flags().GetBool("clear-goal")
+	if goalSet && clearGoal {
+		return false, clierr.New(clierr.StatusConflict, "cannot use --goal and --clear-goal together")
+	}
+	if clearGoal {
+		t.Goal = ""
+		return true, nil
+	}
+	if !goalSet {
+		return false, nil
+	}
+	v, _ := cmd.Flags().GetString("goal")
+	goals, err := cfg.Goals()
+	if err != nil {
+		return false, err
+	}
+	if err := task.ValidateGoal(v, config.GoalNames(goals)); err != nil {
+		return false, err
+	}
+	t.Goal = v
+	return true, nil
+}
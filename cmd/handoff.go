@@ -26,6 +26,7 @@ where standardized handoffs prevent information loss.`,
 
 func init() {
 	handoffCmd.Flags().String("claim", "", "claim task for an agent (required)")
+	handoffCmd.Flags().String("reviewer", "", "set reviewer (\"me\" for $KANBAN_USER or $USER)")
 	handoffCmd.Flags().String("note", "", "handoff note to append to body")
 	handoffCmd.Flags().BoolP("timestamp", "t", false, "prefix a timestamp line to the note")
 	handoffCmd.Flags().String("block", "", "mark task as blocked with reason")
@@ -34,12 +35,15 @@ func init() {
 }
 
 func runHandoff(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig()
+	ids, err := parseIDs(args[0])
 	if err != nil {
 		return err
 	}
@@ -65,7 +69,7 @@ func handoffSingleTask(cfg *config.Config, id int, cmd *cobra.Command) error {
 	}
 
 	var parts []string
-	parts = append(parts, fmt.Sprintf("Handed off task #%d -> review", t.ID))
+	parts = append(parts, fmt.Sprintf("Handed off task %s -> review", output.FormatID(t.ID)))
 	if t.Blocked {
 		parts = append(parts, fmt.Sprintf("(blocked: %s)", t.BlockReason))
 	}
@@ -87,12 +91,12 @@ func executeHandoff(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task,
 		return nil, clierr.New(clierr.InvalidInput, "claim name is required (use --claim NAME)")
 	}
 
-	path, err := task.FindByID(cfg.TasksPath(), id)
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +113,10 @@ func executeHandoff(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task,
 			"board has no 'review' status; add one to use handoff")
 	}
 
+	if _, err = applyReviewerFlag(cmd, t); err != nil {
+		return nil, err
+	}
+
 	// Move to review (skip if already there).
 	oldStatus := t.Status
 	if t.Status != reviewStatus {
@@ -116,11 +124,15 @@ func executeHandoff(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task,
 		if cfg.StatusRequiresClaim(reviewStatus) && claimant == "" {
 			return nil, task.ValidateClaimRequired(reviewStatus)
 		}
+		if err = checkReviewer(cfg, t, reviewStatus); err != nil {
+			return nil, err
+		}
 		if err = enforceMoveWIP(cfg, t, reviewStatus); err != nil {
 			return nil, err
 		}
 		t.Status = reviewStatus
 		task.UpdateTimestamps(t, oldStatus, reviewStatus, cfg)
+		task.ApplyChecklist(t, cfg)
 	}
 
 	// Apply claim (refresh).
@@ -133,13 +145,14 @@ func executeHandoff(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task,
 		if blockReason == "" {
 			return nil, clierr.New(clierr.InvalidInput, "block reason is required (use --block REASON)")
 		}
-		t.Blocked = true
-		t.BlockReason = blockReason
+		task.Block(t, blockReason, nil)
 	}
 
 	// Append note.
 	if note != "" {
-		t.Body = appendBody(t.Body, note, addTimestamp)
+		if err := editBody(cfg, t, func(body string) string { return appendBody(body, note, addTimestamp) }); err != nil {
+			return nil, err
+		}
 	}
 
 	// Release claim if requested.
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Score board health",
+	Long: `Computes board health indicators — WIP against limits, 95th percentile
+age of started work, blocked and overdue ratios, and stale claims — and
+scores them against health.thresholds in config.yml.
+
+Exits with status 1 when any indicator reaches its critical threshold, so
+it can alert from CI or cron.`,
+	Args: cobra.NoArgs,
+	RunE: runHealth,
+}
+
+func init() {
+	rootCmd.AddCommand(healthCmd)
+}
+
+func runHealth(_ *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	report := board.ComputeHealth(cfg, tasks, time.Now())
+	if err := outputHealth(report); err != nil {
+		return err
+	}
+	if !report.Healthy() {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
+
+func outputHealth(r board.HealthReport) error {
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, r)
+	case output.FormatCompact:
+		fmt.Fprintf(os.Stdout, "health %d/100 (%s)\n", r.Score, r.Status)
+		for _, ind := range r.Indicators {
+			fmt.Fprintf(os.Stdout, "  %s=%s %s (%s)\n", ind.Name, formatHealthValue(ind.Value), ind.Status, ind.Detail)
+		}
+	default:
+		output.Messagef(os.Stdout, "Board health: %d/100 (%s)", r.Score, r.Status)
+		fmt.Fprintf(os.Stdout, "  %-16s %8s %8s %8s  %-8s  %s\n", "INDICATOR", "VALUE", "WARN", "CRITICAL", "STATUS", "DETAIL")
+		for _, ind := range r.Indicators {
+			fmt.Fprintf(os.Stdout, "  %-16s %8s %8s %8s  %-8s  %s\n", ind.Name, formatHealthValue(ind.Value),
+				formatHealthValue(ind.Warn), formatHealthValue(ind.Critical), ind.Status, ind.Detail)
+		}
+	}
+	return nil
+}
+
+// formatHealthValue prints a value without trailing zeros.
+func formatHealthValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
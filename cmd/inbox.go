@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/inbox"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var inboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "File new tasks from the configured intake sources",
+	Long: `Reads the sources in the inbox section of config.yml and creates a task in
+inbox.status (default: defaults.status) for each new item:
+
+  folder   Markdown notes (*.md); a leading "# " heading is the title
+  email    messages (*.eml) from an email-to-file bridge; the subject is the title
+  webhook  JSON items (*.json) spooled by a webhook receiver
+
+Items are deduplicated by a hash of their title and body, remembered across
+runs, so a note delivered twice is filed once. Files are removed once filed
+(or found to be duplicates) unless the source sets keep: true. Files that
+cannot be read as items are reported and left in place.`,
+	Args: cobra.NoArgs,
+	RunE: runInbox,
+}
+
+func init() {
+	inboxCmd.Flags().StringSlice("source", nil, "only read these sources (comma-separated names)")
+	inboxCmd.Flags().Bool("dry-run", false, "show what would be filed without changing anything")
+	rootCmd.AddCommand(inboxCmd)
+}
+
+// inboxEntry is one item in the inbox command's output.
+type inboxEntry struct {
+	Source      string `json:"source"`
+	File        string `json:"file"`
+	Title       string `json:"title"`
+	ID          int    `json:"id,omitempty"`
+	DuplicateOf int    `json:"duplicate_of,omitempty"`
+}
+
+// inboxResult is the JSON output of inbox.
+type inboxResult struct {
+	Created    []inboxEntry    `json:"created"`
+	Duplicates []inboxEntry    `json:"duplicates"`
+	Skipped    []inbox.Skipped `json:"skipped"`
+	DryRun     bool            `json:"dry_run,omitempty"`
+}
+
+func runInbox(cmd *cobra.Command, _ []string) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	names, _ := cmd.Flags().GetStringSlice("source")
+	sources, err := inboxSources(cfg, names)
+	if err != nil {
+		return err
+	}
+	ledger, err := inbox.LoadLedger(cfg.Dir())
+	if err != nil {
+		return err
+	}
+	if err := board.SyncNextID(cfg); err != nil {
+		return err
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	res := inboxResult{Created: []inboxEntry{}, Duplicates: []inboxEntry{}, Skipped: []inbox.Skipped{}, DryRun: dryRun}
+	for _, src := range sources {
+		items, skipped, err := inbox.Collect(src, cfg.InboxSourcePath(src))
+		if err != nil {
+			return err
+		}
+		res.Skipped = append(res.Skipped, skipped...)
+		for _, item := range items {
+			if err := fileInboxItem(cfg, ledger, src, item, &res); err != nil {
+				return err
+			}
+		}
+	}
+	if len(res.Created) > 0 && !dryRun {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+	}
+	return printInboxResult(res)
+}
+
+// inboxSources returns the configured sources with the given names, or all
+// of them when names is empty.
+func inboxSources(cfg *config.Config, names []string) ([]config.InboxSource, error) {
+	all := cfg.Inbox.Sources
+	if len(all) == 0 {
+		return nil, clierr.New(clierr.InvalidInput, "no inbox sources configured; add them under inbox.sources in config.yml")
+	}
+	if len(names) == 0 {
+		return all, nil
+	}
+	sources := make([]config.InboxSource, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(all, func(s config.InboxSource) bool { return s.Name == name })
+		if i < 0 {
+			known := make([]string, len(all))
+			for j, s := range all {
+				known[j] = s.Name
+			}
+			return nil, clierr.Newf(clierr.InvalidInput, "unknown inbox source %q", name).
+				WithDetails(map[string]any{"sources": known})
+		}
+		sources = append(sources, all[i])
+	}
+	return sources, nil
+}
+
+// fileInboxItem creates a task for item unless the ledger already has its
+// content, and records the outcome in res. A dry run only records it.
+func fileInboxItem(cfg *config.Config, ledger inbox.Ledger, src config.InboxSource, item inbox.Item, res *inboxResult) error {
+	entry := inboxEntry{Source: item.Source, File: item.File, Title: item.Title}
+	dryRun := res.DryRun
+	if id, ok := ledger[item.Hash]; ok {
+		entry.DuplicateOf = id
+		res.Duplicates = append(res.Duplicates, entry)
+		verbosef(verboseDecisions, "inbox: %s duplicates #%d", item.File, id)
+		return removeInboxFile(src, item, dryRun)
+	}
+
+	entry.ID = cfg.NextID
+	res.Created = append(res.Created, entry)
+	if dryRun {
+		ledger[item.Hash] = cfg.NextID
+		cfg.NextID++
+		return nil
+	}
+	now := time.Now()
+	t := &task.Task{
+		ID:       cfg.NextID,
+		UID:      task.NewUID(),
+		Title:    item.Title,
+		Status:   cfg.InboxStatus(),
+		Priority: cfg.Defaults.Priority,
+		Class:    cfg.Defaults.Class,
+		Tags:     item.Tags,
+		Body:     item.Body,
+		Created:  now,
+		Updated:  now,
+	}
+	task.RecordStatus(t, t.Status, now)
+	task.ApplyChecklist(t, cfg)
+	path := filepath.Join(cfg.TasksPath(), task.Filename(cfg, t))
+	if err := os.MkdirAll(cfg.TasksPath(), tasksDirMode); err != nil {
+		return fmt.Errorf("creating tasks directory: %w", err)
+	}
+	if err := task.Write(path, t); err != nil {
+		return fmt.Errorf("writing task: %w", err)
+	}
+	cfg.NextID++
+	if err := ledger.Record(cfg.Dir(), item.Hash, t.ID); err != nil {
+		return err
+	}
+	logActivity(cfg, "create", t.ID, t.Title)
+	return removeInboxFile(src, item, false)
+}
+
+// removeInboxFile removes a filed item's file unless its source keeps
+// files. A file already gone is not an error.
+func removeInboxFile(src config.InboxSource, item inbox.Item, dryRun bool) error {
+	if src.Keep || dryRun {
+		return nil
+	}
+	if err := os.Remove(item.File); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing filed item: %w", err)
+	}
+	return nil
+}
+
+func printInboxResult(res inboxResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	verb := "Created"
+	if res.DryRun {
+		verb = "Would create"
+	}
+	for _, e := range res.Created {
+		output.Messagef(os.Stdout, "%s task %s: %s (%s)", verb, output.FormatID(e.ID), e.Title, e.Source)
+	}
+	for _, e := range res.Duplicates {
+		output.Messagef(os.Stdout, "Duplicate of %s: %s", output.FormatID(e.DuplicateOf), e.File)
+	}
+	for _, s := range res.Skipped {
+		warnf("skipped %s: %s", s.File, s.Reason)
+	}
+	if len(res.Created) == 0 && len(res.Duplicates) == 0 {
+		output.Messagef(os.Stdout, "Inbox is empty")
+	}
+	return nil
+}
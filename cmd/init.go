@@ -1,34 +1,57 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/antopolskiy/kanban-md/internal/boardtemplate"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/rules"
 )
 
+// initDirMode is the mode of the directories init creates.
+const initDirMode = 0o750
+
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize a new kanban board",
-	Long:  `Creates a kanban directory with config.yml and tasks/ subdirectory.`,
-	RunE:  runInit,
+	Long: `Creates a kanban directory with config.yml and tasks/ subdirectory.
+
+With --interactive (-i), asks for the board name, statuses, WIP limits,
+classes of service, agent claims, and .gitignore handling, then writes a
+config.yml with a comment on every setting. Flags given alongside -i become
+the suggested answers.
+
+With --from-archive, starts from a board template written by
+"template board export": its config (statuses, classes, templates, and
+other settings) and rules, with the board named by --name or after the
+current directory.`,
+	RunE: runInit,
 }
 
 func init() {
 	initCmd.Flags().String("name", "", "board name (defaults to current directory name)")
 	initCmd.Flags().StringSlice("statuses", nil, "comma-separated list of statuses")
 	initCmd.Flags().StringSlice("wip-limit", nil, "WIP limit per status (format: status:N, repeatable)")
+	initCmd.Flags().BoolP("interactive", "i", false, "answer questions to set up the board and write a commented config")
+	initCmd.Flags().String("from-archive", "", "start from a board template archive (see template board export)")
 	rootCmd.AddCommand(initCmd)
 }
 
 func runInit(cmd *cobra.Command, _ []string) error {
+	if flagReadOnly {
+		return clierr.New(clierr.BoardReadOnly, "cannot initialize a board with --readonly")
+	}
 	dir := flagDir
 	if dir == "" {
 		dir = config.DefaultDir
@@ -45,50 +68,19 @@ func runInit(cmd *cobra.Command, _ []string) error {
 			WithDetails(map[string]any{"dir": absDir})
 	}
 
-	name, _ := cmd.Flags().GetString("name")
-	if name == "" {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("getting working directory: %w", err)
-		}
-		name = filepath.Base(cwd)
-	}
-
-	cfg := config.NewDefault(name)
-	cfg.SetDir(absDir)
-
-	if statuses, _ := cmd.Flags().GetStringSlice("statuses"); len(statuses) > 0 {
-		sc := make([]config.StatusConfig, len(statuses))
-		for i, s := range statuses {
-			sc[i] = config.StatusConfig{Name: s}
-		}
-		cfg.Statuses = sc
-		cfg.Defaults.Status = statuses[0]
-	}
-
-	if wipLimits, _ := cmd.Flags().GetStringSlice("wip-limit"); len(wipLimits) > 0 {
-		parsed, err := parseWIPLimits(wipLimits)
-		if err != nil {
-			return err
-		}
-		cfg.WIPLimits = parsed
+	interactive, _ := cmd.Flags().GetBool("interactive")
+	var cfg *config.Config
+	var answers initAnswers
+	if archive, _ := cmd.Flags().GetString("from-archive"); archive != "" {
+		cfg, err = initFromArchive(cmd, absDir, archive)
+	} else {
+		cfg, answers, err = initNewBoard(cmd, absDir, interactive)
 	}
-
-	if err := cfg.Validate(); err != nil {
+	if err != nil {
 		return err
 	}
-
-	// Create directories.
+	name := cfg.Board.Name
 	tasksDir := cfg.TasksPath()
-	const dirMode = 0o750
-	if err := os.MkdirAll(tasksDir, dirMode); err != nil {
-		return fmt.Errorf("creating tasks directory: %w", err)
-	}
-
-	// Write config.
-	if err := cfg.Save(); err != nil {
-		return fmt.Errorf("writing config: %w", err)
-	}
 
 	// Output result.
 	format := outputFormat()
@@ -109,13 +101,175 @@ func runInit(cmd *cobra.Command, _ []string) error {
 	output.Messagef(os.Stdout, "  Columns: %s", strings.Join(cfg.StatusNames(), ", "))
 	output.Messagef(os.Stdout, "  Hint:    Install agent skills with: kanban-md skill install")
 
-	if err := offerAddKanbanToGitignore(absDir); err != nil {
+	if err := updateInitGitignore(absDir, interactive, answers); err != nil {
 		return fmt.Errorf("updating .gitignore: %w", err)
 	}
 
 	return nil
 }
 
+// initNewBoard creates the board's tasks directory and config from the
+// init flags and, with --interactive, the questionnaire answers.
+func initNewBoard(cmd *cobra.Command, absDir string, interactive bool) (*config.Config, initAnswers, error) {
+	cfg, answers, err := newInitConfig(cmd, absDir, interactive)
+	if err != nil {
+		return nil, answers, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, answers, err
+	}
+
+	if err := os.MkdirAll(cfg.TasksPath(), initDirMode); err != nil {
+		return nil, answers, fmt.Errorf("creating tasks directory: %w", err)
+	}
+
+	save := cfg.Save
+	if interactive {
+		save = cfg.SaveCommented
+	}
+	if err := save(); err != nil {
+		return nil, answers, fmt.Errorf("writing config: %w", err)
+	}
+	return cfg, answers, nil
+}
+
+// initFromArchive creates the board from a template archive. A board that
+// fails to load is removed again.
+func initFromArchive(cmd *cobra.Command, absDir, archive string) (*config.Config, error) {
+	for _, flag := range []string{"statuses", "wip-limit", "interactive"} {
+		if cmd.Flags().Changed(flag) {
+			return nil, clierr.Newf(clierr.InvalidInput, "--from-archive cannot be combined with --%s", flag)
+		}
+	}
+	name, err := initBoardName(cmd)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(archive) //nolint:gosec // archive path from the user
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "opening archive: %v", err)
+	}
+	defer f.Close() //nolint:errcheck // read-only file
+
+	_, statErr := os.Stat(absDir)
+	created := errors.Is(statErr, os.ErrNotExist)
+	if err := os.MkdirAll(absDir, initDirMode); err != nil {
+		return nil, fmt.Errorf("creating board directory: %w", err)
+	}
+	files, err := boardtemplate.Extract(f, absDir)
+	if err == nil {
+		var cfg *config.Config
+		if cfg, err = setUpArchivedBoard(absDir, name, files); err == nil {
+			return cfg, nil
+		}
+	}
+
+	if created {
+		_ = os.RemoveAll(absDir)
+	} else {
+		for _, file := range files {
+			_ = os.Remove(filepath.Join(absDir, file))
+		}
+	}
+	if errors.Is(err, boardtemplate.ErrInvalid) {
+		return nil, clierr.New(clierr.InvalidInput, err.Error())
+	}
+	return nil, err
+}
+
+// setUpArchivedBoard loads and checks the config and rules extracted from
+// a template archive, then names the board and creates its tasks directory.
+func setUpArchivedBoard(absDir, name string, files []string) (*config.Config, error) {
+	cfg, err := config.Load(absDir)
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "archive config: %v", err)
+	}
+	if slices.Contains(files, rules.FileName) {
+		if _, err := rules.Load(rules.Path(absDir), cfg); err != nil {
+			return nil, clierr.Newf(clierr.InvalidInput, "archive rules: %v", err)
+		}
+	}
+	cfg.Board.Name = name
+	cfg.NextID = 1
+	if err := os.MkdirAll(cfg.TasksPath(), initDirMode); err != nil {
+		return nil, fmt.Errorf("creating tasks directory: %w", err)
+	}
+	if err := cfg.Save(); err != nil {
+		return nil, fmt.Errorf("writing config: %w", err)
+	}
+	return cfg, nil
+}
+
+// initBoardName returns --name, or else the current directory's name.
+func initBoardName(cmd *cobra.Command) (string, error) {
+	if name, _ := cmd.Flags().GetString("name"); name != "" {
+		return name, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("getting working directory: %w", err)
+	}
+	return filepath.Base(cwd), nil
+}
+
+// newInitConfig builds the new board's config from the init flags and,
+// with --interactive, the questionnaire answers.
+func newInitConfig(cmd *cobra.Command, absDir string, interactive bool) (*config.Config, initAnswers, error) {
+	var answers initAnswers
+	name, err := initBoardName(cmd)
+	if err != nil {
+		return nil, answers, err
+	}
+
+	cfg := config.NewDefault(name)
+	cfg.SetDir(absDir)
+
+	if statuses, _ := cmd.Flags().GetStringSlice("statuses"); len(statuses) > 0 {
+		sc := make([]config.StatusConfig, len(statuses))
+		for i, s := range statuses {
+			sc[i] = config.StatusConfig{Name: s}
+		}
+		cfg.Statuses = sc
+		cfg.Defaults.Status = statuses[0]
+	}
+
+	if wipLimits, _ := cmd.Flags().GetStringSlice("wip-limit"); len(wipLimits) > 0 {
+		parsed, err := parseWIPLimits(wipLimits)
+		if err != nil {
+			return nil, answers, err
+		}
+		cfg.WIPLimits = parsed
+	}
+
+	if interactive {
+		_, entry, err := gitignorePromptData(absDir)
+		if err != nil {
+			return nil, answers, err
+		}
+		p := &initPrompter{r: bufio.NewReader(os.Stdin), w: os.Stderr}
+		if answers, err = runInitQuestionnaire(p, cfg, entry); err != nil {
+			return nil, answers, clierr.New(clierr.InvalidInput, err.Error())
+		}
+	}
+	return cfg, answers, nil
+}
+
+// updateInitGitignore adds the board to .gitignore as answered in the
+// questionnaire, or otherwise offers to.
+func updateInitGitignore(absDir string, interactive bool, answers initAnswers) error {
+	if !interactive {
+		return offerAddKanbanToGitignore(absDir)
+	}
+	if !answers.gitignore {
+		return nil
+	}
+	path, entry, err := gitignorePromptData(absDir)
+	if err != nil {
+		return err
+	}
+	return ensureGitignoreEntry(path, entry)
+}
+
 // parseWIPLimits parses "status:N" pairs into a map.
 func parseWIPLimits(pairs []string) (map[string]int, error) {
 	limits := make(map[string]int, len(pairs))
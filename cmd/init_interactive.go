@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// initPrompter asks init -i questions on w and reads answers from r. An
+// empty answer, or end of input, takes the default; "-" clears it.
+type initPrompter struct {
+	r *bufio.Reader
+	w io.Writer
+}
+
+// ask prints question with its default and returns the trimmed answer,
+// asking again while check rejects it. At end of input a rejected answer
+// is an error.
+func (p *initPrompter) ask(question, def string, check func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(p.w, "%s [%s]: ", question, def)
+		} else {
+			fmt.Fprintf(p.w, "%s: ", question)
+		}
+		line, err := p.r.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("reading input: %w", err)
+		}
+		eof := err != nil
+		if eof {
+			fmt.Fprintln(p.w)
+		}
+		answer := strings.TrimSpace(line)
+		switch answer {
+		case "":
+			answer = def
+		case initClearAnswer:
+			answer = ""
+		}
+		if check == nil {
+			return answer, nil
+		}
+		checkErr := check(answer)
+		if checkErr == nil {
+			return answer, nil
+		}
+		if eof {
+			return "", checkErr
+		}
+		fmt.Fprintf(p.w, "  %v\n", checkErr)
+	}
+}
+
+// initClearAnswer answers a question with an empty value instead of its
+// default.
+const initClearAnswer = "-"
+
+// confirm asks a yes/no question.
+func (p *initPrompter) confirm(question string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+	answer, err := p.ask(question+" ("+hint+")", "", func(a string) error {
+		switch strings.ToLower(a) {
+		case "", "y", "yes", "n", "no":
+			return nil
+		}
+		return fmt.Errorf("answer y or n, not %q", a)
+	})
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	case "n", "no":
+		return false, nil
+	}
+	return def, nil
+}
+
+// initAnswers are the questionnaire results that are not config fields.
+type initAnswers struct {
+	gitignore bool
+}
+
+// runInitQuestionnaire walks through the board settings, starting from
+// cfg's values, and updates cfg with the answers.
+func runInitQuestionnaire(p *initPrompter, cfg *config.Config, gitignoreEntry string) (initAnswers, error) {
+	fmt.Fprintln(p.w, "Set up a new kanban-md board. Press Enter to accept a default, or - to leave it empty.")
+	var ans initAnswers
+
+	name, err := p.ask("Board name", cfg.Board.Name, nil)
+	if err != nil {
+		return ans, err
+	}
+	cfg.Board.Name = name
+
+	if err := askStatuses(p, cfg); err != nil {
+		return ans, err
+	}
+	if err := askWIPLimits(p, cfg); err != nil {
+		return ans, err
+	}
+	if err := askClasses(p, cfg); err != nil {
+		return ans, err
+	}
+	if err := askClaims(p, cfg); err != nil {
+		return ans, err
+	}
+
+	ans.gitignore, err = p.confirm(fmt.Sprintf("Keep the board out of git by adding %q to .gitignore?", gitignoreEntry), true)
+	return ans, err
+}
+
+func askStatuses(p *initPrompter, cfg *config.Config) error {
+	answer, err := p.ask("Statuses, in board order", strings.Join(cfg.StatusNames(), ","), func(a string) error {
+		if len(splitConfigList(a)) < 2 { //nolint:mnd // a board needs at least two columns
+			return errors.New("list at least two statuses, comma-separated")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	names := splitConfigList(answer)
+	cfg.Statuses = statusConfigs(names)
+	cfg.Defaults.Status = names[0]
+	return nil
+}
+
+// statusConfigs builds status entries for names, keeping the default
+// settings of well-known statuses such as hiding durations on done.
+func statusConfigs(names []string) []config.StatusConfig {
+	out := make([]config.StatusConfig, len(names))
+	for i, name := range names {
+		out[i] = config.StatusConfig{Name: name}
+		for _, d := range config.DefaultStatuses {
+			if d.Name == name {
+				out[i] = d
+			}
+		}
+	}
+	return out
+}
+
+func askWIPLimits(p *initPrompter, cfg *config.Config) error {
+	answer, err := p.ask("WIP limits as status:N, comma-separated", formatWIPLimits(cfg), func(a string) error {
+		limits, err := parseWIPLimits(splitConfigList(a))
+		if err != nil {
+			return err
+		}
+		for status := range limits {
+			if cfg.StatusIndex(status) < 0 {
+				return fmt.Errorf("unknown status %q", status)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	limits, _ := parseWIPLimits(splitConfigList(answer))
+	cfg.WIPLimits = nil
+	if len(limits) > 0 {
+		cfg.WIPLimits = limits
+	}
+	return nil
+}
+
+// formatWIPLimits renders cfg's WIP limits in board order as status:N pairs.
+func formatWIPLimits(cfg *config.Config) string {
+	var parts []string
+	for _, s := range cfg.StatusNames() {
+		if n := cfg.WIPLimits[s]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d", s, n))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func askClasses(p *initPrompter, cfg *config.Config) error {
+	names := make([]string, len(config.DefaultClasses))
+	for i, c := range config.DefaultClasses {
+		names[i] = c.Name
+	}
+	use, err := p.confirm("Use classes of service ("+strings.Join(names, ", ")+")?", len(cfg.Classes) > 0)
+	if err != nil {
+		return err
+	}
+	if use {
+		cfg.Classes = append([]config.ClassConfig{}, config.DefaultClasses...)
+		cfg.Defaults.Class = config.DefaultClass
+	} else {
+		cfg.Classes = nil
+		cfg.Defaults.Class = ""
+	}
+	return nil
+}
+
+func askClaims(p *initPrompter, cfg *config.Config) error {
+	use, err := p.confirm("Will agents work on this board? Claims stop two agents taking the same task", true)
+	if err != nil {
+		return err
+	}
+	if !use {
+		for i := range cfg.Statuses {
+			cfg.Statuses[i].RequireClaim = false
+		}
+		return nil
+	}
+
+	var required []string
+	for _, s := range cfg.Statuses {
+		if s.RequireClaim {
+			required = append(required, s.Name)
+		}
+	}
+	answer, err := p.ask("Statuses that require a claim, comma-separated", strings.Join(required, ","), func(a string) error {
+		for _, s := range splitConfigList(a) {
+			if cfg.StatusIndex(s) < 0 {
+				return fmt.Errorf("unknown status %q", s)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	required = splitConfigList(answer)
+	for i := range cfg.Statuses {
+		cfg.Statuses[i].RequireClaim = slices.Contains(required, cfg.Statuses[i].Name)
+	}
+
+	cfg.ClaimTimeout, err = p.ask("Claim timeout (e.g. 1h, 30m; 0 for never)", cfg.ClaimTimeout, func(a string) error {
+		if _, err := time.ParseDuration(a); err != nil {
+			return fmt.Errorf("invalid duration %q", a)
+		}
+		return nil
+	})
+	if cfg.ClaimTimeout == "0" {
+		cfg.ClaimTimeout = ""
+	}
+	return err
+}
@@ -1,7 +1,11 @@
 // Package main is the entry point for the kanban-md CLI.
 package main
 
-import "github.com/antopolskiy/kanban-md/cmd"
+import (
+	_ "time/tzdata" // display.timezone must resolve on systems without a zoneinfo database
+
+	"github.com/antopolskiy/kanban-md/cmd"
+)
 
 func main() {
 	cmd.Execute()
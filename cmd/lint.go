@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [FILE...]",
+	Short: "Check task files against style rules",
+	Long: `Checks task files against the rules in the lint section of config.yml:
+frontmatter that parses, known status, priority, and class values, title
+length, required tags, a non-empty body from a given priority up, and
+forbidden words. Each rule's severity is error, warning, or off.
+
+With no arguments every task file is checked; otherwise only the given
+files. --staged checks the task files staged in git, as they are staged,
+for use as a pre-commit hook. Archived tasks are only checked for unknown
+field values.
+
+Exits with status 1 when there are errors, or warnings with --fail-on
+warning.`,
+	RunE: runLint,
+}
+
+func init() {
+	lintCmd.Flags().Bool("staged", false, "check the task files staged in git instead of the working tree")
+	rootCmd.AddCommand(lintCmd)
+}
+
+// lintResult is the JSON output of lint.
+type lintResult struct {
+	Files    int              `json:"files"`
+	Errors   int              `json:"errors"`
+	Warnings int              `json:"warnings"`
+	Issues   []task.LintIssue `json:"issues"`
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	staged, _ := cmd.Flags().GetBool("staged")
+	if staged && len(args) > 0 {
+		return clierr.New(clierr.InvalidInput, "--staged cannot be combined with file arguments")
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	result := lintFiles(cfg, args)
+	switch {
+	case staged:
+		result, err = lintStaged(cfg)
+	case len(args) == 0:
+		result, err = lintBoard(cfg)
+	}
+	if err != nil {
+		return err
+	}
+	for _, issue := range result.Issues {
+		if issue.Severity == config.LintError {
+			result.Errors++
+		} else {
+			result.Warnings++
+		}
+	}
+	// Lint warnings count toward --fail-on warning.
+	warningCount += result.Warnings
+
+	if err := outputLintResult(result); err != nil {
+		return err
+	}
+	if result.Errors > 0 {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
+
+// lintFiles checks the given task files, skipping files that are not
+// markdown.
+func lintFiles(cfg *config.Config, paths []string) lintResult {
+	result := lintResult{Issues: []task.LintIssue{}}
+	for _, path := range paths {
+		if filepath.Ext(path) != ".md" {
+			continue
+		}
+		result.Files++
+		t, err := task.Read(path)
+		if err != nil {
+			result.Issues = append(result.Issues, task.LintReadError(cfg, path, err)...)
+			continue
+		}
+		t.File = path
+		result.Issues = append(result.Issues, task.Lint(cfg, t)...)
+	}
+	return result
+}
+
+// lintBoard checks every task file of the board.
+func lintBoard(cfg *config.Config) (lintResult, error) {
+	result := lintResult{Issues: []task.LintIssue{}}
+	for _, dir := range cfg.TasksPaths() {
+		tasks, warnings, err := task.ReadAllLenient(dir)
+		if err != nil {
+			return result, err
+		}
+		result.Files += len(tasks) + len(warnings)
+		for _, w := range warnings {
+			path := lintPath(filepath.Join(dir, w.File))
+			result.Issues = append(result.Issues, task.LintReadError(cfg, path, w.Err)...)
+		}
+		for _, t := range tasks {
+			t.File = lintPath(t.File)
+			result.Issues = append(result.Issues, task.Lint(cfg, t)...)
+		}
+	}
+	return result, nil
+}
+
+// lintStaged checks the staged versions of the task files staged in git.
+// Deleted files are left out.
+func lintStaged(cfg *config.Config) (lintResult, error) {
+	result := lintResult{Issues: []task.LintIssue{}}
+	root, err := git(cfg.Dir(), "rev-parse", "--show-toplevel")
+	if err != nil {
+		return result, clierr.Newf(clierr.InvalidInput, "--staged needs the board in a git repository: %v", err)
+	}
+	root = strings.TrimSpace(root)
+	names, err := git(root, "diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR")
+	if err != nil {
+		return result, err
+	}
+
+	dirs := make(map[string]bool)
+	for _, dir := range cfg.TasksPaths() {
+		if real, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = real
+		}
+		dirs[dir] = true
+	}
+	for _, name := range strings.Split(names, "\x00") {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if filepath.Ext(path) != ".md" || !dirs[filepath.Dir(path)] {
+			continue
+		}
+		result.Files++
+		data, err := git(root, "show", ":"+name)
+		if err != nil {
+			return result, err
+		}
+		path = lintPath(path)
+		t, err := task.Parse(path, []byte(data))
+		if err != nil {
+			result.Issues = append(result.Issues, task.LintReadError(cfg, path, err)...)
+			continue
+		}
+		result.Issues = append(result.Issues, task.Lint(cfg, t)...)
+	}
+	return result, nil
+}
+
+// git runs git in dir and returns its output.
+func git(dir string, args ...string) (string, error) {
+	c := exec.CommandContext(context.Background(), "git", append([]string{"-C", dir}, args...)...) //nolint:gosec // fixed git subcommands
+	var stdout, stderr bytes.Buffer
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git: %s", msg)
+		}
+		return "", fmt.Errorf("git: %w", err)
+	}
+	return stdout.String(), nil
+}
+
+// lintPath returns path relative to the working directory when it is
+// below it, as editors and hooks expect.
+func lintPath(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
+
+func outputLintResult(r lintResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, r)
+	}
+	for _, issue := range r.Issues {
+		fmt.Fprintf(os.Stdout, "%s: %s [%s] %s\n", issue.File, issue.Severity, issue.Rule, issue.Message)
+	}
+	switch {
+	case outputFormat() == output.FormatCompact:
+	case len(r.Issues) == 0:
+		output.Messagef(os.Stdout, "No lint issues in %d file(s)", r.Files)
+	default:
+		output.Messagef(os.Stdout, "%d error(s), %d warning(s) in %d file(s)", r.Errors, r.Warnings, r.Files)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -10,6 +11,8 @@ import (
 	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
@@ -26,19 +29,27 @@ func init() {
 	listCmd.Flags().StringSlice("status", nil, "filter by status (comma-separated)")
 	listCmd.Flags().StringSlice("priority", nil, "filter by priority (comma-separated)")
 	listCmd.Flags().String("assignee", "", "filter by assignee")
+	listCmd.Flags().String("reviewer", "", "filter by reviewer (\"me\" for $KANBAN_USER or $USER)")
 	listCmd.Flags().String("tag", "", "filter by tag")
 	listCmd.Flags().String("sort", "id", "sort field (id, status, priority, created, updated, due)")
 	listCmd.Flags().BoolP("reverse", "r", false, "reverse sort order")
-	listCmd.Flags().IntP("limit", "n", 0, "limit number of results")
+	listCmd.Flags().IntP("limit", "n", 0, "limit number of results (the page size when paging)")
+	listCmd.Flags().Int("offset", 0, "skip this many results; pages JSON output (see --cursor)")
+	listCmd.Flags().String("cursor", "", "continue from the next_cursor of the previous page")
 	listCmd.Flags().Bool("blocked", false, "show only blocked tasks")
 	listCmd.Flags().Bool("not-blocked", false, "show only non-blocked tasks")
-	listCmd.Flags().Int("parent", 0, "filter by parent task ID")
+	listCmd.Flags().String("parent", "", "filter by parent task ID")
 	listCmd.Flags().Bool("unblocked", false, "show only tasks with all dependencies satisfied (missing dependency IDs are treated as satisfied)")
 	listCmd.Flags().Bool("unclaimed", false, "show only unclaimed or expired-claim tasks")
+	listCmd.Flags().Bool("ready", false, "show only tasks ready to start (the rule pick uses: see ready in config)")
 	listCmd.Flags().String("claimed-by", "", "filter by claimant")
 	listCmd.Flags().String("class", "", "filter by class of service")
+	listCmd.Flags().String("workstream", "", "filter by workstream (a tasks_dirs entry)")
+	listCmd.Flags().String("goal", "", "filter by goal (a goals.yml entry)")
 	listCmd.Flags().StringP("search", "s", "", "search tasks by title, body, or tags (case-insensitive)")
 	listCmd.Flags().Bool("archived", false, "show only archived tasks")
+	listCmd.Flags().String("due-within", "", "show only tasks due within this many days, e.g. 3d or 2w (overdue included)")
+	listCmd.Flags().Bool("business-days", false, "count --due-within in working days of the board calendar")
 	listCmd.Flags().String("group-by", "", "group results by field ("+strings.Join(board.ValidGroupByFields(), ", ")+")")
 	rootCmd.AddCommand(listCmd)
 }
@@ -49,36 +60,71 @@ func runList(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	opts, groupBy, err := listOptions(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	if page, ok := pageFlags(cmd); ok {
+		return runListPage(cfg, opts, page, groupBy)
+	}
+
+	tasks, warnings, err := board.List(cfg, opts)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+	warnBlockReviews(tasks)
+
+	if groupBy != "" {
+		return outputGroupedList(tasks, groupBy, cfg)
+	}
+
+	return outputTaskList(tasks)
+}
+
+// listOptions reads the list filter and sort flags of cmd, and the
+// --group-by field.
+func listOptions(cmd *cobra.Command, cfg *config.Config) (board.ListOptions, string, error) {
 	statuses, _ := cmd.Flags().GetStringSlice("status")
 	priorities, _ := cmd.Flags().GetStringSlice("priority")
 	assignee, _ := cmd.Flags().GetString("assignee")
+	reviewer, _ := cmd.Flags().GetString("reviewer")
 	tag, _ := cmd.Flags().GetString("tag")
 	sortBy, _ := cmd.Flags().GetString("sort")
 	reverse, _ := cmd.Flags().GetBool("reverse")
 	limit, _ := cmd.Flags().GetInt("limit")
-	blocked, _ := cmd.Flags().GetBool("blocked")
-	notBlocked, _ := cmd.Flags().GetBool("not-blocked")
-	parentID, _ := cmd.Flags().GetInt("parent")
 	unblocked, _ := cmd.Flags().GetBool("unblocked")
 	unclaimed, _ := cmd.Flags().GetBool("unclaimed")
 	claimedBy, _ := cmd.Flags().GetString("claimed-by")
 	class, _ := cmd.Flags().GetString("class")
+	goal, _ := cmd.Flags().GetString("goal")
 	search, _ := cmd.Flags().GetString("search")
 	groupBy, _ := cmd.Flags().GetString("group-by")
 	archived, _ := cmd.Flags().GetBool("archived")
 
 	if groupBy != "" && !slices.Contains(board.ValidGroupByFields(), groupBy) {
-		return clierr.Newf(clierr.InvalidGroupBy, "invalid --group-by field %q; valid: %s",
+		return board.ListOptions{}, "", clierr.Newf(clierr.InvalidGroupBy, "invalid --group-by field %q; valid: %s",
 			groupBy, strings.Join(board.ValidGroupByFields(), ", "))
 	}
 
+	reviewer, err := resolveMe(reviewer)
+	if err != nil {
+		return board.ListOptions{}, "", err
+	}
+
 	filter := board.FilterOptions{
 		Statuses:     statuses,
 		Priorities:   priorities,
 		Assignee:     assignee,
+		Reviewer:     reviewer,
 		Tag:          tag,
 		Search:       search,
+		Unclaimed:    unclaimed,
+		ClaimedBy:    claimedBy,
+		Class:        class,
+		Goal:         goal,
 		ClaimTimeout: cfg.ClaimTimeoutDuration(),
+		Blocked:      blockedFlag(cmd),
 	}
 
 	// --archived flag: show only archived tasks.
@@ -89,26 +135,8 @@ func runList(cmd *cobra.Command, _ []string) error {
 		filter.ExcludeStatuses = []string{config.ArchivedStatus}
 	}
 
-	if unclaimed {
-		filter.Unclaimed = true
-	}
-	if claimedBy != "" {
-		filter.ClaimedBy = claimedBy
-	}
-	if class != "" {
-		filter.Class = class
-	}
-
-	if blocked {
-		v := true
-		filter.Blocked = &v
-	} else if notBlocked {
-		v := false
-		filter.Blocked = &v
-	}
-
-	if cmd.Flags().Changed("parent") {
-		filter.ParentID = &parentID
+	if err := applyListRefFilters(cmd, cfg, &filter); err != nil {
+		return board.ListOptions{}, "", err
 	}
 
 	opts := board.ListOptions{
@@ -118,23 +146,110 @@ func runList(cmd *cobra.Command, _ []string) error {
 		Limit:     limit,
 		Unblocked: unblocked,
 	}
+	opts.Ready, _ = cmd.Flags().GetBool("ready")
+	return opts, groupBy, nil
+}
 
-	tasks, warnings, err := board.List(cfg, opts)
+// runListPage lists one page of tasks. JSON output is a page object with
+// the next_cursor to continue from.
+func runListPage(cfg *config.Config, opts board.ListOptions, page board.PageOptions, groupBy string) error {
+	if groupBy != "" {
+		return clierr.New(clierr.InvalidInput, "--group-by cannot be combined with --offset or --cursor")
+	}
+	if err := validatePage(page); err != nil {
+		return err
+	}
+	p, warnings, err := board.ListPage(cfg, opts, page)
 	if err != nil {
 		return err
 	}
 	printWarnings(warnings)
+	warnBlockReviews(p.Tasks)
 
-	if groupBy != "" {
-		return outputGroupedList(tasks, groupBy, cfg)
+	if outputFormat() == output.FormatJSON {
+		crypt.Reveal(p.Tasks...)
+		return output.JSON(os.Stdout, p)
+	}
+	if err := outputTaskList(p.Tasks); err != nil {
+		return err
 	}
+	printNextCursor(p.NextCursor)
+	return nil
+}
 
-	return outputTaskList(tasks)
+// blockedFlag returns the --blocked or --not-blocked filter, or nil.
+func blockedFlag(cmd *cobra.Command) *bool {
+	if v, _ := cmd.Flags().GetBool("blocked"); v {
+		return &v
+	}
+	if v, _ := cmd.Flags().GetBool("not-blocked"); v {
+		blocked := false
+		return &blocked
+	}
+	return nil
+}
+
+// applyListRefFilters sets the filters whose flags are resolved against the
+// board: --parent, --due-within, and --workstream.
+func applyListRefFilters(cmd *cobra.Command, cfg *config.Config, filter *board.FilterOptions) error {
+	if cmd.Flags().Changed("parent") {
+		parentID, err := idFlag(cmd, "parent")
+		if err != nil {
+			return err
+		}
+		filter.ParentID = &parentID
+	}
+	if v, _ := cmd.Flags().GetString("due-within"); v != "" {
+		businessDays, _ := cmd.Flags().GetBool("business-days")
+		dueBy, err := dueWithinDate(cfg, v, businessDays)
+		if err != nil {
+			return err
+		}
+		filter.DueBy = &dueBy
+	}
+	var err error
+	filter.Workstream, err = workstreamFlag(cmd, cfg)
+	return err
+}
+
+// dueWithinDate resolves a --due-within span such as "3d" or "2w" to the
+// last date it covers. With businessDays, the span counts working days of
+// the board calendar and a week is five of them.
+func dueWithinDate(cfg *config.Config, span string, businessDays bool) (date.Date, error) {
+	invalid := clierr.Newf(clierr.InvalidInput, "invalid --due-within %q: expected a number of days or weeks, e.g. 3d or 2w", span).
+		WithDetails(map[string]any{"input": span})
+	s := strings.ToLower(strings.TrimSpace(span))
+	if len(s) < 2 { //nolint:mnd // number plus unit
+		return date.Date{}, invalid
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n < 0 {
+		return date.Date{}, invalid
+	}
+	const calendarWeek, workWeek = 7, 5
+	switch s[len(s)-1] {
+	case 'd':
+	case 'w':
+		if businessDays {
+			n *= workWeek
+		} else {
+			n *= calendarWeek
+		}
+	default:
+		return date.Date{}, invalid
+	}
+
+	today := date.Today()
+	if businessDays {
+		return board.AddWorkdays(cfg, today, n), nil
+	}
+	return date.Date{Time: today.AddDate(0, 0, n)}, nil
 }
 
 func outputGroupedList(tasks []*task.Task, groupBy string, cfg *config.Config) error {
 	grouped := board.GroupBy(tasks, groupBy, cfg)
 	if outputFormat() == output.FormatJSON {
+		crypt.Reveal(tasks...)
 		return output.JSON(os.Stdout, grouped)
 	}
 	output.GroupedTable(os.Stdout, grouped)
@@ -147,6 +262,7 @@ func outputTaskList(tasks []*task.Task) error {
 		if tasks == nil {
 			tasks = []*task.Task{}
 		}
+		crypt.Reveal(tasks...)
 		return output.JSON(os.Stdout, tasks)
 	}
 	if format == output.FormatCompact {
@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
@@ -18,11 +22,50 @@ var logCmd = &cobra.Command{
 	RunE:  runLog,
 }
 
+const defaultLogDiffDays = 30
+
+var logDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show board activity between two dates",
+	Long: `Shows the activity logged from --from up to --to. --to is exclusive, so
+--from 2026-01-01 --to 2026-02-01 covers January. --to defaults to now and
+--from to 30 days before --to.
+
+With --summary, entries are aggregated into what happened — tasks created,
+completed, deleted, and blocked, with tasks that are still blocked listed
+first — for periodic reviews.`,
+	Args: cobra.NoArgs,
+	RunE: runLogDiff,
+}
+
+var logReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Send activity log entries to a log sink",
+	Long: `Backfills a sink from log.sinks with entries from the activity log, for
+example after adding a sink or after an outage longer than its queue covers.
+Entries still queued for the sink are delivered too.`,
+	Args: cobra.NoArgs,
+	RunE: runLogReplay,
+}
+
 func init() {
-	logCmd.Flags().String("since", "", "show entries after this date (YYYY-MM-DD)")
-	logCmd.Flags().Int("limit", 0, "maximum number of entries to show (most recent)")
+	logReplayCmd.Flags().String("sink", "", "name of the sink in log.sinks (required)")
+	logReplayCmd.Flags().String("since", "", "only replay entries after this date (YYYY-MM-DD or relative, e.g. -1w)")
+	_ = logReplayCmd.MarkFlagRequired("sink")
+	logCmd.AddCommand(logReplayCmd)
+
+	logDiffCmd.Flags().String("from", "", "start date, inclusive (YYYY-MM-DD or relative, e.g. -1m)")
+	logDiffCmd.Flags().String("to", "", "end date, exclusive (YYYY-MM-DD or relative; default now)")
+	logDiffCmd.Flags().Bool("summary", false, "aggregate entries into a narrative instead of listing them")
+	logCmd.AddCommand(logDiffCmd)
+
+	logCmd.Flags().String("since", "", "show entries after this date (YYYY-MM-DD or relative, e.g. -1w)")
+	logCmd.Flags().Int("limit", 0, "maximum number of entries to show (most recent; the page size when paging)")
+	logCmd.Flags().Int("offset", 0, "skip this many entries, oldest first; pages JSON output (see --cursor)")
+	logCmd.Flags().String("cursor", "", "continue from the next_cursor of the previous page")
 	logCmd.Flags().String("action", "", "filter by action type (create, move, edit, delete, block, unblock)")
-	logCmd.Flags().Int("task", 0, "filter by task ID")
+	logCmd.Flags().String("task", "", "filter by task ID")
+	logCmd.Flags().String("actor", "", "filter by the agent or user who made the change")
 	rootCmd.AddCommand(logCmd)
 }
 
@@ -35,27 +78,62 @@ func runLog(cmd *cobra.Command, _ []string) error {
 	opts := board.LogFilterOptions{}
 
 	if v, _ := cmd.Flags().GetString("since"); v != "" {
-		d, parseErr := date.Parse(v)
+		d, parseErr := date.ParseNatural(v)
 		if parseErr != nil {
 			return task.ValidateDate("since", v, parseErr)
 		}
 		opts.Since = d.Time
 	}
-	if v, _ := cmd.Flags().GetInt("limit"); v > 0 {
-		opts.Limit = v
+	page, paged := pageFlags(cmd)
+	if !paged && page.Limit > 0 {
+		opts.Limit = page.Limit
 	}
 	if v, _ := cmd.Flags().GetString("action"); v != "" {
 		opts.Action = v
 	}
-	if v, _ := cmd.Flags().GetInt("task"); v > 0 {
-		opts.TaskID = v
+	if cmd.Flags().Changed("task") {
+		id, err := idFlag(cmd, "task")
+		if err != nil {
+			return err
+		}
+		opts.TaskID = id
+	}
+	if v, _ := cmd.Flags().GetString("actor"); v != "" {
+		opts.Actor = v
 	}
 
+	if paged {
+		return runLogPage(cfg.Dir(), opts, page)
+	}
 	entries, err := board.ReadLog(cfg.Dir(), opts)
 	if err != nil {
 		return err
 	}
+	return outputLogEntries(entries)
+}
+
+// runLogPage shows one page of the log, oldest entries first. JSON output
+// is a page object with the next_cursor to continue from.
+func runLogPage(kanbanDir string, opts board.LogFilterOptions, page board.PageOptions) error {
+	if err := validatePage(page); err != nil {
+		return err
+	}
+	p, err := board.ReadLogPage(kanbanDir, opts, page)
+	if err != nil {
+		return err
+	}
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, p)
+	}
+	if err := outputLogEntries(p.Entries); err != nil {
+		return err
+	}
+	printNextCursor(p.NextCursor)
+	return nil
+}
 
+// outputLogEntries prints log entries in the current output format.
+func outputLogEntries(entries []board.LogEntry) error {
 	format := outputFormat()
 	if format == output.FormatJSON {
 		if entries == nil {
@@ -71,3 +149,109 @@ func runLog(cmd *cobra.Command, _ []string) error {
 	output.ActivityLogTable(os.Stdout, entries)
 	return nil
 }
+
+func runLogDiff(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	from, to, err := logDiffRange(cmd)
+	if err != nil {
+		return err
+	}
+
+	if summary, _ := cmd.Flags().GetBool("summary"); !summary {
+		entries, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{Since: from, Until: to})
+		if err != nil {
+			return err
+		}
+		return outputLogEntries(entries)
+	}
+
+	// Read the whole log: create entries before the period supply the
+	// titles of tasks deleted since.
+	entries, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{})
+	if err != nil {
+		return err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return fmt.Errorf("reading tasks: %w", err)
+	}
+	printWarnings(warnings)
+
+	s := board.SummarizeLog(cfg, entries, tasks, from, to)
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, s)
+	case output.FormatCompact:
+		output.LogSummaryCompact(os.Stdout, s)
+	default:
+		output.LogSummaryTable(os.Stdout, s)
+	}
+	return nil
+}
+
+// logDiffRange parses --from and --to into the period [from, to).
+func logDiffRange(cmd *cobra.Command) (time.Time, time.Time, error) {
+	to := time.Now()
+	if v, _ := cmd.Flags().GetString("to"); v != "" {
+		d, err := date.ParseNatural(v)
+		if err != nil {
+			return time.Time{}, time.Time{}, task.ValidateDate("to", v, err)
+		}
+		to = d.Time
+	}
+	from := to.AddDate(0, 0, -defaultLogDiffDays)
+	if v, _ := cmd.Flags().GetString("from"); v != "" {
+		d, err := date.ParseNatural(v)
+		if err != nil {
+			return time.Time{}, time.Time{}, task.ValidateDate("from", v, err)
+		}
+		from = d.Time
+	}
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, clierr.New(clierr.InvalidInput, "--from must be before --to")
+	}
+	return from, to, nil
+}
+
+func runLogReplay(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	name, _ := cmd.Flags().GetString("sink")
+	sink, ok := cfg.LogSink(name)
+	if !ok {
+		var names []string
+		for _, s := range cfg.Log.Sinks {
+			names = append(names, s.Name)
+		}
+		return clierr.Newf(clierr.InvalidInput, "unknown log sink %q (configured: %s)", name, strings.Join(names, ", ")).
+			WithDetails(map[string]any{"sink": name, "sinks": names})
+	}
+
+	opts := board.LogFilterOptions{}
+	if v, _ := cmd.Flags().GetString("since"); v != "" {
+		d, parseErr := date.ParseNatural(v)
+		if parseErr != nil {
+			return task.ValidateDate("since", v, parseErr)
+		}
+		opts.Since = d.Time
+	}
+	entries, err := board.ReadLog(cfg.Dir(), opts)
+	if err != nil {
+		return err
+	}
+
+	n, err := board.ReplayLog(cfg.Dir(), sink, entries)
+	if err != nil {
+		return fmt.Errorf("replaying to sink %q after %d entries: %w", name, n, err)
+	}
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"sink": name, "replayed": n})
+	}
+	output.Messagef(os.Stdout, "Replayed %d entries to sink %s", n, name)
+	return nil
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// manifestSchemaVersion is bumped when the manifest layout changes in a way
+// that breaks consumers (fields added with new names do not count).
+const manifestSchemaVersion = 1
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Describe every command, flag, and error code",
+	Long: `Prints a machine-readable description of the CLI: every command with its
+usage, aliases, and flags (name, shorthand, type, default), the global flags,
+and every error code with its exit status.
+
+Use --json to generate or validate agent skills and wrapper libraries
+instead of maintaining them by hand.`,
+	Args: cobra.NoArgs,
+	RunE: runManifest,
+}
+
+func init() {
+	rootCmd.AddCommand(manifestCmd)
+}
+
+// manifest is the top-level JSON document printed by the manifest command.
+type manifest struct {
+	SchemaVersion int                 `json:"schema_version"`
+	Name          string              `json:"name"`
+	Version       string              `json:"version"`
+	GlobalFlags   []manifestFlag      `json:"global_flags"`
+	Commands      []manifestCommand   `json:"commands"`
+	ErrorCodes    []manifestErrorCode `json:"error_codes"`
+}
+
+type manifestCommand struct {
+	Name        string            `json:"name"`
+	Path        string            `json:"path"`
+	Use         string            `json:"use"`
+	Short       string            `json:"short"`
+	Aliases     []string          `json:"aliases,omitempty"`
+	Runnable    bool              `json:"runnable"`
+	Flags       []manifestFlag    `json:"flags"`
+	Subcommands []manifestCommand `json:"subcommands,omitempty"`
+}
+
+type manifestFlag struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand,omitempty"`
+	Type      string `json:"type"`
+	Default   string `json:"default"`
+	Usage     string `json:"usage"`
+}
+
+type manifestErrorCode struct {
+	Code      string `json:"code"`
+	ExitCode  int    `json:"exit_code"`
+	Retryable bool   `json:"retryable"`
+}
+
+func runManifest(_ *cobra.Command, _ []string) error {
+	m := buildManifest(rootCmd)
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, m)
+	case output.FormatCompact:
+		for _, c := range flattenManifest(m.Commands) {
+			fmt.Fprintf(os.Stdout, "%s flags=%d\n", c.Path, len(c.Flags))
+		}
+		fmt.Fprintf(os.Stdout, "error_codes=%d\n", len(m.ErrorCodes))
+	default:
+		output.Messagef(os.Stdout, "%s %s — %d commands, %d error codes",
+			m.Name, m.Version, len(flattenManifest(m.Commands)), len(m.ErrorCodes))
+		for _, c := range flattenManifest(m.Commands) {
+			fmt.Fprintf(os.Stdout, "  %-28s %s\n", strings.TrimPrefix(c.Path, m.Name+" "), c.Short)
+		}
+	}
+	return nil
+}
+
+// buildManifest walks the command tree below root.
+func buildManifest(root *cobra.Command) manifest {
+	codes := make([]manifestErrorCode, 0, len(clierr.Codes))
+	for _, code := range clierr.Codes {
+		e := clierr.New(code, "")
+		codes = append(codes, manifestErrorCode{Code: code, ExitCode: e.ExitCode(), Retryable: e.Retryable()})
+	}
+	return manifest{
+		SchemaVersion: manifestSchemaVersion,
+		Name:          root.Name(),
+		Version:       root.Version,
+		GlobalFlags:   manifestFlags(root.PersistentFlags()),
+		Commands:      manifestCommands(root),
+		ErrorCodes:    codes,
+	}
+}
+
+func manifestCommands(parent *cobra.Command) []manifestCommand {
+	var cmds []manifestCommand
+	for _, c := range parent.Commands() {
+		if !c.IsAvailableCommand() {
+			continue
+		}
+		cmds = append(cmds, manifestCommand{
+			Name:        c.Name(),
+			Path:        c.CommandPath(),
+			Use:         c.Use,
+			Short:       c.Short,
+			Aliases:     c.Aliases,
+			Runnable:    c.Runnable(),
+			Flags:       manifestFlags(c.NonInheritedFlags()),
+			Subcommands: manifestCommands(c),
+		})
+	}
+	return cmds
+}
+
+// manifestFlags describes the visible flags in fs, sorted by name.
+func manifestFlags(fs *pflag.FlagSet) []manifestFlag {
+	flags := []manifestFlag{}
+	fs.VisitAll(func(f *pflag.Flag) {
+		if f.Hidden || f.Name == "help" {
+			return
+		}
+		flags = append(flags, manifestFlag{
+			Name:      f.Name,
+			Shorthand: f.Shorthand,
+			Type:      f.Value.Type(),
+			Default:   f.DefValue,
+			Usage:     f.Usage,
+		})
+	})
+	return flags
+}
+
+// flattenManifest lists commands depth-first, parents before children.
+func flattenManifest(cmds []manifestCommand) []manifestCommand {
+	var flat []manifestCommand
+	for _, c := range cmds {
+		flat = append(flat, c)
+		flat = append(flat, flattenManifest(c.Subcommands)...)
+	}
+	return flat
+}
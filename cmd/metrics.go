@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
@@ -15,12 +17,24 @@ import (
 var metricsCmd = &cobra.Command{
 	Use:   "metrics",
 	Short: "Show flow metrics",
-	Long:  `Displays flow metrics: throughput, average lead/cycle time, flow efficiency, and aging work items.`,
-	RunE:  runMetrics,
+	Long: `Displays flow metrics: throughput, average lead/cycle time, flow efficiency, and aging work items.
+
+With --by tag, shows instead how many tasks were created and completed per
+tag since --since (default 90d), week by week, to see where effort goes.
+--csv writes those weekly counts as CSV.
+
+With --triage, shows how long tasks created since --since waited from
+creation to their first status change and to their first assignee, as
+averages and percentiles.`,
+	RunE: runMetrics,
 }
 
 func init() {
-	metricsCmd.Flags().String("since", "", "only include tasks completed after this date (YYYY-MM-DD)")
+	metricsCmd.Flags().Bool("business-days", false, "measure lead, cycle, and aging times in working days only")
+	metricsCmd.Flags().String("since", "", "only include tasks completed after this date (YYYY-MM-DD, relative, e.g. -2w, or a period, e.g. 90d)")
+	metricsCmd.Flags().String("by", "", "break down created and completed tasks by field over time (tag)")
+	metricsCmd.Flags().Bool("csv", false, "write the --by breakdown as CSV")
+	metricsCmd.Flags().Bool("triage", false, "show how long new tasks wait for their first status change and assignee")
 	rootCmd.AddCommand(metricsCmd)
 }
 
@@ -30,7 +44,7 @@ func runMetrics(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return err
 	}
@@ -39,7 +53,38 @@ func runMetrics(cmd *cobra.Command, _ []string) error {
 		allTasks = []*task.Task{}
 	}
 
-	// Exclude archived tasks from metrics.
+	now := time.Now()
+	if triage, _ := cmd.Flags().GetBool("triage"); triage {
+		return runMetricsTriage(cmd, cfg, allTasks, now)
+	}
+	if by, _ := cmd.Flags().GetString("by"); by != "" {
+		return runMetricsBy(cmd, by, allTasks, now)
+	}
+	if csvOut, _ := cmd.Flags().GetBool("csv"); csvOut {
+		return clierr.New(clierr.InvalidInput, "--csv needs --by")
+	}
+
+	m, err := flowMetrics(cmd, cfg, allTasks, now)
+	if err != nil {
+		return err
+	}
+
+	format := outputFormat()
+	if format == output.FormatJSON {
+		return output.JSON(os.Stdout, m)
+	}
+	if format == output.FormatCompact {
+		output.MetricsCompact(os.Stdout, m)
+		return nil
+	}
+
+	output.MetricsTable(os.Stdout, m)
+	return nil
+}
+
+// flowMetrics computes the flow metrics of allTasks as the --since and
+// --business-days flags of cmd ask. Archived tasks are left out.
+func flowMetrics(cmd *cobra.Command, cfg *config.Config, allTasks []*task.Task, now time.Time) (board.Metrics, error) {
 	tasks := make([]*task.Task, 0, len(allTasks))
 	for _, t := range allTasks {
 		if !cfg.IsArchivedStatus(t.Status) {
@@ -47,13 +92,13 @@ func runMetrics(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
-	sinceStr, _ := cmd.Flags().GetString("since")
-	if sinceStr != "" {
-		d, parseErr := date.Parse(sinceStr)
-		if parseErr != nil {
-			return task.ValidateDate("since", sinceStr, parseErr)
+	var sinceTime time.Time
+	if sinceStr, _ := cmd.Flags().GetString("since"); sinceStr != "" {
+		var err error
+		sinceTime, err = metricsSince(sinceStr, now)
+		if err != nil {
+			return board.Metrics{}, err
 		}
-		sinceTime := d.Time
 		filtered := make([]*task.Task, 0, len(tasks))
 		for _, t := range tasks {
 			if t.Completed == nil || t.Completed.After(sinceTime) {
@@ -63,18 +108,107 @@ func runMetrics(cmd *cobra.Command, _ []string) error {
 		tasks = filtered
 	}
 
-	now := time.Now()
-	m := board.ComputeMetrics(cfg, tasks, now)
+	businessDays, _ := cmd.Flags().GetBool("business-days")
+	m := board.ComputeMetricsWith(cfg, tasks, now, board.MetricsOptions{BusinessDays: businessDays})
+	var err error
+	m.Abandonment, err = metricsAbandonment(cfg, allTasks, sinceTime, now)
+	return m, err
+}
 
-	format := outputFormat()
-	if format == output.FormatJSON {
-		return output.JSON(os.Stdout, m)
+// metricsAbandonment replays the claims logged since since, or nil when
+// claims never expire. Claims on archived tasks count: the work was lost
+// all the same.
+func metricsAbandonment(cfg *config.Config, allTasks []*task.Task, since, now time.Time) (*board.Abandonment, error) {
+	timeout := cfg.ClaimTimeoutDuration()
+	if timeout <= 0 {
+		return nil, nil
 	}
-	if format == output.FormatCompact {
-		output.MetricsCompact(os.Stdout, m)
-		return nil
+	entries, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{Since: since})
+	if err != nil {
+		return nil, err
 	}
+	a := board.ComputeAbandonment(cfg, allTasks, entries, timeout, now)
+	return &a, nil
+}
 
-	output.MetricsTable(os.Stdout, m)
+// defaultTrendPeriod is the period metrics --by covers without --since.
+const defaultTrendPeriod = "90d"
+
+// runMetricsBy shows created and completed tasks per tag over time.
+// Archived tasks count: they are finished work of the period.
+func runMetricsBy(cmd *cobra.Command, by string, tasks []*task.Task, now time.Time) error {
+	if by != "tag" {
+		return clierr.Newf(clierr.InvalidInput, "invalid --by %q (want tag)", by)
+	}
+	sinceStr, _ := cmd.Flags().GetString("since")
+	if sinceStr == "" {
+		sinceStr = defaultTrendPeriod
+	}
+	since, err := metricsSince(sinceStr, now)
+	if err != nil {
+		return err
+	}
+	if !since.Before(now) {
+		return clierr.New(clierr.InvalidInput, "--since must be in the past")
+	}
+	r := board.ComputeTagTrends(tasks, since, now)
+
+	if csvOut, _ := cmd.Flags().GetBool("csv"); csvOut {
+		return output.TagTrendsCSV(os.Stdout, r)
+	}
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, r)
+	case output.FormatCompact:
+		output.TagTrendsCompact(os.Stdout, r)
+	default:
+		output.TagTrendsTable(os.Stdout, r)
+	}
 	return nil
 }
+
+// runMetricsTriage shows triage times of the tasks created since --since.
+// Archived tasks count: they were triaged too.
+func runMetricsTriage(cmd *cobra.Command, cfg *config.Config, tasks []*task.Task, now time.Time) error {
+	if by, _ := cmd.Flags().GetString("by"); by != "" {
+		return clierr.New(clierr.InvalidInput, "--triage and --by are separate reports; pass one")
+	}
+	if sinceStr, _ := cmd.Flags().GetString("since"); sinceStr != "" {
+		since, err := metricsSince(sinceStr, now)
+		if err != nil {
+			return err
+		}
+		created := make([]*task.Task, 0, len(tasks))
+		for _, t := range tasks {
+			if !t.Created.Before(since) {
+				created = append(created, t)
+			}
+		}
+		tasks = created
+	}
+	businessDays, _ := cmd.Flags().GetBool("business-days")
+	m := board.ComputeTriageMetrics(cfg, tasks, now, board.MetricsOptions{BusinessDays: businessDays})
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, m)
+	case output.FormatCompact:
+		output.TriageCompact(os.Stdout, m)
+	default:
+		output.TriageTable(os.Stdout, m)
+	}
+	return nil
+}
+
+// metricsSince parses --since as a date (2026-01-01, -2w) or as a period
+// back from now (90d, 12w).
+func metricsSince(s string, now time.Time) (time.Time, error) {
+	d, err := date.ParseNatural(s)
+	if err == nil {
+		return d.Time, nil
+	}
+	if period, periodErr := parseIdleDuration(s); periodErr == nil {
+		return now.Add(-period), nil
+	}
+	return time.Time{}, task.ValidateDate("since", s, err)
+}
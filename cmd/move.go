@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,7 +21,11 @@ var moveCmd = &cobra.Command{
 	Short: "Move a task to a different status",
 	Long: `Changes the status of a task. Provide the new status directly,
 or use --next/--prev to move along the configured status order.
-Multiple IDs can be provided as a comma-separated list.`,
+Multiple IDs can be provided as a comma-separated list.
+
+With --fill, tasks are moved highest priority first and only while the
+target column's WIP limit has room; the rest are reported as deferred
+instead of failing.`,
 	Args: cobra.RangeArgs(1, 2), //nolint:mnd // 1 or 2 positional args
 	RunE: runMove,
 }
@@ -28,20 +34,30 @@ func init() {
 	moveCmd.Flags().Bool("next", false, "move to next status")
 	moveCmd.Flags().Bool("prev", false, "move to previous status")
 	moveCmd.Flags().String("claim", "", "claim task for an agent during move")
+	moveCmd.Flags().String("reviewer", "", "set reviewer during move (\"me\" for $KANBAN_USER or $USER)")
+	moveCmd.Flags().Bool("force", false, "move even if children are incomplete or a required estimate is missing")
+	moveCmd.Flags().Bool("fill", false, "move by priority until the WIP limit is reached; defer the rest")
 	rootCmd.AddCommand(moveCmd)
 }
 
 func runMove(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
+	}
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig()
+	ids, err := parseIDs(args[0])
 	if err != nil {
 		return err
 	}
 
+	if fill, _ := cmd.Flags().GetBool("fill"); fill {
+		return runFillMove(cfg, ids, cmd, args)
+	}
+
 	// Single ID: preserve exact current behavior.
 	if len(ids) == 1 {
 		return moveSingleTask(cfg, ids[0], cmd, args)
@@ -54,6 +70,39 @@ func runMove(cmd *cobra.Command, args []string) error {
 	})
 }
 
+// runFillMove moves tasks highest priority first. Moves refused by the
+// target column's WIP limit are deferred rather than failed.
+func runFillMove(cfg *config.Config, ids []int, cmd *cobra.Command, args []string) error {
+	results := make([]output.BatchResult, 0, len(ids))
+	for _, id := range fillOrder(cfg, ids) {
+		r := runBatchItem(id, func() error {
+			_, _, err := executeMove(cfg, id, cmd, args)
+			return err
+		})
+		r.Deferred = r.Code == clierr.WIPLimitExceeded
+		results = append(results, r)
+	}
+	return reportBatch(results)
+}
+
+// fillOrder sorts ids by task priority, highest first, keeping the given
+// order among equals. Tasks that cannot be read go last so their errors
+// are still reported.
+func fillOrder(cfg *config.Config, ids []int) []int {
+	rank := make(map[int]int, len(ids))
+	for _, id := range ids {
+		rank[id] = -1
+		if path, err := task.FindByIDIn(cfg.TasksPaths(), id); err == nil {
+			if t, err := task.Read(path); err == nil {
+				rank[id] = cfg.PriorityIndex(t.Priority)
+			}
+		}
+	}
+	ordered := slices.Clone(ids)
+	slices.SortStableFunc(ordered, func(a, b int) int { return rank[b] - rank[a] })
+	return ordered
+}
+
 // moveResult wraps a task with a changed flag for JSON output.
 type moveResult struct {
 	*task.Task
@@ -76,7 +125,7 @@ func moveSingleTask(cfg *config.Config, id int, cmd *cobra.Command, args []strin
 		return outputMoveResult(t, true)
 	}
 
-	output.Messagef(os.Stdout, "Moved task #%d: %s -> %s", id, oldStatus, t.Status)
+	output.Messagef(os.Stdout, "Moved task %s: %s -> %s", output.FormatID(id), oldStatus, t.Status)
 	return nil
 }
 
@@ -84,12 +133,12 @@ func moveSingleTask(cfg *config.Config, id int, cmd *cobra.Command, args []strin
 // Returns (task, oldStatus, error). If the task was already at the target status
 // (idempotent), oldStatus is empty and the task is returned unchanged.
 func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string) (*task.Task, string, error) {
-	path, err := task.FindByID(cfg.TasksPath(), id)
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
 	if err != nil {
 		return nil, "", err
 	}
 
-	t, err := task.Read(path)
+	t, err := readTask(cfg, path)
 	if err != nil {
 		return nil, "", err
 	}
@@ -108,25 +157,43 @@ func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string)
 	if t.Status == newStatus {
 		return t, "", nil
 	}
+	if err = checkArchiveGuard(cfg, newStatus); err != nil {
+		return nil, "", err
+	}
 
 	// Enforce require_claim for target status.
 	if cfg.StatusRequiresClaim(newStatus) && claimant == "" {
 		return nil, "", task.ValidateClaimRequired(newStatus)
 	}
 
+	force, _ := cmd.Flags().GetBool("force")
+	if err = checkEstimate(cfg, t, newStatus, force); err != nil {
+		return nil, "", err
+	}
+	if _, err = applyReviewerFlag(cmd, t); err != nil {
+		return nil, "", err
+	}
+	if err = checkReviewer(cfg, t, newStatus); err != nil {
+		return nil, "", err
+	}
+	if err = checkChildrenComplete(cfg, t, newStatus, force); err != nil {
+		return nil, "", err
+	}
+
 	if err = enforceMoveWIP(cfg, t, newStatus); err != nil {
 		return nil, "", err
 	}
 
 	// Warn when moving a blocked task.
 	if t.Blocked {
-		fmt.Fprintf(os.Stderr, "Warning: task #%d is blocked (%s)\n", t.ID, t.BlockReason)
+		warnf("task %s is blocked (%s)\n", output.FormatID(t.ID), t.BlockReason)
 	}
 
 	oldStatus := t.Status
 	t.Status = newStatus
 	task.UpdateTimestamps(t, oldStatus, newStatus, cfg)
-	applyMoveClaim(cmd, t, claimant)
+	task.ApplyChecklist(t, cfg)
+	newClaim := applyMoveClaim(cmd, t, claimant)
 	t.Updated = time.Now()
 
 	if err := task.Write(path, t); err != nil {
@@ -135,21 +202,61 @@ func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string)
 
 	// Warn if moving to terminal status with worktree/branch still set.
 	if cfg.IsTerminalStatus(newStatus) && t.Worktree != "" {
-		fmt.Fprintf(os.Stderr, "Warning: task #%d still has worktree %s. Consider removing it.\n", t.ID, t.Worktree)
+		warnf("task %s still has worktree %s. Consider removing it.\n", output.FormatID(t.ID), t.Worktree)
 	}
 	if cfg.IsTerminalStatus(newStatus) && t.Branch != "" {
-		fmt.Fprintf(os.Stderr, "Warning: task #%d still has branch %s. Consider cleaning it up.\n", t.ID, t.Branch)
+		warnf("task %s still has branch %s. Consider cleaning it up.\n", output.FormatID(t.ID), t.Branch)
 	}
 
-	logActivity(cfg, "move", id, oldStatus+" -> "+newStatus)
+	logMove(cfg, id, oldStatus, newStatus, newClaim)
+	applyOnUnblock(cfg, t, oldStatus)
 	return t, oldStatus, nil
 }
 
+// checkEstimate enforces require_estimate_for unless force is set.
+func checkEstimate(cfg *config.Config, t *task.Task, newStatus string, force bool) error {
+	if force || t.Estimate != "" || !cfg.StatusRequiresEstimate(newStatus) {
+		return nil
+	}
+	return task.ValidateEstimateRequired(t, newStatus)
+}
+
 // validateMoveClaim checks claim ownership before allowing a move.
 func validateMoveClaim(cfg *config.Config, t *task.Task, claimant string) error {
 	return checkClaim(t, claimant, cfg.ClaimTimeoutDuration())
 }
 
+// archiveGuard, when set, vets every create, edit, or move that puts a
+// task in the archived status; serve uses it to keep archiving to tokens
+// with admin scope.
+var archiveGuard func() error
+
+// checkArchiveGuard runs archiveGuard when status is the archived status.
+func checkArchiveGuard(cfg *config.Config, status string) error {
+	if archiveGuard == nil || !cfg.IsArchivedStatus(status) {
+		return nil
+	}
+	return archiveGuard()
+}
+
+// checkChildrenComplete refuses to complete a parent task while any of its
+// children are still open. With force, the move proceeds with a warning.
+// Archiving a parent is always allowed.
+func checkChildrenComplete(cfg *config.Config, t *task.Task, newStatus string, force bool) error {
+	if !cfg.IsTerminalStatus(newStatus) || cfg.IsArchivedStatus(newStatus) {
+		return nil
+	}
+	progress := childProgress(cfg, t.ID)
+	if progress == nil || len(progress.Incomplete) == 0 {
+		return nil
+	}
+	if !force {
+		return task.ValidateChildrenIncomplete(t.ID, newStatus, progress.Incomplete)
+	}
+	warnf("task %s has incomplete children (%s)\n", output.FormatID(t.ID), progress)
+	return nil
+}
+
 // enforceMoveWIP checks WIP limits, considering class of service.
 func enforceMoveWIP(cfg *config.Config, t *task.Task, newStatus string) error {
 	if t.Class != "" && len(cfg.Classes) > 0 {
@@ -159,11 +266,26 @@ func enforceMoveWIP(cfg *config.Config, t *task.Task, newStatus string) error {
 }
 
 // applyMoveClaim sets the claim on the task if --claim flag was provided.
-func applyMoveClaim(cmd *cobra.Command, t *task.Task, claimant string) {
-	if cmd.Flags().Changed("claim") && claimant != "" {
-		now := time.Now()
-		t.ClaimedBy = claimant
-		t.ClaimedAt = &now
+// It returns the claimant if the move newly claimed the task.
+func applyMoveClaim(cmd *cobra.Command, t *task.Task, claimant string) string {
+	if !cmd.Flags().Changed("claim") || claimant == "" {
+		return ""
+	}
+	newClaim := ""
+	if t.ClaimedBy != claimant {
+		newClaim = claimant
+	}
+	now := time.Now()
+	t.ClaimedBy = claimant
+	t.ClaimedAt = &now
+	return newClaim
+}
+
+// logMove logs a move, and the claim it made if newClaim is set.
+func logMove(cfg *config.Config, id int, oldStatus, newStatus, newClaim string) {
+	logActivity(cfg, "move", id, oldStatus+" -> "+newStatus)
+	if newClaim != "" {
+		logActivity(cfg, "claim", id, newClaim)
 	}
 }
 
@@ -201,13 +323,15 @@ func resolveTargetStatus(cmd *cobra.Command, args []string, t *task.Task, cfg *c
 func enforceWIPLimit(cfg *config.Config, currentStatus, targetStatus string) error {
 	limit := cfg.WIPLimit(targetStatus)
 	if limit == 0 {
+		verbosef(verboseDecisions, "WIP check %s: no limit", targetStatus)
 		return nil
 	}
 
-	allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return fmt.Errorf("reading tasks for WIP check: %w", err)
 	}
+	verbosef(verboseDetails, "WIP check %s: counted %d tasks", targetStatus, len(allTasks))
 
 	counts := board.CountByStatus(allTasks)
 	return checkWIPLimit(cfg, counts, targetStatus, currentStatus)
@@ -220,18 +344,28 @@ func enforceWIPLimitForClass(cfg *config.Config, t *task.Task, currentStatus, ta
 
 	// Check class-level board-wide WIP limit.
 	if classConf != nil && classConf.WIPLimit > 0 {
-		allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+		allTasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
 		if err != nil {
 			return fmt.Errorf("reading tasks for class WIP check: %w", err)
 		}
 		count := countByClass(allTasks, t.Class, t.ID)
+		var wipErr error
 		if count >= classConf.WIPLimit {
-			return task.ValidateClassWIPExceeded(t.Class, classConf.WIPLimit, count)
+			wipErr = task.ValidateClassWIPExceeded(t.Class, classConf.WIPLimit, count)
+		}
+		verbosef(verboseDecisions, "class WIP check %s: %d/%d, %s", t.Class, count, classConf.WIPLimit, decision(wipErr))
+		if wipErr != nil {
+			return wipErr
 		}
 	}
 
-	// If class bypasses column WIP, skip column check.
+	// If class bypasses column WIP, the column check only warns.
 	if classConf != nil && classConf.BypassColumnWIP {
+		var wipErr *clierr.Error
+		if err := enforceWIPLimit(cfg, currentStatus, targetStatus); errors.As(err, &wipErr) &&
+			wipErr.Code == clierr.WIPLimitExceeded {
+			warnf("%s; class %s bypasses column limits\n", wipErr.Message, t.Class)
+		}
 		return nil
 	}
 
@@ -256,7 +390,7 @@ func outputMoveResult(t *task.Task, changed bool) error {
 		return output.JSON(os.Stdout, moveResult{Task: t, Changed: changed})
 	}
 	if !changed {
-		output.Messagef(os.Stdout, "Task #%d is already at %s", t.ID, t.Status)
+		output.Messagef(os.Stdout, "Task %s is already at %s", output.FormatID(t.ID), t.Status)
 	}
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/task"
@@ -595,7 +596,7 @@ func TestExecutePick_NoCandidates(t *testing.T) {
 		t.Fatal(err)
 	}
 	// No tasks created — nothing to pick.
-	_, _, err = executePick(cfg, "agent", "", "", nil)
+	_, _, err = executePick(cfg, "agent", "", "", board.PickOptions{})
 	if err == nil {
 		t.Fatal("expected error when nothing to pick")
 	}
@@ -616,7 +617,7 @@ func TestExecutePick_Success(t *testing.T) {
 	}
 	createTaskFileWithStatus(t, cfg.TasksPath(), 1, "pickable-task", "backlog")
 
-	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "", nil)
+	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "", board.PickOptions{})
 	if pickErr != nil {
 		t.Fatalf("executePick error: %v", pickErr)
 	}
@@ -639,7 +640,7 @@ func TestExecutePick_WithMove(t *testing.T) {
 	}
 	createTaskFileWithStatus(t, cfg.TasksPath(), 1, "pick-and-move", "backlog")
 
-	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "todo", nil)
+	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "todo", board.PickOptions{})
 	if pickErr != nil {
 		t.Fatalf("executePick error: %v", pickErr)
 	}
@@ -659,7 +660,7 @@ func TestExecutePick_MoveIdempotent(t *testing.T) {
 	}
 	createTaskFileWithStatus(t, cfg.TasksPath(), 1, "already-there", "todo")
 
-	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "todo", nil)
+	picked, oldStatus, pickErr := executePick(cfg, "test-agent", "", "todo", board.PickOptions{})
 	if pickErr != nil {
 		t.Fatalf("executePick error: %v", pickErr)
 	}
@@ -682,7 +683,7 @@ func TestExecutePick_StatusFilter(t *testing.T) {
 	createTaskFileWithStatus(t, cfg.TasksPath(), 2, "in-todo", "todo")
 
 	// Pick only from "todo" — should pick task #2.
-	picked, _, pickErr := executePick(cfg, "test-agent", "todo", "", nil)
+	picked, _, pickErr := executePick(cfg, "test-agent", "todo", "", board.PickOptions{})
 	if pickErr != nil {
 		t.Fatalf("executePick error: %v", pickErr)
 	}
@@ -709,7 +710,7 @@ func TestExecutePick_WIPViolationOnMove(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, _, err = executePick(cfg, "test-agent", "backlog", "todo", nil)
+	_, _, err = executePick(cfg, "test-agent", "backlog", "todo", board.PickOptions{})
 	if err == nil {
 		t.Fatal("expected WIP limit error on move")
 	}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+var mvBoardCmd = &cobra.Command{
+	Use:   "mv-board NEW_DIR",
+	Short: "Move the board to another directory",
+	Long: `Moves the whole kanban directory (config, tasks, activity log, and sink
+state) to NEW_DIR, which must not exist or be empty. Absolute paths in the
+config that pointed inside the old directory (log sink files, the serve
+tokens file) are rewritten; relative ones already follow the board.
+
+If the old directory was listed in its parent's .gitignore, the entry is
+moved to the .gitignore next to NEW_DIR. Boards are found automatically only
+in a directory named "kanban"; elsewhere pass --dir.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMvBoard,
+}
+
+func init() {
+	rootCmd.AddCommand(mvBoardCmd)
+}
+
+// mvBoardResult is the JSON output of mv-board.
+type mvBoardResult struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Rewritten []string `json:"rewritten,omitempty"`
+	Gitignore bool     `json:"gitignore,omitempty"`
+}
+
+func runMvBoard(_ *cobra.Command, args []string) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	dst, err := filepath.Abs(args[0])
+	if err != nil {
+		return fmt.Errorf("resolving path: %w", err)
+	}
+	if board.Within(dst, dir) {
+		return clierr.Newf(clierr.InvalidInput, "cannot move the board into itself (%s)", dst)
+	}
+
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	// The lock file moves with the board; unlocking only closes it.
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(dst, config.ConfigFileName)); err == nil {
+		return clierr.Newf(clierr.BoardAlreadyExists, "a board already exists in %s", dst)
+	}
+
+	if err := relocateDir(dir, dst); err != nil {
+		return err
+	}
+	cfg.SetDir(dst)
+	res := mvBoardResult{From: dir, To: dst, Rewritten: cfg.RebasePaths(dir, dst)}
+	if len(res.Rewritten) > 0 {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+		verbosef(verboseDecisions, "rewrote %s", strings.Join(res.Rewritten, ", "))
+	}
+	res.Gitignore, err = moveGitignoreEntry(dir, dst)
+	if err != nil {
+		warnf("board moved, but .gitignore was not updated: %v", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	output.Messagef(os.Stdout, "Moved board from %s to %s", dir, dst)
+	if filepath.Base(dst) != config.DefaultDir {
+		output.Messagef(os.Stdout, "The directory is not named %q, so commands run outside it need --dir %s",
+			config.DefaultDir, dst)
+	}
+	return nil
+}
+
+// relocateDir moves directory src to dst, turning a busy destination into
+// an input error.
+func relocateDir(src, dst string) error {
+	err := board.MoveDir(src, dst)
+	if errors.Is(err, board.ErrDestinationNotEmpty) {
+		return clierr.Newf(clierr.InvalidInput, "%s exists and is not empty", dst).
+			WithDetails(map[string]any{"path": dst})
+	}
+	if err != nil {
+		return fmt.Errorf("moving %s: %w", src, err)
+	}
+	return nil
+}
+
+// moveGitignoreEntry moves the old kanban directory's .gitignore entry to
+// the .gitignore beside the new one. It reports whether an entry was moved.
+func moveGitignoreEntry(from, to string) (bool, error) {
+	oldPath, oldEntry, err := gitignorePromptData(from)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(oldPath) //nolint:gosec // .gitignore beside the kanban directory
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("reading .gitignore: %w", err)
+	}
+	if !hasGitignoreEntry(data, oldEntry) {
+		return false, nil
+	}
+
+	newPath, newEntry, err := gitignorePromptData(to)
+	if err != nil {
+		return false, err
+	}
+	if oldPath != newPath || oldEntry != newEntry {
+		if err := removeGitignoreEntry(oldPath, data, oldEntry); err != nil {
+			return false, err
+		}
+	}
+	return true, ensureGitignoreEntry(newPath, newEntry)
+}
+
+// removeGitignoreEntry rewrites the .gitignore at path without entry.
+func removeGitignoreEntry(path string, data []byte, entry string) error {
+	lines := strings.SplitAfter(string(data), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if strings.TrimSpace(line) != entry {
+			kept = append(kept, line)
+		}
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(kept, "")), gitignoreFileMode); err != nil {
+		return fmt.Errorf("updating .gitignore: %w", err)
+	}
+	return nil
+}
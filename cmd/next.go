@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var nextCmd = &cobra.Command{
+	Use:   "next",
+	Short: "Suggest what to work on next",
+	Long: `Ranks the ready tasks — those pick would take — by priority, class of
+service, due date, and age, and explains the factors behind each score. Nothing is changed
+unless --claim is given, in which case the top task is claimed.`,
+	Args: cobra.NoArgs,
+	RunE: runNext,
+}
+
+func init() {
+	nextCmd.Flags().IntP("limit", "n", 3, "number of suggestions to show") //nolint:mnd // default suggestion count
+	nextCmd.Flags().String("claim", "", "claim the top suggestion for this agent")
+	nextCmd.Flags().String("status", "", "only suggest tasks in this status (default: ready.pull_from)")
+	nextCmd.Flags().StringSlice("tags", nil, "filter by tags (comma-separated, OR logic)")
+	rootCmd.AddCommand(nextCmd)
+}
+
+func runNext(cmd *cobra.Command, _ []string) error {
+	claimant, _ := cmd.Flags().GetString("claim")
+	load := loadConfig
+	if claimant != "" {
+		load = loadWritableConfig
+	}
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+
+	limit, _ := cmd.Flags().GetInt("limit")
+	if limit < 1 {
+		return clierr.Newf(clierr.InvalidInput, "--limit must be at least 1, got %d", limit)
+	}
+	statusFilter, _ := cmd.Flags().GetString("status")
+	tags, _ := cmd.Flags().GetStringSlice("tags")
+	opts := board.PickOptions{Tags: tags, ClaimTimeout: cfg.ClaimTimeoutDuration()}
+	if statusFilter != "" {
+		if err := task.ValidateStatus(statusFilter, cfg.StatusNames()); err != nil {
+			return err
+		}
+		opts.Statuses = []string{statusFilter}
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	recs := board.Next(cfg, tasks, opts, time.Now())
+	if len(recs) > limit {
+		recs = recs[:limit]
+	}
+
+	if claimant != "" {
+		if len(recs) == 0 {
+			return clierr.New(clierr.NothingToPick, "no ready tasks found")
+		}
+		if err := claimNext(cfg, recs[0].Task, claimant); err != nil {
+			return err
+		}
+	}
+
+	return outputNext(recs, claimant)
+}
+
+// claimNext claims t for claimant and records it in the activity log.
+func claimNext(cfg *config.Config, t *task.Task, claimant string) error {
+	now := time.Now()
+	t.ClaimedBy = claimant
+	t.ClaimedAt = &now
+	t.Updated = now
+	if err := task.Write(t.File, t); err != nil {
+		return fmt.Errorf("writing task: %w", err)
+	}
+	logActivity(cfg, "claim", t.ID, claimant)
+	return nil
+}
+
+func outputNext(recs []board.Recommendation, claimant string) error {
+	switch outputFormat() {
+	case output.FormatJSON:
+		for _, r := range recs {
+			crypt.Reveal(r.Task)
+		}
+		return output.JSON(os.Stdout, recs)
+	case output.FormatCompact:
+		for _, r := range recs {
+			reasons := make([]string, 0, len(r.Factors))
+			for _, f := range r.Factors {
+				reasons = append(reasons, fmt.Sprintf("%s %+d", f.Reason, f.Points))
+			}
+			fmt.Fprintf(os.Stdout, "%s [%s/%s] %s — score %d: %s\n", output.FormatID(r.ID),
+				r.Status, r.Priority, r.Title, r.Score, strings.Join(reasons, ", "))
+		}
+	default:
+		if len(recs) == 0 {
+			output.Messagef(os.Stdout, "Nothing to work on: no ready tasks")
+			return nil
+		}
+		for i, r := range recs {
+			if i > 0 {
+				fmt.Fprintln(os.Stdout)
+			}
+			fmt.Fprintf(os.Stdout, "%d. %s %s (%s, %s) — score %d\n", i+1, output.FormatID(r.ID),
+				r.Title, r.Status, r.Priority, r.Score)
+			for _, f := range r.Factors {
+				fmt.Fprintf(os.Stdout, "   %+3d  %s\n", f.Points, f.Reason)
+			}
+		}
+	}
+	if claimant != "" && len(recs) > 0 && outputFormat() != output.FormatJSON {
+		output.Messagef(os.Stdout, "\nClaimed task %s for %s", output.FormatID(recs[0].ID), claimant)
+	}
+	return nil
+}
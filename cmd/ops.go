@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var opsCmd = &cobra.Command{
+	Use:   "ops",
+	Short: "Inspect the ops log of commands run against the board",
+	Long: `With log.ops set in config.yml, every command that loads the board is
+recorded in ops.jsonl — its name, duration, exit code, and actor — apart
+from the activity log. Use the report to see which commands or agents
+hammer the board.`,
+}
+
+var opsReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize command counts and durations from the ops log",
+	Args:  cobra.NoArgs,
+	RunE:  runOpsReport,
+}
+
+func init() {
+	opsReportCmd.Flags().String("since", "", "only count commands after this date (YYYY-MM-DD or relative, e.g. -1w)")
+	opsReportCmd.Flags().String("by", board.OpsByCommand, "group by command or actor")
+	opsCmd.AddCommand(opsReportCmd)
+	rootCmd.AddCommand(opsCmd)
+}
+
+func runOpsReport(cmd *cobra.Command, _ []string) error {
+	by, _ := cmd.Flags().GetString("by")
+	if by != board.OpsByCommand && by != board.OpsByActor {
+		return clierr.Newf(clierr.InvalidInput, "invalid --by %q (want %s or %s)", by, board.OpsByCommand, board.OpsByActor)
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	if v, _ := cmd.Flags().GetString("since"); v != "" {
+		d, parseErr := date.ParseNatural(v)
+		if parseErr != nil {
+			return task.ValidateDate("since", v, parseErr)
+		}
+		since = d.Time
+	}
+
+	entries, err := board.ReadOps(cfg.Dir(), since)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 && !cfg.Log.Ops {
+		warnf("log.ops is off, so no commands are recorded (kanban-md config set log.ops true)\n")
+	}
+	return outputOpsReport(board.OpsReport(entries, by), by)
+}
+
+func outputOpsReport(stats []board.OpsStat, by string) error {
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, stats)
+	case output.FormatCompact:
+		for _, s := range stats {
+			fmt.Fprintf(os.Stdout, "%s: %d run(s), %d failed, mean %dms, p95 %dms, max %dms\n",
+				s.Key, s.Count, s.Errors, s.MeanMS, s.P95MS, s.MaxMS)
+		}
+	default:
+		if len(stats) == 0 {
+			output.Messagef(os.Stdout, "No commands recorded")
+			return nil
+		}
+		fmt.Fprintf(os.Stdout, "%-20s %6s %6s %8s %8s %8s %10s\n", strings.ToUpper(by), "RUNS", "FAILED", "MEAN", "P95", "MAX", "TOTAL")
+		for _, s := range stats {
+			fmt.Fprintf(os.Stdout, "%-20s %6d %6d %6dms %6dms %6dms %8dms\n",
+				s.Key, s.Count, s.Errors, s.MeanMS, s.P95MS, s.MaxMS, s.TotalMS)
+		}
+	}
+	return nil
+}
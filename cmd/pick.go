@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,6 +12,7 @@ import (
 	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
@@ -17,23 +20,31 @@ import (
 var pickCmd = &cobra.Command{
 	Use:   "pick",
 	Short: "Pick the next available task",
-	Long: `Atomically finds the highest-priority unclaimed, unblocked task and claims it.
-Replaces the multi-step list/edit/move pattern with a single command.`,
+	Long: `Atomically finds the highest-priority ready task and claims it.
+Replaces the multi-step list/edit/move pattern with a single command.
+
+A task is ready when it waits in a ready.pull_from status (default: any
+non-terminal one), is not blocked by hand or by a dependency, is unclaimed
+or its claim has expired, and the target column — --move, or ready.target
+— has room under its WIP limit. list --ready shows the same tasks.`,
 	RunE: runPick,
 }
 
 func init() {
 	pickCmd.Flags().String("claim", "", "agent name to claim as (required)")
-	pickCmd.Flags().String("status", "", "status column to pick from (default: all non-terminal)")
+	pickCmd.Flags().String("status", "", "status column to pick from (default: ready.pull_from)")
 	pickCmd.Flags().String("move", "", "also move the picked task to this status")
 	pickCmd.Flags().StringSlice("tags", nil, "filter by tags (comma-separated, OR logic)")
 	pickCmd.Flags().Bool("no-body", false, "suppress full task details after pick")
+	pickCmd.Flags().Bool("with-context", false, "include dependencies, parent, and recent related activity")
+	pickCmd.Flags().String("strategy", board.PickStrategyPriority,
+		"ordering strategy ("+strings.Join(board.ValidPickStrategies(), ", ")+")")
 	_ = pickCmd.MarkFlagRequired("claim")
 	rootCmd.AddCommand(pickCmd)
 }
 
 func runPick(cmd *cobra.Command, _ []string) error {
-	cfg, err := loadConfig()
+	cfg, err := loadWritableConfig()
 	if err != nil {
 		return err
 	}
@@ -43,16 +54,26 @@ func runPick(cmd *cobra.Command, _ []string) error {
 	moveTarget, _ := cmd.Flags().GetString("move")
 	tags, _ := cmd.Flags().GetStringSlice("tags")
 	noBody, _ := cmd.Flags().GetBool("no-body")
+	strategy, _ := cmd.Flags().GetString("strategy")
+	withContext, _ := cmd.Flags().GetBool("with-context")
 
 	if err = validatePickFlags(cfg, statusFilter, moveTarget); err != nil {
 		return err
 	}
+	if strategy != "" && !slices.Contains(board.ValidPickStrategies(), strategy) {
+		return clierr.Newf(clierr.InvalidInput, "invalid --strategy %q; valid: %s",
+			strategy, strings.Join(board.ValidPickStrategies(), ", "))
+	}
 
-	picked, oldStatus, err := executePick(cfg, claimant, statusFilter, moveTarget, tags)
+	picked, oldStatus, err := executePick(cfg, claimant, statusFilter, moveTarget,
+		board.PickOptions{Tags: tags, Strategy: strategy})
 	if err != nil {
 		return err
 	}
 
+	if withContext {
+		return outputPickWithContext(cfg, picked, oldStatus, claimant, noBody)
+	}
 	return outputPickResult(picked, oldStatus, claimant, noBody)
 }
 
@@ -70,24 +91,27 @@ func validatePickFlags(cfg *config.Config, statusFilter, moveTarget string) erro
 	return nil
 }
 
-func executePick(cfg *config.Config, claimant, statusFilter, moveTarget string, tags []string) (*task.Task, string, error) {
-	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+// executePick selects, claims, and optionally moves a task. opts supplies the
+// tag filter and strategy; status and claim timeout are filled in here.
+func executePick(cfg *config.Config, claimant, statusFilter, moveTarget string, opts board.PickOptions) (*task.Task, string, error) {
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return nil, "", err
 	}
 	printWarnings(warnings)
 
-	opts := board.PickOptions{
-		ClaimTimeout: cfg.ClaimTimeoutDuration(),
-		Tags:         tags,
-	}
+	opts.ClaimTimeout = cfg.ClaimTimeoutDuration()
+	opts.Target = moveTarget
 	if statusFilter != "" {
 		opts.Statuses = []string{statusFilter}
 	}
 
 	picked := board.Pick(cfg, allTasks, opts)
 	if picked == nil {
-		return nil, "", clierr.New(clierr.NothingToPick, "no unblocked, unclaimed tasks found")
+		if full := board.FullReadyTarget(cfg, allTasks, opts); full != "" {
+			return nil, "", checkWIPLimit(cfg, board.CountByStatus(allTasks), full, "")
+		}
+		return nil, "", clierr.New(clierr.NothingToPick, "no ready tasks found")
 	}
 
 	// Claim the task.
@@ -104,12 +128,13 @@ func executePick(cfg *config.Config, claimant, statusFilter, moveTarget string,
 		oldStatus = picked.Status
 		task.UpdateTimestamps(picked, oldStatus, moveTarget, cfg)
 		picked.Status = moveTarget
+		task.ApplyChecklist(picked, cfg)
 	}
 
 	picked.Updated = time.Now()
 
 	// Write the task back.
-	path, err := task.FindByID(cfg.TasksPath(), picked.ID)
+	path, err := task.FindByIDIn(cfg.TasksPaths(), picked.ID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -119,10 +144,10 @@ func executePick(cfg *config.Config, claimant, statusFilter, moveTarget string,
 
 	// Warn if picked task has an existing worktree from a previous claim.
 	if picked.Worktree != "" {
-		fmt.Fprintf(os.Stderr, "Warning: task #%d has an existing worktree at %s (from previous claim). Check before creating a new one.\n", picked.ID, picked.Worktree)
+		warnf("task %s has an existing worktree at %s (from previous claim). Check before creating a new one.\n", output.FormatID(picked.ID), picked.Worktree)
 	}
 	if picked.Branch != "" {
-		fmt.Fprintf(os.Stderr, "Warning: task #%d has an existing branch %s (from previous claim).\n", picked.ID, picked.Branch)
+		warnf("task %s has an existing branch %s (from previous claim).\n", output.FormatID(picked.ID), picked.Branch)
 	}
 
 	logActivity(cfg, "claim", picked.ID, claimant)
@@ -138,11 +163,11 @@ func outputPickResult(picked *task.Task, oldStatus, claimant string, noBody bool
 		return output.JSON(os.Stdout, picked)
 	}
 	if oldStatus != "" {
-		output.Messagef(os.Stdout, "Picked and moved task #%d: %s (%s -> %s, claimed by %s)",
-			picked.ID, picked.Title, oldStatus, picked.Status, claimant)
+		output.Messagef(os.Stdout, "Picked and moved task %s: %s (%s -> %s, claimed by %s)",
+			output.FormatID(picked.ID), picked.Title, oldStatus, picked.Status, claimant)
 	} else {
-		output.Messagef(os.Stdout, "Picked task #%d: %s (%s, claimed by %s)",
-			picked.ID, picked.Title, picked.Status, claimant)
+		output.Messagef(os.Stdout, "Picked task %s: %s (%s, claimed by %s)",
+			output.FormatID(picked.ID), picked.Title, picked.Status, claimant)
 	}
 	if noBody {
 		return nil
@@ -150,5 +175,40 @@ func outputPickResult(picked *task.Task, oldStatus, claimant string, noBody bool
 	if _, err := fmt.Fprintln(os.Stdout); err != nil {
 		return err
 	}
-	return outputTaskDetail(picked)
+	return outputTaskDetail(picked, nil)
+}
+
+// pickContextLogLimit is how many related log entries --with-context shows.
+const pickContextLogLimit = 10
+
+// pickContextResult is a picked task with its work context, as JSON.
+type pickContextResult struct {
+	*task.Task
+	Context board.WorkContext `json:"context"`
+}
+
+// outputPickWithContext prints the picked task like outputPickResult, with
+// its work context, so an agent can start without follow-up calls. The
+// context is supplementary: a failed board or log read leaves it partial.
+func outputPickWithContext(cfg *config.Config, picked *task.Task, oldStatus, claimant string, noBody bool) error {
+	tasks, _, _ := task.ReadAllLenient(cfg.TasksPaths()...)
+	entries, _ := board.ReadLog(cfg.Dir(), board.LogFilterOptions{})
+	wc := board.BuildWorkContext(cfg, picked, tasks, entries, pickContextLogLimit)
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		crypt.Reveal(picked)
+		return output.JSON(os.Stdout, pickContextResult{Task: picked, Context: wc})
+	case output.FormatCompact:
+		if err := outputPickResult(picked, oldStatus, claimant, noBody); err != nil {
+			return err
+		}
+		output.WorkContextCompact(os.Stdout, wc)
+	default:
+		if err := outputPickResult(picked, oldStatus, claimant, noBody); err != nil {
+			return err
+		}
+		output.WorkContextTable(os.Stdout, wc)
+	}
+	return nil
 }
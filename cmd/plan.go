@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Match remaining work against team capacity",
+	Long: `Fills each person's capacity, from today (or --from) through --until, with
+ready tasks in pick order: class of service, then priority. Ready tasks are
+unfinished, not blocked, and have all dependencies done.
+
+Tasks assigned to someone use that person's capacity. Unassigned tasks are
+suggested for whoever has the most capacity left. The report lists what fits,
+what slips, and who has more assigned work than capacity.
+
+Capacity is hours per working day, e.g. alice=6h/d,bob=4h/d. Use /w for
+hours per week (5 working days). Weekends and holidays from the board
+calendar are not working days.`,
+	Args: cobra.NoArgs,
+	RunE: runPlan,
+}
+
+func init() {
+	planCmd.Flags().String("capacity", "", "capacity per person, e.g. alice=6h/d,bob=4h/d (required)")
+	planCmd.Flags().String("until", "", "last day of the plan, YYYY-MM-DD or relative (required)")
+	planCmd.Flags().String("from", "", "first day of the plan, YYYY-MM-DD or relative (default today)")
+	_ = planCmd.MarkFlagRequired("capacity")
+	_ = planCmd.MarkFlagRequired("until")
+	rootCmd.AddCommand(planCmd)
+}
+
+func runPlan(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	spec, _ := cmd.Flags().GetString("capacity")
+	capacity, err := board.ParseCapacity(spec, cfg.EstimateHoursPerDay())
+	if err != nil {
+		return clierr.Newf(clierr.InvalidInput, "invalid --capacity %q: %v", spec, err)
+	}
+
+	untilStr, _ := cmd.Flags().GetString("until")
+	until, err := date.ParseNatural(untilStr)
+	if err != nil {
+		return task.ValidateDate("until", untilStr, err)
+	}
+	from := date.Today()
+	if v, _ := cmd.Flags().GetString("from"); v != "" {
+		from, err = date.ParseNatural(v)
+		if err != nil {
+			return task.ValidateDate("from", v, err)
+		}
+	}
+	if until.Before(from.Time) {
+		return clierr.Newf(clierr.InvalidDate, "--until %s is before %s", until, from)
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	plan := board.ComputePlan(cfg, tasks, capacity, from, until)
+
+	format := outputFormat()
+	if format == output.FormatJSON {
+		return output.JSON(os.Stdout, plan)
+	}
+	if format == output.FormatCompact {
+		output.PlanCompact(os.Stdout, plan)
+		return nil
+	}
+
+	output.PlanTable(os.Stdout, plan)
+	return nil
+}
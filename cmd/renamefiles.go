@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var renameFilesCmd = &cobra.Command{
+	Use:   "rename-files",
+	Short: "Rename task files to the configured naming scheme",
+	Long: `Renames every task file to match files.naming and files.slug_length, for
+after either setting changes. Slugs are generated again from the titles.
+Task contents, IDs, and the activity log do not change, and files stay in
+their own tasks directory.
+
+Fails without renaming anything if a new name is already taken by another
+file. Use --dry-run to preview the new names.`,
+	Args: cobra.NoArgs,
+	RunE: runRenameFiles,
+}
+
+func init() {
+	renameFilesCmd.Flags().Bool("dry-run", false, "show the new names without renaming anything")
+	rootCmd.AddCommand(renameFilesCmd)
+}
+
+// fileRename is one task file rename.
+type fileRename struct {
+	ID   int    `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// renameFilesResult is the JSON output of rename-files.
+type renameFilesResult struct {
+	Naming  string       `json:"naming"`
+	Renamed []fileRename `json:"renamed"`
+	DryRun  bool         `json:"dry_run,omitempty"`
+}
+
+func runRenameFiles(cmd *cobra.Command, _ []string) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	result := renameFilesResult{Naming: cfg.FileNaming(), Renamed: []fileRename{}}
+	result.DryRun, _ = cmd.Flags().GetBool("dry-run")
+	current := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		current[t.File] = true
+	}
+	targets := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		to := filepath.Join(filepath.Dir(t.File), task.Filename(cfg, t))
+		if to == t.File {
+			continue
+		}
+		if _, err := os.Stat(to); targets[to] || current[to] || err == nil {
+			return clierr.Newf(clierr.StatusConflict, "cannot rename %s: %s already exists",
+				filepath.Base(t.File), filepath.Base(to))
+		}
+		targets[to] = true
+		result.Renamed = append(result.Renamed, fileRename{ID: t.ID, From: t.File, To: to})
+	}
+
+	if !result.DryRun {
+		for _, r := range result.Renamed {
+			if err := os.Rename(r.From, r.To); err != nil {
+				return fmt.Errorf("renaming %s: %w", filepath.Base(r.From), err)
+			}
+		}
+	}
+	return outputRenameFilesResult(result)
+}
+
+func outputRenameFilesResult(r renameFilesResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, r)
+	}
+	if len(r.Renamed) == 0 {
+		output.Messagef(os.Stdout, "Task files already follow %s", r.Naming)
+		return nil
+	}
+	for _, c := range r.Renamed {
+		fmt.Fprintf(os.Stdout, "%s -> %s\n", filepath.Base(c.From), filepath.Base(c.To))
+	}
+	verb := "Renamed"
+	if r.DryRun {
+		verb = "Would rename"
+	}
+	output.Messagef(os.Stdout, "%s %d file(s) to %s", verb, len(r.Renamed), r.Naming)
+	return nil
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// renumberTmpExt marks task files written by renumber before they replace
+// the originals. It is not ".md", so readers ignore half-finished files.
+const renumberTmpExt = ".renumber"
+
+var renumberCmd = &cobra.Command{
+	Use:   "renumber",
+	Short: "Reassign contiguous task IDs",
+	Long: `Reassigns task IDs 1..N in their current order, closing the gaps left by
+deletions or imports. Parents, dependencies, subscriptions, filenames, and
+activity log entries are rewritten to the new IDs, and next_id is reset to
+N+1. Archived tasks are included. Task UIDs do not change.
+
+All task files are written before any original is replaced, so a failed
+write leaves the board untouched. References to IDs in task bodies are not
+rewritten. Use --dry-run to preview the mapping.`,
+	Args: cobra.NoArgs,
+	RunE: runRenumber,
+}
+
+func init() {
+	renumberCmd.Flags().Bool("dry-run", false, "show the ID mapping without changing anything")
+	rootCmd.AddCommand(renumberCmd)
+}
+
+// renumberResult is the JSON output of renumber.
+type renumberResult struct {
+	Changes []board.IDChange `json:"changes"`
+	NextID  int              `json:"next_id"`
+	DryRun  bool             `json:"dry_run,omitempty"`
+}
+
+func runRenumber(cmd *cobra.Command, _ []string) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+
+	// Strict read: a file that cannot be parsed could hold references that
+	// would be left pointing at the wrong tasks.
+	tasks, err := task.ReadAll(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	seen := make(map[int]bool, len(tasks))
+	for _, t := range tasks {
+		if seen[t.ID] {
+			return clierr.Newf(clierr.InvalidInput, "duplicate task ID %d; fix it before renumbering", t.ID)
+		}
+		seen[t.ID] = true
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	changes := board.PlanRenumber(tasks)
+	result := renumberResult{Changes: changes, NextID: len(tasks) + 1, DryRun: dryRun}
+	if result.Changes == nil {
+		result.Changes = []board.IDChange{}
+	}
+
+	if !dryRun && len(changes) > 0 {
+		if err := applyRenumber(cfg, tasks, changes); err != nil {
+			return err
+		}
+		if err := board.RenumberLog(cfg.Dir(), board.IDMapping(changes)); err != nil {
+			return err
+		}
+		for _, c := range changes {
+			logActivity(cfg, "renumber", c.New, output.FormatID(c.Old)+" -> "+output.FormatID(c.New))
+		}
+	}
+	subsChanged := !dryRun && board.RenumberSubscriptions(cfg.Subscriptions, board.IDMapping(changes))
+	if !dryRun && (cfg.NextID != result.NextID || subsChanged) {
+		cfg.NextID = result.NextID
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+	}
+
+	return outputRenumberResult(result)
+}
+
+// applyRenumber rewrites the task files for changes. Every changed task is
+// first written to a temporary file; only when all writes succeed are the
+// originals removed and the new files moved into place. Files stay in their
+// own tasks directory.
+func applyRenumber(cfg *config.Config, tasks []*task.Task, changes []board.IDChange) error {
+	oldPaths := make(map[*task.Task]string, len(tasks))
+	for _, t := range tasks {
+		oldPaths[t] = t.File
+	}
+	now := time.Now()
+	changed := board.ApplyRenumber(tasks, board.IDMapping(changes))
+
+	newPaths := make([]string, len(changed))
+	for i, t := range changed {
+		t.Updated = now
+		newPaths[i] = filepath.Join(filepath.Dir(oldPaths[t]), task.FilenameWithSlug(cfg, t, fileSlug(oldPaths[t], t.Title)))
+		if err := task.Write(newPaths[i]+renumberTmpExt, t); err != nil {
+			for _, p := range newPaths[:i+1] {
+				_ = os.Remove(p + renumberTmpExt)
+			}
+			return fmt.Errorf("writing task %s: %w", output.FormatID(t.ID), err)
+		}
+	}
+
+	// New filenames can reuse old ones, so remove all originals first.
+	for _, t := range changed {
+		if err := os.Remove(oldPaths[t]); err != nil {
+			return fmt.Errorf("removing %s: %w", filepath.Base(oldPaths[t]), err)
+		}
+	}
+	for _, p := range newPaths {
+		if err := os.Rename(p+renumberTmpExt, p); err != nil {
+			return fmt.Errorf("renaming %s: %w", filepath.Base(p), err)
+		}
+	}
+	return nil
+}
+
+// fileSlug returns the slug part of a task filename ("012-fix-login.md" ->
+// "fix-login"), falling back to a slug of the title.
+func fileSlug(path, title string) string {
+	if slug := task.SlugFromFilename(filepath.Base(path)); slug != "" {
+		return slug
+	}
+	return task.GenerateSlug(title)
+}
+
+func outputRenumberResult(r renumberResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, r)
+	}
+	if len(r.Changes) == 0 {
+		output.Messagef(os.Stdout, "Task IDs are already contiguous (next ID %d)", r.NextID)
+		return nil
+	}
+	for _, c := range r.Changes {
+		fmt.Fprintf(os.Stdout, "%s -> %s\n", output.FormatID(c.Old), output.FormatID(c.New))
+	}
+	verb := "Renumbered"
+	if r.DryRun {
+		verb = "Would renumber"
+	}
+	output.Messagef(os.Stdout, "%s %d task(s); next ID %d", verb, len(r.Changes), r.NextID)
+	return nil
+}
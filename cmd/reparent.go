@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var reparentCmd = &cobra.Command{
+	Use:   "reparent OLD-PARENT NEW-PARENT",
+	Short: "Move all children of a task under another parent",
+	Long: `Moves every direct child of OLD-PARENT under NEW-PARENT. Each child keeps its
+own children, so whole subtrees move together. Fails without changing anything
+if the move would create a loop in the parent chain.`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // old and new parent
+	RunE: runReparent,
+}
+
+func init() {
+	rootCmd.AddCommand(reparentCmd)
+}
+
+// reparentResult is the JSON output of reparent.
+type reparentResult struct {
+	OldParent int   `json:"old_parent"`
+	NewParent int   `json:"new_parent"`
+	Moved     []int `json:"moved"`
+}
+
+func runReparent(_ *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if err := checkIDSyntax(arg); err != nil {
+			return err
+		}
+	}
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+
+	oldParent, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	newParent, err := parseID(args[1])
+	if err != nil {
+		return err
+	}
+	if oldParent == newParent {
+		return clierr.New(clierr.InvalidInput, "old and new parent are the same task")
+	}
+	for _, id := range []int{oldParent, newParent} {
+		if _, err := task.FindByIDIn(cfg.TasksPaths(), id); err != nil {
+			return err
+		}
+	}
+
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	var children []*task.Task
+	for _, t := range allTasks {
+		if t.Parent != nil && *t.Parent == oldParent {
+			children = append(children, t)
+		}
+	}
+	if len(children) == 0 {
+		return clierr.Newf(clierr.NoChanges, "task %s has no children", output.FormatID(oldParent))
+	}
+
+	// Validate every child before writing any, so a cycle leaves the board untouched.
+	for _, t := range children {
+		if chain := board.ParentCycle(allTasks, t.ID, newParent); chain != nil {
+			return task.ValidateParentCycle(t.ID, newParent, chain)
+		}
+	}
+
+	result := reparentResult{OldParent: oldParent, NewParent: newParent, Moved: make([]int, 0, len(children))}
+	now := time.Now()
+	for _, t := range children {
+		p := newParent
+		t.Parent = &p
+		t.Updated = now
+		if err := task.Write(t.File, t); err != nil {
+			return fmt.Errorf("writing task #%d: %w", t.ID, err)
+		}
+		logActivity(cfg, "reparent", t.ID, "#"+strconv.Itoa(oldParent)+" -> #"+strconv.Itoa(newParent))
+		result.Moved = append(result.Moved, t.ID)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, result)
+	}
+	output.Messagef(os.Stdout, "Reparented %d task(s) from %s to %s", len(result.Moved), output.FormatID(oldParent), output.FormatID(newParent))
+	return nil
+}
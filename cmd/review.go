@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var reviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Review the board and tidy it up in bulk",
+	Long: `Walks through what a daily or weekly review tidies up: stale tasks, expired
+claims, overdue tasks, done tasks ready to archive, and columns over their
+WIP limit. Each finding but the WIP ones comes with a fix: tag a stale task
+"stale", release an expired claim, postpone an overdue task by a working
+week, or archive a done task.
+
+In a terminal, the fixes are offered as a checklist, all checked; the ones
+left checked are applied together at the end. Elsewhere, or with --dry-run,
+the findings are only listed; --yes applies every fix without asking.`,
+	Args: cobra.NoArgs,
+	RunE: runReview,
+}
+
+func init() {
+	reviewCmd.Flags().String("stale", "30d", "idle time before an open task is stale (e.g. 14d, 2w)")
+	reviewCmd.Flags().String("archive-after", "7d", "time since completion before a done task is archived")
+	reviewCmd.Flags().BoolP("yes", "y", false, "apply every fix without asking")
+	reviewCmd.Flags().Bool("dry-run", false, "list the findings without changing anything")
+	reviewCmd.MarkFlagsMutuallyExclusive("yes", "dry-run")
+	rootCmd.AddCommand(reviewCmd)
+}
+
+// reviewFixResult reports one applied fix.
+type reviewFixResult struct {
+	Kind  string `json:"kind"`
+	ID    int    `json:"id"`
+	Fix   string `json:"fix"`
+	Error string `json:"error,omitempty"`
+}
+
+// reviewResult is the JSON output of review.
+type reviewResult struct {
+	Findings []board.ReviewItem `json:"findings"`
+	Applied  []reviewFixResult  `json:"applied"`
+}
+
+func runReview(cmd *cobra.Command, _ []string) error {
+	opts, err := reviewOptions(cmd)
+	if err != nil {
+		return err
+	}
+	yes, _ := cmd.Flags().GetBool("yes")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	prompt := !yes && !dryRun && outputFormat() != output.FormatJSON && isInteractive()
+
+	load := loadConfig
+	if yes || prompt {
+		load = loadWritableConfig
+	}
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+	yes = yes || (!dryRun && assumeYes(cfg))
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	now := time.Now()
+	findings := board.Review(cfg, tasks, opts, now)
+	if outputFormat() != output.FormatJSON {
+		printReview(findings)
+	}
+
+	var chosen []board.ReviewItem
+	switch {
+	case yes:
+		chosen = fixable(findings)
+	case prompt:
+		chosen = chooseReviewFixes(findings)
+	}
+
+	applied := applyReviewFixes(cfg, tasks, chosen, now)
+	if outputFormat() == output.FormatJSON {
+		if findings == nil {
+			findings = []board.ReviewItem{}
+		}
+		return output.JSON(os.Stdout, reviewResult{Findings: findings, Applied: applied})
+	}
+	printReviewFixes(applied, len(fixable(findings)), yes || prompt)
+	return nil
+}
+
+// reviewOptions reads the review thresholds from the flags.
+func reviewOptions(cmd *cobra.Command) (board.ReviewOptions, error) {
+	staleStr, _ := cmd.Flags().GetString("stale")
+	staleAfter, err := parseIdleDuration(staleStr)
+	if err != nil {
+		return board.ReviewOptions{}, err
+	}
+	archiveStr, _ := cmd.Flags().GetString("archive-after")
+	archiveAfter, err := parseIdleDuration(archiveStr)
+	if err != nil {
+		return board.ReviewOptions{}, err
+	}
+	return board.ReviewOptions{StaleAfter: staleAfter, ArchiveAfter: archiveAfter}, nil
+}
+
+// fixable returns the findings that come with a fix.
+func fixable(findings []board.ReviewItem) []board.ReviewItem {
+	var items []board.ReviewItem
+	for _, f := range findings {
+		if f.Fix != "" {
+			items = append(items, f)
+		}
+	}
+	return items
+}
+
+// reviewHeadings titles the finding kinds in the review listing.
+var reviewHeadings = map[string]string{
+	board.ReviewStale:        "Stale tasks",
+	board.ReviewExpiredClaim: "Expired claims",
+	board.ReviewOverdue:      "Overdue tasks",
+	board.ReviewArchive:      "Done tasks to archive",
+	board.ReviewWIP:          "Columns over their WIP limit",
+}
+
+// printReview lists the findings, grouped by kind.
+func printReview(findings []board.ReviewItem) {
+	if len(findings) == 0 {
+		output.Messagef(os.Stdout, "Nothing to review: the board is tidy")
+		return
+	}
+	kind := ""
+	for _, f := range findings {
+		if f.Kind != kind {
+			if kind != "" {
+				fmt.Fprintln(os.Stdout)
+			}
+			kind = f.Kind
+			fmt.Fprintf(os.Stdout, "%s:\n", reviewHeadings[kind])
+		}
+		if f.ID == 0 {
+			fmt.Fprintf(os.Stdout, "  %s: %s\n", f.Status, f.Detail)
+			continue
+		}
+		fmt.Fprintf(os.Stdout, "  %s %s (%s, %s) -> %s\n", output.FormatID(f.ID), f.Title, f.Status, f.Detail, f.Fix)
+	}
+	fmt.Fprintln(os.Stdout)
+}
+
+// chooseReviewFixes offers the fixes as a checklist and returns the ones
+// left checked.
+func chooseReviewFixes(findings []board.ReviewItem) []board.ReviewItem {
+	candidates := fixable(findings)
+	if len(candidates) == 0 {
+		return nil
+	}
+	items := make([]menuItem, len(candidates))
+	for i, f := range candidates {
+		items[i] = menuItem{
+			label:       output.FormatID(f.ID) + " " + f.Title,
+			description: reviewHeadings[f.Kind] + ": " + f.Fix,
+			selected:    true,
+		}
+	}
+	var chosen []board.ReviewItem
+	for _, i := range multiSelect("Fixes to apply:", items) {
+		chosen = append(chosen, candidates[i])
+	}
+	return chosen
+}
+
+// applyReviewFixes applies the chosen fixes to tasks, reporting each.
+func applyReviewFixes(cfg *config.Config, tasks []*task.Task, chosen []board.ReviewItem, now time.Time) []reviewFixResult {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	applied := make([]reviewFixResult, 0, len(chosen))
+	for _, item := range chosen {
+		r := reviewFixResult{Kind: item.Kind, ID: item.ID, Fix: item.Fix}
+		if err := applyReviewFix(cfg, byID[item.ID], item, now); err != nil {
+			r.Error = err.Error()
+		}
+		applied = append(applied, r)
+	}
+	return applied
+}
+
+// applyReviewFix applies the fix of one finding to t and logs it.
+func applyReviewFix(cfg *config.Config, t *task.Task, item board.ReviewItem, now time.Time) error {
+	var action, detail string
+	switch item.Kind {
+	case board.ReviewArchive:
+		return executeArchive(cfg, item.ID)
+	case board.ReviewStale:
+		if slices.Contains(t.Tags, board.ReviewStaleTag) {
+			return nil
+		}
+		t.Tags = append(t.Tags, board.ReviewStaleTag)
+		action, detail = "stale", "tagged "+board.ReviewStaleTag
+	case board.ReviewExpiredClaim:
+		action, detail = "release", t.ClaimedBy
+		t.ClaimedBy = ""
+		t.ClaimedAt = nil
+	case board.ReviewOverdue:
+		due := board.ReviewPostponeDate(cfg, now)
+		t.Due = &due
+		action, detail = "edit", "due -> "+due.String()
+	default:
+		return nil
+	}
+	t.Updated = now
+	if err := task.Write(t.File, t); err != nil {
+		return fmt.Errorf("writing task: %w", err)
+	}
+	logActivity(cfg, action, t.ID, detail)
+	return nil
+}
+
+// printReviewFixes reports the fixes applied out of the fixable findings,
+// or, when review only listed them, how to apply them.
+func printReviewFixes(applied []reviewFixResult, fixableCount int, offered bool) {
+	if !offered {
+		if fixableCount > 0 {
+			output.Messagef(os.Stdout, "%d fix(es) available; run review --yes to apply them", fixableCount)
+		}
+		return
+	}
+	failed := 0
+	for _, r := range applied {
+		if r.Error != "" {
+			failed++
+			fmt.Fprintf(os.Stdout, "%s: %s failed: %s\n", output.FormatID(r.ID), r.Fix, r.Error)
+		}
+	}
+	if fixableCount == 0 {
+		return
+	}
+	output.Messagef(os.Stdout, "Applied %d of %d fix(es)", len(applied)-failed, fixableCount)
+}
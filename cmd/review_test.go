@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// newReviewCmd creates a fresh cobra command with review flags for testing.
+func newReviewCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String("stale", "30d", "")
+	cmd.Flags().String("archive-after", "7d", "")
+	cmd.Flags().BoolP("yes", "y", false, "")
+	cmd.Flags().Bool("dry-run", false, "")
+	return cmd
+}
+
+func TestRunReview_AppliesCheckedFixes(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	cfg, err := config.Load(kanbanDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().AddDate(0, 0, -40)
+	writeHandoffTask(t, cfg, &task.Task{ID: 1, Title: "Idle", Status: "todo", Priority: "medium", Created: old, Updated: old})
+	writeHandoffTask(t, cfg, &task.Task{ID: 2, Title: "Shipped", Status: "done", Priority: "medium",
+		Created: old, Updated: old, Completed: &old})
+
+	oldFlagDir := flagDir
+	flagDir = kanbanDir
+	t.Cleanup(func() { flagDir = oldFlagDir })
+	setFlags(t, false, true, false)
+
+	var offered []menuItem
+	mockInteractive(t, nil)
+	multiSelectFn = func(_ string, items []menuItem) []int {
+		offered = items
+		return []int{1}
+	}
+
+	r, w := captureStdout(t)
+	err = runReview(newReviewCmd(), nil)
+	got := drainPipe(t, r, w)
+	if err != nil {
+		t.Fatalf("runReview error: %v", err)
+	}
+
+	if len(offered) != 2 || !offered[0].selected || !strings.Contains(offered[1].description, "archive") {
+		t.Errorf("offered fixes = %+v, want tag and archive, checked", offered)
+	}
+	if !strings.Contains(got, "Applied 1 of 2 fix(es)") {
+		t.Errorf("output lacks the summary:\n%s", got)
+	}
+	idle, err := task.Read(mustFindTask(t, cfg, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idle.Tags) != 0 {
+		t.Errorf("unchecked fix was applied: tags = %v", idle.Tags)
+	}
+	shipped, err := task.Read(mustFindTask(t, cfg, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shipped.Status != config.ArchivedStatus {
+		t.Errorf("status = %q, want %q", shipped.Status, config.ArchivedStatus)
+	}
+}
+
+func mustFindTask(t *testing.T, cfg *config.Config, id int) string {
+	t.Helper()
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
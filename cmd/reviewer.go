@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// meName is the --reviewer value that stands for the current user.
+const meName = "me"
+
+// resolveMe expands "me" to the current user: KANBAN_USER, falling back to
+// USER. Any other name is returned unchanged.
+func resolveMe(name string) (string, error) {
+	if name != meName {
+		return name, nil
+	}
+	for _, env := range []string{"KANBAN_USER", "USER"} {
+		if v := os.Getenv(env); v != "" {
+			return v, nil
+		}
+	}
+	return "", clierr.New(clierr.InvalidInput, `cannot resolve "me": set KANBAN_USER`)
+}
+
+// applyReviewerFlag sets the task reviewer from --reviewer, if given.
+func applyReviewerFlag(cmd *cobra.Command, t *task.Task) (bool, error) {
+	if !cmd.Flags().Changed("reviewer") {
+		return false, nil
+	}
+	v, _ := cmd.Flags().GetString("reviewer")
+	if v == "" {
+		return false, clierr.New(clierr.InvalidInput, "reviewer name is required (use --reviewer NAME)")
+	}
+	name, err := resolveMe(v)
+	if err != nil {
+		return false, err
+	}
+	t.Reviewer = name
+	return true, nil
+}
+
+// applyReviewerEditFlags handles edit's --reviewer and --clear-reviewer.
+func applyReviewerEditFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
+	clearReviewer, _ := cmd.Flags().GetBool("clear-reviewer")
+	if clearReviewer && cmd.Flags().Changed("reviewer") {
+		return false, clierr.New(clierr.StatusConflict, "cannot use --reviewer and --clear-reviewer together")
+	}
+	if clearReviewer {
+		t.Reviewer = ""
+		return true, nil
+	}
+	return applyReviewerFlag(cmd, t)
+}
+
+// checkReviewer enforces require_reviewer for newStatus: the task needs a
+// reviewer, and the reviewer cannot be its assignee.
+func checkReviewer(cfg *config.Config, t *task.Task, newStatus string) error {
+	if !cfg.StatusRequiresReviewer(newStatus) {
+		return nil
+	}
+	if t.Reviewer == "" || t.Reviewer == t.Assignee {
+		return task.ValidateReviewerRequired(t, newStatus)
+	}
+	return nil
+}
@@ -4,17 +4,26 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/i18n"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/timing"
 )
 
 // version is set at build time via ldflags.
@@ -22,13 +31,25 @@ var version = "dev"
 
 // Global flags.
 var (
-	flagJSON    bool
-	flagTable   bool
-	flagCompact bool
-	flagDir     string
-	flagNoColor bool
+	flagJSON     bool
+	flagTable    bool
+	flagCompact  bool
+	flagDir      string
+	flagNoColor  bool
+	flagColor    string
+	flagUTC      bool
+	flagReadOnly bool
+	flagTiming   bool
+	flagFailOn   string
+	flagLang     string
+	flagQuiet    bool
+	flagVerbose  int
 )
 
+// boardConfig is the config last loaded by loadConfig. Task ID arguments are
+// resolved against it: they may carry board.id_prefix or be a task UID.
+var boardConfig *config.Config
+
 var rootCmd = &cobra.Command{
 	Use:   "kanban-md",
 	Short: "A file-based Kanban tool powered by Markdown",
@@ -38,39 +59,171 @@ easy to read, edit, and version-control. Designed for AI agents and humans alike
 	Version:       version,
 	SilenceErrors: true,
 	SilenceUsage:  true,
-	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
-		if flagNoColor || os.Getenv("NO_COLOR") != "" {
-			output.DisableColor()
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		if flagFailOn != failOnError && flagFailOn != failOnWarning {
+			return clierr.Newf(clierr.InvalidInput, "invalid --fail-on %q (want %s or %s)", flagFailOn, failOnError, failOnWarning)
+		}
+		if flagTiming || os.Getenv("KANBAN_DEBUG") == "1" {
+			timing.Enable()
+		}
+		if err := applyColorMode(cmd); err != nil {
+			return err
+		}
+		if err := applyVerbosity(cmd); err != nil {
+			return err
+		}
+		if err := setLanguage(); err != nil {
+			return err
 		}
+		setLogActorFromClaim(cmd)
 		// Check skill staleness for non-skill commands.
 		if cmd.Name() != "skill" && cmd.Parent() != nil && cmd.Parent().Name() != "skill" {
 			if root, err := findProjectRoot(); err == nil {
 				CheckSkillStaleness(root)
 			}
 		}
+		return nil
 	},
 }
 
+// setLogActorFromClaim attributes log entries to the --claim name when cmd
+// has one; otherwise the board falls back to KANBAN_AGENT or the OS user.
+func setLogActorFromClaim(cmd *cobra.Command) {
+	claim := ""
+	if f := cmd.Flags().Lookup("claim"); f != nil {
+		claim = f.Value.String()
+	}
+	board.SetLogActor(claim)
+}
+
+// --color values.
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// applyColorMode resolves --color and strips or forces ANSI styling. An
+// explicit --color wins; otherwise --no-color or a non-empty NO_COLOR means
+// never. In auto mode output is styled only when stdout is a terminal, so
+// piped table output never carries escape codes.
+func applyColorMode(cmd *cobra.Command) error {
+	mode := flagColor
+	if !cmd.Flags().Changed("color") && (flagNoColor || os.Getenv("NO_COLOR") != "") {
+		mode = colorNever
+	}
+	switch mode {
+	case colorAlways:
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	case colorNever:
+		disableColor()
+	case colorAuto:
+		if !term.IsTerminal(int(os.Stdout.Fd())) { //nolint:gosec // Fd returns uintptr, int cast is safe for terminal check
+			disableColor()
+		}
+	default:
+		return clierr.Newf(clierr.InvalidInput, "invalid --color %q (want %s, %s, or %s)",
+			mode, colorAuto, colorAlways, colorNever)
+	}
+	return nil
+}
+
+// disableColor strips styling from table output and, through the Ascii
+// profile, from every other lipgloss style such as the TUI's.
+func disableColor() {
+	output.DisableColor()
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// setLanguage loads the translation catalog for --lang, or KANBAN_LANG when
+// the flag is not given. Catalogs are looked up in the board's i18n directory
+// first, then in the user config directory. A missing catalog is an error for
+// --lang but silently falls back to English for the environment variable.
+func setLanguage() error {
+	lang, explicit := flagLang, flagLang != ""
+	if !explicit {
+		lang = os.Getenv(i18n.EnvVar)
+	}
+	var dirs []string
+	if dir, err := resolveDir(); err == nil {
+		dirs = append(dirs, filepath.Join(dir, i18n.DirName))
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		dirs = append(dirs, filepath.Join(dir, "kanban-md", i18n.DirName))
+	}
+	err := i18n.Load(lang, dirs...)
+	if err == nil || (!explicit && errors.Is(err, i18n.ErrNotFound)) {
+		return nil
+	}
+	return clierr.New(clierr.InvalidInput, err.Error())
+}
+
+// --fail-on values.
+const (
+	failOnError   = "error"
+	failOnWarning = "warning"
+)
+
+// warningCount counts warnings printed by warnf, for --fail-on warning.
+var warningCount int
+
+// itemWarnings, when set, collects the warnings of the batch item running
+// so they can be reported in its result.
+var itemWarnings *[]string
+
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&flagJSON, "json", false, "output as JSON")
 	rootCmd.PersistentFlags().BoolVar(&flagTable, "table", false, "output as table")
 	rootCmd.PersistentFlags().BoolVar(&flagCompact, "compact", false, "compact one-line-per-record output")
 	rootCmd.PersistentFlags().BoolVar(&flagCompact, "oneline", false, "alias for --compact")
 	rootCmd.PersistentFlags().StringVar(&flagDir, "dir", "", "path to kanban directory")
-	rootCmd.PersistentFlags().BoolVar(&flagNoColor, "no-color", false, "disable color output")
+	rootCmd.PersistentFlags().BoolVar(&flagNoColor, "no-color", false, "disable color output (same as --color never)")
+	rootCmd.PersistentFlags().StringVar(&flagColor, "color", colorAuto,
+		"color output: auto (only on a terminal), always, or never (auto respects NO_COLOR)")
+	rootCmd.PersistentFlags().BoolVar(&flagUTC, "utc", false, "show timestamps in UTC (overrides display.timezone)")
+	rootCmd.PersistentFlags().BoolVar(&flagReadOnly, "readonly", false, "reject all commands that modify the board")
+	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false,
+		"print nothing but errors; the exit code reports the outcome")
+	rootCmd.PersistentFlags().CountVarP(&flagVerbose, "verbose", "v",
+		"print decision details to stderr, such as WIP and claim checks (-vv for more)")
+	rootCmd.PersistentFlags().StringVar(&flagLang, "lang", "",
+		"language for human-readable output, from an i18n/<lang>.yml catalog (also KANBAN_LANG)")
+	rootCmd.PersistentFlags().BoolVar(&flagTiming, "timing", false, "print phase timings and file counts to stderr (also KANBAN_DEBUG=1)")
+	// Unknown or malformed flags are validation errors, like bad values.
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return clierr.New(clierr.InvalidInput, err.Error())
+	})
+	rootCmd.PersistentFlags().StringVar(&flagFailOn, "fail-on", failOnError,
+		"exit non-zero on: error, or warning (warnings such as a blocked move become fatal)")
 }
 
 // Execute runs the root command.
 func Execute() {
-	_, err := rootCmd.ExecuteC()
+	start := time.Now()
+	cmd, err := rootCmd.ExecuteC()
+	timing.Report(os.Stderr)
+	exit := reportError(err)
+	recordOp(cmd, start, exit, err)
+	if exit != 0 {
+		os.Exit(exit)
+	}
+}
+
+// reportError prints err, if any, and returns the process exit code.
+func reportError(err error) int {
 	if err == nil {
-		return
+		if flagFailOn == failOnWarning && warningCount > 0 {
+			return clierr.ExitGeneral
+		}
+		return 0
 	}
 
+	restoreStdout()
+
 	// Handle SilentError — exit with code, no output.
 	var silent *clierr.SilentError
 	if errors.As(err, &silent) {
-		os.Exit(silent.Code)
+		return silent.Code
 	}
 
 	// Determine if JSON mode is active.
@@ -82,21 +235,52 @@ func Execute() {
 	if jsonMode {
 		var cliErr *clierr.Error
 		if errors.As(err, &cliErr) {
-			output.JSONError(os.Stdout, cliErr.Code, cliErr.Message, cliErr.Details)
-			os.Exit(cliErr.ExitCode())
+			output.WriteError(os.Stdout, errorResponse(cliErr))
+			return cliErr.ExitCode()
 		}
 		// Unknown error — wrap as INTERNAL_ERROR.
 		output.JSONError(os.Stdout, clierr.InternalError, err.Error(), nil)
-		os.Exit(2) //nolint:mnd // exit code 2 for internal errors
+		return clierr.ExitInternal
 	}
 
 	// Non-JSON mode: print to stderr.
 	fmt.Fprintln(os.Stderr, err)
 	var cliErr *clierr.Error
 	if errors.As(err, &cliErr) {
-		os.Exit(cliErr.ExitCode())
+		return cliErr.ExitCode()
 	}
-	os.Exit(1)
+	return clierr.ExitGeneral
+}
+
+// errorResponse is the --json form of e. retry_after is rounded up to whole
+// seconds so that waiting that long is always enough.
+func errorResponse(e *clierr.Error) output.ErrorResponse {
+	resp := output.ErrorResponse{Error: e.Message, Code: e.Code, Details: e.Details, Retryable: e.Retryable()}
+	if e.RetryAfter > 0 {
+		resp.RetryAfter = int(math.Ceil(e.RetryAfter.Seconds()))
+	}
+	return resp
+}
+
+// recordOp appends the finished command to the ops log when the board it
+// loaded has log.ops set. Commands that never loaded a board are not
+// recorded, and failures to record are ignored.
+func recordOp(cmd *cobra.Command, start time.Time, exit int, err error) {
+	if boardConfig == nil || !boardConfig.Log.Ops || cmd == nil {
+		return
+	}
+	entry := board.OpsEntry{
+		Timestamp:  start,
+		Command:    strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" "),
+		DurationMS: time.Since(start).Milliseconds(),
+		Exit:       exit,
+		Actor:      board.CurrentActor(),
+	}
+	var cliErr *clierr.Error
+	if errors.As(err, &cliErr) {
+		entry.Code = cliErr.Code
+	}
+	_ = board.AppendOps(boardConfig.Dir(), entry)
 }
 
 // resolveDir returns the absolute path to the kanban directory.
@@ -130,43 +314,145 @@ func loadConfig() (*config.Config, error) {
 		return nil, err
 	}
 
+	verbosef(verboseDetails, "board %s (config version %d)", cfg.Dir(), cfg.Version)
+
 	report, err := task.EnsureConsistency(cfg)
 	if err != nil {
 		return nil, err
 	}
 	printWarnings(report.Warnings)
 	printConsistencyRepairs(report.Repairs)
+	output.SetLocation(displayLocation(cfg))
+	output.SetTagStyles(cfg.Tags.Styles)
+	output.SetIDPrefix(cfg.Board.IDPrefix)
+	board.SetLogSinks(cfg.Dir(), cfg.Log.Sinks)
+	board.SetAutoCommit(cfg)
+	boardConfig = cfg
 
 	return cfg, nil
 }
 
+// loadWritableConfig loads the config for a command that modifies the board,
+// failing with BOARD_READONLY if the board or the --readonly flag forbids it.
+func loadWritableConfig() (*config.Config, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWritable(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// checkWritable returns a BOARD_READONLY error if the board is read-only.
+func checkWritable(cfg *config.Config) error {
+	if flagReadOnly {
+		return clierr.New(clierr.BoardReadOnly, "board is read-only (--readonly)")
+	}
+	if cfg.Board.ReadOnly {
+		return clierr.New(clierr.BoardReadOnly,
+			"board is read-only (run 'kanban-md config set board.readonly false' to allow changes)")
+	}
+	return nil
+}
+
+// displayLocation returns the time zone for rendering timestamps: UTC when
+// --utc is set, otherwise the board's display.timezone.
+func displayLocation(cfg *config.Config) *time.Location {
+	if flagUTC {
+		return time.UTC
+	}
+	return cfg.DisplayLocation()
+}
+
 // outputFormat returns the detected output format from flags/env.
 func outputFormat() output.Format {
 	return output.Detect(flagJSON, flagTable, flagCompact)
 }
 
+// warnf prints a warning to stderr and counts it for --fail-on warning.
+func warnf(format string, args ...any) {
+	warningCount++
+	if itemWarnings != nil {
+		*itemWarnings = append(*itemWarnings, strings.TrimSuffix(fmt.Sprintf(format, args...), "\n"))
+	}
+	if flagQuiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: "+format, args...)
+}
+
 // printWarnings writes task read warnings to stderr.
 func printWarnings(warnings []task.ReadWarning) {
 	for _, w := range warnings {
-		fmt.Fprintf(os.Stderr, "Warning: skipping malformed file %s: %v\n", w.File, w.Err)
+		warnf("skipping malformed file %s: %v\n", w.File, w.Err)
 	}
 }
 
 func printConsistencyRepairs(repairs []string) {
 	for _, repair := range repairs {
-		fmt.Fprintf(os.Stderr, "Warning: auto-repaired consistency issue: %s\n", repair)
+		warnf("auto-repaired consistency issue: %s\n", repair)
 	}
 }
 
+// readTask reads the task file at path and fills in its workstream.
+func readTask(cfg *config.Config, path string) (*task.Task, error) {
+	t, err := task.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	t.Workstream = cfg.WorkstreamOf(path)
+	return t, nil
+}
+
+// workstreamFlag returns the --workstream flag, checked against the board's
+// tasks_dirs.
+func workstreamFlag(cmd *cobra.Command, cfg *config.Config) (string, error) {
+	ws, _ := cmd.Flags().GetString("workstream")
+	if _, err := workstreamPath(cfg, ws); err != nil {
+		return "", err
+	}
+	return ws, nil
+}
+
+// workstreamPath returns the directory of workstream ws, or an error naming
+// the board's workstreams when there is no such workstream.
+func workstreamPath(cfg *config.Config, ws string) (string, error) {
+	dir, ok := cfg.WorkstreamPath(ws)
+	if !ok {
+		return "", clierr.Newf(clierr.InvalidInput, "unknown workstream %q", ws).
+			WithDetails(map[string]any{"workstreams": cfg.Workstreams()})
+	}
+	return dir, nil
+}
+
 // validateDepIDs checks that all dependency IDs exist and none are self-referencing.
-func validateDepIDs(tasksDir string, selfID int, ids []int) error {
-	return task.ValidateDependencyIDs(tasksDir, selfID, ids)
+func validateDepIDs(tasksDirs []string, selfID int, ids []int) error {
+	return task.ValidateDependencyIDsIn(tasksDirs, selfID, ids)
 }
 
 // checkWIPLimit verifies that adding a task to targetStatus would not exceed
 // the WIP limit. currentTaskStatus is the task's current status (empty for new tasks).
 func checkWIPLimit(cfg *config.Config, statusCounts map[string]int, targetStatus, currentTaskStatus string) error {
-	return board.CheckWIPLimit(cfg, statusCounts, targetStatus, currentTaskStatus)
+	err := board.CheckWIPLimit(cfg, statusCounts, targetStatus, currentTaskStatus)
+	verboseWIPCheck(cfg, statusCounts, targetStatus, currentTaskStatus, err)
+	return err
+}
+
+// applyOnUnblock runs the dependencies.on_unblock automation after a task
+// enters a terminal status and reports each affected dependent on stderr.
+func applyOnUnblock(cfg *config.Config, t *task.Task, oldStatus string) {
+	if t.Status == oldStatus || !cfg.IsTerminalStatus(t.Status) {
+		return
+	}
+	results, err := board.ApplyOnUnblock(cfg, t.ID, time.Now())
+	if err != nil {
+		warnf("dependency automation: %v\n", err)
+	}
+	for _, u := range results {
+		fmt.Fprintf(os.Stderr, "Unblocked task %s (%s): %s\n", output.FormatID(u.ID), u.Action, u.Detail)
+	}
 }
 
 // logActivity appends an entry to the activity log. Errors are silently
@@ -177,47 +463,148 @@ func logActivity(cfg *config.Config, action string, taskID int, detail string) {
 
 // checkClaim verifies that a mutating operation is allowed on a claimed task.
 func checkClaim(t *task.Task, claimant string, timeout time.Duration) error {
-	return task.CheckClaim(t, claimant, timeout)
+	claimedBy := t.ClaimedBy
+	err := task.CheckClaim(t, claimant, timeout)
+	verboseClaimCheck(t, claimedBy, claimant, timeout, err)
+	return err
 }
 
 // validateDeps validates parent and dependency references for a task.
 func validateDeps(cfg *config.Config, t *task.Task) error {
 	if t.Parent != nil {
-		if err := validateDepIDs(cfg.TasksPath(), t.ID, []int{*t.Parent}); err != nil {
+		if err := validateDepIDs(cfg.TasksPaths(), t.ID, []int{*t.Parent}); err != nil {
 			return fmt.Errorf("invalid parent: %w", err)
 		}
+		if err := validateParentChain(cfg, t.ID, *t.Parent); err != nil {
+			return err
+		}
 	}
 	if len(t.DependsOn) > 0 {
-		if err := validateDepIDs(cfg.TasksPath(), t.ID, t.DependsOn); err != nil {
+		if err := validateDepIDs(cfg.TasksPaths(), t.ID, t.DependsOn); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// validateParentChain rejects a parent assignment that would make the task
+// its own ancestor.
+func validateParentChain(cfg *config.Config, id, parent int) error {
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return fmt.Errorf("reading tasks for parent check: %w", err)
+	}
+	if chain := board.ParentCycle(tasks, id, parent); chain != nil {
+		return task.ValidateParentCycle(id, parent, chain)
+	}
+	return nil
+}
+
+// idSyntaxRe matches a task ID, optionally carrying an ID prefix ("API-12").
+// Task UIDs are accepted separately.
+var idSyntaxRe = regexp.MustCompile(`^[A-Za-z0-9_-]*[0-9]$`)
+
+// checkIDSyntax rejects malformed comma-separated task IDs before the board
+// is loaded. Prefixes are checked against the board by parseIDs.
+func checkIDSyntax(arg string) error {
+	for _, p := range strings.Split(arg, ",") {
+		if p = strings.TrimSpace(p); p != "" && !idSyntaxRe.MatchString(p) && !task.IsUID(p) {
+			return task.ValidateTaskID(p)
+		}
+	}
+	return nil
+}
+
 // parseIDs splits a comma-separated ID string into deduplicated int IDs.
+// IDs may carry the board's ID prefix or be task UIDs, so call it after
+// loading the config.
 func parseIDs(arg string) ([]int, error) {
-	return board.ParseIDs(arg)
+	return board.ParseIDsFunc(arg, parseID)
+}
+
+// parseID parses a single task ID argument: a number, a number with the
+// board's ID prefix, or a task UID.
+func parseID(arg string) (int, error) {
+	if boardConfig == nil {
+		return task.ParseID(arg, "")
+	}
+	if task.IsUID(arg) {
+		return task.FindByUIDIn(boardConfig.TasksPaths(), arg)
+	}
+	return task.ParseID(arg, boardConfig.Board.IDPrefix)
+}
+
+// idFlag parses a task ID flag, accepting the board's ID prefix.
+func idFlag(cmd *cobra.Command, name string) (int, error) {
+	return parseID(cmd.Flags().Lookup(name).Value.String())
+}
+
+// idListFlag parses a comma-separated task ID list flag, accepting the
+// board's ID prefix.
+func idListFlag(cmd *cobra.Command, name string) ([]int, error) {
+	var raw []string
+	if v, ok := cmd.Flags().Lookup(name).Value.(pflag.SliceValue); ok {
+		raw = v.GetSlice()
+	}
+	ids := make([]int, 0, len(raw))
+	for _, s := range raw {
+		id, err := parseID(s)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
 // runBatch executes fn for each ID and collects results. Returns a SilentError
 // with exit code 1 if any operation failed (after outputting results).
 func runBatch(ids []int, fn func(int) error) error {
 	results := make([]output.BatchResult, 0, len(ids))
-	anyFailed := false
-
 	for _, id := range ids {
-		err := fn(id)
-		if err != nil {
+		results = append(results, runBatchItem(id, func() error { return fn(id) }))
+	}
+	return reportBatch(results)
+}
+
+// runBatchItem runs one batch operation and records its outcome, with the
+// warnings it printed.
+func runBatchItem(id int, fn func() error) output.BatchResult {
+	warnings, err := collectWarnings(fn)
+	r := newBatchResult(id, err)
+	r.Warnings = warnings
+	return r
+}
+
+// collectWarnings runs fn and returns the warnings it printed alongside
+// its error. The warnings are still printed and counted.
+func collectWarnings(fn func() error) ([]string, error) {
+	var warnings []string
+	itemWarnings = &warnings
+	defer func() { itemWarnings = nil }()
+	err := fn()
+	return warnings, err
+}
+
+// newBatchResult records the outcome of one batch operation.
+func newBatchResult(id int, err error) output.BatchResult {
+	if err == nil {
+		return output.BatchResult{ID: id, OK: true}
+	}
+	var cliErr *clierr.Error
+	if errors.As(err, &cliErr) {
+		return output.BatchResult{ID: id, OK: false, Error: cliErr.Message, Code: cliErr.Code, Retryable: cliErr.Retryable()}
+	}
+	return output.BatchResult{ID: id, OK: false, Error: err.Error()}
+}
+
+// reportBatch outputs batch results. Deferred operations are reported but
+// do not count as failures.
+func reportBatch(results []output.BatchResult) error {
+	anyFailed := false
+	for _, r := range results {
+		if !r.OK && !r.Deferred {
 			anyFailed = true
-			var cliErr *clierr.Error
-			if errors.As(err, &cliErr) {
-				results = append(results, output.BatchResult{ID: id, OK: false, Error: cliErr.Message, Code: cliErr.Code})
-			} else {
-				results = append(results, output.BatchResult{ID: id, OK: false, Error: err.Error()})
-			}
-		} else {
-			results = append(results, output.BatchResult{ID: id, OK: true})
 		}
 	}
 
@@ -226,15 +613,23 @@ func runBatch(ids []int, fn func(int) error) error {
 			return err
 		}
 	} else {
-		var succeeded int
+		var succeeded, deferred int
 		for _, r := range results {
-			if r.OK {
+			switch {
+			case r.OK:
 				succeeded++
-			} else {
-				fmt.Fprintf(os.Stderr, "Error: task #%d: %s\n", r.ID, r.Error)
+			case r.Deferred:
+				deferred++
+				fmt.Fprintf(os.Stderr, "Deferred task %s: %s\n", output.FormatID(r.ID), r.Error)
+			default:
+				fmt.Fprintf(os.Stderr, "Error: task %s: %s\n", output.FormatID(r.ID), r.Error)
 			}
 		}
-		output.Messagef(os.Stdout, "Completed %d/%d operations", succeeded, len(ids))
+		if deferred > 0 {
+			output.Messagef(os.Stdout, "Completed %d/%d operations, deferred %d", succeeded, len(results), deferred)
+		} else {
+			output.Messagef(os.Stdout, "Completed %d/%d operations", succeeded, len(results))
+		}
 	}
 
 	if anyFailed {
@@ -242,3 +637,27 @@ func runBatch(ids []int, fn func(int) error) error {
 	}
 	return nil
 }
+
+// pageFlags reads --limit, --offset, and --cursor. ok reports whether
+// --offset or --cursor was given, which asks for paged output.
+func pageFlags(cmd *cobra.Command) (page board.PageOptions, ok bool) {
+	page.Limit, _ = cmd.Flags().GetInt("limit")
+	page.Offset, _ = cmd.Flags().GetInt("offset")
+	page.Cursor, _ = cmd.Flags().GetString("cursor")
+	return page, cmd.Flags().Changed("offset") || cmd.Flags().Changed("cursor")
+}
+
+// validatePage rejects negative page sizes and offsets.
+func validatePage(page board.PageOptions) error {
+	if page.Limit < 0 || page.Offset < 0 {
+		return clierr.New(clierr.InvalidInput, "--limit and --offset must not be negative")
+	}
+	return nil
+}
+
+// printNextCursor tells table and compact readers how to get the next page.
+func printNextCursor(cursor string) {
+	if cursor != "" && !flagQuiet {
+		fmt.Fprintf(os.Stderr, "More results: --cursor %s\n", cursor)
+	}
+}
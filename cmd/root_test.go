@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -344,7 +345,7 @@ func TestValidateDepIDs_ValidDeps(t *testing.T) {
 	// Create a task file that can be found.
 	createTaskFile(t, tasksDir, 2, "dependency-task")
 
-	err := validateDepIDs(tasksDir, 1, []int{2})
+	err := validateDepIDs([]string{tasksDir}, 1, []int{2})
 	if err != nil {
 		t.Errorf("expected nil for valid dependency, got %v", err)
 	}
@@ -353,7 +354,7 @@ func TestValidateDepIDs_ValidDeps(t *testing.T) {
 func TestValidateDepIDs_SelfReference(t *testing.T) {
 	dir := t.TempDir()
 
-	err := validateDepIDs(dir, 1, []int{1})
+	err := validateDepIDs([]string{dir}, 1, []int{1})
 	if err == nil {
 		t.Fatal("expected error for self-reference")
 	}
@@ -369,7 +370,7 @@ func TestValidateDepIDs_SelfReference(t *testing.T) {
 func TestValidateDepIDs_NotFound(t *testing.T) {
 	dir := t.TempDir()
 
-	err := validateDepIDs(dir, 1, []int{99})
+	err := validateDepIDs([]string{dir}, 1, []int{99})
 	if err == nil {
 		t.Fatal("expected error for missing dependency")
 	}
@@ -764,6 +765,35 @@ func TestRunBatch_JSONOutputWithCliError(t *testing.T) {
 	}
 }
 
+func TestRunBatch_JSONOutputWithWarnings(t *testing.T) {
+	setFlags(t, true, false, false)
+	r, w := captureStdout(t)
+	rErr, wErr := captureStderr(t)
+
+	_ = runBatch([]int{1, 2}, func(id int) error {
+		if id == 1 {
+			warnf("task #1 is blocked\n")
+		}
+		return nil
+	})
+
+	got := drainPipe(t, r, w)
+	stderr := drainPipe(t, rErr, wErr)
+
+	if !containsSubstring(got, `"warnings": [`+"\n"+`      "task #1 is blocked"`) {
+		t.Errorf("expected warning in task #1 result, got: %s", got)
+	}
+	if strings.Count(got, `"warnings"`) != 1 {
+		t.Errorf("expected warnings on task #1 only, got: %s", got)
+	}
+	if !containsSubstring(stderr, "Warning: task #1 is blocked") {
+		t.Errorf("expected warning still printed to stderr, got: %s", stderr)
+	}
+	if itemWarnings != nil {
+		t.Error("itemWarnings should be reset after the batch")
+	}
+}
+
 // --- helpers ---
 
 // createTaskFile creates a minimal task markdown file in the given directory.
@@ -781,3 +811,23 @@ func createTaskFile(t *testing.T, tasksDir string, id int, title string) {
 func idStr(id int) string {
 	return strconv.Itoa(id)
 }
+
+func TestCheckWritable(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	if err := checkWritable(cfg); err != nil {
+		t.Errorf("writable board: unexpected error %v", err)
+	}
+
+	cfg.Board.ReadOnly = true
+	var cliErr *clierr.Error
+	if err := checkWritable(cfg); !errors.As(err, &cliErr) || cliErr.Code != clierr.BoardReadOnly {
+		t.Errorf("read-only board: err = %v, want BOARD_READONLY", err)
+	}
+
+	cfg.Board.ReadOnly = false
+	flagReadOnly = true
+	t.Cleanup(func() { flagReadOnly = false })
+	if err := checkWritable(cfg); !errors.As(err, &cliErr) || cliErr.Code != clierr.BoardReadOnly {
+		t.Errorf("--readonly: err = %v, want BOARD_READONLY", err)
+	}
+}
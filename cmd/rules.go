@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/rules"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Run the board's automation rules",
+	Long: `Automation rules live in rules.yml in the kanban directory. Each rule has a
+trigger (when), optional conditions (if), and actions (then):
+
+  rules:
+    - name: escalate-blocked
+      when: {blocked: true, for: 7d}
+      then: [escalate_priority, tag needs-attention]
+    - name: release-milestone
+      when: {enters: done}
+      if: {tags: [release]}
+      then: [tag milestone-2.0]
+
+Triggers: enters <status>, blocked, overdue, idle (each with an optional
+"for" duration; idle requires one). Conditions: tags, not_tags, status,
+priority, class, assignee. Actions: tag X, untag X, move_to S, priority P,
+escalate_priority, assign NAME, notify. move_to makes the checks move does
+(WIP limits, required estimates and reviewers, open children), and notify
+records the firing in the activity log, which log sinks mirror.
+
+A rule fires once per occurrence of its trigger on a task: a task blocked,
+unblocked, and blocked again fires twice. Run "rules run" from cron to
+apply rules on a schedule.`,
+}
+
+var rulesRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Apply rules whose triggers have fired",
+	Args:  cobra.NoArgs,
+	RunE:  runRulesRun,
+}
+
+var rulesCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate rules.yml and list its rules",
+	Args:  cobra.NoArgs,
+	RunE:  runRulesCheck,
+}
+
+func init() {
+	rulesRunCmd.Flags().Bool("dry-run", false, "show what would fire without changing anything")
+	rulesCmd.AddCommand(rulesRunCmd, rulesCheckCmd)
+	rootCmd.AddCommand(rulesCmd)
+}
+
+// rulesFired is one firing in the output of rules run.
+type rulesFired struct {
+	Rule    string   `json:"rule"`
+	ID      int      `json:"id"`
+	Title   string   `json:"title"`
+	Actions []string `json:"actions"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// rulesRunResult is the JSON output of rules run.
+type rulesRunResult struct {
+	Fired  []rulesFired `json:"fired"`
+	DryRun bool         `json:"dry_run,omitempty"`
+}
+
+func runRulesRun(cmd *cobra.Command, _ []string) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return err
+	}
+	file, err := loadRules(cfg)
+	if err != nil {
+		return err
+	}
+	state, err := rules.LoadState(cfg.Dir())
+	if err != nil {
+		return err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+	log, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{})
+	if err != nil {
+		return err
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	res := fireRules(cfg, rules.Evaluate(cfg, file, tasks, log, state, time.Now()), tasks, state, dryRun)
+	if !dryRun && len(res.Fired) > 0 {
+		if err := state.Save(cfg.Dir()); err != nil {
+			return err
+		}
+	}
+	return printRulesRunResult(res)
+}
+
+// fireRules applies each firing to its task and records it in state,
+// unless dryRun. A firing whose actions fail is reported and left
+// unrecorded, so the next run retries it.
+func fireRules(cfg *config.Config, firings []rules.Firing, tasks []*task.Task, state rules.State, dryRun bool) rulesRunResult {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	counts := board.CountByStatus(tasks)
+	now := time.Now()
+	res := rulesRunResult{Fired: []rulesFired{}, DryRun: dryRun}
+	for _, f := range firings {
+		fired := rulesFired{Rule: f.Rule, ID: f.ID, Title: f.Title, Actions: f.Actions}
+		verbosef(verboseDecisions, "rules: %s fired on #%d (since %s)", f.Rule, f.ID, f.Since.Format(time.RFC3339))
+		if !dryRun {
+			// An earlier firing's move can have changed this task on disk
+			// through dependencies.on_unblock.
+			if fresh, err := readTask(cfg, byID[f.ID].File); err == nil {
+				byID[f.ID] = fresh
+			}
+			if err := applyFiring(cfg, byID[f.ID], f, counts, now); err != nil {
+				fired.Error = err.Error()
+			} else {
+				state.Record(f)
+			}
+		}
+		res.Fired = append(res.Fired, fired)
+	}
+	return res
+}
+
+// loadRules reads the board's rules file.
+func loadRules(cfg *config.Config) (*rules.File, error) {
+	path := rules.Path(cfg.Dir())
+	file, err := rules.Load(path, cfg)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, clierr.Newf(clierr.InvalidInput, "no rules file; create %s", path)
+	case errors.Is(err, rules.ErrInvalid):
+		return nil, clierr.New(clierr.InvalidInput, err.Error())
+	case err != nil:
+		return nil, fmt.Errorf("reading rules: %w", err)
+	}
+	return file, nil
+}
+
+// applyFiring applies the firing's actions to t, writes it if any changed
+// it, and logs each change. move_to goes through the same checks as move,
+// and notify is recorded in the activity log, which log sinks mirror. An
+// action that fails leaves the task unwritten.
+func applyFiring(cfg *config.Config, t *task.Task, f rules.Firing, counts map[string]int, now time.Time) error {
+	oldStatus := t.Status
+	var logged []string
+	dirty := false
+	for _, a := range f.Actions {
+		verb, arg := rules.SplitAction(a)
+		if verb == rules.ActionMoveTo {
+			if err := checkRuleMove(cfg, t, arg); err != nil {
+				return fmt.Errorf("%s: %w", a, err)
+			}
+		}
+		ok, err := rules.Apply(cfg, t, a, counts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", a, err)
+		}
+		if ok {
+			dirty = true
+		}
+		if ok || verb == rules.ActionNotify {
+			logged = append(logged, a)
+		}
+	}
+	if dirty {
+		t.Updated = now
+		if err := task.Write(t.File, t); err != nil {
+			return fmt.Errorf("writing task: %w", err)
+		}
+	}
+	for _, a := range logged {
+		logActivity(cfg, "rule", t.ID, f.Rule+": "+a)
+	}
+	applyOnUnblock(cfg, t, oldStatus)
+	return nil
+}
+
+// checkRuleMove runs the checks move makes before a rule moves t to
+// status: require_estimate_for, require_reviewer, and open children. Rules
+// cannot force past them.
+func checkRuleMove(cfg *config.Config, t *task.Task, status string) error {
+	if t.Status == status {
+		return nil
+	}
+	if err := checkEstimate(cfg, t, status, false); err != nil {
+		return err
+	}
+	if err := checkReviewer(cfg, t, status); err != nil {
+		return err
+	}
+	return checkChildrenComplete(cfg, t, status, false)
+}
+
+func printRulesRunResult(res rulesRunResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	for _, f := range res.Fired {
+		line := fmt.Sprintf("%s %s: %s -> %s", output.FormatID(f.ID), f.Title, f.Rule, strings.Join(f.Actions, ", "))
+		if f.Error != "" {
+			line += " (" + f.Error + ")"
+		}
+		fmt.Fprintln(os.Stdout, line)
+	}
+	verb := "Fired"
+	if res.DryRun {
+		verb = "Would fire"
+	}
+	output.Messagef(os.Stdout, "%s %d rule(s)", verb, len(res.Fired))
+	return nil
+}
+
+func runRulesCheck(_ *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	file, err := loadRules(cfg)
+	if err != nil {
+		return err
+	}
+	if outputFormat() == output.FormatJSON {
+		if file.Rules == nil {
+			file.Rules = []rules.Rule{}
+		}
+		return output.JSON(os.Stdout, file.Rules)
+	}
+	for _, r := range file.Rules {
+		fmt.Fprintf(os.Stdout, "%s: when %s -> %s\n", r.Name, describeTrigger(r.When), strings.Join(r.Then, ", "))
+	}
+	output.Messagef(os.Stdout, "%d rule(s) OK", len(file.Rules))
+	return nil
+}
+
+func describeTrigger(tr rules.Trigger) string {
+	var s string
+	switch {
+	case tr.Enters != "":
+		s = "enters " + tr.Enters
+	case tr.Blocked:
+		s = "blocked"
+	case tr.Overdue:
+		s = "overdue"
+	case tr.Idle:
+		s = "idle"
+	}
+	if tr.For != "" {
+		s += " for " + tr.For
+	}
+	return s
+}
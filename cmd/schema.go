@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/jsonschema"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// outputSchemaVersion is bumped when a JSON output changes in a way that
+// breaks consumers: a field removed, renamed, or retyped. New fields do not
+// count. It is part of every schema's $id.
+const outputSchemaVersion = 1
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema [task|error|board|metrics]",
+	Short: "Print JSON Schemas of the JSON output",
+	Long: `Prints the JSON Schema (draft 2020-12) of a JSON output, derived from the
+types the CLI encodes, so integrations can validate output or generate
+code from it. Each $id carries the output schema version, which changes
+only when an output changes incompatibly.
+
+Without an argument, lists the schemas; with --json, prints all of them
+keyed by name.`,
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"task", "error", "board", "metrics"},
+	RunE:      runSchema,
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
+
+// outputSchema describes one JSON output and the value it encodes. Notes
+// explain fields the types cannot, for the skill reference.
+type outputSchema struct {
+	name        string
+	description string
+	value       any
+	notes       string
+}
+
+func outputSchemas() []outputSchema {
+	return []outputSchema{
+		{"task", "A task as printed by show --json. create, edit, move, and pick print the same object.",
+			taskDetailResult{}, taskSchemaNotes},
+		{"error", "The error printed to stdout when a command fails with --json.", output.ErrorResponse{}, ""},
+		{"board", "The board overview printed by board --json.", board.Overview{}, ""},
+		{"metrics", "The flow metrics printed by metrics --json.", board.Metrics{}, ""},
+	}
+}
+
+const taskSchemaNotes = "`private` is true when the body is encrypted at rest. `show`, `list`, and\n" +
+	"`pick` return the decrypted body when a key is available, or `[encrypted]`.\n\n" +
+	"`blocked_by_dependency` is computed by `list` and `show` (never stored): it is\n" +
+	"true when some task in `depends_on` has not reached a terminal status. It is\n" +
+	"independent of the manual `blocked` flag.\n\n" +
+	"`ready` is computed by `list` and `show` too: it is true when `pick` could\n" +
+	"take the task now (`list --ready` shows those tasks)."
+
+// schemaDocument is the full JSON Schema document of s.
+func schemaDocument(s outputSchema) jsonschema.Schema {
+	doc := jsonschema.For(s.value)
+	doc["$schema"] = jsonschema.Draft
+	doc["$id"] = fmt.Sprintf("https://github.com/antopolskiy/kanban-md/schemas/v%d/%s.json", outputSchemaVersion, s.name)
+	doc["title"] = "kanban-md " + s.name
+	doc["description"] = s.description
+	doc["x-kanban-md-version"] = rootCmd.Version
+	if s.name == "error" {
+		props, _ := doc["properties"].(jsonschema.Schema)
+		props["code"] = jsonschema.Schema{"type": "string", "enum": clierr.Codes}
+	}
+	return doc
+}
+
+func runSchema(_ *cobra.Command, args []string) error {
+	schemas := outputSchemas()
+	if len(args) == 1 {
+		for _, s := range schemas {
+			if s.name == args[0] {
+				return output.JSON(os.Stdout, schemaDocument(s))
+			}
+		}
+		names := make([]string, len(schemas))
+		for i, s := range schemas {
+			names[i] = s.name
+		}
+		return clierr.Newf(clierr.InvalidInput, "unknown schema %q", args[0]).
+			WithDetails(map[string]any{"schemas": names})
+	}
+
+	if outputFormat() == output.FormatJSON {
+		all := make(map[string]jsonschema.Schema, len(schemas))
+		for _, s := range schemas {
+			all[s.name] = schemaDocument(s)
+		}
+		return output.JSON(os.Stdout, all)
+	}
+	for _, s := range schemas {
+		fmt.Fprintf(os.Stdout, "%-8s %s\n", s.name, s.description)
+	}
+	return nil
+}
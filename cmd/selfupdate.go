@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/selfupdate"
+)
+
+// selfUpdateTimeout bounds each request made while updating.
+const selfUpdateTimeout = 2 * time.Minute
+
+// releasesAPIEnv overrides the GitHub API base URL releases are read from,
+// for mirrors and tests.
+const releasesAPIEnv = "KANBAN_RELEASES_API"
+
+var selfUpdateCmd = &cobra.Command{
+	Use:   "self-update",
+	Short: "Update kanban-md to the latest release",
+	Long: `Checks the GitHub releases of kanban-md and, when a newer one exists on the
+channel, downloads the archive for this platform, verifies it against the
+release's SHA-256 checksums file, and replaces the running binary.
+
+--channel stable (the default) only considers full releases; prerelease
+also considers release candidates and betas. --check only reports whether
+an update is available. Development builds and Homebrew installs are left
+alone unless --force is given; use brew upgrade for the latter.
+
+GITHUB_TOKEN, when set, authenticates the release lookup to avoid API rate
+limits.`,
+	Args: cobra.NoArgs,
+	RunE: runSelfUpdate,
+}
+
+func init() {
+	selfUpdateCmd.Flags().String("channel", selfupdate.ChannelStable, "release channel: stable or prerelease")
+	selfUpdateCmd.Flags().Bool("check", false, "only report whether an update is available")
+	selfUpdateCmd.Flags().Bool("force", false, "install even if not newer, on development builds, or over Homebrew")
+	rootCmd.AddCommand(selfUpdateCmd)
+}
+
+// selfUpdateResult is the JSON output of self-update.
+type selfUpdateResult struct {
+	Current         string `json:"current"`
+	Latest          string `json:"latest"`
+	Channel         string `json:"channel"`
+	UpdateAvailable bool   `json:"update_available"`
+	Updated         bool   `json:"updated"`
+	Path            string `json:"path,omitempty"`
+}
+
+func runSelfUpdate(cmd *cobra.Command, _ []string) error {
+	channel, _ := cmd.Flags().GetString("channel")
+	if !slices.Contains(selfupdate.Channels, channel) {
+		return clierr.Newf(clierr.InvalidInput, "invalid --channel %q (want %s)",
+			channel, strings.Join(selfupdate.Channels, " or "))
+	}
+	check, _ := cmd.Flags().GetBool("check")
+	force, _ := cmd.Flags().GetBool("force")
+
+	client := newSelfUpdateClient()
+	rel, err := client.Latest(cmd.Context(), channel)
+	if err != nil {
+		return err
+	}
+	res := selfUpdateResult{
+		Current:         version,
+		Latest:          rel.Version(),
+		Channel:         channel,
+		UpdateAvailable: selfupdate.Compare(rel.Version(), version) > 0,
+	}
+	verbosef(verboseDecisions, "self-update: %s channel latest is %s, running %s", channel, rel.Tag, version)
+	if check || (!res.UpdateAvailable && !force) {
+		return printSelfUpdateResult(res)
+	}
+	if !selfupdate.IsRelease(version) && !force {
+		return clierr.Newf(clierr.InvalidInput,
+			"this is a development build (version %s); pass --force to replace it with %s", version, rel.Tag)
+	}
+
+	exe, err := selfUpdateTarget(force)
+	if err != nil {
+		return err
+	}
+	if err := installRelease(cmd, client, rel, exe); err != nil {
+		return err
+	}
+	res.Updated = true
+	res.Path = exe
+	return printSelfUpdateResult(res)
+}
+
+// newSelfUpdateClient returns a client for the GitHub API, or the one named
+// by KANBAN_RELEASES_API. GITHUB_TOKEN is only sent to GitHub itself.
+func newSelfUpdateClient() *selfupdate.Client {
+	c := &selfupdate.Client{
+		HTTP: &http.Client{Timeout: selfUpdateTimeout},
+		API:  selfupdate.DefaultAPI,
+		Repo: selfupdate.Repo,
+	}
+	if api := os.Getenv(releasesAPIEnv); api != "" {
+		c.API = api
+	} else {
+		c.Token = os.Getenv("GITHUB_TOKEN")
+	}
+	return c
+}
+
+// selfUpdateTarget returns the path of the running binary, refusing a
+// Homebrew-managed one unless forced.
+func selfUpdateTarget(force bool) (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("locating the running binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	if strings.Contains(filepath.ToSlash(exe), "/Cellar/") && !force {
+		return "", clierr.Newf(clierr.InvalidInput,
+			"%s is managed by Homebrew; run brew upgrade kanban-md (or pass --force)", exe)
+	}
+	return exe, nil
+}
+
+// installRelease downloads this platform's archive from rel, verifies its
+// checksum, and installs the binary inside it as exe.
+func installRelease(cmd *cobra.Command, client *selfupdate.Client, rel *selfupdate.Release, exe string) error {
+	name := selfupdate.ArchiveName(rel.Version(), runtime.GOOS, runtime.GOARCH)
+	asset, ok := rel.Asset(name)
+	if !ok {
+		return clierr.Newf(clierr.InvalidInput, "release %s has no build for %s/%s", rel.Tag, runtime.GOOS, runtime.GOARCH).
+			WithDetails(map[string]any{"asset": name})
+	}
+	sums, ok := rel.ChecksumsAsset()
+	if !ok {
+		return fmt.Errorf("release %s publishes no checksums file; refusing to install it", rel.Tag)
+	}
+	archive, err := client.Download(cmd.Context(), asset)
+	if err != nil {
+		return err
+	}
+	checksums, err := client.Download(cmd.Context(), sums)
+	if err != nil {
+		return err
+	}
+	if err := selfupdate.VerifyChecksum(archive, checksums, name); err != nil {
+		return err
+	}
+	verbosef(verboseDecisions, "self-update: %s matches %s", name, sums.Name)
+	bin, err := selfupdate.ExtractBinary(archive, runtime.GOOS == "windows", selfupdate.BinaryName(runtime.GOOS))
+	if err != nil {
+		return err
+	}
+	return selfupdate.Replace(exe, bin, runtime.GOOS)
+}
+
+func printSelfUpdateResult(res selfUpdateResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	switch {
+	case res.Updated:
+		output.Messagef(os.Stdout, "Updated kanban-md %s -> %s (%s)", res.Current, res.Latest, res.Path)
+	case res.UpdateAvailable:
+		output.Messagef(os.Stdout, "kanban-md %s is available (running %s); run kanban-md self-update", res.Latest, res.Current)
+	default:
+		output.Messagef(os.Stdout, "kanban-md %s is up to date (latest %s release: %s)", res.Current, res.Channel, res.Latest)
+	}
+	return nil
+}
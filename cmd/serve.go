@@ -0,0 +1,501 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/serve"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/watcher"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the board over a local HTTP API",
+	Long: `Serves the board over an HTTP API for editor plugins and dashboards, so they
+need not run the CLI for every operation. Bodies are JSON in the same
+shape as --json output, with redact rules applied and private task bodies
+left as [encrypted].
+
+  GET    /api/v1/tasks             list (query parameters are list flags)
+  POST   /api/v1/tasks             create (body: {"title": ..., create flags})
+  GET    /api/v1/tasks/{id}        show
+  PATCH  /api/v1/tasks/{id}        edit (body: edit flags)
+  POST   /api/v1/tasks/{id}/move   move (body: {"status": ..., move flags})
+  DELETE /api/v1/tasks/{id}        delete
+  GET    /api/v1/board             board summary
+  GET    /api/v1/metrics           metrics (query: since, business-days)
+  POST   /inbox/{source}           spool an item for a webhook inbox source
+  GET    /healthz, /readyz         liveness and readiness
+
+API responses carry X-Kanban-Revision, which moves whenever the board's
+files change, so clients can refetch only then. /readyz fails when the
+file watcher behind it has stopped.
+
+Requests need a bearer token from serve.tokens when any are configured;
+GET requests need read scope, deleting and archiving tasks admin scope,
+and the others write scope. Errors use the --json error format. POST and
+PATCH bodies must be sent as application/json, and requests from other
+sites are refused: an Origin header must match the host, and on a loopback
+address the Host header must name one.
+
+With --ui, / serves a web board built into the binary: drag cards between
+columns, add, edit, and delete tasks. It works through the API above, so
+the task files stay the source of truth.`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().String("addr", "127.0.0.1:8080", "address to listen on")
+	serveCmd.Flags().Bool("ui", false, "also serve the web board at /")
+	rootCmd.AddCommand(serveCmd)
+}
+
+// Server timeouts.
+const (
+	serveHeaderTimeout   = 10 * time.Second
+	serveShutdownTimeout = 5 * time.Second
+)
+
+func runServe(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	auth, err := serve.NewAuthorizer(cfg)
+	if err != nil {
+		return clierr.New(clierr.InvalidInput, err.Error())
+	}
+
+	addr, _ := cmd.Flags().GetString("addr")
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", addr, err)
+	}
+	if !auth.Enabled() && !isLoopback(ln.Addr()) {
+		warnf("no serve tokens configured; anyone who can reach %s can change the board\n", ln.Addr())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	api := &serveAPI{}
+	watching := func() bool { return false }
+	if w, err := watcher.New(append(cfg.ExistingTasksPaths(), cfg.Dir()), api.changed); err != nil {
+		warnf("starting file watcher: %v\n", err)
+	} else {
+		defer w.Close()
+		go w.Run(ctx, func(watchErr error) {
+			warnf("file watcher: %v\n", watchErr)
+		})
+		watching = w.Alive
+	}
+
+	ui, _ := cmd.Flags().GetBool("ui")
+	handler := serve.SameOrigin(isLoopback(ln.Addr()), newServeHandler(cfg, auth, api, watching, ui))
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: serveHeaderTimeout}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Serving %s on http://%s (Ctrl-C to stop)\n", cfg.Board.Name, ln.Addr())
+	if ui {
+		fmt.Fprintf(os.Stderr, "Web board: http://%s/\n", ln.Addr())
+	}
+	if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// isLoopback reports whether addr only accepts local connections.
+func isLoopback(addr net.Addr) bool {
+	tcp, ok := addr.(*net.TCPAddr)
+	return ok && tcp.IP.IsLoopback()
+}
+
+// newServeHandler routes the HTTP API, and the web board when ui is set.
+// Inbox routes are mounted for the webhook sources configured when the
+// server starts. watching reports whether the board's file watcher is
+// running, for readiness.
+func newServeHandler(cfg *config.Config, auth *serve.Authorizer, api *serveAPI, watching func() bool, ui bool) http.Handler {
+	read := func(h apiFunc) http.Handler { return auth.Require(config.ScopeRead, api.handle(h)) }
+	write := func(h apiFunc) http.Handler {
+		return auth.Require(config.ScopeWrite, serve.RequireJSON(api.handle(h)))
+	}
+	admin := func(h apiFunc) http.Handler {
+		return auth.Require(config.ScopeAdmin, serve.RequireJSON(api.handle(h)))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", serve.Healthz())
+	mux.Handle("GET /readyz", serve.Readyz(cfg.Dir(), watching))
+	mux.Handle("GET /api/v1/tasks", read(api.listTasks))
+	mux.Handle("POST /api/v1/tasks", write(api.createTask))
+	mux.Handle("GET /api/v1/tasks/{id}", read(api.showTask))
+	mux.Handle("PATCH /api/v1/tasks/{id}", write(api.editTask))
+	mux.Handle("POST /api/v1/tasks/{id}/move", write(api.moveTask))
+	mux.Handle("DELETE /api/v1/tasks/{id}", admin(api.deleteTask))
+	mux.Handle("GET /api/v1/board", read(api.boardSummary))
+	mux.Handle("GET /api/v1/metrics", read(api.metrics))
+	for _, src := range cfg.Inbox.Sources {
+		if src.Type == config.InboxWebhook {
+			mux.Handle("POST /inbox/"+src.Name, auth.Require(config.ScopeWrite, serve.InboxWebhook(cfg.InboxSourcePath(src))))
+		}
+	}
+	if ui {
+		mux.Handle("GET /", serve.UI())
+	}
+	return mux
+}
+
+// apiFunc handles one API request, returning the response status and body.
+type apiFunc func(r *http.Request) (int, any, error)
+
+// serveAPI runs API requests one at a time: they share the command flags
+// and board state of the process, as batch operations do.
+type serveAPI struct {
+	mu sync.Mutex
+	// revision counts the changes the file watcher has seen.
+	revision atomic.Uint64
+}
+
+// changed records a change to the board's files.
+func (a *serveAPI) changed() {
+	a.revision.Add(1)
+}
+
+// handle runs h and writes its result, its warnings, or its error in the
+// --json error format.
+func (a *serveAPI) handle(h apiFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		var status int
+		var body any
+		warnings, err := collectWarnings(func() error {
+			var err error
+			status, body, err = h(r)
+			return err
+		})
+		for _, warning := range redactWarnings(warnings) {
+			w.Header().Add(serve.WarningHeader, warning)
+		}
+		w.Header().Set(serve.RevisionHeader, strconv.FormatUint(a.revision.Load(), 10))
+		if err != nil {
+			var cliErr *clierr.Error
+			if !errors.As(err, &cliErr) {
+				cliErr = clierr.New(clierr.InternalError, err.Error())
+			}
+			if cliErr.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(cliErr.RetryAfter.Seconds()))))
+			}
+			serve.WriteJSON(w, serve.StatusFor(cliErr), errorResponse(cliErr))
+			return
+		}
+		serve.WriteJSON(w, status, body)
+	})
+}
+
+func (a *serveAPI) listTasks(r *http.Request) (int, any, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := setBatchFlags(listCmd, queryFlags(r)); err != nil {
+		return 0, nil, err
+	}
+	opts, groupBy, err := listOptions(listCmd, cfg)
+	if err != nil {
+		return 0, nil, err
+	}
+	if groupBy != "" {
+		return 0, nil, clierr.New(clierr.InvalidInput, "group-by is not supported by the API")
+	}
+	if page, ok := pageFlags(listCmd); ok {
+		if err := validatePage(page); err != nil {
+			return 0, nil, err
+		}
+		p, warnings, err := board.ListPage(cfg, opts, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		printWarnings(warnings)
+		if p.Tasks, err = shareTasks(cfg, p.Tasks); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, p, nil
+	}
+
+	tasks, warnings, err := board.List(cfg, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	printWarnings(warnings)
+	shared, err := shareTasks(cfg, tasks)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, shared, nil
+}
+
+func (a *serveAPI) showTask(r *http.Request) (int, any, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	id, err := pathID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	t, progress, err := taskDetail(cfg, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	shared, err := shareTasks(cfg, []*task.Task{t})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, taskDetailResult{Task: shared[0], Progress: progress}, nil
+}
+
+func (a *serveAPI) createTask(r *http.Request) (int, any, error) {
+	flags, err := bodyFlags(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	title, _ := flags["title"].(string)
+	delete(flags, "title")
+	t, err := a.mutate(r, batchOp{Op: "create", Args: []string{title}, Flags: flags})
+	return http.StatusCreated, t, err
+}
+
+func (a *serveAPI) editTask(r *http.Request) (int, any, error) {
+	flags, err := bodyFlags(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	t, err := a.mutate(r, batchOp{Op: "edit", Args: []string{r.PathValue("id")}, Flags: flags})
+	return http.StatusOK, t, err
+}
+
+func (a *serveAPI) moveTask(r *http.Request) (int, any, error) {
+	flags, err := bodyFlags(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	args := []string{r.PathValue("id")}
+	if status, _ := flags["status"].(string); status != "" {
+		args = append(args, status)
+	}
+	delete(flags, "status")
+	t, err := a.mutate(r, batchOp{Op: "move", Args: args, Flags: flags})
+	return http.StatusOK, t, err
+}
+
+func (a *serveAPI) deleteTask(r *http.Request) (int, any, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	id, err := pathID(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	t, _, err := taskDetail(cfg, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := a.mutate(r, batchOp{Op: "delete", Args: []string{r.PathValue("id")}}); err != nil {
+		return 0, nil, err
+	}
+	shared, err := shareTasks(cfg, []*task.Task{t})
+	if err != nil {
+		return 0, nil, err
+	}
+	t = shared[0]
+	return http.StatusOK, map[string]any{"status": "deleted", "id": t.ID, "title": t.Title}, nil
+}
+
+// mutate runs a create, edit, move, or delete under the board lock, as a
+// batch operation, and returns the task it changed, redacted and with a
+// private body left sealed.
+func (a *serveAPI) mutate(r *http.Request, op batchOp) (*task.Task, error) {
+	dir, err := resolveDir()
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+	if err != nil {
+		return nil, fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock
+
+	cfg, err := loadWritableConfig()
+	if err != nil {
+		return nil, err
+	}
+	// Archiving, like deleting, needs admin scope, however the request
+	// arrives at the archived status.
+	archiveGuard = func() error { return serve.CheckScope(r, config.ScopeAdmin) }
+	defer func() { archiveGuard = nil }()
+	t, _, err := runBatchOp(cfg, op)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, nil
+	}
+	shared, err := shareTasks(cfg, []*task.Task{t})
+	if err != nil {
+		return nil, err
+	}
+	return shared[0], nil
+}
+
+func (a *serveAPI) boardSummary(_ *http.Request) (int, any, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return 0, nil, err
+	}
+	printWarnings(warnings)
+	active := make([]*task.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if !cfg.IsArchivedStatus(t.Status) {
+			active = append(active, t)
+		}
+	}
+	goals, err := cfg.Goals()
+	if err != nil {
+		return 0, nil, err
+	}
+	summary := board.Summary(cfg, active, time.Now())
+	summary.Goals = board.GoalRollup(cfg, goals, tasks)
+	return http.StatusOK, summary, nil
+}
+
+func (a *serveAPI) metrics(r *http.Request) (int, any, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	flags := queryFlags(r)
+	for name := range flags {
+		if name != "since" && name != "business-days" {
+			return 0, nil, clierr.Newf(clierr.InvalidInput, "unknown metrics parameter %q (want since or business-days)", name)
+		}
+	}
+	if err := setBatchFlags(metricsCmd, flags); err != nil {
+		return 0, nil, err
+	}
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return 0, nil, err
+	}
+	printWarnings(warnings)
+	shared, err := shareTasks(cfg, allTasks)
+	if err != nil {
+		return 0, nil, err
+	}
+	m, err := flowMetrics(metricsCmd, cfg, shared, time.Now())
+	return http.StatusOK, m, err
+}
+
+// redactWarnings applies the board's redaction rules to warnings, which
+// can quote task fields such as a block reason or a branch. Warnings are
+// dropped when the rules cannot be loaded.
+func redactWarnings(warnings []string) []string {
+	if len(warnings) == 0 {
+		return nil
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil
+	}
+	redactor, err := board.NewRedactor(cfg)
+	if err != nil {
+		return nil
+	}
+	if !redactor.Enabled() {
+		return warnings
+	}
+	var tasks []*task.Task
+	if len(cfg.Redact.Fields) > 0 {
+		tasks, _, _ = task.ReadAllLenient(cfg.TasksPaths()...)
+	}
+	out := make([]string, len(warnings))
+	for i, warning := range warnings {
+		out[i] = redactor.Message(warning, tasks)
+	}
+	return out
+}
+
+// maxAPIBody caps the size of an API request body.
+const maxAPIBody = 1 << 20
+
+// bodyFlags decodes a JSON object of flags from the request body. An empty
+// body has no flags.
+func bodyFlags(r *http.Request) (map[string]any, error) {
+	flags := map[string]any{}
+	err := json.NewDecoder(io.LimitReader(r.Body, maxAPIBody)).Decode(&flags)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid JSON body: %v", err)
+	}
+	return flags, nil
+}
+
+// queryFlags turns query parameters into flags: one value is a string,
+// a repeated parameter a list.
+func queryFlags(r *http.Request) map[string]any {
+	flags := map[string]any{}
+	for name, values := range r.URL.Query() {
+		if len(values) == 1 {
+			flags[name] = values[0]
+			continue
+		}
+		list := make([]any, len(values))
+		for i, v := range values {
+			list[i] = v
+		}
+		flags[name] = list
+	}
+	return flags
+}
+
+// pathID parses the {id} of the request path.
+func pathID(r *http.Request) (int, error) {
+	s := r.PathValue("id")
+	if err := checkIDSyntax(s); err != nil {
+		return 0, err
+	}
+	return parseID(s)
+}
@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/serve"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/watcher"
+)
+
+func newTestServer(t *testing.T, cfg *config.Config, ui bool) *httptest.Server {
+	t.Helper()
+	auth, err := serve.NewAuthorizer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(newServeHandler(cfg, auth, &serveAPI{}, func() bool { return true }, ui))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func serveRequest(t *testing.T, method, url, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestServeTaskLifecycle(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	oldFlagDir := flagDir
+	flagDir = kanbanDir
+	t.Cleanup(func() { flagDir = oldFlagDir })
+	cfg, err := loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestServer(t, cfg, false)
+
+	resp := serveRequest(t, http.MethodPost, srv.URL+"/api/v1/tasks", `{"title": "From the API", "priority": "high"}`)
+	var created task.Task
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusCreated || created.ID != 1 || created.Priority != "high" {
+		t.Fatalf("create: status %d, task %+v", resp.StatusCode, created)
+	}
+
+	resp = serveRequest(t, http.MethodPost, srv.URL+"/api/v1/tasks/1/move", `{"status": "done"}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("move: status %d", resp.StatusCode)
+	}
+
+	resp = serveRequest(t, http.MethodGet, srv.URL+"/api/v1/tasks?status=done", "")
+	var listed []task.Task
+	if err := json.NewDecoder(resp.Body).Decode(&listed); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 || listed[0].Status != "done" {
+		t.Errorf("list ?status=done = %+v", listed)
+	}
+
+	resp = serveRequest(t, http.MethodPatch, srv.URL+"/api/v1/tasks/1", `{"priority": "urgent-ish"}`)
+	var apiErr struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest || apiErr.Code != "INVALID_PRIORITY" {
+		t.Errorf("bad edit: status %d, code %q", resp.StatusCode, apiErr.Code)
+	}
+
+	resp = serveRequest(t, http.MethodGet, srv.URL+"/api/v1/tasks/42", "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("show missing task: status %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestServeRequiresToken(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Serve.Tokens = []config.ServeToken{{Name: "dash", Token: "s3cret", Scope: config.ScopeRead}}
+	srv := newTestServer(t, cfg, false)
+
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/api/v1/board", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("no token: status %d, want 401", resp.StatusCode)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, srv.URL+"/api/v1/tasks/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer s3cret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("read token deleting: status %d, want 403", resp.StatusCode)
+	}
+
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/healthz", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("healthz: status %d, want 200 without a token", resp.StatusCode)
+	}
+}
+
+func TestServeUI(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Serve.Tokens = []config.ServeToken{{Name: "dash", Token: "s3cret", Scope: config.ScopeRead}}
+
+	// The page holds no board data, so it loads without a token.
+	srv := newTestServer(t, cfg, true)
+	resp := serveRequest(t, http.MethodGet, srv.URL+"/", "")
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") {
+		t.Errorf("GET / with --ui: status %d, content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/api/v1/board", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("API without a token: status %d, want 401", resp.StatusCode)
+	}
+
+	srv = newTestServer(t, cfg, false)
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET / without --ui: status %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestServeRedactsTasks(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	oldFlagDir := flagDir
+	flagDir = kanbanDir
+	t.Cleanup(func() { flagDir = oldFlagDir })
+	cfg, err := loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Redact.Patterns = []string{`sk-[0-9]+`}
+	cfg.NextID = 2
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	// The file name's slug carries the secret too.
+	writeArchiveTask(t, cfg, &task.Task{
+		ID: 1, Title: "Rotate sk-12345", Status: "todo", Priority: "medium",
+		Created: now, Updated: now, Started: &now, Private: true, Body: "launch codes",
+		Blocked: true, BlockReason: "waiting for sk-12345",
+	})
+	srv := newTestServer(t, cfg, false)
+
+	for _, req := range []struct{ method, path, body string }{
+		{http.MethodGet, "/api/v1/tasks", ""},
+		{http.MethodGet, "/api/v1/tasks/1", ""},
+		{http.MethodGet, "/api/v1/metrics", ""},
+		{http.MethodPatch, "/api/v1/tasks/1", `{"priority": "high"}`},
+		{http.MethodPost, "/api/v1/tasks/1/move", `{"status": "done"}`},
+		{http.MethodDelete, "/api/v1/tasks/1", ""},
+	} {
+		resp := serveRequest(t, req.method, srv.URL+req.path, req.body)
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s %s: status %d: %s", req.method, req.path, resp.StatusCode, data)
+		}
+		if strings.Contains(string(data), "sk-12345") || strings.Contains(string(data), "launch codes") {
+			t.Errorf("%s %s leaks redacted or private text: %s", req.method, req.path, data)
+		}
+		for _, warning := range resp.Header.Values(serve.WarningHeader) {
+			if strings.Contains(warning, "sk-12345") {
+				t.Errorf("%s %s leaks redacted text in a warning: %s", req.method, req.path, warning)
+			}
+		}
+	}
+}
+
+func TestServeInboxWebhookUsesBoardDir(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	cfg, err := config.Load(kanbanDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Inbox.Sources = []config.InboxSource{{Name: "alerts", Type: config.InboxWebhook, Path: "spool"}}
+	// A relative path must not resolve against the server's working directory.
+	t.Chdir(t.TempDir())
+	srv := newTestServer(t, cfg, false)
+
+	resp := serveRequest(t, http.MethodPost, srv.URL+"/inbox/alerts", `{"title": "Disk full"}`)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("status %d, want 202", resp.StatusCode)
+	}
+	entries, err := os.ReadDir(filepath.Join(kanbanDir, "spool"))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("spool dir under the board has %d entries (%v), want 1", len(entries), err)
+	}
+}
+
+func TestServeReadyzWatcher(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	cfg, err := config.Load(kanbanDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := serve.NewAuthorizer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := &serveAPI{}
+	w, err := watcher.New([]string{cfg.TasksPath(), cfg.Dir()}, api.changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = w.Close() })
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx, nil)
+		close(done)
+	}()
+	srv := httptest.NewServer(newServeHandler(cfg, auth, api, w.Alive, false))
+	t.Cleanup(srv.Close)
+
+	deadline := time.Now().Add(time.Second)
+	for !w.Alive() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/readyz", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("readyz with the watcher running: status %d, want 200", resp.StatusCode)
+	}
+
+	if err := os.WriteFile(filepath.Join(cfg.TasksPath(), "001-outside.md"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	deadline = time.Now().Add(2 * time.Second)
+	for api.revision.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/api/v1/board", ""); resp.Header.Get(serve.RevisionHeader) == "0" {
+		t.Errorf("%s = 0 after a task file changed", serve.RevisionHeader)
+	}
+
+	cancel()
+	<-done
+	if resp := serveRequest(t, http.MethodGet, srv.URL+"/readyz", ""); resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("readyz after the watcher stopped: status %d, want 503", resp.StatusCode)
+	}
+}
+
+func TestServeAdminScope(t *testing.T) {
+	kanbanDir := setupBoard(t)
+	oldFlagDir := flagDir
+	flagDir = kanbanDir
+	t.Cleanup(func() { flagDir = oldFlagDir })
+	cfg, err := loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Serve.Tokens = []config.ServeToken{
+		{Name: "agent", Token: "write-secret", Scope: config.ScopeWrite},
+		{Name: "ops", Token: "admin-secret", Scope: config.ScopeAdmin},
+	}
+	srv := newTestServer(t, cfg, false)
+	do := func(method, path, body, token string) int {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := do(http.MethodPost, "/api/v1/tasks", `{"title": "One"}`, "write-secret"); code != http.StatusCreated {
+		t.Fatalf("write token creating: status %d, want 201", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/tasks", `{"title": "Two"}`, "write-secret"); code != http.StatusCreated {
+		t.Fatalf("write token creating: status %d, want 201", code)
+	}
+	if code := do(http.MethodDelete, "/api/v1/tasks/1", "", "write-secret"); code != http.StatusForbidden {
+		t.Errorf("write token deleting: status %d, want 403", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/tasks/1/move", `{"status": "archived"}`, "write-secret"); code != http.StatusForbidden {
+		t.Errorf("write token archiving: status %d, want 403", code)
+	}
+	if code := do(http.MethodPatch, "/api/v1/tasks/1", `{"status": "archived"}`, "write-secret"); code != http.StatusForbidden {
+		t.Errorf("write token archiving by edit: status %d, want 403", code)
+	}
+	if code := do(http.MethodPatch, "/api/v1/tasks/1", `{"patch": "{\"status\": \"archived\"}"}`, "write-secret"); code != http.StatusForbidden {
+		t.Errorf("write token archiving by patch: status %d, want 403", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/tasks/1/move", `{"status": "done"}`, "write-secret"); code != http.StatusOK {
+		t.Fatalf("write token moving to done: status %d, want 200", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/tasks/1/move", `{"next": true}`, "write-secret"); code != http.StatusForbidden {
+		t.Errorf("write token archiving by next: status %d, want 403", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/tasks/1/move", `{"status": "archived"}`, "admin-secret"); code != http.StatusOK {
+		t.Errorf("admin token archiving: status %d, want 200", code)
+	}
+	if code := do(http.MethodDelete, "/api/v1/tasks/2", "", "admin-secret"); code != http.StatusOK {
+		t.Errorf("admin token deleting: status %d, want 200", code)
+	}
+}
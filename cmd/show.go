@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"os"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
 	"github.com/antopolskiy/kanban-md/internal/output"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
@@ -23,39 +25,79 @@ func init() {
 }
 
 func runShow(_ *cobra.Command, args []string) error {
-	id, err := strconv.Atoi(args[0])
-	if err != nil {
-		return task.ValidateTaskID(args[0])
+	if err := checkIDSyntax(args[0]); err != nil {
+		return err
 	}
-
 	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	path, err := task.FindByID(cfg.TasksPath(), id)
+	id, err := parseID(args[0])
 	if err != nil {
 		return err
 	}
 
-	t, err := task.Read(path)
+	t, progress, err := taskDetail(cfg, id)
 	if err != nil {
 		return err
 	}
 
-	return outputTaskDetail(t)
+	warnBlockReviews([]*task.Task{t})
+	return outputTaskDetail(t, progress)
 }
 
-func outputTaskDetail(t *task.Task) error {
+// taskDetail reads task id with its computed fields and child rollup.
+func taskDetail(cfg *config.Config, id int) (*task.Task, *board.ChildProgress, error) {
+	path, err := task.FindByIDIn(cfg.TasksPaths(), id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t, err := readTask(cfg, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Computed fields are supplementary, so a failed board read only omits them.
+	var progress *board.ChildProgress
+	if allTasks, _, readErr := task.ReadAllLenient(cfg.TasksPaths()...); readErr == nil {
+		board.MarkDependencyBlocked(cfg, []*task.Task{t}, allTasks)
+		board.MarkReady(cfg, []*task.Task{t}, allTasks, board.PickOptions{ClaimTimeout: cfg.ClaimTimeoutDuration()})
+		progress = board.ComputeChildProgress(cfg, allTasks, t.ID)
+	}
+	return t, progress, nil
+}
+
+// taskDetailResult adds the child rollup to a task's JSON detail.
+type taskDetailResult struct {
+	*task.Task
+	Progress *board.ChildProgress `json:"progress,omitempty"`
+}
+
+// outputTaskDetail prints a task. Private bodies are decrypted for display
+// only, so callers must have written the task before calling it.
+func outputTaskDetail(t *task.Task, progress *board.ChildProgress) error {
+	crypt.Reveal(t)
 	format := outputFormat()
 	if format == output.FormatJSON {
-		return output.JSON(os.Stdout, t)
+		return output.JSON(os.Stdout, taskDetailResult{Task: t, Progress: progress})
 	}
 	if format == output.FormatCompact {
-		output.TaskDetailCompact(os.Stdout, t)
+		output.TaskDetailCompactWithProgress(os.Stdout, t, progress)
 		return nil
 	}
 
-	output.TaskDetail(os.Stdout, t)
+	output.TaskDetailWithProgress(os.Stdout, t, progress)
 	return nil
 }
+
+// childProgress rolls up the children of a task, or returns nil if it has
+// none. Read errors are ignored since the rollup is supplementary.
+func childProgress(cfg *config.Config, id int) *board.ChildProgress {
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return nil
+	}
+	return board.ComputeChildProgress(cfg, tasks, id)
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Predict cycle time and throughput under other WIP limits",
+	Long: `Replays the activity log over --history under hypothetical WIP limits and
+compares the recorded flow with the predicted one: average WIP, time in each
+column, queueing before it, cycle time, and throughput.
+
+--wip STATUS:N sets a hypothetical limit (0 for none); repeat it or separate
+several with commas. Tasks over the limit queue before the column and enter
+in --strategy order: fifo (arrival) or priority (highest first, then
+arrival).
+
+The model assumes a column's capacity is fixed and shared equally among the
+tasks in it, so fewer tasks at once each finish sooner. Columns are
+simulated independently. Only moves recorded in the activity log are
+replayed; the log keeps its most recent entries only.`,
+	Args: cobra.NoArgs,
+	RunE: runSimulate,
+}
+
+func init() {
+	simulateCmd.Flags().StringSlice("wip", nil, "hypothetical WIP limit as STATUS:N (repeatable)")
+	simulateCmd.Flags().String("history", "90d", "period of the activity log to replay (e.g. 90d, 12w)")
+	simulateCmd.Flags().String("strategy", board.SimulateFIFO,
+		"order queued tasks enter a column: "+strings.Join(board.SimulateStrategies, " or "))
+	_ = simulateCmd.MarkFlagRequired("wip")
+	rootCmd.AddCommand(simulateCmd)
+}
+
+func runSimulate(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	wip, _ := cmd.Flags().GetStringSlice("wip")
+	limits, err := parseSimulatedLimits(cfg, wip)
+	if err != nil {
+		return err
+	}
+	historyStr, _ := cmd.Flags().GetString("history")
+	history, err := parseIdleDuration(historyStr)
+	if err != nil {
+		return clierr.Newf(clierr.InvalidInput, "invalid --history %q (use e.g. 90d, 12w)", historyStr)
+	}
+	strategy, _ := cmd.Flags().GetString("strategy")
+	if !slices.Contains(board.SimulateStrategies, strategy) {
+		return clierr.Newf(clierr.InvalidInput, "invalid --strategy %q (want %s)",
+			strategy, strings.Join(board.SimulateStrategies, " or "))
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+	log, err := board.ReadLog(cfg.Dir(), board.LogFilterOptions{})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	sim := board.Simulate(cfg, tasks, log, board.SimulateOptions{
+		From: now.Add(-history), To: now, Limits: limits, Strategy: strategy,
+	})
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, sim)
+	case output.FormatCompact:
+		output.SimulationCompact(os.Stdout, sim)
+	default:
+		output.SimulationTable(os.Stdout, sim)
+	}
+	return nil
+}
+
+// parseSimulatedLimits parses --wip values of the form STATUS:N.
+func parseSimulatedLimits(cfg *config.Config, values []string) (map[string]int, error) {
+	limits := make(map[string]int, len(values))
+	for _, v := range values {
+		status, n, ok := strings.Cut(v, ":")
+		limit, err := strconv.Atoi(n)
+		if !ok || err != nil || limit < 0 {
+			return nil, clierr.Newf(clierr.InvalidInput, "invalid --wip %q (want STATUS:N, e.g. in-progress:3)", v)
+		}
+		if err := task.ValidateStatus(status, cfg.StatusNames()); err != nil {
+			return nil, err
+		}
+		limits[status] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/jsonschema"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/skill"
+)
+
+// referenceSkill is the skill whose JSON reference is generated.
+const referenceSkill = "kanban-md"
+
+var skillGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Regenerate skill references from the CLI's own types",
+	Long: `Rewrites the JSON output reference (references/json-schemas.md) of the
+installed kanban-md skills from the types the CLI encodes and from its
+error codes — the same sources as the schema and manifest commands — so
+the skill cannot drift from the output.
+
+--from-manifest is required: it names the source, and is the only one.
+With --path, writes below that directory instead of the agents' skill
+directories, e.g. --path internal/skill/skills for the embedded copy.`,
+	Args: cobra.NoArgs,
+	RunE: runSkillGenerate,
+}
+
+func init() {
+	skillGenerateCmd.Flags().Bool("from-manifest", false, "generate from the CLI's output types and error codes")
+	skillGenerateCmd.Flags().StringSlice("agent", nil, "agent(s) to generate for")
+	skillGenerateCmd.Flags().Bool("global", false, "generate for user-level (global) skills")
+	skillGenerateCmd.Flags().String("path", "", "write to a specific skill directory (skips installed skill lookup)")
+	skillCmd.AddCommand(skillGenerateCmd)
+}
+
+func runSkillGenerate(cmd *cobra.Command, _ []string) error {
+	if fromManifest, _ := cmd.Flags().GetBool("from-manifest"); !fromManifest {
+		return clierr.New(clierr.InvalidInput, "nothing to generate from: pass --from-manifest")
+	}
+	global, _ := cmd.Flags().GetBool("global")
+	agentFilter, _ := cmd.Flags().GetStringSlice("agent")
+	pathFlag, _ := cmd.Flags().GetString("path")
+	data := jsonSchemasReference()
+
+	if pathFlag != "" {
+		absDir, err := filepath.Abs(pathFlag)
+		if err != nil {
+			return fmt.Errorf("resolving path: %w", err)
+		}
+		if err := skill.WriteReference(referenceSkill, absDir, skill.JSONSchemasReference, data); err != nil {
+			return fmt.Errorf("writing reference to %s: %w", absDir, err)
+		}
+		output.Messagef(os.Stdout, "  %s",
+			skillSuccessStyle.Render(filepath.Join(absDir, referenceSkill, skill.JSONSchemasReference)))
+		return nil
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil && !global {
+		return fmt.Errorf("finding project root: %w", err)
+	}
+	var generated int
+	for _, agent := range resolveAgentList(agentFilter) {
+		baseDir := agent.SkillPath(projectRoot, global)
+		if _, ok := skill.FindInstalledSkills(baseDir)[referenceSkill]; !ok {
+			continue
+		}
+		if err := skill.WriteReference(referenceSkill, baseDir, skill.JSONSchemasReference, data); err != nil {
+			return fmt.Errorf("generating reference for %s: %w", agent.DisplayName, err)
+		}
+		output.Messagef(os.Stdout, "  %s", skillSuccessStyle.Render(relativePath(projectRoot,
+			filepath.Join(baseDir, referenceSkill, skill.JSONSchemasReference))))
+		generated++
+	}
+
+	if generated == 0 {
+		output.Messagef(os.Stdout, "No kanban-md skills installed. Run: kanban-md skill install")
+		return nil
+	}
+	output.Messagef(os.Stdout, "%s", skillSuccessStyle.Render(fmt.Sprintf("Generated %d reference(s).", generated)))
+	return nil
+}
+
+// exitClasses names the error classes by exit code, in reference order.
+var exitClasses = []struct {
+	code int
+	name string
+}{
+	{clierr.ExitValidation, "validation"},
+	{clierr.ExitNotFound, "not found"},
+	{clierr.ExitConflict, "conflict"},
+	{clierr.ExitWIP, "WIP"},
+	{clierr.ExitInternal, "internal"},
+}
+
+// jsonSchemasReference renders the skill's JSON output reference: a field
+// table per output schema and the error codes by exit code.
+func jsonSchemasReference() []byte {
+	var b bytes.Buffer
+	b.WriteString("<!-- Generated by `kanban-md skill generate --from-manifest`. Do not edit. -->\n\n")
+	b.WriteString("# kanban-md JSON Output Schemas\n\n")
+	b.WriteString("Reference for parsing `--json` output, generated from the types the CLI\n")
+	b.WriteString("encodes. `kanban-md schema NAME` prints the full JSON Schema. Optional\n")
+	b.WriteString("fields are absent when empty; outputs may gain fields in later versions.\n")
+
+	for _, s := range outputSchemas() {
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n\n", s.name, s.description)
+		b.WriteString("| Field | Type | Optional |\n|---|---|---|\n")
+		writeSchemaFields(&b, "", jsonschema.For(s.value))
+		if s.notes != "" {
+			fmt.Fprintf(&b, "\n%s\n", s.notes)
+		}
+	}
+
+	b.WriteString("\n## Error codes\n\nThe `code` of an error response, by exit code:\n\n")
+	byExit := make(map[int][]string)
+	var retryable []string
+	for _, code := range clierr.Codes {
+		e := clierr.New(code, "")
+		byExit[e.ExitCode()] = append(byExit[e.ExitCode()], code)
+		if e.Retryable() {
+			retryable = append(retryable, code)
+		}
+	}
+	for _, class := range exitClasses {
+		fmt.Fprintf(&b, "- %d %s: %s\n", class.code, class.name, strings.Join(byExit[class.code], ", "))
+	}
+	b.WriteString("\nExit code 1 means a partial batch failure, or a warning under\n")
+	b.WriteString("`--fail-on warning`. `kanban-md manifest --json` lists every code.\n")
+	b.WriteString("\n`retryable` is true when the same command may succeed later, once another\n")
+	fmt.Fprintf(&b, "agent's claim expires or work moves on: %s.\n", strings.Join(retryable, ", "))
+	b.WriteString("When the wait is known, `retry_after` gives it in seconds. Other errors need\n")
+	b.WriteString("a different command. Board locks never fail: commands wait for them.\n")
+	return b.Bytes()
+}
+
+// writeSchemaFields writes a table row per property of the object schema s,
+// sorted by name, descending into nested objects with dotted names.
+func writeSchemaFields(b *bytes.Buffer, prefix string, s jsonschema.Schema) {
+	props, _ := s["properties"].(jsonschema.Schema)
+	required, _ := s["required"].([]string)
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop, _ := props[name].(jsonschema.Schema)
+		optional := ""
+		if !slices.Contains(required, name) {
+			optional = "yes"
+		}
+		fmt.Fprintf(b, "| `%s%s` | %s | %s |\n", prefix, name, schemaTypeName(prop), optional)
+
+		switch nested := objectSchema(prop); {
+		case nested != nil:
+			writeSchemaFields(b, prefix+name+".", nested)
+		case objectSchema(arrayItems(prop)) != nil:
+			writeSchemaFields(b, prefix+name+"[].", objectSchema(arrayItems(prop)))
+		}
+	}
+}
+
+// schemaTypeName describes the type of s in a few words.
+func schemaTypeName(s jsonschema.Schema) string {
+	if anyOf, ok := s["anyOf"].([]jsonschema.Schema); ok {
+		names := make([]string, len(anyOf))
+		for i, alt := range anyOf {
+			names[i] = schemaTypeName(alt)
+		}
+		return strings.Join(names, " or ")
+	}
+
+	var types []string
+	switch typ := s["type"].(type) {
+	case string:
+		types = []string{typ}
+	case []string:
+		types = typ
+	default:
+		return "any"
+	}
+	names := make([]string, len(types))
+	for i, typ := range types {
+		names[i] = typeName(s, typ)
+	}
+	return strings.Join(names, " or ")
+}
+
+// typeName names one JSON type of s, with its format, items, or values.
+func typeName(s jsonschema.Schema, typ string) string {
+	switch typ {
+	case "string":
+		if format, ok := s["format"].(string); ok {
+			return format
+		}
+	case "array":
+		if items := arrayItems(s); items != nil {
+			return "array of " + schemaTypeName(items)
+		}
+	case "object":
+		if values, ok := s["additionalProperties"].(jsonschema.Schema); ok {
+			return "map of " + schemaTypeName(values)
+		}
+	}
+	return typ
+}
+
+// objectSchema returns s when it is an object with properties, or the
+// object alternative of a nullable object.
+func objectSchema(s jsonschema.Schema) jsonschema.Schema {
+	if anyOf, ok := s["anyOf"].([]jsonschema.Schema); ok {
+		for _, alt := range anyOf {
+			if obj := objectSchema(alt); obj != nil {
+				return obj
+			}
+		}
+		return nil
+	}
+	if _, ok := s["properties"].(jsonschema.Schema); ok {
+		return s
+	}
+	return nil
+}
+
+// arrayItems returns the items schema of an array schema, or nil.
+func arrayItems(s jsonschema.Schema) jsonschema.Schema {
+	items, _ := s["items"].(jsonschema.Schema)
+	return items
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/jsonschema"
+	"github.com/antopolskiy/kanban-md/internal/skill"
+)
+
+// The embedded reference must be regenerated whenever an output type or
+// error code changes: kanban-md skill generate --from-manifest --path internal/skill/skills.
+func TestJSONSchemasReferenceIsCurrent(t *testing.T) {
+	path := filepath.Join("..", "internal", "skill", "skills", referenceSkill, filepath.FromSlash(skill.JSONSchemasReference))
+	embedded, err := os.ReadFile(path) //nolint:gosec // test path
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(embedded) != string(jsonSchemasReference()) {
+		t.Errorf("%s is stale; run: kanban-md skill generate --from-manifest --path internal/skill/skills", path)
+	}
+}
+
+func TestSchemaTypeName(t *testing.T) {
+	tests := []struct {
+		schema jsonschema.Schema
+		want   string
+	}{
+		{jsonschema.Schema{"type": "string", "format": "date"}, "date"},
+		{jsonschema.Schema{"type": []string{"array", "null"}, "items": jsonschema.Schema{"type": "integer"}}, "array of integer or null"},
+		{jsonschema.Schema{"type": "object", "additionalProperties": jsonschema.Schema{"type": "number"}}, "map of number"},
+		{jsonschema.Schema{"anyOf": []jsonschema.Schema{{"type": "object"}, {"type": "null"}}}, "object or null"},
+		{jsonschema.Schema{}, "any"},
+	}
+	for _, tt := range tests {
+		if got := schemaTypeName(tt.schema); got != tt.want {
+			t.Errorf("schemaTypeName(%v) = %q, want %q", tt.schema, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var staleCmd = &cobra.Command{
+	Use:   "stale",
+	Short: "Find and act on tasks untouched for too long",
+	Long: `Lists tasks in active (non-terminal) columns that have not been updated
+within --threshold. With --tag or --move, applies that action to each stale
+task and logs it; without either, nothing is changed.`,
+	Args: cobra.NoArgs,
+	RunE: runStale,
+}
+
+func init() {
+	staleCmd.Flags().String("threshold", "30d", "idle time before a task is stale (e.g. 30d, 2w, 36h)")
+	staleCmd.Flags().StringSlice("status", nil, "only check these statuses (default: all non-terminal)")
+	staleCmd.Flags().String("tag", "", "add this tag to stale tasks")
+	staleCmd.Flags().String("move", "", "move stale tasks to this status")
+	staleCmd.MarkFlagsMutuallyExclusive("tag", "move")
+	rootCmd.AddCommand(staleCmd)
+}
+
+// staleResult reports one stale task and what was done to it.
+type staleResult struct {
+	ID       int       `json:"id"`
+	Title    string    `json:"title"`
+	Status   string    `json:"status"`
+	Updated  time.Time `json:"updated"`
+	IdleDays int       `json:"idle_days"`
+	Action   string    `json:"action,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+func runStale(cmd *cobra.Command, _ []string) error {
+	thresholdStr, _ := cmd.Flags().GetString("threshold")
+	threshold, err := parseIdleDuration(thresholdStr)
+	if err != nil {
+		return err
+	}
+	tag, _ := cmd.Flags().GetString("tag")
+	moveTo, _ := cmd.Flags().GetString("move")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+
+	load := loadConfig
+	if tag != "" || moveTo != "" {
+		load = loadWritableConfig
+	}
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if err := task.ValidateStatus(s, cfg.StatusNames()); err != nil {
+			return err
+		}
+	}
+	if moveTo != "" {
+		if err := task.ValidateStatus(moveTo, cfg.StatusNames()); err != nil {
+			return err
+		}
+		if len(statuses) == 0 {
+			// Tasks already in the target column are not stale candidates.
+			for _, s := range cfg.ActiveStatuses() {
+				if s != moveTo {
+					statuses = append(statuses, s)
+				}
+			}
+		}
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	now := time.Now()
+	stale := board.FindStale(cfg, tasks, statuses, threshold, now)
+	results := make([]staleResult, 0, len(stale))
+	for _, t := range stale {
+		r := staleResult{
+			ID: t.ID, Title: t.Title, Status: t.Status, Updated: t.Updated,
+			IdleDays: int(now.Sub(t.Updated).Hours() / 24), //nolint:mnd // hours per day
+		}
+		action, err := applyStaleAction(cfg, t, tag, moveTo, now)
+		r.Action = action
+		if err != nil {
+			r.Error = err.Error()
+		}
+		results = append(results, r)
+	}
+	return outputStale(results)
+}
+
+// applyStaleAction tags or moves t and logs the change. It returns a short
+// description of what was done, or "" when there was nothing to do.
+func applyStaleAction(cfg *config.Config, t *task.Task, tag, moveTo string, now time.Time) (string, error) {
+	var action string
+	switch {
+	case tag != "":
+		if slices.Contains(t.Tags, tag) {
+			return "", nil
+		}
+		t.Tags = append(t.Tags, tag)
+		action = "tagged " + tag
+	case moveTo != "":
+		if err := enforceMoveWIP(cfg, t, moveTo); err != nil {
+			return "", err
+		}
+		oldStatus := t.Status
+		t.Status = moveTo
+		task.UpdateTimestamps(t, oldStatus, moveTo, cfg)
+		task.ApplyChecklist(t, cfg)
+		action = "moved " + oldStatus + " -> " + moveTo
+	default:
+		return "", nil
+	}
+
+	t.Updated = now
+	if err := task.Write(t.File, t); err != nil {
+		return "", fmt.Errorf("writing task: %w", err)
+	}
+	logActivity(cfg, "stale", t.ID, action)
+	return action, nil
+}
+
+func outputStale(results []staleResult) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, results)
+	}
+	for _, r := range results {
+		line := fmt.Sprintf("%s %s (%s, idle %dd)", output.FormatID(r.ID), r.Title, r.Status, r.IdleDays)
+		switch {
+		case r.Error != "":
+			line += ": " + r.Error
+		case r.Action != "":
+			line += ": " + r.Action
+		}
+		fmt.Fprintln(os.Stdout, line)
+	}
+	output.Messagef(os.Stdout, "Found %d stale task(s)", len(results))
+	return nil
+}
+
+// parseIdleDuration parses a threshold such as "30d", "2w", or any Go
+// duration like "36h".
+func parseIdleDuration(s string) (time.Duration, error) {
+	const day = 24 * time.Hour
+	invalid := clierr.Newf(clierr.InvalidInput, "invalid threshold %q (use e.g. 30d, 2w, 36h)", s)
+	mult := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		mult = day
+	case strings.HasSuffix(s, "w"):
+		mult = 7 * day //nolint:mnd // days per week
+	}
+	var d time.Duration
+	if mult > 0 {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil {
+			return 0, invalid
+		}
+		d = time.Duration(n) * mult
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, invalid
+		}
+	}
+	if d <= 0 {
+		return 0, invalid
+	}
+	return d, nil
+}
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe [ID]",
+	Short: "Run a command when a task changes",
+	Long: `Subscribes a command to changes of one task. While the board is watched,
+with subscribe --watch or board --watch, the command runs each time the task
+is created, updated, or deleted.
+
+The command runs through the shell with a JSON object on stdin:
+{"task_id", "event", "old", "new"}, where event is created, updated, or
+deleted and old/new are the task before and after (null when absent). The
+environment also carries KANBAN_TASK_ID, KANBAN_EVENT, and KANBAN_DIR.
+
+Without --exec, --remove, or --watch, lists subscriptions: all of them, or
+those of the given task.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSubscribe,
+}
+
+func init() {
+	subscribeCmd.Flags().String("exec", "", "command to run when the task changes")
+	subscribeCmd.Flags().Bool("remove", false, "remove the task's subscriptions (only --exec's, if given)")
+	subscribeCmd.Flags().Bool("watch", false, "watch the board and run subscriptions until interrupted")
+	rootCmd.AddCommand(subscribeCmd)
+}
+
+func runSubscribe(cmd *cobra.Command, args []string) error {
+	execCmd, _ := cmd.Flags().GetString("exec")
+	remove, _ := cmd.Flags().GetBool("remove")
+	watch, _ := cmd.Flags().GetBool("watch")
+
+	if watch && (len(args) > 0 || execCmd != "" || remove) {
+		return clierr.New(clierr.InvalidInput, "--watch takes no task ID, --exec, or --remove")
+	}
+	if len(args) == 1 {
+		if err := checkIDSyntax(args[0]); err != nil {
+			return err
+		}
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if watch {
+		return watchSubscriptions(cfg)
+	}
+
+	id := 0
+	if len(args) == 1 {
+		if id, err = parseID(args[0]); err != nil {
+			return err
+		}
+	}
+	switch {
+	case remove:
+		if id == 0 {
+			return clierr.New(clierr.InvalidInput, "--remove needs a task ID")
+		}
+		return removeSubscriptions(cfg, id, execCmd)
+	case execCmd != "":
+		if id == 0 {
+			return clierr.New(clierr.InvalidInput, "--exec needs a task ID")
+		}
+		return addSubscription(cfg, config.Subscription{Task: id, Exec: execCmd})
+	default:
+		return listSubscriptions(cfg, id)
+	}
+}
+
+func addSubscription(cfg *config.Config, sub config.Subscription) error {
+	if err := checkWritable(cfg); err != nil {
+		return err
+	}
+	if _, err := task.FindByIDIn(cfg.TasksPaths(), sub.Task); err != nil {
+		return err
+	}
+	for _, s := range cfg.Subscriptions {
+		if s == sub {
+			return clierr.Newf(clierr.NoChanges, "task %s already runs %q", output.FormatID(sub.Task), sub.Exec)
+		}
+	}
+
+	cfg.Subscriptions = append(cfg.Subscriptions, sub)
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, sub)
+	}
+	output.Messagef(os.Stdout, "Subscribed to task %s: %s", output.FormatID(sub.Task), sub.Exec)
+	return nil
+}
+
+func removeSubscriptions(cfg *config.Config, id int, execCmd string) error {
+	if err := checkWritable(cfg); err != nil {
+		return err
+	}
+
+	var kept, removed []config.Subscription
+	for _, s := range cfg.Subscriptions {
+		if s.Task == id && (execCmd == "" || s.Exec == execCmd) {
+			removed = append(removed, s)
+		} else {
+			kept = append(kept, s)
+		}
+	}
+	if len(removed) == 0 {
+		return clierr.Newf(clierr.NoChanges, "task %s has no matching subscriptions", output.FormatID(id))
+	}
+
+	cfg.Subscriptions = kept
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, removed)
+	}
+	output.Messagef(os.Stdout, "Removed %d subscription(s) from task %s", len(removed), output.FormatID(id))
+	return nil
+}
+
+func listSubscriptions(cfg *config.Config, id int) error {
+	subs := cfg.Subscriptions
+	if id > 0 {
+		subs = cfg.SubscriptionsFor(id)
+	}
+	if subs == nil {
+		subs = []config.Subscription{}
+	}
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, subs)
+	case output.FormatCompact:
+		output.SubscriptionsCompact(os.Stdout, subs)
+	default:
+		output.SubscriptionsTable(os.Stdout, subs)
+	}
+	return nil
+}
+
+// watchSubscriptions runs subscriptions on board changes until interrupted.
+func watchSubscriptions(cfg *config.Config) error {
+	runner, err := newSubscriptionRunner(cfg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Watching %d subscription(s)... (Ctrl+C to stop)\n", len(cfg.Subscriptions))
+	return watchBoardFiles(cfg, func(ctx context.Context, freshCfg *config.Config) {
+		runner.check(ctx, freshCfg)
+	})
+}
+
+// subscriptionRunner remembers the last read of the board so each check
+// can tell which subscribed tasks changed since.
+type subscriptionRunner struct {
+	mu     sync.Mutex // checks can overlap when a command runs long
+	before []*task.Task
+}
+
+func newSubscriptionRunner(cfg *config.Config) (*subscriptionRunner, error) {
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return nil, fmt.Errorf("reading tasks: %w", err)
+	}
+	return &subscriptionRunner{before: tasks}, nil
+}
+
+// check rereads the board and runs the subscriptions of every subscribed
+// task that changed since the last check.
+func (r *subscriptionRunner) check(ctx context.Context, cfg *config.Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	after, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		warnf("reading tasks: %v\n", err)
+		return
+	}
+	ids := make(map[int]bool, len(cfg.Subscriptions))
+	for _, s := range cfg.Subscriptions {
+		ids[s.Task] = true
+	}
+	for _, change := range board.DiffTasks(r.before, after, ids) {
+		for _, s := range cfg.SubscriptionsFor(change.TaskID) {
+			if err := runSubscription(ctx, cfg, s, change); err != nil {
+				warnf("subscription %q for task %s: %v\n", s.Exec, output.FormatID(s.Task), err)
+			}
+		}
+	}
+	r.before = after
+}
+
+// runSubscription runs s's command through the shell with change as JSON
+// on stdin.
+func runSubscription(ctx context.Context, cfg *config.Config, s config.Subscription, change board.TaskChange) error {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	c := exec.CommandContext(ctx, shell, flag, s.Exec) //nolint:gosec // the board owner configured this command
+	c.Stdin = bytes.NewReader(payload)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	c.Env = append(os.Environ(),
+		"KANBAN_TASK_ID="+strconv.Itoa(change.TaskID),
+		"KANBAN_EVENT="+change.Event,
+		"KANBAN_DIR="+cfg.Dir(),
+	)
+	fmt.Fprintf(os.Stderr, "Task %s %s: running %s\n", output.FormatID(change.TaskID), change.Event, s.Exec)
+	return c.Run()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestSubscriptionRunnerRunsOnChange(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("subscription command uses sh")
+	}
+	cfg, err := config.Load(setupBoard(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	watched := &task.Task{ID: 1, Title: "Watched", Status: "todo", Priority: "medium", Created: now, Updated: now}
+	other := &task.Task{ID: 2, Title: "Other", Status: "todo", Priority: "medium", Created: now, Updated: now}
+	writeHandoffTask(t, cfg, watched)
+	writeHandoffTask(t, cfg, other)
+
+	out := filepath.Join(t.TempDir(), "payload.json")
+	cfg.Subscriptions = []config.Subscription{{Task: 1, Exec: `cat > "` + out + `"`}}
+
+	runner, err := newSubscriptionRunner(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A change to an unsubscribed task runs nothing.
+	other.Priority = "high"
+	writeHandoffTask(t, cfg, other)
+	r, w := captureStderr(t)
+	runner.check(context.Background(), cfg)
+	_ = drainPipe(t, r, w)
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Fatalf("command ran for an unsubscribed task (stat err = %v)", err)
+	}
+
+	watched.Status = "in-progress"
+	writeHandoffTask(t, cfg, watched)
+	r, w = captureStderr(t)
+	runner.check(context.Background(), cfg)
+	_ = drainPipe(t, r, w)
+
+	data, err := os.ReadFile(out) //nolint:gosec // test path
+	if err != nil {
+		t.Fatalf("command did not run: %v", err)
+	}
+	var change board.TaskChange
+	if err := json.Unmarshal(data, &change); err != nil {
+		t.Fatalf("payload %q: %v", data, err)
+	}
+	if change.TaskID != 1 || change.Event != board.ChangeUpdated {
+		t.Errorf("change = %d %s, want 1 updated", change.TaskID, change.Event)
+	}
+	if change.Old == nil || change.Old.Status != "todo" || change.New == nil || change.New.Status != "in-progress" {
+		t.Errorf("old/new = %+v / %+v, want todo -> in-progress", change.Old, change.New)
+	}
+}
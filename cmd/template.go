@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/boardtemplate"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Share board setups as templates",
+}
+
+var templateBoardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Export a board's setup for init --from-archive",
+}
+
+var templateBoardExportCmd = &cobra.Command{
+	Use:   "export FILE",
+	Short: "Write the board's setup to a .tar.gz archive",
+	Long: `Bundles the board's setup — config.yml with its statuses, classes, WIP
+limits, templates, and other settings, plus rules.yml when present — into a
+.tar.gz archive. Tasks, the activity log, and other board state are left
+out, as are the next task ID, the readonly flag, serve tokens,
+subscriptions, inbox sources, and log sinks.
+
+Start another board from the archive with:
+
+  kanban-md init --from-archive FILE
+
+FILE "-" writes the archive to stdout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTemplateBoardExport,
+}
+
+func init() {
+	templateBoardCmd.AddCommand(templateBoardExportCmd)
+	templateCmd.AddCommand(templateBoardCmd)
+	rootCmd.AddCommand(templateCmd)
+}
+
+func runTemplateBoardExport(_ *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	files, err := boardtemplate.Export(&buf, cfg)
+	if err != nil {
+		return err
+	}
+	file := args[0]
+	if file == "-" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	const archiveMode = 0o600
+	if err := os.WriteFile(file, buf.Bytes(), archiveMode); err != nil {
+		return fmt.Errorf("writing archive: %w", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"file": file, "files": files})
+	}
+	output.Messagef(os.Stdout, "Exported board template to %s (%s)", file, strings.Join(files, ", "))
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree [ID]",
+	Short: "Show the parent/child task hierarchy",
+	Long: `Renders tasks as an indented tree following parent links. With an ID, shows
+only that task and its descendants. Archived tasks are hidden.
+
+Status glyphs: ○ not started, ◐ in progress, ● done.
+Priority glyphs: ‼ critical, ↑ high, · medium, ↓ low.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTree,
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+}
+
+func runTree(_ *cobra.Command, args []string) error {
+	if len(args) == 1 {
+		if err := checkIDSyntax(args[0]); err != nil {
+			return err
+		}
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	rootID := 0
+	if len(args) == 1 {
+		rootID, err = parseID(args[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	if rootID != 0 {
+		if _, err := task.FindByIDIn(cfg.TasksPaths(), rootID); err != nil {
+			return err
+		}
+	}
+
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	tasks := make([]*task.Task, 0, len(allTasks))
+	for _, t := range allTasks {
+		if !cfg.IsArchivedStatus(t.Status) || t.ID == rootID {
+			tasks = append(tasks, t)
+		}
+	}
+
+	nodes := board.BuildTree(cfg, tasks, rootID)
+
+	format := outputFormat()
+	if format == output.FormatJSON {
+		if nodes == nil {
+			nodes = []*board.TreeNode{}
+		}
+		return output.JSON(os.Stdout, nodes)
+	}
+	if format == output.FormatCompact {
+		output.TreeCompact(os.Stdout, nodes)
+		return nil
+	}
+
+	output.TreeTable(os.Stdout, nodes)
+	return nil
+}
@@ -12,8 +12,10 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
+	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
 	"github.com/antopolskiy/kanban-md/internal/tui"
 	"github.com/antopolskiy/kanban-md/internal/watcher"
 )
@@ -24,6 +26,10 @@ var tuiCmd = &cobra.Command{
 	Long: `Launches the interactive terminal UI for browsing and managing the
 kanban board. The board live-reloads when task files change on disk.
 
+With --board, several boards open as tabs: the current board first, then
+each --board in order. Switch with 1-9, tab, and shift+tab. Every tab has
+its own watcher, so hidden boards stay current.
+
 Navigate with arrow keys or vim-style h/j/k/l, press ? for help.`,
 	RunE: runTUI,
 }
@@ -31,6 +37,7 @@ Navigate with arrow keys or vim-style h/j/k/l, press ? for help.`,
 func init() {
 	tuiCmd.Flags().Bool("hide-empty-columns", false, "hide empty columns in TUI (overrides config)")
 	tuiCmd.Flags().Bool("show-empty-columns", false, "show empty columns in TUI (overrides config)")
+	tuiCmd.Flags().StringArray("board", nil, "also open the board in this project or kanban directory as a tab (repeatable)")
 	rootCmd.AddCommand(tuiCmd)
 }
 
@@ -47,38 +54,101 @@ func runTUI(cmd *cobra.Command, _ []string) error {
 		cmd = &cobra.Command{Use: "tui"}
 		cmd.Flags().Bool("hide-empty-columns", false, "")
 		cmd.Flags().Bool("show-empty-columns", false, "")
+		cmd.Flags().StringArray("board", nil, "")
 	}
+	extra, _ := cmd.Flags().GetStringArray("board")
 
 	cfg, err := loadConfig()
 	if err != nil {
-		if isBoardNotFound(err) {
-			cfg, err = offerInitTUI()
-			if err != nil {
+		switch {
+		case isBoardNotFound(err) && len(extra) > 0:
+			cfg = nil // open just the --board boards
+		case isBoardNotFound(err) && !flagReadOnly:
+			if cfg, err = offerInitTUI(); err != nil {
 				return err
 			}
-		} else {
+		default:
 			return err
 		}
 	}
 
-	hideEmptyColumns, err := resolveHideEmptyColumns(cmd, cfg)
+	cfgs, err := tuiBoardConfigs(cfg, extra)
 	if err != nil {
 		return err
 	}
-
-	model := tui.NewBoard(cfg)
-	model.SetHideEmptyColumns(hideEmptyColumns)
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	models := make([]*tui.Board, len(cfgs))
+	for i, c := range cfgs {
+		if models[i], err = newTUIBoard(cmd, c); err != nil {
+			return err
+		}
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	go startTUIWatcher(ctx, model, p)
+	if len(models) == 1 {
+		p := tea.NewProgram(models[0], tea.WithAltScreen())
+		go startTUIWatcher(ctx, models[0].WatchPaths(), tui.ReloadMsg{}, p)
+		_, err = p.Run()
+		return err
+	}
 
+	p := tea.NewProgram(tui.NewWorkspace(models), tea.WithAltScreen())
+	for i, m := range models {
+		go startTUIWatcher(ctx, m.WatchPaths(), tui.TabReloadMsg{Tab: i}, p)
+	}
 	_, err = p.Run()
 	return err
 }
 
+// tuiBoardConfigs returns the configs of the boards to open: cfg, when
+// there is a current board, then each --board directory, skipping repeats.
+func tuiBoardConfigs(cfg *config.Config, extra []string) ([]*config.Config, error) {
+	var cfgs []*config.Config
+	seen := make(map[string]bool)
+	if cfg != nil {
+		cfgs = append(cfgs, cfg)
+		seen[cfg.Dir()] = true
+	}
+	for _, path := range extra {
+		dir, err := config.FindDir(path)
+		if err != nil {
+			return nil, err
+		}
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		c, err := config.Load(dir)
+		if err != nil {
+			return nil, err
+		}
+		report, err := task.EnsureConsistency(c)
+		if err != nil {
+			return nil, err
+		}
+		printWarnings(report.Warnings)
+		printConsistencyRepairs(report.Repairs)
+		board.SetLogSinks(c.Dir(), c.Log.Sinks)
+		board.SetAutoCommit(c)
+		cfgs = append(cfgs, c)
+	}
+	return cfgs, nil
+}
+
+// newTUIBoard creates the TUI model for one board.
+func newTUIBoard(cmd *cobra.Command, cfg *config.Config) (*tui.Board, error) {
+	hideEmptyColumns, err := resolveHideEmptyColumns(cmd, cfg)
+	if err != nil {
+		return nil, err
+	}
+	model := tui.NewBoard(cfg)
+	model.SetHideEmptyColumns(hideEmptyColumns)
+	model.SetLocation(displayLocation(cfg))
+	model.SetReadOnly(flagReadOnly || cfg.Board.ReadOnly)
+	return model, nil
+}
+
 func resolveHideEmptyColumns(cmd *cobra.Command, cfg *config.Config) (bool, error) {
 	hideEmptyColumns := cfg.TUI.HideEmptyColumns
 	if cmd == nil {
@@ -138,10 +208,10 @@ func offerInitTUI() (*config.Config, error) {
 	return cfg, nil
 }
 
-func startTUIWatcher(ctx context.Context, model *tui.Board, p *tea.Program) {
-	paths := model.WatchPaths()
+// startTUIWatcher sends msg to p whenever files under paths change.
+func startTUIWatcher(ctx context.Context, paths []string, msg tea.Msg, p *tea.Program) {
 	w, err := watcher.New(paths, func() {
-		p.Send(tui.ReloadMsg{})
+		p.Send(msg)
 	})
 	if err != nil {
 		return // non-fatal: TUI works without live refresh
@@ -438,7 +438,7 @@ func TestStartTUIWatcher_CancelledContext(t *testing.T) {
 	go func() {
 		// Pass nil for Program — startTUIWatcher only uses p.Send which
 		// won't be called because the context is already canceled.
-		startTUIWatcher(ctx, model, nil)
+		startTUIWatcher(ctx, model.WatchPaths(), tui.ReloadMsg{}, nil)
 		close(done)
 	}()
 
@@ -463,7 +463,7 @@ func TestStartTUIWatcher_InvalidPath(t *testing.T) {
 	// Should return immediately because watcher.New fails (non-fatal).
 	done := make(chan struct{})
 	go func() {
-		startTUIWatcher(ctx, model, nil)
+		startTUIWatcher(ctx, model.WatchPaths(), tui.ReloadMsg{}, nil)
 		close(done)
 	}()
 
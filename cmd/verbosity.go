@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Verbosity levels for -v and -vv.
+const (
+	verboseDecisions = 1 // -v: which checks ran and what they decided
+	verboseDetails   = 2 // -vv: also board loading and counts
+)
+
+// realStdout is stdout before -q redirected it, so errors can still be
+// reported.
+var realStdout = os.Stdout
+
+// applyVerbosity validates -q/-v and, for -q, sends stdout to the null
+// device so only errors and the exit code remain. The TUI keeps its
+// terminal.
+func applyVerbosity(cmd *cobra.Command) error {
+	if flagQuiet && flagVerbose > 0 {
+		return clierr.New(clierr.InvalidInput, "--quiet and --verbose cannot be combined")
+	}
+	if !flagQuiet || cmd.Name() == "tui" {
+		return nil
+	}
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", os.DevNull, err)
+	}
+	os.Stdout = devNull
+	return nil
+}
+
+// restoreStdout undoes -q so the final error reaches the caller.
+func restoreStdout() {
+	os.Stdout = realStdout
+}
+
+// verbosef prints a decision detail to stderr when -v was given at least
+// level times.
+func verbosef(level int, format string, args ...any) {
+	if flagVerbose < level {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "verbose: "+format+"\n", args...)
+}
+
+// decision renders a check outcome for verbose output.
+func decision(err error) string {
+	if err != nil {
+		return "denied: " + err.Error()
+	}
+	return "allowed"
+}
+
+// verboseWIPCheck reports a column WIP check.
+func verboseWIPCheck(cfg *config.Config, counts map[string]int, targetStatus, currentStatus string, err error) {
+	if flagVerbose < verboseDecisions {
+		return
+	}
+	limit := cfg.WIPLimit(targetStatus)
+	switch {
+	case limit == 0:
+		verbosef(verboseDecisions, "WIP check %s: no limit", targetStatus)
+	case currentStatus == targetStatus:
+		verbosef(verboseDecisions, "WIP check %s: task already there, limit %d not consulted", targetStatus, limit)
+	default:
+		verbosef(verboseDecisions, "WIP check %s: %d/%d, %s", targetStatus, counts[targetStatus], limit, decision(err))
+	}
+}
+
+// verboseClaimCheck reports a claim check on t, which CheckClaim may have
+// cleared if the claim had expired.
+func verboseClaimCheck(t *task.Task, claimedBy, claimant string, timeout time.Duration, err error) {
+	if flagVerbose < verboseDecisions {
+		return
+	}
+	id := output.FormatID(t.ID)
+	who := claimant
+	if who == "" {
+		who = "(no claimant)"
+	}
+	switch {
+	case claimedBy == "":
+		verbosef(verboseDecisions, "claim check %s: unclaimed, allowed", id)
+	case err == nil && t.ClaimedBy == "":
+		verbosef(verboseDecisions, "claim check %s: claim by %s expired after %s, allowed", id, claimedBy, timeout)
+	default:
+		verbosef(verboseDecisions, "claim check %s: claimed by %s, evaluated for %s, %s", id, claimedBy, who, decision(err))
+	}
+}
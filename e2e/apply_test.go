@@ -0,0 +1,143 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// apply tests
+// ---------------------------------------------------------------------------
+
+type applyJSON struct {
+	Applied int                 `json:"applied"`
+	DryRun  bool                `json:"dry_run"`
+	Results []batchOpResultJSON `json:"results"`
+}
+
+func writeChanges(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "changes.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// boardFiles returns the task file names on the board, for before/after checks.
+func boardFiles(t *testing.T, kanbanDir string) string {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(kanbanDir, "tasks"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func TestApplyRunsAllOperations(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Existing")
+	changes := writeChanges(t, `
+- op: create
+  args: [Write docs]
+  flags: {priority: high, tags: [docs]}
+- op: move
+  args: ["1", todo]
+- op: edit
+  args: ["2"]
+  flags: {estimate: 3}
+`)
+
+	var res applyJSON
+	runKanbanJSON(t, kanbanDir, &res, "apply", changes)
+	if res.Applied != 3 || res.DryRun {
+		t.Fatalf("apply = %+v, want 3 applied", res)
+	}
+
+	var moved taskJSON
+	runKanbanJSON(t, kanbanDir, &moved, "show", "1")
+	if moved.Status != statusTodo {
+		t.Errorf("task 1 status = %q, want todo", moved.Status)
+	}
+}
+
+func TestApplyRollsBackOnFailure(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Existing")
+	cfgBefore, err := os.ReadFile(filepath.Join(kanbanDir, "config.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	filesBefore := boardFiles(t, kanbanDir)
+
+	changes := writeChanges(t, `
+- op: create
+  args: [Half applied]
+- op: edit
+  args: ["1"]
+  flags: {title: Renamed}
+- op: move
+  args: ["99", done]
+`)
+	errResp := runKanbanJSONError(t, kanbanDir, "apply", changes)
+	if errResp.Code != "TASK_NOT_FOUND" {
+		t.Errorf("code = %q, want TASK_NOT_FOUND", errResp.Code)
+	}
+	if !strings.Contains(errResp.Error, "op 3") || !strings.Contains(errResp.Error, "rolled back") {
+		t.Errorf("error = %q, want op number and rollback note", errResp.Error)
+	}
+
+	if got := boardFiles(t, kanbanDir); got != filesBefore {
+		t.Errorf("task files = %s, want %s", got, filesBefore)
+	}
+	cfgAfter, _ := os.ReadFile(filepath.Join(kanbanDir, "config.yml"))
+	if string(cfgAfter) != string(cfgBefore) {
+		t.Error("config.yml should be restored (next_id unchanged)")
+	}
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "show", "1")
+	if tk.Title != "Existing" {
+		t.Errorf("title = %q, want edit rolled back", tk.Title)
+	}
+}
+
+func TestApplyValidatesBeforeWriting(t *testing.T) {
+	kanbanDir := initBoard(t)
+	filesBefore := boardFiles(t, kanbanDir)
+
+	changes := writeChanges(t, `
+- op: create
+  args: [Should not exist]
+- op: edit
+  args: ["1"]
+  flags: {colour: red}
+`)
+	r := runKanban(t, kanbanDir, "apply", changes)
+	if r.exitCode != 3 {
+		t.Errorf("exit = %d, want 3 (validation)\nstderr: %s", r.exitCode, r.stderr)
+	}
+	if !strings.Contains(r.stderr, "nothing was changed") {
+		t.Errorf("stderr = %q", r.stderr)
+	}
+	if got := boardFiles(t, kanbanDir); got != filesBefore {
+		t.Errorf("task files = %s, want none created", got)
+	}
+}
+
+func TestApplyDryRunFromStdin(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	r := runKanbanStdin(t, kanbanDir, `[{"op": "create", "args": ["Preview"]}]`, "--json", "apply", "--dry-run", "-")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, `"dry_run": true`) {
+		t.Fatalf("dry run: exit %d\nstdout: %s\nstderr: %s", r.exitCode, r.stdout, r.stderr)
+	}
+	if got := boardFiles(t, kanbanDir); got != "" {
+		t.Errorf("dry run left files: %s", got)
+	}
+}
@@ -0,0 +1,108 @@
+package e2e_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gitLog returns the commit subjects of the repository at dir, newest first.
+func gitLog(t *testing.T, dir string) []string {
+	t.Helper()
+	out, err := exec.Command("git", "-C", dir, "log", "--format=%s").CombinedOutput() //nolint:gosec,noctx // test git command
+	if err != nil {
+		t.Fatalf("git log: %v\n%s", err, out)
+	}
+	return strings.Split(strings.TrimSpace(string(out)), "\n")
+}
+
+func TestGitAutoCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	kanbanDir := initBoard(t)
+	repo := filepath.Dir(kanbanDir)
+	// init ignores the board by default; auto-commit needs it tracked.
+	if err := os.Remove(filepath.Join(repo, ".gitignore")); err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "-C", repo, "init", "--quiet").CombinedOutput(); err != nil { //nolint:gosec,noctx // test git command
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+
+	mustCreateTask(t, kanbanDir, "Before auto-commit")
+	if out, err := exec.Command("git", "-C", repo, "log").CombinedOutput(); err == nil { //nolint:gosec,noctx // test git command
+		t.Fatalf("mutation committed with git.auto_commit off:\n%s", out)
+	}
+
+	runKanban(t, kanbanDir, "config", "set", "git.auto_commit", "true")
+	mustCreateTask(t, kanbanDir, "Tracked")
+	runKanban(t, kanbanDir, "move", "2", "todo")
+	runKanban(t, kanbanDir, "delete", "1", "--yes")
+
+	want := []string{
+		"kanban: delete #1 Before auto-commit",
+		"kanban: move #2 backlog -> todo",
+		"kanban: create #2 Tracked",
+	}
+	got := gitLog(t, repo)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("commits = %q, want %q", got, want)
+	}
+}
+
+func TestGitAutoCommitApply(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	kanbanDir := initBoard(t)
+	repo := filepath.Dir(kanbanDir)
+	if err := os.Remove(filepath.Join(repo, ".gitignore")); err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "-C", repo, "init", "--quiet").CombinedOutput(); err != nil { //nolint:gosec,noctx // test git command
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	runKanban(t, kanbanDir, "config", "set", "git.auto_commit", "true")
+	mustCreateTask(t, kanbanDir, "Existing")
+	changes := writeChanges(t, `
+- op: create
+  args: [First]
+- op: create
+  args: [Second]
+`)
+
+	runKanban(t, kanbanDir, "apply", "--dry-run", changes)
+	if got := gitLog(t, repo); len(got) != 1 {
+		t.Errorf("commits after apply --dry-run = %q, want only the earlier create", got)
+	}
+
+	failing := writeChanges(t, `
+- op: create
+  args: [Kept only if all succeed]
+- op: move
+  args: ["99", todo]
+`)
+	runKanbanJSONError(t, kanbanDir, "apply", failing)
+	if got := gitLog(t, repo); len(got) != 1 {
+		t.Errorf("commits after a failed apply = %q, want only the earlier create", got)
+	}
+
+	runKanban(t, kanbanDir, "apply", changes)
+	if got := gitLog(t, repo); len(got) != 2 || got[0] != "kanban: 2 changes" {
+		t.Errorf("commits after apply = %q, want one commit for both creates", got)
+	}
+	if out, _ := exec.Command("git", "-C", repo, "status", "--porcelain", "--", "kanban/tasks", "kanban/activity.jsonl", "kanban/config.yml").CombinedOutput(); len(out) != 0 { //nolint:gosec,noctx // test git command
+		t.Errorf("board not fully committed after apply:\n%s", out)
+	}
+}
@@ -0,0 +1,103 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// batch --stdin tests
+// ---------------------------------------------------------------------------
+
+type batchOpResultJSON struct {
+	Op    string    `json:"op"`
+	ID    int       `json:"id"`
+	OK    bool      `json:"ok"`
+	Code  string    `json:"code"`
+	Error string    `json:"error"`
+	Task  *taskJSON `json:"task"`
+}
+
+func runBatchStdin(t *testing.T, kanbanDir, ops string) ([]batchOpResultJSON, result) {
+	t.Helper()
+	r := runKanbanStdin(t, kanbanDir, ops, "--json", "batch", "--stdin")
+	var results []batchOpResultJSON
+	if err := json.Unmarshal([]byte(r.stdout), &results); err != nil {
+		t.Fatalf("parsing batch results: %v\nstdout: %s\nstderr: %s", err, r.stdout, r.stderr)
+	}
+	return results, r
+}
+
+func TestBatchStdinRunsOperationsInOrder(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Existing")
+
+	results, r := runBatchStdin(t, kanbanDir, `[
+		{"op": "create", "args": ["Write docs"], "flags": {"priority": "high", "tags": ["docs", "x"]}},
+		{"op": "move", "args": ["2", "todo"]},
+		{"op": "edit", "args": ["1"], "flags": {"title": "Renamed"}},
+		{"op": "create", "args": ["Plain"]},
+		{"op": "delete", "args": ["1"]}
+	]`)
+	if r.exitCode != 0 {
+		t.Fatalf("exit = %d, stderr: %s", r.exitCode, r.stderr)
+	}
+	if len(results) != 5 {
+		t.Fatalf("results = %d, want 5", len(results))
+	}
+	for i, res := range results {
+		if !res.OK {
+			t.Errorf("op %d (%s) failed: %s", i+1, res.Op, res.Error)
+		}
+	}
+	if results[0].ID != 2 || results[0].Task == nil || len(results[0].Task.Tags) != 2 {
+		t.Errorf("create result = %+v, want task #2 with 2 tags", results[0])
+	}
+	if results[1].Task == nil || results[1].Task.Status != statusTodo {
+		t.Errorf("move result = %+v, want status todo", results[1])
+	}
+
+	// Flags must not leak between operations: the second create gets defaults.
+	var plain taskJSON
+	runKanbanJSON(t, kanbanDir, &plain, "show", "3")
+	if plain.Priority != "medium" || len(plain.Tags) != 0 {
+		t.Errorf("second create = %+v, want default priority and no tags", plain)
+	}
+}
+
+func TestBatchStdinReportsFailuresAndContinues(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Only")
+
+	results, r := runBatchStdin(t, kanbanDir, `[
+		{"op": "move", "args": ["99", "todo"]},
+		{"op": "edit", "args": ["1"], "flags": {"no-such-flag": true}},
+		{"op": "archive", "args": ["1"]},
+		{"op": "move", "args": ["1", "todo"]}
+	]`)
+	if r.exitCode != 1 {
+		t.Errorf("exit = %d, want 1", r.exitCode)
+	}
+	wantCodes := []string{"TASK_NOT_FOUND", "INVALID_INPUT", "INVALID_INPUT", ""}
+	for i, want := range wantCodes {
+		if results[i].Code != want {
+			t.Errorf("op %d code = %q, want %q", i+1, results[i].Code, want)
+		}
+	}
+	if !results[3].OK {
+		t.Errorf("last op should still run: %+v", results[3])
+	}
+}
+
+func TestBatchStdinRequiresFlagAndValidJSON(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	if errResp := runKanbanJSONError(t, kanbanDir, "batch"); errResp.Code != "INVALID_INPUT" {
+		t.Errorf("without --stdin code = %q, want INVALID_INPUT", errResp.Code)
+	}
+
+	r := runKanbanStdin(t, kanbanDir, `[{"op": "create", "arg": ["typo"]}]`, "--json", "batch", "--stdin")
+	if r.exitCode != 3 {
+		t.Errorf("unknown field exit = %d, want 3\nstdout: %s", r.exitCode, r.stdout)
+	}
+}
@@ -60,6 +60,70 @@ func TestBatchMovePartialFailure(t *testing.T) {
 	}
 }
 
+func TestBatchMoveFill(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 2)
+	mustCreateTask(t, kanbanDir, "Low", "--priority", "low")
+	mustCreateTask(t, kanbanDir, "Critical", "--priority", "critical")
+	mustCreateTask(t, kanbanDir, "High", "--priority", "high")
+
+	r := runKanban(t, kanbanDir, "--json", "move", "1,2,3", "in-progress", "--fill", "--claim", claimTestAgent)
+	if r.exitCode != 0 {
+		t.Fatalf("move --fill exit = %d, want 0 (deferrals are not failures): %s", r.exitCode, r.stderr)
+	}
+
+	var results []batchResultJSON
+	if err := json.Unmarshal([]byte(r.stdout), &results); err != nil {
+		t.Fatalf("parsing batch results: %v\nstdout: %s", err, r.stdout)
+	}
+	if len(results) != 3 {
+		t.Fatalf("results = %d, want 3", len(results))
+	}
+	if results[0].ID != 2 || !results[0].OK || results[1].ID != 3 || !results[1].OK {
+		t.Errorf("critical and high should move first: %+v", results)
+	}
+	if results[2].ID != 1 || results[2].OK || !results[2].Deferred || results[2].Code != codeWIPLimitExceeded {
+		t.Errorf("low should be deferred: %+v", results[2])
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "move", "1", "in-progress", "--fill", "--claim", claimTestAgent)
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "deferred 1") {
+		t.Errorf("table fill (exit %d): stdout %q stderr %q", r.exitCode, r.stdout, r.stderr)
+	}
+}
+
+func TestBatchMoveWarnings(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	mustCreateTask(t, kanbanDir, "Normal task")
+	mustCreateTask(t, kanbanDir, "Blocked task")
+	mustCreateTask(t, kanbanDir, "Expedite task", "--class", "expedite")
+	runKanban(t, kanbanDir, "edit", "2", "--block", "waiting on vendor")
+	runKanban(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimTestAgent)
+
+	var results []batchResultJSON
+	runKanbanJSON(t, kanbanDir, &results, "move", "2,3", statusTodo)
+	if len(results) != 2 {
+		t.Fatalf("results = %d, want 2", len(results))
+	}
+	if len(results[0].Warnings) != 1 || !strings.Contains(results[0].Warnings[0], "is blocked") {
+		t.Errorf("task #2 warnings = %q, want the blocked warning", results[0].Warnings)
+	}
+	if len(results[1].Warnings) != 0 {
+		t.Errorf("task #3 warnings = %q, want none", results[1].Warnings)
+	}
+
+	// The expedite task passes the full column, but the move is not silent.
+	r := runKanban(t, kanbanDir, "--json", "move", "3,1", statusInProgress, "--claim", claimTestAgent)
+	if err := json.Unmarshal([]byte(r.stdout), &results); err != nil {
+		t.Fatalf("parsing batch results: %v\nstdout: %s", err, r.stdout)
+	}
+	if len(results) == 0 || !results[0].OK {
+		t.Fatalf("results = %+v, want expedite move ok", results)
+	}
+	if len(results[0].Warnings) != 1 || !strings.Contains(results[0].Warnings[0], "bypasses column limits") {
+		t.Errorf("task #3 warnings = %q, want the WIP bypass warning", results[0].Warnings)
+	}
+}
+
 func TestBatchEditMultiple(t *testing.T) {
 	kanbanDir := initBoard(t)
 	mustCreateTask(t, kanbanDir, "Task A")
@@ -0,0 +1,162 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// block / unblock command tests
+// ---------------------------------------------------------------------------
+
+type blockedTaskJSON struct {
+	ID           int    `json:"id"`
+	Blocked      bool   `json:"blocked"`
+	BlockReason  string `json:"block_reason"`
+	BlockedUntil string `json:"blocked_until"`
+	BlockedOn    string `json:"blocked_on"`
+}
+
+func TestBlockWithUntil(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Integrate vendor API")
+	mustCreateTask(t, kanbanDir, "Unrelated")
+	until := time.Now().AddDate(0, 0, 3).Format("2006-01-02")
+
+	var blocked blockedTaskJSON
+	runKanbanJSON(t, kanbanDir, &blocked, "block", "1", "--reason", "waiting on vendor", "--until", until)
+	if !blocked.Blocked || blocked.BlockReason != "waiting on vendor" || blocked.BlockedUntil != until {
+		t.Fatalf("block = %+v", blocked)
+	}
+
+	// The expected unblock date counts in due reports.
+	var due []taskJSON
+	runKanbanJSON(t, kanbanDir, &due, "list", "--due-within", "7d")
+	if len(due) != 1 || due[0].ID != 1 {
+		t.Errorf("list --due-within = %+v, want task 1 only", due)
+	}
+
+	// --until alone on a blocked task moves the date and keeps the reason.
+	later := time.Now().AddDate(0, 0, 10).Format("2006-01-02")
+	runKanbanJSON(t, kanbanDir, &blocked, "block", "1", "--until", later)
+	if blocked.BlockReason != "waiting on vendor" || blocked.BlockedUntil != later {
+		t.Errorf("re-block = %+v", blocked)
+	}
+
+	var unblocked blockedTaskJSON
+	runKanbanJSON(t, kanbanDir, &unblocked, "unblock", "1")
+	if unblocked.Blocked || unblocked.BlockReason != "" || unblocked.BlockedUntil != "" {
+		t.Errorf("unblock = %+v", unblocked)
+	}
+}
+
+func TestBlockReviewWarning(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Stuck")
+	runKanban(t, kanbanDir, "block", "1", "--reason", "legal review", "--until", "tomorrow")
+
+	// Backdate the expected unblock, as if the date had passed.
+	path := filepath.Join(kanbanDir, "tasks", "001-stuck.md")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = regexp.MustCompile(`(?m)^blocked_until: .*$`).ReplaceAll(data, []byte("blocked_until: 2020-01-01"))
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{{"list"}, {"show", "1"}} {
+		r := runKanban(t, kanbanDir, args...)
+		if !strings.Contains(r.stderr, "expected to be unblocked by 2020-01-01") {
+			t.Errorf("%v stderr = %q, want a review warning", args, r.stderr)
+		}
+	}
+}
+
+func TestBlockInvalid(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Task")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "block", "1")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("block without reason: code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "block", "1", "--reason", "x", "--until", "2020-01-01")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("block until the past: code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "unblock", "1")
+	if errResp.Code != "NO_CHANGES" {
+		t.Errorf("unblock unblocked task: code = %q, want NO_CHANGES", errResp.Code)
+	}
+}
+
+func TestBlockOnExternal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/acme/api/issues/7":
+			fmt.Fprint(w, `{"state": "closed"}`)
+		case "/repos/acme/api/issues/8":
+			fmt.Fprint(w, `{"state": "open"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	env := []string{"KANBAN_GITHUB_API=" + srv.URL}
+
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Closed upstream")
+	mustCreateTask(t, kanbanDir, "Open upstream")
+	mustCreateTask(t, kanbanDir, "Ticket")
+
+	var blocked blockedTaskJSON
+	runKanbanJSON(t, kanbanDir, &blocked, "block", "1", "--reason", "upstream fix",
+		"--on", "https://github.com/acme/api/issues/7")
+	if blocked.BlockedOn != "https://github.com/acme/api/issues/7" {
+		t.Fatalf("block --on = %+v", blocked)
+	}
+	runKanban(t, kanbanDir, "block", "2", "--reason", "upstream fix", "--on", "acme/api#8")
+	runKanban(t, kanbanDir, "block", "3", "--reason", "vendor", "--on", "OPS-12")
+
+	r := runKanban(t, kanbanDir, "show", "1")
+	if !strings.Contains(r.stdout, "acme/api/issues/7") {
+		t.Errorf("show should display blocked_on, got:\n%s", r.stdout)
+	}
+
+	r = runKanbanEnv(t, kanbanDir, env, "--json", "unblock", "--external")
+	if r.exitCode != 0 {
+		t.Fatalf("unblock --external failed: %s", r.stderr)
+	}
+	var res struct {
+		Checked []struct {
+			ID        int  `json:"id"`
+			Closed    bool `json:"closed"`
+			Unblocked bool `json:"unblocked"`
+			Skipped   bool `json:"skipped"`
+		} `json:"checked"`
+	}
+	if err := json.Unmarshal([]byte(r.stdout), &res); err != nil {
+		t.Fatalf("parsing output: %v\n%s", err, r.stdout)
+	}
+	if len(res.Checked) != 3 || !res.Checked[0].Unblocked || res.Checked[1].Closed || !res.Checked[2].Skipped {
+		t.Errorf("unblock --external = %+v", res.Checked)
+	}
+
+	for id, want := range map[string]bool{"1": false, "2": true, "3": true} {
+		var got blockedTaskJSON
+		runKanbanJSON(t, kanbanDir, &got, "show", id)
+		if got.Blocked != want {
+			t.Errorf("task %s blocked = %v, want %v", id, got.Blocked, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -155,6 +156,43 @@ func TestBoardGroupByInvalid(t *testing.T) {
 	}
 }
 
+func TestBoardGroupByMatrix(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "A", "--status", statusTodo, "--assignee", "alice")
+	mustCreateTask(t, kanbanDir, "B", "--status", statusTodo, "--assignee", "bob")
+	mustCreateTask(t, kanbanDir, "C", "--status", statusReview, "--assignee", "alice")
+
+	var m struct {
+		Columns []string `json:"columns"`
+		Rows    []struct {
+			Key    string `json:"key"`
+			Counts []int  `json:"counts"`
+			Total  int    `json:"total"`
+		} `json:"rows"`
+		Total int `json:"total"`
+	}
+	runKanbanJSON(t, kanbanDir, &m, "board", "--group-by", "assignee,status")
+	if m.Total != 3 || len(m.Rows) != 2 || m.Rows[0].Key != "alice" || m.Rows[0].Total != 2 {
+		t.Fatalf("matrix = %+v", m)
+	}
+	todo := slices.Index(m.Columns, statusTodo)
+	review := slices.Index(m.Columns, statusReview)
+	if todo < 0 || review < 0 || m.Rows[0].Counts[todo] != 1 || m.Rows[0].Counts[review] != 1 {
+		t.Errorf("alice counts = %v over %v", m.Rows[0].Counts, m.Columns)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "board", "--group-by", "assignee,status")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "assignee / status") {
+		t.Errorf("matrix table (exit %d):\n%s", r.exitCode, r.stdout)
+	}
+
+	for _, bad := range []string{"status,status", "status,assignee,tag", "status,nope"} {
+		if errResp := runKanbanJSONError(t, kanbanDir, "board", "--group-by", bad); errResp.Code != "INVALID_GROUP_BY" {
+			t.Errorf("--group-by %s code = %q, want INVALID_GROUP_BY", bad, errResp.Code)
+		}
+	}
+}
+
 func TestBoardCompactOutput(t *testing.T) {
 	kanbanDir := initBoard(t)
 	mustCreateTask(t, kanbanDir, "Board compact task")
@@ -164,3 +202,25 @@ func TestBoardCompactOutput(t *testing.T) {
 		t.Fatalf("board --compact failed (exit %d): %s", r.exitCode, r.stderr)
 	}
 }
+
+func TestGroupByDueAndAge(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Late", "--due", "2020-01-01")
+	mustCreateTask(t, kanbanDir, "Undated")
+
+	var grouped struct {
+		Groups []struct {
+			Key   string `json:"key"`
+			Total int    `json:"total"`
+		} `json:"groups"`
+	}
+	runKanbanJSON(t, kanbanDir, &grouped, "list", "--group-by", "due")
+	if len(grouped.Groups) != 2 || grouped.Groups[0].Key != "overdue" || grouped.Groups[1].Key != "none" {
+		t.Errorf("list --group-by due = %+v, want overdue then none", grouped.Groups)
+	}
+
+	runKanbanJSON(t, kanbanDir, &grouped, "board", "--group-by", "age")
+	if len(grouped.Groups) != 1 || grouped.Groups[0].Key != "0-1d" || grouped.Groups[0].Total != 2 {
+		t.Errorf("board --group-by age = %+v, want one 0-1d group", grouped.Groups)
+	}
+}
@@ -326,6 +326,23 @@ claimed_at: 2099-01-01T00:00:00Z
 	}
 }
 
+func TestClaimConflictIsRetryable(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Contested")
+	runKanban(t, kanbanDir, "edit", "1", "--claim", "agent-alpha")
+
+	// The claim expires after claim_timeout (1h by default).
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--title", "Mine", "--claim", "agent-beta")
+	if errResp.Code != codeTaskClaimed || !errResp.Retryable || errResp.RetryAfter < 3500 || errResp.RetryAfter > 3600 {
+		t.Errorf("claim conflict = %+v, want retryable after about 3600 seconds", errResp)
+	}
+
+	errResp = runKanbanJSONError(t, kanbanDir, "edit", "1", "--priority", "urgent", "--claim", "agent-alpha")
+	if errResp.Retryable || errResp.RetryAfter != 0 {
+		t.Errorf("validation error = %+v, want not retryable", errResp)
+	}
+}
+
 func TestPickSkipsClaimedTasks(t *testing.T) {
 	kanbanDir := initBoard(t)
 
@@ -0,0 +1,69 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColor_PipedOutputIsPlainByDefault(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Color task", "--tags", "bug", "--priority", "critical")
+	// CLICOLOR_FORCE would otherwise make the styling library emit ANSI.
+	t.Setenv("CLICOLOR_FORCE", "1")
+
+	r := runKanban(t, kanbanDir, "--table", "list")
+	if r.exitCode != 0 {
+		t.Fatalf("list failed: %s", r.stderr)
+	}
+	if strings.Contains(r.stdout, "\x1b[") {
+		t.Errorf("piped table output contains ANSI codes:\n%q", r.stdout)
+	}
+}
+
+func TestColor_Always(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Color task", "--priority", "critical")
+
+	r := runKanban(t, kanbanDir, "--table", "--color", "always", "list")
+	if !strings.Contains(r.stdout, "\x1b[") {
+		t.Errorf("--color always should keep ANSI codes when piped:\n%q", r.stdout)
+	}
+
+	// An explicit --color wins over NO_COLOR.
+	t.Setenv("NO_COLOR", "1")
+	r = runKanban(t, kanbanDir, "--table", "--color", "always", "list")
+	if !strings.Contains(r.stdout, "\x1b[") {
+		t.Errorf("--color always should override NO_COLOR:\n%q", r.stdout)
+	}
+}
+
+func TestColor_NeverAndNoColor(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Color task", "--priority", "critical")
+	t.Setenv("CLICOLOR_FORCE", "1")
+
+	for _, args := range [][]string{
+		{"--color", "never"},
+		{"--no-color"},
+	} {
+		r := runKanban(t, kanbanDir, append(append([]string{"--table"}, args...), "list")...)
+		if strings.Contains(r.stdout, "\x1b[") {
+			t.Errorf("%v output contains ANSI codes:\n%q", args, r.stdout)
+		}
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	r := runKanban(t, kanbanDir, "--table", "list")
+	if strings.Contains(r.stdout, "\x1b[") {
+		t.Errorf("NO_COLOR output contains ANSI codes:\n%q", r.stdout)
+	}
+}
+
+func TestColor_Invalid(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "--color", "sometimes", "list")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -186,3 +188,69 @@ func TestConfigTableOutput(t *testing.T) {
 // ---------------------------------------------------------------------------
 // Context command tests
 // ---------------------------------------------------------------------------
+
+func TestConfigDisplayTimezone(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Zoned task")
+	r := runKanban(t, kanbanDir, "edit", "1", "--started", "2026-02-08")
+	if r.exitCode != 0 {
+		t.Fatalf("edit failed: %s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "config", "set", "display.timezone", "Asia/Tokyo")
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "show", "1")
+	if !strings.Contains(r.stdout, "2026-02-08 09:00") {
+		t.Errorf("show should render started in Asia/Tokyo:\n%s", r.stdout)
+	}
+	r = runKanban(t, kanbanDir, "--table", "--utc", "show", "1")
+	if !strings.Contains(r.stdout, "2026-02-08 00:00") {
+		t.Errorf("show --utc should render started in UTC:\n%s", r.stdout)
+	}
+
+	// Frontmatter stays in UTC regardless of the display zone.
+	data, err := os.ReadFile(filepath.Join(kanbanDir, "tasks", "001-zoned-task.md")) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading task file: %v", err)
+	}
+	if !strings.Contains(string(data), "started: 2026-02-08T00:00:00Z") {
+		t.Errorf("task file should store UTC:\n%s", data)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "config", "set", "display.timezone", "Mars/Olympus")
+	if errResp.Code == "" {
+		t.Error("expected error for unknown timezone")
+	}
+}
+
+func TestConfigTagStyles(t *testing.T) {
+	kanbanDir := initBoard(t)
+	runKanban(t, kanbanDir, "create", "Crash on start", "--tags", "bug,ui")
+
+	r := runKanban(t, kanbanDir, "config", "set", "tags.styles", "bug=196:🐛,ui=#88f")
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "config", "get", "tags.styles")
+	if !strings.Contains(r.stdout, "bug=196:🐛, ui=#88f") {
+		t.Errorf("config get = %q, want both styles", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "list")
+	if !strings.Contains(r.stdout, "🐛 bug") {
+		t.Errorf("list should show the tag icon:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "config", "set", "tags.styles", "bug=crimson")
+	if r.exitCode == 0 {
+		t.Error("config set should reject a named color")
+	}
+	errResp := runKanbanJSONError(t, kanbanDir, "config", "set", "tags.styles", "=196")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
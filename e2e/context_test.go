@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // ---------------------------------------------------------------------------
@@ -213,20 +214,65 @@ func TestContextSectionsFilter(t *testing.T) {
 	}
 }
 
-func TestContextSectionsReadyIsIgnored(t *testing.T) {
+func TestContextOptionalSections(t *testing.T) {
 	kanbanDir := initBoard(t)
-	mustCreateTask(t, kanbanDir, "Accepted-ish task")
-	runKanban(t, kanbanDir, "--json", "move", "1", statusTodo)
+	mustCreateTask(t, kanbanDir, "Ready task", "--status", statusTodo)
+	mustCreateTask(t, kanbanDir, "Claimed task", "--status", statusTodo, "--claim", claimTestAgent)
+	mustCreateTask(t, kanbanDir, "Waiting task", "--status", statusTodo, "--depends-on", "1")
+	mustCreateTask(t, kanbanDir, "Due task", "--status", "backlog", "--due", time.Now().AddDate(0, 0, 3).Format("2006-01-02"))
+	mustCreateTask(t, kanbanDir, "Due later", "--status", "backlog", "--due", time.Now().AddDate(0, 0, 30).Format("2006-01-02"))
 
 	var ctx struct {
 		Sections []struct {
-			Name string `json:"name"`
+			Name  string `json:"name"`
+			Items []struct {
+				ID int `json:"id"`
+			} `json:"items"`
 		} `json:"sections"`
 	}
-	runKanbanJSON(t, kanbanDir, &ctx, "context", "--sections", "ready")
+	runKanbanJSON(t, kanbanDir, &ctx, "context", "--sections", "ready,due-soon")
+	if len(ctx.Sections) != 2 || ctx.Sections[0].Name != "ready" || ctx.Sections[1].Name != "due-soon" {
+		t.Fatalf("Sections = %+v, want ready and due-soon", ctx.Sections)
+	}
+	ready := map[int]bool{}
+	for _, item := range ctx.Sections[0].Items {
+		ready[item.ID] = true
+	}
+	if !ready[1] || ready[2] || ready[3] {
+		t.Errorf("ready = %v, want task 1 but not claimed task 2 or waiting task 3", ready)
+	}
+	if items := ctx.Sections[1].Items; len(items) != 1 || items[0].ID != 4 {
+		t.Errorf("due-soon = %+v, want task 4", items)
+	}
 
-	if len(ctx.Sections) != 0 {
-		t.Fatalf("Sections = %d, want 0", len(ctx.Sections))
+	// Neither section is shown by default.
+	runKanbanJSON(t, kanbanDir, &ctx, "context")
+	for _, sec := range ctx.Sections {
+		if sec.Name == "ready" || sec.Name == "due-soon" {
+			t.Errorf("default context includes %s", sec.Name)
+		}
+	}
+}
+
+func TestContextIncludeBodies(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Login", "--status", "in-progress", "--body", "Acceptance: SSO works")
+
+	var ctx struct {
+		Sections []struct {
+			Items []struct {
+				Body string `json:"body"`
+			} `json:"items"`
+		} `json:"sections"`
+	}
+	runKanbanJSON(t, kanbanDir, &ctx, "context", "--sections", "in-progress", "--include-bodies", "--max-body-chars", "10")
+	if len(ctx.Sections) != 1 || ctx.Sections[0].Items[0].Body != "Acceptance…" {
+		t.Errorf("context = %+v, want the body cut to 10 characters", ctx.Sections)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "context", "--max-body-chars", "10")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("--max-body-chars alone: code = %q, want %s", errResp.Code, codeInvalidInput)
 	}
 }
 
@@ -235,8 +281,52 @@ func TestContextSectionsReadyIsIgnored(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 type batchResultJSON struct {
-	ID    int    `json:"id"`
-	OK    bool   `json:"ok"`
-	Error string `json:"error,omitempty"`
-	Code  string `json:"code,omitempty"`
+	ID       int      `json:"id"`
+	OK       bool     `json:"ok"`
+	Deferred bool     `json:"deferred,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Code     string   `json:"code,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+func TestContextRedaction(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Renew ACME contract", "--assignee", "alice")
+	runKanban(t, kanbanDir, "--json", "move", "1", "in-progress", "--claim", claimTestAgent)
+	mustCreateTask(t, kanbanDir, "Rotate keys")
+	runKanban(t, kanbanDir, "--json", "edit", "2", "--block", "token sk-abcdef123456 leaked")
+
+	for _, args := range [][]string{
+		{"config", "set", "redact.patterns", `(?i)acme,sk-[A-Za-z0-9]+`},
+		{"config", "set", "redact.fields", "assignee"},
+	} {
+		if r := runKanban(t, kanbanDir, args...); r.exitCode != 0 {
+			t.Fatalf("%v failed: %s", args, r.stderr)
+		}
+	}
+
+	r := runKanban(t, kanbanDir, "context")
+	if r.exitCode != 0 {
+		t.Fatalf("context failed: %s", r.stderr)
+	}
+	for _, leaked := range []string{"ACME", "sk-abcdef123456", "alice"} {
+		if strings.Contains(r.stdout, leaked) {
+			t.Errorf("context output leaks %q:\n%s", leaked, r.stdout)
+		}
+	}
+	if !strings.Contains(r.stdout, "Renew [redacted] contract") || !strings.Contains(r.stdout, "token [redacted] leaked") {
+		t.Errorf("expected redacted title and note:\n%s", r.stdout)
+	}
+
+	// The task files themselves are untouched.
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "1")
+	if shown.Title != "Renew ACME contract" {
+		t.Errorf("show title = %q, want original", shown.Title)
+	}
+
+	r = runKanban(t, kanbanDir, "config", "set", "redact.fields", "priority")
+	if r.exitCode == 0 {
+		t.Error("expected unknown redact field to be rejected")
+	}
 }
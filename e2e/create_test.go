@@ -1,8 +1,10 @@
 package e2e_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 )
 
 // ---------------------------------------------------------------------------
@@ -116,6 +118,24 @@ func TestCreateBadDateFormat(t *testing.T) {
 	}
 }
 
+func TestCreateRelativeDueDate(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	var task taskJSON
+	runKanbanJSON(t, kanbanDir, &task, "create", "Relative due", "--due", "tomorrow")
+
+	want := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	if task.Due != want {
+		t.Errorf("due = %q, want %q", task.Due, want)
+	}
+
+	runKanbanJSON(t, kanbanDir, &task, "edit", "1", "--due", "+2w")
+	want = time.Now().AddDate(0, 0, 14).Format("2006-01-02")
+	if task.Due != want {
+		t.Errorf("due after edit = %q, want %q", task.Due, want)
+	}
+}
+
 func TestCreateWithTitleFlag(t *testing.T) {
 	kanbanDir := initBoard(t)
 
@@ -239,6 +259,40 @@ func TestCreateRespectsWIPLimit(t *testing.T) {
 	}
 }
 
+func TestCreateStatusAuto(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	if r := runKanban(t, kanbanDir, "config", "set", "defaults.auto_status", "in-progress,todo"); r.exitCode != 0 {
+		t.Fatalf("config set defaults.auto_status: %s", r.stderr)
+	}
+
+	first := mustCreateTask(t, kanbanDir, "First", "--status", "auto")
+	if first.Status != statusInProgress {
+		t.Errorf("first status = %q, want %q", first.Status, statusInProgress)
+	}
+	second := mustCreateTask(t, kanbanDir, "Second", "--status", "auto")
+	if second.Status != statusTodo {
+		t.Errorf("second status = %q, want %q (in-progress is full)", second.Status, statusTodo)
+	}
+
+	// Without a configured order, the first non-terminal status is used.
+	runKanban(t, kanbanDir, "config", "set", "defaults.auto_status", "")
+	third := mustCreateTask(t, kanbanDir, "Third", "--status", "auto")
+	if third.Status != "backlog" {
+		t.Errorf("third status = %q, want backlog", third.Status)
+	}
+}
+
+func TestCreateStatusAutoAllFull(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	runKanban(t, kanbanDir, "config", "set", "defaults.auto_status", "in-progress")
+	mustCreateTask(t, kanbanDir, "Fill", "--status", "in-progress")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "create", "Overflow", "--status", "auto")
+	if errResp.Code != codeWIPLimitExceeded {
+		t.Errorf("code = %q, want WIP_LIMIT_EXCEEDED", errResp.Code)
+	}
+}
+
 func TestCreateExpediteClassWIPCheck(t *testing.T) {
 	kanbanDir := initBoard(t)
 	// Create an expedite task.
@@ -254,3 +308,121 @@ func TestCreateExpediteClassWIPCheck(t *testing.T) {
 // ---------------------------------------------------------------------------
 // Move command: claim during move, compact output
 // ---------------------------------------------------------------------------
+
+func TestCreateAppliesTagDefaults(t *testing.T) {
+	kanbanDir := initBoard(t)
+	appendConfig(t, kanbanDir, `templates:
+    bug: |
+        ## Steps to reproduce
+tag_defaults:
+    bug:
+        priority: high
+        class: expedite
+        template: bug
+    docs:
+        priority: low
+        assignee: writer
+`)
+
+	bug := mustCreateTask(t, kanbanDir, "Crash on save", "--tags", "bug")
+	if bug.Priority != "high" || bug.Class != "expedite" || !strings.Contains(bug.Body, "Steps to reproduce") {
+		t.Errorf("bug task = %+v, want tag defaults applied", bug)
+	}
+
+	// Explicit flags win; the first tag wins over later ones.
+	both := mustCreateTask(t, kanbanDir, "Document crash", "--tags", "docs,bug", "--body", "custom", "--class", "standard")
+	if both.Priority != "low" || both.Assignee != "writer" || both.Class != "standard" || both.Body != "custom" {
+		t.Errorf("docs,bug task = %+v, want docs priority, writer, explicit class and body", both)
+	}
+
+	plain := mustCreateTask(t, kanbanDir, "Untagged")
+	if plain.Priority != "medium" || plain.Body != "" {
+		t.Errorf("untagged task = %+v, want config defaults", plain)
+	}
+}
+
+func TestCreateTemplateFlag(t *testing.T) {
+	kanbanDir := initBoard(t)
+	appendConfig(t, kanbanDir, "templates:\n    spike: \"## Question\\n\"\n")
+
+	tk := mustCreateTask(t, kanbanDir, "Try caching", "--template", "spike")
+	if tk.Body != "## Question\n" {
+		t.Errorf("body = %q, want template", tk.Body)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "create", "Oops", "--template", "nope")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
+func TestCreateJSONStdinRoundTrip(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Dependency")
+	mustCreateTask(t, kanbanDir, "Original", "--priority", "high", "--tags", "api,backend",
+		"--due", "2026-05-01", "--depends-on", "1", "--body", "Line one\n\nLine two")
+
+	shown := runKanban(t, kanbanDir, "--json", "show", "2")
+	if shown.exitCode != 0 {
+		t.Fatalf("show failed: %s", shown.stderr)
+	}
+	r := runKanbanStdin(t, kanbanDir, shown.stdout, "--json", "create", "--json-stdin")
+	if r.exitCode != 0 {
+		t.Fatalf("create --json-stdin failed (exit %d): %s%s", r.exitCode, r.stdout, r.stderr)
+	}
+
+	var orig, created struct {
+		taskJSON
+		DependsOn []int `json:"depends_on"`
+	}
+	if err := json.Unmarshal([]byte(shown.stdout), &orig); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(r.stdout), &created); err != nil {
+		t.Fatalf("parsing create output: %v\n%s", err, r.stdout)
+	}
+	if created.ID != 3 {
+		t.Errorf("id = %d, want a new id 3", created.ID)
+	}
+	if created.Title != orig.Title || created.Priority != orig.Priority || created.Due != orig.Due ||
+		created.Body != orig.Body || strings.Join(created.Tags, ",") != strings.Join(orig.Tags, ",") ||
+		len(created.DependsOn) != 1 || created.DependsOn[0] != 1 {
+		t.Errorf("created %+v, want the fields of %+v", created, orig)
+	}
+}
+
+func TestCreateJSONStdinFlagsOverride(t *testing.T) {
+	kanbanDir := initBoard(t)
+	in := `{"title": "From JSON", "priority": "low", "assignee": "alice"}`
+
+	var created taskJSON
+	r := runKanbanStdin(t, kanbanDir, in, "--json", "create", "--json-stdin", "--priority", "critical")
+	if r.exitCode != 0 {
+		t.Fatalf("create --json-stdin failed (exit %d): %s", r.exitCode, r.stderr)
+	}
+	if err := json.Unmarshal([]byte(r.stdout), &created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Title != "From JSON" || created.Priority != "critical" || created.Assignee != "alice" {
+		t.Errorf("created %+v, want title From JSON, priority critical, assignee alice", created)
+	}
+}
+
+func TestCreateJSONStdinRejectsBadInput(t *testing.T) {
+	kanbanDir := initBoard(t)
+	for in, code := range map[string]string{
+		`{"title": "Typo", "priorty": "high"}`:         "INVALID_INPUT",
+		`{"title": "Bad status", "status": "nowhere"}`: "INVALID_STATUS",
+		`not json`: "INVALID_INPUT",
+	} {
+		r := runKanbanStdin(t, kanbanDir, in, "--json", "create", "--json-stdin")
+		if r.exitCode == 0 {
+			t.Errorf("create --json-stdin with %s succeeded, want an error", in)
+			continue
+		}
+		var errResp errorJSON
+		if err := json.Unmarshal([]byte(r.stdout), &errResp); err != nil || errResp.Code != code {
+			t.Errorf("input %s: error = %s, want %s", in, r.stdout, code)
+		}
+	}
+}
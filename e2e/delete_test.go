@@ -27,9 +27,48 @@ func TestDeleteWithDependentsWarns(t *testing.T) {
 	}
 }
 
-// ---------------------------------------------------------------------------
-// Delete tests
-// ---------------------------------------------------------------------------
+func TestDeleteParentNeedsChildPolicy(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")                       // #1
+	mustCreateTask(t, kanbanDir, "Story", "--parent", "1")     // #2
+	mustCreateTask(t, kanbanDir, "Sub-story", "--parent", "2") // #3
+	mustCreateTask(t, kanbanDir, "Other epic")                 // #4
+	mustCreateTask(t, kanbanDir, "Loose", "--parent", "4")     // #5
+
+	errResp := runKanbanJSONError(t, kanbanDir, "delete", "1", "--yes")
+	if errResp.Code != "HAS_CHILDREN" {
+		t.Errorf("code = %q, want HAS_CHILDREN", errResp.Code)
+	}
+
+	var result struct {
+		Archived []int `json:"archived"`
+		Orphaned []int `json:"orphaned"`
+	}
+	runKanbanJSON(t, kanbanDir, &result, "delete", "1", "--yes", "--cascade")
+	if len(result.Archived) != 2 || result.Archived[0] != 2 || result.Archived[1] != 3 {
+		t.Errorf("archived = %v, want [2 3]", result.Archived)
+	}
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "3")
+	if shown.Status != statusArchived {
+		t.Errorf("grandchild status = %q, want %q", shown.Status, statusArchived)
+	}
+
+	runKanbanJSON(t, kanbanDir, &result, "delete", "4", "--yes", "--orphan")
+	if len(result.Orphaned) != 1 || result.Orphaned[0] != 5 {
+		t.Errorf("orphaned = %v, want [5]", result.Orphaned)
+	}
+	var orphan map[string]any
+	runKanbanJSON(t, kanbanDir, &orphan, "show", "5")
+	if _, ok := orphan["parent"]; ok || orphan["status"] == statusArchived {
+		t.Errorf("orphaned child = %v, want live with no parent", orphan)
+	}
+
+	r := runKanban(t, kanbanDir, "delete", "5", "--yes", "--cascade", "--orphan")
+	if r.exitCode == 0 {
+		t.Error("--cascade with --orphan should fail")
+	}
+}
 
 // ---------------------------------------------------------------------------
 // Delete tests
@@ -74,6 +113,38 @@ func TestDeleteWithoutYesNonTTY(t *testing.T) {
 	}
 }
 
+func TestDeleteAssumeYesNonTTY(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Agent task")
+	mustCreateTask(t, kanbanDir, "Batch one")
+	mustCreateTask(t, kanbanDir, "Batch two")
+
+	r := runKanban(t, kanbanDir, "config", "set", "automation.assume_yes", "true")
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	var result map[string]interface{}
+	r = runKanbanJSON(t, kanbanDir, &result, "delete", "1")
+	if r.exitCode != 0 {
+		t.Fatalf("delete without --yes under assume_yes: exit %d: %s", r.exitCode, r.stderr)
+	}
+	if result["status"] != statusDeleted {
+		t.Errorf("JSON status = %v, want %q", result["status"], statusDeleted)
+	}
+
+	// Batch delete no longer needs --yes either.
+	r = runKanban(t, kanbanDir, "delete", "2,3")
+	if r.exitCode != 0 {
+		t.Errorf("batch delete under assume_yes: exit %d: %s", r.exitCode, r.stderr)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "config", "set", "automation.assume_yes", "maybe")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Cross-cutting tests
 // ---------------------------------------------------------------------------
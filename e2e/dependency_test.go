@@ -208,3 +208,110 @@ func TestBlockAndUnblockConflict(t *testing.T) {
 		t.Errorf("error = %q, want conflict message", errResp.Error)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Parent progress tests
+// ---------------------------------------------------------------------------
+
+func TestShowParentProgress(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Child A", "--parent", "1", "--estimate", "4h")
+	mustCreateTask(t, kanbanDir, "Child B", "--parent", "1", "--estimate", "2h", "--status", "done")
+
+	var shown struct {
+		Progress struct {
+			Total          int     `json:"total"`
+			Done           int     `json:"done"`
+			RemainingHours float64 `json:"remaining_hours"`
+		} `json:"progress"`
+	}
+	runKanbanJSON(t, kanbanDir, &shown, "show", "1")
+	if shown.Progress.Total != 2 || shown.Progress.Done != 1 || shown.Progress.RemainingHours != 4 {
+		t.Errorf("progress = %+v, want 1/2 done, 4h remaining", shown.Progress)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "show", "1")
+	if !strings.Contains(r.stdout, "1/2 done, 4h remaining") {
+		t.Errorf("table output missing progress:\n%s", r.stdout)
+	}
+}
+
+func TestMoveParentToDoneRequiresForce(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Open child", "--parent", "1")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "move", "1", "done")
+	if errResp.Code != "CHILDREN_INCOMPLETE" {
+		t.Errorf("code = %q, want CHILDREN_INCOMPLETE", errResp.Code)
+	}
+
+	r := runKanban(t, kanbanDir, "move", "1", "done", "--force")
+	if r.exitCode != 0 {
+		t.Fatalf("move --force failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stderr, "incomplete children") {
+		t.Errorf("stderr = %q, want incomplete children warning", r.stderr)
+	}
+}
+
+func TestEditParentStatusToDoneRequiresForce(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Open child", "--parent", "1")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--status", "done")
+	if errResp.Code != "CHILDREN_INCOMPLETE" {
+		t.Errorf("code = %q, want CHILDREN_INCOMPLETE", errResp.Code)
+	}
+
+	r := runKanban(t, kanbanDir, "edit", "1", "--status", "done", "--force")
+	if r.exitCode != 0 {
+		t.Fatalf("edit --force failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stderr, "incomplete children") {
+		t.Errorf("stderr = %q, want incomplete children warning", r.stderr)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Computed dependency-blocked state
+// ---------------------------------------------------------------------------
+
+func TestListBlockedByDependency(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Prerequisite")
+	mustCreateTask(t, kanbanDir, "Waiting", "--depends-on", "1")
+
+	var tasks []struct {
+		ID                  int  `json:"id"`
+		Blocked             bool `json:"blocked"`
+		BlockedByDependency bool `json:"blocked_by_dependency"`
+	}
+	runKanbanJSON(t, kanbanDir, &tasks, "list")
+	if len(tasks) != 2 || tasks[0].BlockedByDependency || !tasks[1].BlockedByDependency {
+		t.Fatalf("tasks = %+v, want only #2 blocked_by_dependency", tasks)
+	}
+	if tasks[1].Blocked {
+		t.Error("manual blocked flag should stay false")
+	}
+
+	r := runKanban(t, kanbanDir, "--compact", "list")
+	if !strings.Contains(r.stdout, "#2 [backlog/medium] ⛓ Waiting") {
+		t.Errorf("compact output missing marker:\n%s", r.stdout)
+	}
+	r = runKanban(t, kanbanDir, "--table", "list")
+	if !strings.Contains(r.stdout, "⛓ Waiting") {
+		t.Errorf("table output missing marker:\n%s", r.stdout)
+	}
+
+	runKanban(t, kanbanDir, "move", "1", "done")
+	var shown struct {
+		BlockedByDependency bool `json:"blocked_by_dependency"`
+	}
+	runKanbanJSON(t, kanbanDir, &shown, "show", "2")
+	if shown.BlockedByDependency {
+		t.Error("blocked_by_dependency = true after dependency completed")
+	}
+}
@@ -0,0 +1,93 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Dependency graph tests
+// ---------------------------------------------------------------------------
+
+func TestDepsCriticalPath(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Design", "--estimate", "1d")
+	mustCreateTask(t, kanbanDir, "Backend", "--estimate", "3d", "--depends-on", "1")
+	mustCreateTask(t, kanbanDir, "Frontend", "--estimate", "4h", "--depends-on", "1")
+	mustCreateTask(t, kanbanDir, "Release", "--estimate", "2h", "--depends-on", "2,3")
+
+	var cp struct {
+		Tasks []struct {
+			ID int `json:"id"`
+		} `json:"tasks"`
+		TotalHours float64 `json:"total_hours"`
+	}
+	runKanbanJSON(t, kanbanDir, &cp, "deps", "critical-path")
+
+	var ids []int
+	for _, tk := range cp.Tasks {
+		ids = append(ids, tk.ID)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 4 {
+		t.Errorf("critical path = %v, want [1 2 4]", ids)
+	}
+	if cp.TotalHours != 34 {
+		t.Errorf("total_hours = %v, want 34", cp.TotalHours)
+	}
+}
+
+func TestDepsCriticalPathTable(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Only task", "--estimate", "2h")
+
+	r := runKanban(t, kanbanDir, "--table", "deps", "critical-path")
+	if r.exitCode != 0 {
+		t.Fatalf("deps critical-path failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "Only task") || !strings.Contains(r.stdout, "2h") {
+		t.Errorf("output missing task or total:\n%s", r.stdout)
+	}
+}
+
+func TestDepsCriticalPathCompact(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Unestimated")
+
+	r := runKanban(t, kanbanDir, "--compact", "deps", "critical-path")
+	if r.exitCode != 0 {
+		t.Fatalf("deps critical-path failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "Critical path: 1 tasks, 0h (unestimated: #1)") {
+		t.Errorf("unexpected compact output:\n%s", r.stdout)
+	}
+}
+
+func TestOnUnblockMovesDependent(t *testing.T) {
+	kanbanDir := initBoard(t)
+	runKanban(t, kanbanDir, "config", "set", "dependencies.on_unblock", "move_to todo")
+	mustCreateTask(t, kanbanDir, "Dependency")
+	mustCreateTask(t, kanbanDir, "Dependent", "--depends-on", "1")
+
+	r := runKanban(t, kanbanDir, "move", "1", "done")
+	if r.exitCode != 0 {
+		t.Fatalf("move failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stderr, "Unblocked task #2") {
+		t.Errorf("stderr = %q, want unblock notice", r.stderr)
+	}
+
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "2")
+	if shown.Status != "todo" {
+		t.Errorf("dependent status = %q, want todo", shown.Status)
+	}
+
+	var entries []struct {
+		Action string `json:"action"`
+		TaskID int    `json:"task_id"`
+	}
+	runKanbanJSON(t, kanbanDir, &entries, "log", "--action", "auto-move")
+	if len(entries) != 1 || entries[0].TaskID != 2 {
+		t.Errorf("log = %+v, want one auto-move for #2", entries)
+	}
+}
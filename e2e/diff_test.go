@@ -0,0 +1,89 @@
+package e2e_test
+
+import (
+	"testing"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// diff command tests
+// ---------------------------------------------------------------------------
+
+type boardDiffJSON struct {
+	Changed int `json:"changed"`
+	Columns []struct {
+		Status string `json:"status"`
+		Before int    `json:"before"`
+		After  int    `json:"after"`
+		Added  []struct {
+			ID      int    `json:"id"`
+			From    string `json:"from"`
+			Created bool   `json:"created"`
+		} `json:"added"`
+		Removed []struct {
+			ID int    `json:"id"`
+			To string `json:"to"`
+		} `json:"removed"`
+	} `json:"columns"`
+}
+
+func TestDiffSince(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Old", "--status", "todo")
+	mustCreateTask(t, kanbanDir, "Fresh", "--status", "todo")
+	runKanban(t, kanbanDir, "move", "1", "done")
+
+	// Task 1 was created two weeks ago; task 2 this week.
+	old := time.Now().UTC().Add(-14 * 24 * time.Hour)
+	recent := time.Now().UTC().Add(-24 * time.Hour)
+	writeActivityLog(t, kanbanDir, []map[string]any{
+		{"timestamp": old, "action": "create", "task_id": 1, "detail": "Old"},
+		{"timestamp": recent, "action": "create", "task_id": 2, "detail": "Fresh"},
+		{"timestamp": recent, "action": "move", "task_id": 1, "detail": "todo -> done"},
+	})
+
+	var d boardDiffJSON
+	runKanbanJSON(t, kanbanDir, &d, "diff", "--since", "7d")
+	if d.Changed != 2 {
+		t.Errorf("changed = %d, want 2", d.Changed)
+	}
+	for _, c := range d.Columns {
+		switch c.Status {
+		case "todo":
+			if c.Before != 1 || c.After != 1 || len(c.Added) != 1 || !c.Added[0].Created ||
+				len(c.Removed) != 1 || c.Removed[0].To != "done" {
+				t.Errorf("todo = %+v", c)
+			}
+		case "done":
+			if c.Before != 0 || c.After != 1 || len(c.Added) != 1 || c.Added[0].From != "todo" {
+				t.Errorf("done = %+v", c)
+			}
+		}
+	}
+
+	// Two weeks back, task 1 did not exist yet either.
+	runKanbanJSON(t, kanbanDir, &d, "diff", "--since", "3w")
+	if d.Changed != 2 {
+		t.Errorf("--since 3w: changed = %d, want 2", d.Changed)
+	}
+	for _, c := range d.Columns {
+		if c.Status == "done" && (len(c.Added) != 1 || !c.Added[0].Created) {
+			t.Errorf("--since 3w: done = %+v, want task 1 created", c)
+		}
+	}
+
+	r := runKanban(t, kanbanDir, "diff")
+	if r.exitCode != 0 {
+		t.Fatalf("table output failed: %s", r.stderr)
+	}
+}
+
+func TestDiffInvalidSince(t *testing.T) {
+	kanbanDir := initBoard(t)
+	for _, since := range []string{"soon", "2999-01-01"} {
+		errResp := runKanbanJSONError(t, kanbanDir, "diff", "--since", since)
+		if errResp.Code != codeInvalidInput {
+			t.Errorf("--since %s: code = %q, want %s", since, errResp.Code, codeInvalidInput)
+		}
+	}
+}
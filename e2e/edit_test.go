@@ -312,3 +312,37 @@ func TestEditStartedAndClearStartedConflict(t *testing.T) {
 		t.Errorf("error = %q, want conflict error", errResp.Error)
 	}
 }
+
+func TestEditPatch(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Dependency")
+	mustCreateTask(t, kanbanDir, "Patch me", "--tags", "old,keep", "--due", "2026-05-01")
+
+	var got struct {
+		taskJSON
+		DependsOn []int `json:"depends_on"`
+	}
+	r := runKanbanJSON(t, kanbanDir, &got, "edit", "2", "--patch",
+		`{"priority":"high","tags":{"add":["urgent"],"remove":["old"]},"depends_on":{"add":[1]},"due":null}`)
+	if r.exitCode != 0 {
+		t.Fatalf("edit --patch failed (exit %d): %s%s", r.exitCode, r.stdout, r.stderr)
+	}
+	if got.Priority != "high" || strings.Join(got.Tags, ",") != "keep,urgent" || got.Due != "" ||
+		len(got.DependsOn) != 1 || got.DependsOn[0] != 1 {
+		t.Errorf("patched task = %+v, want priority high, tags keep,urgent, no due, depends on 1", got)
+	}
+}
+
+func TestEditPatchValidatesLikeFlags(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Patch me")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--patch", `{"priority":"urgentest"}`)
+	if errResp.Code != "INVALID_PRIORITY" {
+		t.Errorf("code = %s, want INVALID_PRIORITY", errResp.Code)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "edit", "1", "--patch", `{"priority":"high"}`, "--priority", "low")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %s, want INVALID_INPUT for a field set twice", errResp.Code)
+	}
+}
@@ -0,0 +1,136 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Estimate report tests
+// ---------------------------------------------------------------------------
+
+type estimateReportJSON struct {
+	Groups []struct {
+		Key         string  `json:"key"`
+		Tasks       int     `json:"tasks"`
+		Hours       float64 `json:"hours"`
+		Unestimated int     `json:"unestimated"`
+	} `json:"groups"`
+	TotalHours  float64 `json:"total_hours"`
+	Unestimated []int   `json:"unestimated"`
+}
+
+func TestEstimateReportByAssignee(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "A", "--estimate", "1d", "--assignee", "alice")
+	mustCreateTask(t, kanbanDir, "B", "--estimate", "4h", "--assignee", "alice")
+	mustCreateTask(t, kanbanDir, "C", "--assignee", "bob")
+	mustCreateTask(t, kanbanDir, "Done", "--estimate", "1w", "--assignee", "bob", "--status", "done")
+
+	var r estimateReportJSON
+	runKanbanJSON(t, kanbanDir, &r, "estimate", "report", "--by", "assignee")
+
+	if len(r.Groups) != 2 {
+		t.Fatalf("groups = %+v, want 2", r.Groups)
+	}
+	if g := r.Groups[0]; g.Key != "alice" || g.Hours != 12 || g.Tasks != 2 {
+		t.Errorf("alice group = %+v, want 12h over 2 tasks", g)
+	}
+	if g := r.Groups[1]; g.Key != "bob" || g.Hours != 0 || g.Unestimated != 1 {
+		t.Errorf("bob group = %+v, want 0h with 1 unestimated", g)
+	}
+	if r.TotalHours != 12 {
+		t.Errorf("total_hours = %v, want 12", r.TotalHours)
+	}
+}
+
+func TestEstimateReportHoursPerDay(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "A", "--estimate", "2d")
+
+	r := runKanban(t, kanbanDir, "config", "set", "estimates.hours_per_day", "6")
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	var rep estimateReportJSON
+	runKanbanJSON(t, kanbanDir, &rep, "estimate", "report")
+	if rep.TotalHours != 12 {
+		t.Errorf("total_hours = %v, want 12 at 6h/day", rep.TotalHours)
+	}
+
+	runKanbanJSON(t, kanbanDir, &rep, "estimate", "report", "--hours-per-day", "7")
+	if rep.TotalHours != 14 {
+		t.Errorf("total_hours = %v, want 14 with --hours-per-day 7", rep.TotalHours)
+	}
+}
+
+func TestEstimateReportByEpicTable(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Launch")
+	mustCreateTask(t, kanbanDir, "API", "--parent", "1", "--estimate", "3h")
+	mustCreateTask(t, kanbanDir, "Loose end", "--estimate", "1h")
+
+	r := runKanban(t, kanbanDir, "--table", "estimate", "report", "--by", "epic")
+	if r.exitCode != 0 {
+		t.Fatalf("estimate report failed: %s", r.stderr)
+	}
+	for _, want := range []string{"#1 Launch", "3h", "(no epic)", "1h"} {
+		if !strings.Contains(r.stdout, want) {
+			t.Errorf("output missing %q:\n%s", want, r.stdout)
+		}
+	}
+}
+
+func TestEstimateReportInvalidBy(t *testing.T) {
+	kanbanDir := initBoard(t)
+	errResp := runKanbanJSONError(t, kanbanDir, "estimate", "report", "--by", "color")
+	if errResp.Code != "INVALID_GROUP_BY" {
+		t.Errorf("code = %q, want INVALID_GROUP_BY", errResp.Code)
+	}
+}
+
+func TestRequireEstimateForMove(t *testing.T) {
+	kanbanDir := initBoard(t)
+	runKanban(t, kanbanDir, "config", "set", "require_estimate_for", statusInProgress)
+	mustCreateTask(t, kanbanDir, "Unsized")
+	mustCreateTask(t, kanbanDir, "Sized", "--estimate", "4h")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimTestAgent)
+	if errResp.Code != "ESTIMATE_REQUIRED" {
+		t.Errorf("code = %q, want ESTIMATE_REQUIRED", errResp.Code)
+	}
+	r := runKanban(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimTestAgent)
+	if r.exitCode != 5 {
+		t.Errorf("exit = %d, want 5 (conflict)", r.exitCode)
+	}
+
+	// Other statuses, estimated tasks, and --force are not affected.
+	if r := runKanban(t, kanbanDir, "move", "1", statusTodo); r.exitCode != 0 {
+		t.Errorf("move to todo: exit %d: %s", r.exitCode, r.stderr)
+	}
+	if r := runKanban(t, kanbanDir, "move", "2", statusInProgress, "--claim", claimTestAgent); r.exitCode != 0 {
+		t.Errorf("move estimated task: exit %d: %s", r.exitCode, r.stderr)
+	}
+	if r := runKanban(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimTestAgent, "--force"); r.exitCode != 0 {
+		t.Errorf("move --force: exit %d: %s", r.exitCode, r.stderr)
+	}
+}
+
+func TestRequireEstimateForEdit(t *testing.T) {
+	kanbanDir := initBoard(t)
+	runKanban(t, kanbanDir, "config", "set", "require_estimate_for", statusReview)
+	mustCreateTask(t, kanbanDir, "Unsized")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--status", statusReview, "--claim", claimTestAgent)
+	if errResp.Code != "ESTIMATE_REQUIRED" {
+		t.Errorf("code = %q, want ESTIMATE_REQUIRED", errResp.Code)
+	}
+
+	// Setting the estimate in the same edit satisfies the requirement.
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "edit", "1", "--status", statusReview, "--claim", claimTestAgent, "--estimate", "2h")
+	if tk.Status != statusReview {
+		t.Errorf("status = %q, want review", tk.Status)
+	}
+}
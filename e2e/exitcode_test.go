@@ -0,0 +1,72 @@
+package e2e_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Exit code taxonomy and --fail-on tests
+// ---------------------------------------------------------------------------
+
+func TestExitCodesByErrorClass(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	mustCreateTask(t, kanbanDir, "Active", "--status", statusInProgress)
+	second := mustCreateTask(t, kanbanDir, "Waiting", "--status", statusTodo)
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"validation", []string{"move", "1", "nonexistent"}, 3},
+		{"unknown flag", []string{"list", "--no-such-flag"}, 3},
+		{"not found", []string{"show", "99"}, 4},
+		{"wip", []string{"move", strconv.Itoa(second.ID), statusInProgress, "--claim", claimTestAgent}, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := runKanban(t, kanbanDir, tt.args...)
+			if r.exitCode != tt.want {
+				t.Errorf("exit = %d, want %d\nstderr: %s", r.exitCode, tt.want, r.stderr)
+			}
+		})
+	}
+}
+
+func TestFailOnWarning(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Stuck")
+	runKanban(t, kanbanDir, "edit", "1", "--block", "waiting on vendor")
+
+	r := runKanban(t, kanbanDir, "move", "1", statusTodo)
+	if r.exitCode != 0 || !strings.Contains(r.stderr, "Warning:") {
+		t.Fatalf("default move: exit %d, stderr %q; want 0 with a warning", r.exitCode, r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "--fail-on", "warning", "move", "1", statusInProgress, "--claim", claimTestAgent)
+	if r.exitCode != 1 {
+		t.Errorf("--fail-on warning exit = %d, want 1\nstderr: %s", r.exitCode, r.stderr)
+	}
+
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "show", "1")
+	if tk.Status != statusInProgress {
+		t.Errorf("status = %q, want %q (the move itself still happens)", tk.Status, statusInProgress)
+	}
+
+	r = runKanban(t, kanbanDir, "--fail-on", "warning", "list")
+	if r.exitCode != 0 {
+		t.Errorf("no warnings: exit = %d, want 0", r.exitCode)
+	}
+}
+
+func TestFailOnRejectsUnknownValue(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "--fail-on", "sometimes", "list")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
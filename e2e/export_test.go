@@ -0,0 +1,126 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Export tests
+// ---------------------------------------------------------------------------
+
+func TestExportHTMLToFile(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Ship <beta>", "--priority", "high", "--tags", "release", "--body", "Notes & links")
+	mustCreateTask(t, kanbanDir, "Archived one")
+	runKanban(t, kanbanDir, "archive", "2")
+
+	out := filepath.Join(t.TempDir(), "board.html")
+	r := runKanban(t, kanbanDir, "export", "html", "--out", out)
+	if r.exitCode != 0 {
+		t.Fatalf("export html failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "Exported board to "+out) {
+		t.Errorf("stdout = %q, want confirmation", r.stdout)
+	}
+
+	data, err := os.ReadFile(out) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	page := string(data)
+	for _, want := range []string{"<!DOCTYPE html>", "Ship &lt;beta&gt;", "Notes &amp; links", `class="tag">release`} {
+		if !strings.Contains(page, want) {
+			t.Errorf("export missing %q", want)
+		}
+	}
+	if strings.Contains(page, "Archived one") {
+		t.Error("export should leave out archived tasks")
+	}
+}
+
+func TestExportHTMLSwimlanes(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Docs", "--assignee", "alice")
+
+	r := runKanban(t, kanbanDir, "export", "html", "--swimlane", "assignee")
+	if r.exitCode != 0 {
+		t.Fatalf("export html --swimlane failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, `<div class="lane">alice</div>`) {
+		t.Errorf("expected an alice lane:\n%s", r.stdout)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "export", "html", "--swimlane", "status")
+	if errResp.Code != "INVALID_GROUP_BY" {
+		t.Errorf("code = %q, want INVALID_GROUP_BY", errResp.Code)
+	}
+}
+
+func TestExportSVG(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	mustCreateTask(t, kanbanDir, "First")
+	runKanban(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimTestAgent)
+
+	out := filepath.Join(t.TempDir(), "board.svg")
+	r := runKanban(t, kanbanDir, "export", "svg", "--out", out)
+	if r.exitCode != 0 {
+		t.Fatalf("export svg failed: %s", r.stderr)
+	}
+	data, err := os.ReadFile(out) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	svg := string(data)
+	if !strings.HasPrefix(svg, "<svg ") || !strings.Contains(svg, "First") || !strings.Contains(svg, ">1/1</text>") {
+		t.Errorf("unexpected SVG:\n%s", svg)
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Write guide", "--status", statusTodo, "--assignee", "alice", "--body", "long body text")
+
+	r := runKanban(t, kanbanDir, "export", "markdown")
+	if r.exitCode != 0 {
+		t.Fatalf("export markdown failed: %s", r.stderr)
+	}
+	for _, want := range []string{"## todo (1)", "| #1 | Write guide | medium | alice |", "## done (0)"} {
+		if !strings.Contains(r.stdout, want) {
+			t.Errorf("markdown missing %q:\n%s", want, r.stdout)
+		}
+	}
+	if strings.Contains(r.stdout, "long body text") {
+		t.Error("markdown export should leave out task bodies")
+	}
+}
+
+func TestExportRedactsAndKeepsPrivateBodiesSealed(t *testing.T) {
+	setupGPGHome(t)
+	kanbanDir := initBoard(t)
+	for _, args := range [][]string{
+		{"config", "set", "security.recipients", privateTestRecipient},
+		{"config", "set", "redact.patterns", `(?i)acme`},
+	} {
+		if r := runKanban(t, kanbanDir, args...); r.exitCode != 0 {
+			t.Fatalf("%v failed: %s", args, r.stderr)
+		}
+	}
+	mustCreateTask(t, kanbanDir, "Renew ACME deal")
+	mustCreateTask(t, kanbanDir, "Client call", "--private", "--body", "budget 10k")
+
+	r := runKanban(t, kanbanDir, "export", "html")
+	if r.exitCode != 0 {
+		t.Fatalf("export html failed: %s", r.stderr)
+	}
+	for _, leaked := range []string{"ACME", "budget 10k"} {
+		if strings.Contains(r.stdout, leaked) {
+			t.Errorf("export leaks %q", leaked)
+		}
+	}
+	if !strings.Contains(r.stdout, "Renew [redacted] deal") || !strings.Contains(r.stdout, "[encrypted]") {
+		t.Errorf("expected redacted title and sealed body:\n%s", r.stdout)
+	}
+}
@@ -0,0 +1,61 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFocus(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Deep work")
+
+	var focused struct {
+		taskJSON
+		FocusUntil string `json:"focus_until"`
+		Worklog    []struct {
+			Start string `json:"start"`
+			End   string `json:"end"`
+			By    string `json:"by"`
+		} `json:"worklog"`
+	}
+	r := runKanbanJSON(t, kanbanDir, &focused, "focus", "1", "--for", "1s", "--claim", claimTestAgent)
+	if r.exitCode != 0 {
+		t.Fatalf("focus failed: %s", r.stderr)
+	}
+	if focused.ClaimedBy != claimTestAgent {
+		t.Errorf("claimed_by = %q, want %q", focused.ClaimedBy, claimTestAgent)
+	}
+	if focused.FocusUntil != "" {
+		t.Errorf("focus_until = %q after the timer ended, want it cleared", focused.FocusUntil)
+	}
+	if len(focused.Worklog) != 1 || focused.Worklog[0].By != claimTestAgent || focused.Worklog[0].Start >= focused.Worklog[0].End {
+		t.Errorf("worklog = %+v, want one session by %s", focused.Worklog, claimTestAgent)
+	}
+
+	var entries []logEntry
+	runKanbanJSON(t, kanbanDir, &entries, "log", "--action", "focus")
+	if len(entries) != 1 || entries[0].TaskID != 1 || entries[0].Detail != "1s" || entries[0].Actor != claimTestAgent {
+		t.Errorf("focus log entries = %+v", entries)
+	}
+
+	r = runKanban(t, kanbanDir, "show", "1")
+	if !strings.Contains(r.stdout, "Worked:") || !strings.Contains(r.stdout, "(1 session)") {
+		t.Errorf("show lacks the worked time:\n%s", r.stdout)
+	}
+}
+
+func TestFocusRefusesOtherClaimAndFinishedTask(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Claimed", "--claim", "someone-else")
+	mustCreateTask(t, kanbanDir, "Finished", "--status", "done")
+
+	if errResp := runKanbanJSONError(t, kanbanDir, "focus", "1", "--for", "1s", "--claim", claimTestAgent); errResp.Code != "TASK_CLAIMED" {
+		t.Errorf("focus on a task claimed by another agent: code %q, want TASK_CLAIMED", errResp.Code)
+	}
+	if errResp := runKanbanJSONError(t, kanbanDir, "focus", "2", "--for", "1s"); errResp.Code != "INVALID_INPUT" {
+		t.Errorf("focus on a done task: code %q, want INVALID_INPUT", errResp.Code)
+	}
+	if errResp := runKanbanJSONError(t, kanbanDir, "focus", "2", "--for", "0s"); errResp.Code != "INVALID_INPUT" {
+		t.Errorf("focus --for 0s: code %q, want INVALID_INPUT", errResp.Code)
+	}
+}
@@ -0,0 +1,60 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type goalBoardJSON struct {
+	Goals []struct {
+		Goal  string `json:"goal"`
+		Title string `json:"title"`
+		Total int    `json:"total"`
+		Done  int    `json:"done"`
+	} `json:"goals"`
+}
+
+func TestGoals(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Cache responses")
+	mustCreateTask(t, kanbanDir, "Profile handlers")
+	mustCreateTask(t, kanbanDir, "Unrelated")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--goal", "Q1-latency")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT without goals.yml", errResp.Code)
+	}
+
+	goals := "goals:\n  - name: Q1-latency\n    title: Cut p99 latency below 200ms\n"
+	if err := os.WriteFile(filepath.Join(kanbanDir, "goals.yml"), []byte(goals), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var tk struct {
+		Goal string `json:"goal"`
+	}
+	runKanban(t, kanbanDir, "edit", "1,2", "--goal", "Q1-latency")
+	runKanbanJSON(t, kanbanDir, &tk, "show", "2")
+	if tk.Goal != "Q1-latency" {
+		t.Fatalf("goal = %q, want Q1-latency", tk.Goal)
+	}
+	runKanban(t, kanbanDir, "move", "1", "done")
+
+	var listed []taskJSON
+	runKanbanJSON(t, kanbanDir, &listed, "list", "--goal", "Q1-latency")
+	if len(listed) != 2 || listed[0].ID != 1 || listed[1].ID != 2 {
+		t.Errorf("list --goal = %+v, want tasks 1 and 2", listed)
+	}
+
+	var b goalBoardJSON
+	runKanbanJSON(t, kanbanDir, &b, "board")
+	if len(b.Goals) != 1 || b.Goals[0].Goal != "Q1-latency" || b.Goals[0].Done != 1 || b.Goals[0].Total != 2 {
+		t.Errorf("board goals = %+v, want Q1-latency 1/2", b.Goals)
+	}
+
+	runKanban(t, kanbanDir, "edit", "2", "--clear-goal")
+	runKanbanJSON(t, kanbanDir, &listed, "list", "--goal", "Q1-latency")
+	if len(listed) != 1 || listed[0].ID != 1 {
+		t.Errorf("list --goal after --clear-goal = %+v, want task 1 only", listed)
+	}
+}
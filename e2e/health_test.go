@@ -0,0 +1,59 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Health tests
+// ---------------------------------------------------------------------------
+
+type healthJSON struct {
+	Score      int    `json:"score"`
+	Status     string `json:"status"`
+	Indicators []struct {
+		Name   string  `json:"name"`
+		Value  float64 `json:"value"`
+		Status string  `json:"status"`
+	} `json:"indicators"`
+}
+
+func TestHealthHealthyBoard(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Fine")
+
+	var report healthJSON
+	runKanbanJSON(t, kanbanDir, &report, "health")
+	if report.Score != 100 || report.Status != "ok" || len(report.Indicators) != 5 {
+		t.Errorf("health = %+v, want 100 ok with 5 indicators", report)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "health")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "Board health: 100/100 (ok)") {
+		t.Errorf("table health (exit %d):\n%s", r.exitCode, r.stdout)
+	}
+}
+
+func TestHealthCriticalExitsNonZero(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Late", "--due", "2020-01-01")
+
+	r := runKanban(t, kanbanDir, "--json", "health")
+	if r.exitCode != 1 {
+		t.Errorf("exit code = %d, want 1 for a critical breach", r.exitCode)
+	}
+	var report healthJSON
+	if err := json.Unmarshal([]byte(r.stdout), &report); err != nil {
+		t.Fatalf("parsing report: %v\n%s", err, r.stdout)
+	}
+	if report.Status != "critical" {
+		t.Errorf("status = %q, want critical", report.Status)
+	}
+	for _, ind := range report.Indicators {
+		if ind.Name == "overdue_ratio" && (ind.Value != 1 || ind.Status != "critical") {
+			t.Errorf("overdue_ratio = %+v, want 1 critical", ind)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -87,6 +88,7 @@ type taskJSON struct {
 	Status      string   `json:"status"`
 	Priority    string   `json:"priority"`
 	Assignee    string   `json:"assignee,omitempty"`
+	Reviewer    string   `json:"reviewer,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
 	Due         string   `json:"due,omitempty"`
 	Estimate    string   `json:"estimate,omitempty"`
@@ -97,6 +99,8 @@ type taskJSON struct {
 	ClaimedBy   string   `json:"claimed_by,omitempty"`
 	Blocked     bool     `json:"blocked,omitempty"`
 	BlockReason string   `json:"block_reason,omitempty"`
+	Class       string   `json:"class,omitempty"`
+	Workstream  string   `json:"workstream,omitempty"`
 }
 
 // runKanban executes the binary with --dir prepended for test isolation.
@@ -164,6 +168,52 @@ func runKanbanEnv(t *testing.T, dir string, env []string, args ...string) result
 	return r
 }
 
+// appendConfig appends top-level YAML to config.yml, for settings that
+// config set cannot express.
+func appendConfig(t *testing.T, kanbanDir, yamlText string) {
+	t.Helper()
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	f, err := os.OpenFile(cfgPath, os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("opening config: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(yamlText); err != nil {
+		t.Fatalf("appending config: %v", err)
+	}
+}
+
+// runKanbanStdin runs the binary with stdin fed from the given string.
+func runKanbanStdin(t *testing.T, dir, stdin string, args ...string) result {
+	t.Helper()
+
+	fullArgs := append([]string{"--dir", dir}, args...)
+	cmd := exec.Command(binPath, fullArgs...) //nolint:gosec,noctx // e2e test binary
+	cmd.Stdin = strings.NewReader(stdin)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+
+	r := result{
+		stdout: stdout.String(),
+		stderr: stderr.String(),
+	}
+
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			r.exitCode = exitErr.ExitCode()
+		} else {
+			t.Fatalf("running kanban-md: %v", err)
+		}
+	}
+
+	return r
+}
+
 // runKanbanJSON runs with --json and unmarshals stdout into dest.
 
 // runKanbanJSON runs with --json and unmarshals stdout into dest.
@@ -186,9 +236,11 @@ func runKanbanJSON(t *testing.T, dir string, dest interface{}, args ...string) r
 
 // errorJSON captures the structured error JSON output.
 type errorJSON struct {
-	Error   string         `json:"error"`
-	Code    string         `json:"code"`
-	Details map[string]any `json:"details,omitempty"`
+	Error      string         `json:"error"`
+	Code       string         `json:"code"`
+	Details    map[string]any `json:"details,omitempty"`
+	Retryable  bool           `json:"retryable"`
+	RetryAfter int            `json:"retry_after"`
 }
 
 // runKanbanJSONError runs with --json and expects a non-zero exit code.
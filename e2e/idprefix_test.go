@@ -0,0 +1,59 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Task ID prefix tests
+// ---------------------------------------------------------------------------
+
+func TestIDPrefix(t *testing.T) {
+	kanbanDir := initBoard(t)
+	if r := runKanban(t, kanbanDir, "config", "set", "board.id_prefix", "API-"); r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "create", "Design endpoints")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "Created task API-1") {
+		t.Fatalf("create output = %q (stderr %q), want prefixed ID", r.stdout, r.stderr)
+	}
+	mustCreateTask(t, kanbanDir, "Implement endpoints", "--parent", "API-1", "--depends-on", "api-1")
+
+	var shown struct {
+		ID        int   `json:"id"`
+		Parent    *int  `json:"parent"`
+		DependsOn []int `json:"depends_on"`
+	}
+	runKanbanJSON(t, kanbanDir, &shown, "show", "API-2")
+	if shown.ID != 2 || shown.Parent == nil || *shown.Parent != 1 || len(shown.DependsOn) != 1 || shown.DependsOn[0] != 1 {
+		t.Errorf("show API-2 = %+v, want task 2 with parent and dependency 1", shown)
+	}
+
+	// Bare numbers still work.
+	if r = runKanban(t, kanbanDir, "edit", "2", "--priority", "high"); r.exitCode != 0 {
+		t.Errorf("edit with bare ID failed: %s", r.stderr)
+	}
+	if r = runKanban(t, kanbanDir, "move", "API-1,API-2", statusTodo); r.exitCode != 0 {
+		t.Errorf("batch move with prefixed IDs failed: %s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "--compact", "list")
+	if !strings.Contains(r.stdout, "API-1 [todo/") || !strings.Contains(r.stdout, "API-2 [todo/") {
+		t.Errorf("compact list should show prefixed IDs:\n%s", r.stdout)
+	}
+
+	// Another board's prefix does not address this board's tasks.
+	errResp := runKanbanJSONError(t, kanbanDir, "show", "WEB-1")
+	if errResp.Code != "INVALID_TASK_ID" {
+		t.Errorf("show WEB-1 code = %q, want INVALID_TASK_ID", errResp.Code)
+	}
+}
+
+func TestIDPrefixRejectsInvalidPrefix(t *testing.T) {
+	kanbanDir := initBoard(t)
+	if r := runKanban(t, kanbanDir, "config", "set", "board.id_prefix", "V2"); r.exitCode == 0 {
+		t.Error("expected a prefix ending in a digit to be rejected")
+	}
+}
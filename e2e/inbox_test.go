@@ -0,0 +1,123 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// inbox command tests
+// ---------------------------------------------------------------------------
+
+type inboxEntryJSON struct {
+	Source      string `json:"source"`
+	File        string `json:"file"`
+	Title       string `json:"title"`
+	ID          int    `json:"id"`
+	DuplicateOf int    `json:"duplicate_of"`
+}
+
+type inboxJSON struct {
+	Created    []inboxEntryJSON `json:"created"`
+	Duplicates []inboxEntryJSON `json:"duplicates"`
+	Skipped    []struct {
+		File string `json:"file"`
+	} `json:"skipped"`
+	DryRun bool `json:"dry_run"`
+}
+
+func initInboxBoard(t *testing.T) string {
+	t.Helper()
+	kanbanDir := initBoard(t)
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	data, err := os.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, `inbox:
+    status: todo
+    sources:
+        - name: drop
+          type: folder
+          path: inbox
+          tags: [intake]
+        - name: mail
+          type: email
+          path: mail
+`...)
+	if err := os.WriteFile(cfgPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return kanbanDir
+}
+
+func writeInboxFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInboxFilesAndDeduplicates(t *testing.T) {
+	kanbanDir := initInboxBoard(t)
+	drop := filepath.Join(kanbanDir, "inbox")
+	writeInboxFile(t, drop, "crash.md", "# Crash on save\n\nSteps: save twice.\n")
+	writeInboxFile(t, filepath.Join(kanbanDir, "mail"), "1.eml",
+		"From: ann@example.com\r\nSubject: Export is slow\r\n\r\nTakes a minute.\r\n")
+
+	var preview inboxJSON
+	runKanbanJSON(t, kanbanDir, &preview, "inbox", "--dry-run")
+	if !preview.DryRun || len(preview.Created) != 2 {
+		t.Fatalf("dry run = %+v, want 2 items", preview)
+	}
+	if _, err := os.Stat(filepath.Join(drop, "crash.md")); err != nil {
+		t.Errorf("dry run removed the note: %v", err)
+	}
+
+	var got inboxJSON
+	runKanbanJSON(t, kanbanDir, &got, "inbox")
+	if len(got.Created) != 2 || got.Created[0].ID != 1 || got.Created[1].Title != "Export is slow" {
+		t.Fatalf("inbox = %+v", got)
+	}
+	var crash taskJSON
+	runKanbanJSON(t, kanbanDir, &crash, "show", "1")
+	if crash.Status != "todo" || !slices.Equal(crash.Tags, []string{"intake"}) || strings.TrimSpace(crash.Body) != "Steps: save twice." {
+		t.Errorf("filed task = %+v", crash)
+	}
+	if _, err := os.Stat(filepath.Join(drop, "crash.md")); !os.IsNotExist(err) {
+		t.Errorf("filed note not removed: %v", err)
+	}
+
+	// The same note delivered again is a duplicate, not a new task.
+	writeInboxFile(t, drop, "crash-again.md", "# Crash on save\n\nSteps: save twice.")
+	var again inboxJSON
+	runKanbanJSON(t, kanbanDir, &again, "inbox", "--source", "drop")
+	if len(again.Created) != 0 || len(again.Duplicates) != 1 || again.Duplicates[0].DuplicateOf != 1 {
+		t.Errorf("second run = %+v, want one duplicate of #1", again)
+	}
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "list")
+	if len(tasks) != 2 {
+		t.Errorf("list = %d tasks, want 2", len(tasks))
+	}
+}
+
+func TestInboxErrors(t *testing.T) {
+	kanbanDir := initBoard(t)
+	errResp := runKanbanJSONError(t, kanbanDir, "inbox")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("no sources code = %q, want INVALID_INPUT", errResp.Code)
+	}
+
+	kanbanDir = initInboxBoard(t)
+	errResp = runKanbanJSONError(t, kanbanDir, "inbox", "--source", "fax")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("unknown source code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
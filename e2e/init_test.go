@@ -97,3 +97,104 @@ func TestInitShowsSkillHint(t *testing.T) {
 // writeTaskFile writes a raw task markdown file into the tasks directory.
 // The filename follows the convention: 001-<slug>.md (zero-padded ID + slug).
 // The title is extracted from the YAML frontmatter.
+
+func TestInitInteractive(t *testing.T) {
+	dir := t.TempDir()
+	kanbanDir := filepath.Join(dir, "kanban")
+
+	answers := strings.Join([]string{
+		"Team Board",      // name
+		"todo,doing,done", // statuses
+		"doing:2",         // WIP limits
+		"n",               // classes of service
+		"y",               // agents use claims
+		"doing",           // statuses requiring a claim
+		"30m",             // claim timeout
+		"n",               // .gitignore
+	}, "\n") + "\n"
+	r := runKanbanStdin(t, kanbanDir, answers, "init", "-i")
+	if r.exitCode != 0 {
+		t.Fatalf("init -i failed (exit %d): %s", r.exitCode, r.stderr)
+	}
+	if !strings.Contains(r.stderr, "Statuses, in board order") {
+		t.Errorf("questions not printed to stderr:\n%s", r.stderr)
+	}
+
+	var cfg map[string]any
+	runKanbanJSON(t, kanbanDir, &cfg, "config")
+	if cfg["board.name"] != "Team Board" {
+		t.Errorf("board.name = %v", cfg["board.name"])
+	}
+	if cfg["claim_timeout"] != "30m" || cfg["defaults.status"] != "todo" || cfg["defaults.class"] != "" {
+		t.Errorf("claim_timeout = %v, defaults.status = %v, defaults.class = %v",
+			cfg["claim_timeout"], cfg["defaults.status"], cfg["defaults.class"])
+	}
+	if wip, _ := cfg["wip_limits"].(map[string]any); wip["doing"] != float64(2) {
+		t.Errorf("wip_limits = %v, want doing: 2", cfg["wip_limits"])
+	}
+
+	data, err := os.ReadFile(filepath.Join(kanbanDir, "config.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# kanban-md board configuration.", "# Maximum number of tasks per status", "require_claim: true"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("config.yml missing %q:\n%s", want, data)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".gitignore")); !os.IsNotExist(err) {
+		t.Errorf(".gitignore written although declined: %v", err)
+	}
+
+	// Claims are enforced only where requested.
+	mustCreateTask(t, kanbanDir, "Work")
+	errResp := runKanbanJSONError(t, kanbanDir, "move", "1", "doing")
+	if errResp.Code != "CLAIM_REQUIRED" {
+		t.Errorf("move to doing without claim: code = %q, want CLAIM_REQUIRED", errResp.Code)
+	}
+}
+
+func TestInitInteractiveDefaults(t *testing.T) {
+	dir := t.TempDir()
+	kanbanDir := filepath.Join(dir, "kanban")
+
+	// End of input accepts every default.
+	r := runKanbanStdin(t, kanbanDir, "", "init", "-i", "--name", "Defaults")
+	if r.exitCode != 0 {
+		t.Fatalf("init -i failed (exit %d): %s", r.exitCode, r.stderr)
+	}
+
+	var cfg map[string]any
+	runKanbanJSON(t, kanbanDir, &cfg, "config")
+	if cfg["board.name"] != "Defaults" || cfg["defaults.class"] != "standard" || cfg["claim_timeout"] != "1h" {
+		t.Errorf("defaults not kept: %v", cfg)
+	}
+	gitignore, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	if err != nil || !strings.Contains(string(gitignore), "kanban/") {
+		t.Errorf(".gitignore = %q (%v), want kanban/ entry", gitignore, err)
+	}
+
+	// config set keeps the comments.
+	runKanban(t, kanbanDir, "config", "set", "board.name", "Renamed")
+	data, _ := os.ReadFile(filepath.Join(kanbanDir, "config.yml"))
+	if !strings.Contains(string(data), "# Name shown in the TUI") || !strings.Contains(string(data), "name: Renamed") {
+		t.Errorf("config set lost comments or value:\n%s", data)
+	}
+}
+
+func TestInitInteractiveRejectsBadAnswer(t *testing.T) {
+	dir := t.TempDir()
+	kanbanDir := filepath.Join(dir, "kanban")
+
+	// An invalid final answer cannot be asked again.
+	r := runKanbanStdin(t, kanbanDir, "Board\n\nbogus", "--json", "init", "-i")
+	if r.exitCode == 0 {
+		t.Fatal("expected init -i to fail")
+	}
+	if !strings.Contains(r.stdout, "INVALID_INPUT") {
+		t.Errorf("stdout = %q, want INVALID_INPUT", r.stdout)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "config.yml")); !os.IsNotExist(err) {
+		t.Errorf("config written after a failed questionnaire: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeLangCatalog writes an i18n/<lang>.yml catalog into the board directory.
+func writeLangCatalog(t *testing.T, kanbanDir, lang, content string) {
+	t.Helper()
+	dir := filepath.Join(kanbanDir, "i18n")
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, lang+".yml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+const deCatalog = `STATUS: ZUSTAND
+TITLE: TITEL
+"Created task %s: %s": "Aufgabe %s erstellt: %s"
+Priority: Priorität
+`
+
+func TestLang_TranslatesHumanOutput(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	kanbanDir := initBoard(t)
+	writeLangCatalog(t, kanbanDir, "de", deCatalog)
+
+	r := runKanban(t, kanbanDir, "--table", "--lang", "de", "create", "Erste Aufgabe")
+	if r.exitCode != 0 {
+		t.Fatalf("create failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "Aufgabe #1 erstellt: Erste Aufgabe") {
+		t.Errorf("create message not translated:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "--lang", "de", "list")
+	if !strings.Contains(r.stdout, "ZUSTAND") || !strings.Contains(r.stdout, "TITEL") {
+		t.Errorf("list headers not translated:\n%s", r.stdout)
+	}
+	// Untranslated headers fall back to English.
+	if !strings.Contains(r.stdout, "PRIORITY") {
+		t.Errorf("untranslated header missing:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "--lang", "de", "show", "1")
+	if !strings.Contains(r.stdout, "Priorität:") {
+		t.Errorf("show labels not translated:\n%s", r.stdout)
+	}
+}
+
+func TestLang_EnvAndJSONKeysStable(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	kanbanDir := initBoard(t)
+	writeLangCatalog(t, kanbanDir, "de", deCatalog+"status: zustand\n")
+	t.Setenv("KANBAN_LANG", "de_DE.UTF-8")
+
+	task := mustCreateTask(t, kanbanDir, "JSON task")
+	if task.Status == "" {
+		t.Errorf("JSON output lost the status key: %+v", task)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "list")
+	if !strings.Contains(r.stdout, "ZUSTAND") {
+		t.Errorf("KANBAN_LANG not applied:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--json", "show", "1")
+	if !strings.Contains(r.stdout, `"status"`) || strings.Contains(r.stdout, "zustand") {
+		t.Errorf("JSON keys changed under KANBAN_LANG:\n%s", r.stdout)
+	}
+}
+
+func TestLang_Missing(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "--lang", "xx", "list")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+
+	// An unknown KANBAN_LANG falls back to English.
+	t.Setenv("KANBAN_LANG", "xx")
+	r := runKanban(t, kanbanDir, "--table", "list")
+	if r.exitCode != 0 {
+		t.Errorf("KANBAN_LANG=xx should fall back to English, got exit %d: %s", r.exitCode, r.stderr)
+	}
+}
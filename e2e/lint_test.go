@@ -0,0 +1,116 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// lint command tests
+// ---------------------------------------------------------------------------
+
+type lintJSON struct {
+	Files    int `json:"files"`
+	Errors   int `json:"errors"`
+	Warnings int `json:"warnings"`
+	Issues   []struct {
+		File     string `json:"file"`
+		ID       int    `json:"id"`
+		Rule     string `json:"rule"`
+		Severity string `json:"severity"`
+	} `json:"issues"`
+}
+
+func runLintJSON(t *testing.T, dir string, args ...string) (lintJSON, int) {
+	t.Helper()
+	r := runKanban(t, dir, append([]string{"--json", "lint"}, args...)...)
+	var got lintJSON
+	if err := json.Unmarshal([]byte(r.stdout), &got); err != nil {
+		t.Fatalf("parsing lint output: %v\nstdout: %s\nstderr: %s", err, r.stdout, r.stderr)
+	}
+	return got, r.exitCode
+}
+
+func TestLintRules(t *testing.T) {
+	kanbanDir := initBoard(t)
+	runKanban(t, kanbanDir, "config", "set", "lint.title_max_length", "20")
+	runKanban(t, kanbanDir, "config", "set", "lint.forbidden_words", "wip")
+	mustCreateTask(t, kanbanDir, "Fix login", "--body", "Steps to reproduce")
+	mustCreateTask(t, kanbanDir, "A title longer than twenty characters")
+
+	got, code := runLintJSON(t, kanbanDir)
+	if code != 0 || got.Files != 2 || got.Warnings != 1 || got.Errors != 0 {
+		t.Fatalf("lint = %+v (exit %d), want one warning and exit 0", got, code)
+	}
+	if got.Issues[0].ID != 2 || got.Issues[0].Rule != "title-length" {
+		t.Errorf("issue = %+v, want title-length on task 2", got.Issues[0])
+	}
+	if r := runKanban(t, kanbanDir, "lint", "--fail-on", "warning"); r.exitCode == 0 {
+		t.Error("--fail-on warning should fail on lint warnings")
+	}
+
+	// Errors fail the run.
+	created := mustCreateTask(t, kanbanDir, "Still WIP", "--priority", "critical")
+	got, code = runLintJSON(t, kanbanDir, created.File)
+	if code != 1 || got.Files != 1 || got.Errors != 1 || got.Warnings != 1 {
+		t.Errorf("lint %s = %+v (exit %d), want a forbidden word error and an empty body warning",
+			filepath.Base(created.File), got, code)
+	}
+
+	// Unparsable files are frontmatter errors; other files are skipped.
+	bad := filepath.Join(kanbanDir, "tasks", "010-bad.md")
+	if err := os.WriteFile(bad, []byte("---\nid: [\n---\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, code = runLintJSON(t, kanbanDir, bad, filepath.Join(kanbanDir, "config.yml"))
+	if code != 1 || got.Files != 1 || len(got.Issues) != 1 || got.Issues[0].Rule != "frontmatter" {
+		t.Errorf("lint bad file = %+v (exit %d), want one frontmatter error", got, code)
+	}
+}
+
+func TestLintStaged(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	kanbanDir := initBoard(t)
+	gitIn := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", filepath.Dir(kanbanDir)}, args...)...) //nolint:gosec,noctx // test helper
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	gitIn("init", "-q")
+	runKanban(t, kanbanDir, "config", "set", "lint.forbidden_words", "wip")
+	good := mustCreateTask(t, kanbanDir, "Fix login")
+	bad := mustCreateTask(t, kanbanDir, "WIP refactor")
+
+	// Only staged files are checked. init ignores the board, hence -f.
+	gitIn("add", "-f", good.File)
+	got, code := runLintJSON(t, kanbanDir, "--staged")
+	if code != 0 || got.Files != 1 || len(got.Issues) != 0 {
+		t.Errorf("lint --staged = %+v (exit %d), want one clean file", got, code)
+	}
+	gitIn("add", "-f", bad.File)
+	got, code = runLintJSON(t, kanbanDir, "--staged")
+	if code != 1 || got.Files != 2 || got.Errors != 1 || got.Issues[0].ID != 2 {
+		t.Errorf("lint --staged = %+v (exit %d), want a forbidden word error on task 2", got, code)
+	}
+
+	// The staged version is checked, not the working tree.
+	if err := os.WriteFile(bad.File, []byte("---\nid: [\n---\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = runLintJSON(t, kanbanDir, "--staged")
+	if got.Errors != 1 || got.Issues[0].Rule != "forbidden-words" {
+		t.Errorf("lint --staged = %+v, want the staged forbidden word error", got)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "lint", "--staged", good.File)
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("--staged with files: code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+}
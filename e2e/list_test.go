@@ -2,6 +2,7 @@ package e2e_test
 
 import (
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -119,6 +120,52 @@ func TestListSortAndLimit(t *testing.T) {
 	}
 }
 
+func TestListPagination(t *testing.T) {
+	kanbanDir := initBoard(t)
+	for _, title := range []string{"A", "B", "C", "D", "E"} {
+		mustCreateTask(t, kanbanDir, title)
+	}
+
+	type taskPage struct {
+		Tasks      []taskJSON `json:"tasks"`
+		NextCursor string     `json:"next_cursor"`
+	}
+	var ids []int
+	args := []string{"list", "--limit", "2", "--offset", "0"}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("paging did not end")
+		}
+		var page taskPage
+		runKanbanJSON(t, kanbanDir, &page, args...)
+		for _, tk := range page.Tasks {
+			ids = append(ids, tk.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		args = []string{"list", "--limit", "2", "--cursor", page.NextCursor}
+	}
+	if !slices.Equal(ids, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("paged IDs = %v, want [1 2 3 4 5]", ids)
+	}
+
+	var page taskPage
+	runKanbanJSON(t, kanbanDir, &page, "list", "--offset", "3")
+	if len(page.Tasks) != 2 || page.Tasks[0].ID != 4 || page.NextCursor != "" {
+		t.Errorf("--offset 3 = %+v, want tasks 4 and 5 and no cursor", page)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "list", "--cursor", "not-a-cursor")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("bad cursor code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+	r := runKanban(t, kanbanDir, "list", "--limit", "2", "--offset", "0", "--compact")
+	if !strings.Contains(r.stderr, "More results: --cursor ") {
+		t.Errorf("compact stderr = %q, want the next cursor hint", r.stderr)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Show tests
 // ---------------------------------------------------------------------------
@@ -431,3 +478,27 @@ func TestListCompactOutput(t *testing.T) {
 		t.Error("compact list output should contain task title")
 	}
 }
+
+func TestListDueWithin(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Overdue", "--due", "-1d")
+	mustCreateTask(t, kanbanDir, "Soon", "--due", "+2d")
+	mustCreateTask(t, kanbanDir, "Later", "--due", "+10d")
+	mustCreateTask(t, kanbanDir, "Undated")
+
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "list", "--due-within", "3d")
+	if len(tasks) != 2 || tasks[0].ID != 1 || tasks[1].ID != 2 {
+		t.Errorf("--due-within 3d = %+v, want tasks #1 and #2", tasks)
+	}
+
+	runKanbanJSON(t, kanbanDir, &tasks, "list", "--due-within", "0d", "--business-days")
+	if len(tasks) != 1 || tasks[0].ID != 1 {
+		t.Errorf("--due-within 0d --business-days = %+v, want task #1", tasks)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "list", "--due-within", "soon")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
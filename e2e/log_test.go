@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -22,6 +24,7 @@ type logEntry struct {
 	Action string `json:"action"`
 	TaskID int    `json:"task_id"`
 	Detail string `json:"detail"`
+	Actor  string `json:"actor"`
 }
 
 func TestLogEmptyBoard(t *testing.T) {
@@ -35,6 +38,27 @@ func TestLogEmptyBoard(t *testing.T) {
 	}
 }
 
+func TestLogPagination(t *testing.T) {
+	kanbanDir := initBoard(t)
+	for _, title := range []string{"A", "B", "C"} {
+		mustCreateTask(t, kanbanDir, title)
+	}
+
+	type logPage struct {
+		Entries    []logEntry `json:"entries"`
+		NextCursor string     `json:"next_cursor"`
+	}
+	var first, second logPage
+	runKanbanJSON(t, kanbanDir, &first, "log", "--limit", "2", "--offset", "0")
+	if len(first.Entries) != 2 || first.Entries[0].TaskID != 1 || first.NextCursor == "" {
+		t.Fatalf("first page = %+v, want tasks 1 and 2 with a cursor", first)
+	}
+	runKanbanJSON(t, kanbanDir, &second, "log", "--limit", "2", "--cursor", first.NextCursor)
+	if len(second.Entries) != 1 || second.Entries[0].TaskID != 3 || second.NextCursor != "" {
+		t.Errorf("second page = %+v, want task 3 and no cursor", second)
+	}
+}
+
 func TestLogAfterCreate(t *testing.T) {
 	kanbanDir := initBoard(t)
 	mustCreateTask(t, kanbanDir, "Log me")
@@ -158,6 +182,99 @@ func TestLogTaskFilter(t *testing.T) {
 	}
 }
 
+func TestLogTaskFilterPrefixedID(t *testing.T) {
+	kanbanDir := initBoard(t)
+	if r := runKanban(t, kanbanDir, "config", "set", "board.id_prefix", "API-"); r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+	mustCreateTask(t, kanbanDir, "Task A")
+	mustCreateTask(t, kanbanDir, "Task B")
+
+	var entries []logEntry
+	runKanbanJSON(t, kanbanDir, &entries, "log", "--task", "API-2")
+	if len(entries) != 1 || entries[0].TaskID != 2 {
+		t.Errorf("log --task API-2 = %+v, want one entry for task 2", entries)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "log", "--task", "WEB-2")
+	if errResp.Code != "INVALID_TASK_ID" {
+		t.Errorf("log --task WEB-2 code = %q, want INVALID_TASK_ID", errResp.Code)
+	}
+}
+
+func TestLogActorAttribution(t *testing.T) {
+	kanbanDir := initBoard(t)
+	env := []string{"KANBAN_AGENT=agent-alpha"}
+	if r := runKanbanEnv(t, kanbanDir, env, "create", "From env"); r.exitCode != 0 {
+		t.Fatalf("create failed: %s", r.stderr)
+	}
+	runKanban(t, kanbanDir, "--json", "move", "1", statusInProgress, "--claim", "agent-beta")
+
+	var entries []logEntry
+	runKanbanJSON(t, kanbanDir, &entries, "log")
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	if entries[0].Actor != "agent-alpha" {
+		t.Errorf("create actor = %q, want agent-alpha (from KANBAN_AGENT)", entries[0].Actor)
+	}
+	if entries[1].Actor != "agent-beta" {
+		t.Errorf("move actor = %q, want agent-beta (from --claim)", entries[1].Actor)
+	}
+	if entries[2].Action != "claim" || entries[2].Detail != "agent-beta" {
+		t.Errorf("third entry = %+v, want the claim made by the move", entries[2])
+	}
+
+	var filtered []logEntry
+	runKanbanJSON(t, kanbanDir, &filtered, "log", "--actor", "agent-alpha")
+	if len(filtered) != 1 || filtered[0].Action != "create" {
+		t.Errorf("log --actor agent-alpha = %+v, want only the create", filtered)
+	}
+
+	r := runKanban(t, kanbanDir, "log", "--compact")
+	if !strings.Contains(r.stdout, "by:agent-beta") {
+		t.Errorf("compact log missing actor:\n%s", r.stdout)
+	}
+}
+
+func TestLogSinkMirrorsAndReplays(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Before the sink")
+	appendConfig(t, kanbanDir, "log:\n  sinks:\n    - name: audit\n      type: file\n      path: audit.jsonl\n")
+	mustCreateTask(t, kanbanDir, "After the sink")
+
+	sinkPath := filepath.Join(kanbanDir, "audit.jsonl")
+	data, err := os.ReadFile(sinkPath) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading sink: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 1 || !strings.Contains(lines[0], `"task_id":2`) {
+		t.Fatalf("sink = %q, want only the entry for task 2", data)
+	}
+
+	// Backfill the whole log into a fresh sink file.
+	if err := os.Remove(sinkPath); err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Sink     string `json:"sink"`
+		Replayed int    `json:"replayed"`
+	}
+	runKanbanJSON(t, kanbanDir, &resp, "log", "replay", "--sink", "audit")
+	if resp.Sink != "audit" || resp.Replayed != 2 {
+		t.Errorf("replay = %+v, want 2 entries to audit", resp)
+	}
+	data, _ = os.ReadFile(sinkPath) //nolint:gosec // e2e test file
+	if n := strings.Count(string(data), "\n"); n != 2 {
+		t.Errorf("sink has %d lines after replay, want 2", n)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "log", "replay", "--sink", "nope")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("unknown sink code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
 func TestLogLimit(t *testing.T) {
 	kanbanDir := initBoard(t)
 	mustCreateTask(t, kanbanDir, "Task A")
@@ -239,3 +356,64 @@ func TestLogWithBadSince(t *testing.T) {
 // ---------------------------------------------------------------------------
 // Pick command tests
 // ---------------------------------------------------------------------------
+
+type logSummaryJSON struct {
+	Entries int `json:"entries"`
+	Created []struct {
+		ID    int    `json:"id"`
+		Title string `json:"title"`
+	} `json:"created"`
+	Completed []struct {
+		ID int `json:"id"`
+	} `json:"completed"`
+	Blocked []struct {
+		ID           int    `json:"id"`
+		Detail       string `json:"detail"`
+		StillBlocked bool   `json:"still_blocked"`
+	} `json:"blocked"`
+}
+
+func TestLogDiffSummary(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Ship it")
+	mustCreateTask(t, kanbanDir, "Waiting")
+	runKanban(t, kanbanDir, "move", "1", "done")
+	runKanban(t, kanbanDir, "edit", "2", "--block", "vendor")
+
+	var s logSummaryJSON
+	r := runKanbanJSON(t, kanbanDir, &s, "log", "diff", "--from", "yesterday", "--to", "tomorrow", "--summary")
+	if r.exitCode != 0 {
+		t.Fatalf("log diff --summary failed: %s", r.stderr)
+	}
+	if len(s.Created) != 2 || len(s.Completed) != 1 || s.Completed[0].ID != 1 {
+		t.Errorf("created %d, completed %+v; want 2 created and task 1 completed", len(s.Created), s.Completed)
+	}
+	if len(s.Blocked) != 1 || s.Blocked[0].Detail != "vendor" || !s.Blocked[0].StillBlocked {
+		t.Errorf("blocked = %+v, want task 2 still blocked by vendor", s.Blocked)
+	}
+
+	r = runKanban(t, kanbanDir, "log", "diff", "--from", "yesterday", "--to", "tomorrow", "--summary")
+	if !strings.Contains(r.stdout, "Created 2, completed 1") || !strings.Contains(r.stdout, "(still blocked)") {
+		t.Errorf("unexpected summary:\n%s", r.stdout)
+	}
+}
+
+func TestLogDiffRange(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Today")
+
+	var entries []logEntry
+	runKanbanJSON(t, kanbanDir, &entries, "log", "diff", "--from", "-1w", "--to", "yesterday")
+	if len(entries) != 0 {
+		t.Errorf("got %d entries before today, want 0", len(entries))
+	}
+	runKanbanJSON(t, kanbanDir, &entries, "log", "diff", "--from", "yesterday")
+	if len(entries) != 1 || entries[0].Action != "create" {
+		t.Errorf("entries = %+v, want the create entry", entries)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "log", "diff", "--from", "tomorrow", "--to", "yesterday")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
@@ -0,0 +1,98 @@
+package e2e_test
+
+import (
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Manifest tests
+// ---------------------------------------------------------------------------
+
+type manifestFlagJSON struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand"`
+	Type      string `json:"type"`
+	Default   string `json:"default"`
+}
+
+type manifestCommandJSON struct {
+	Name        string                `json:"name"`
+	Path        string                `json:"path"`
+	Flags       []manifestFlagJSON    `json:"flags"`
+	Subcommands []manifestCommandJSON `json:"subcommands"`
+}
+
+type manifestJSON struct {
+	SchemaVersion int                   `json:"schema_version"`
+	Name          string                `json:"name"`
+	GlobalFlags   []manifestFlagJSON    `json:"global_flags"`
+	Commands      []manifestCommandJSON `json:"commands"`
+	ErrorCodes    []struct {
+		Code     string `json:"code"`
+		ExitCode int    `json:"exit_code"`
+	} `json:"error_codes"`
+}
+
+func findManifestCommand(cmds []manifestCommandJSON, name string) *manifestCommandJSON {
+	for i := range cmds {
+		if cmds[i].Name == name {
+			return &cmds[i]
+		}
+	}
+	return nil
+}
+
+func findManifestFlag(flags []manifestFlagJSON, name string) *manifestFlagJSON {
+	for i := range flags {
+		if flags[i].Name == name {
+			return &flags[i]
+		}
+	}
+	return nil
+}
+
+func TestManifestDescribesCommandsAndFlags(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	var m manifestJSON
+	runKanbanJSON(t, kanbanDir, &m, "manifest")
+
+	if m.SchemaVersion != 1 || m.Name != "kanban-md" {
+		t.Errorf("header = %d %q, want 1 kanban-md", m.SchemaVersion, m.Name)
+	}
+	if f := findManifestFlag(m.GlobalFlags, "json"); f == nil || f.Type != "bool" {
+		t.Errorf("global --json = %+v, want bool flag", f)
+	}
+
+	next := findManifestCommand(m.Commands, "next")
+	if next == nil {
+		t.Fatal("manifest missing next command")
+	}
+	limit := findManifestFlag(next.Flags, "limit")
+	if limit == nil || limit.Shorthand != "n" || limit.Type != "int" || limit.Default != "3" {
+		t.Errorf("next --limit = %+v, want -n int default 3", limit)
+	}
+	if findManifestFlag(next.Flags, "json") != nil {
+		t.Error("inherited global flags should not be repeated per command")
+	}
+
+	cfg := findManifestCommand(m.Commands, "config")
+	if cfg == nil || findManifestCommand(cfg.Subcommands, "set") == nil {
+		t.Errorf("config set subcommand missing: %+v", cfg)
+	}
+}
+
+func TestManifestListsErrorCodes(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	var m manifestJSON
+	runKanbanJSON(t, kanbanDir, &m, "manifest")
+
+	exits := map[string]int{}
+	for _, c := range m.ErrorCodes {
+		exits[c.Code] = c.ExitCode
+	}
+	if exits["TASK_NOT_FOUND"] != 4 || exits["WIP_LIMIT_EXCEEDED"] != 6 || exits["INTERNAL_ERROR"] != 2 {
+		t.Errorf("error codes = %v", exits)
+	}
+}
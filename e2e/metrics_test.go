@@ -1,8 +1,10 @@
 package e2e_test
 
 import (
+	"math"
 	"strings"
 	"testing"
+	"time"
 )
 
 // ---------------------------------------------------------------------------
@@ -130,3 +132,195 @@ func TestMetricsWithBadSince(t *testing.T) {
 		t.Errorf("error code = %q, want %q", errResp.Code, codeInvalidDate)
 	}
 }
+
+func TestMetricsBusinessDays(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Task A")
+	runKanban(t, kanbanDir, "--json", "move", "1", "in-progress", "--claim", claimTestAgent)
+
+	var m struct {
+		BusinessDays bool `json:"business_days"`
+		AgingItems   []struct {
+			ID int `json:"id"`
+		} `json:"aging_items"`
+	}
+	runKanbanJSON(t, kanbanDir, &m, "metrics", "--business-days")
+	if !m.BusinessDays {
+		t.Error("business_days = false, want true")
+	}
+	if len(m.AgingItems) != 1 {
+		t.Errorf("AgingItems = %d, want 1", len(m.AgingItems))
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "metrics", "--business-days")
+	if !strings.Contains(r.stdout, "Flow Metrics (business days)") {
+		t.Errorf("table output missing business days heading:\n%s", r.stdout)
+	}
+}
+
+func TestMetricsByTag(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Crash on save", "--tags", "bug")
+	mustCreateTask(t, kanbanDir, "Flaky deploy", "--tags", "bug,infra")
+	mustCreateTask(t, kanbanDir, "Dark mode", "--tags", "feature")
+	runKanban(t, kanbanDir, "--json", "move", "1", "done")
+
+	var r struct {
+		Tags []struct {
+			Tag       string `json:"tag"`
+			Created   int    `json:"created"`
+			Completed int    `json:"completed"`
+			Weekly    []struct {
+				Completed int `json:"completed"`
+			} `json:"weekly"`
+		} `json:"tags"`
+	}
+	runKanbanJSON(t, kanbanDir, &r, "metrics", "--by", "tag", "--since", "4w")
+	if len(r.Tags) != 3 {
+		t.Fatalf("tags = %+v, want bug, feature, and infra", r.Tags)
+	}
+	bug := r.Tags[0]
+	if bug.Tag != "bug" || bug.Created != 2 || bug.Completed != 1 {
+		t.Errorf("first tag = %+v, want bug with 2 created and 1 completed", bug)
+	}
+	if len(bug.Weekly) != 4 || bug.Weekly[3].Completed != 1 {
+		t.Errorf("bug weekly = %+v, want 4 weeks with the completion in the last", bug.Weekly)
+	}
+
+	out := runKanban(t, kanbanDir, "metrics", "--by", "tag", "--since", "1w", "--csv")
+	if out.exitCode != 0 {
+		t.Fatalf("metrics --csv failed (exit %d): %s", out.exitCode, out.stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(out.stdout), "\n")
+	if len(lines) != 4 || lines[0] != "tag,week_start,created,completed" || !strings.HasSuffix(lines[1], ",2,1") {
+		t.Errorf("csv = %q, want a header and one row per tag", out.stdout)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "metrics", "--by", "owner")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("--by owner code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+}
+
+func TestMetricsTriage(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Assigned at intake", "--assignee", "alice")
+	mustCreateTask(t, kanbanDir, "Triaged later")
+	mustCreateTask(t, kanbanDir, "Untouched")
+	runKanban(t, kanbanDir, "--json", "move", "2", statusTodo)
+	runKanban(t, kanbanDir, "--json", "edit", "2", "--assignee", "bob")
+
+	type stat struct {
+		Count    int      `json:"count"`
+		Waiting  int      `json:"waiting"`
+		P90Hours *float64 `json:"p90_hours"`
+	}
+	var m struct {
+		FirstStatusChange stat `json:"first_status_change"`
+		FirstAssignee     stat `json:"first_assignee"`
+	}
+	runKanbanJSON(t, kanbanDir, &m, "metrics", "--triage")
+	if m.FirstStatusChange.Count != 1 || m.FirstStatusChange.Waiting != 2 || m.FirstStatusChange.P90Hours == nil {
+		t.Errorf("first_status_change = %+v, want 1 measured and 2 waiting", m.FirstStatusChange)
+	}
+	if m.FirstAssignee.Count != 2 || m.FirstAssignee.Waiting != 1 {
+		t.Errorf("first_assignee = %+v, want 2 measured and 1 waiting", m.FirstAssignee)
+	}
+
+	r := runKanban(t, kanbanDir, "show", "2", "--json")
+	if !strings.Contains(r.stdout, `"assigned"`) {
+		t.Errorf("show --json has no assigned time:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--table", "metrics", "--triage")
+	if !strings.Contains(r.stdout, "Triage Times") || !strings.Contains(r.stdout, "First assignee") {
+		t.Errorf("table output missing triage rows:\n%s", r.stdout)
+	}
+}
+
+func TestMetricsReopened(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Bounced back")
+	mustCreateTask(t, kanbanDir, "Stayed done")
+	runKanban(t, kanbanDir, "--json", "move", "1", "done")
+	runKanban(t, kanbanDir, "--json", "move", "1", statusTodo)
+	runKanban(t, kanbanDir, "--json", "move", "2", "done")
+
+	r := runKanban(t, kanbanDir, "--json", "show", "1")
+	if !strings.Contains(r.stdout, `"reopened": 1`) {
+		t.Errorf("show --json lacks reopened count:\n%s", r.stdout)
+	}
+
+	var m struct {
+		Reopened   int      `json:"reopened"`
+		Reopens    int      `json:"reopens"`
+		ReopenRate *float64 `json:"reopen_rate"`
+	}
+	runKanbanJSON(t, kanbanDir, &m, "metrics")
+	if m.Reopened != 1 || m.Reopens != 1 {
+		t.Errorf("reopened = %d, reopens = %d, want 1 and 1", m.Reopened, m.Reopens)
+	}
+	if m.ReopenRate == nil || *m.ReopenRate != 0.5 {
+		t.Errorf("reopen_rate = %v, want 0.5", m.ReopenRate)
+	}
+}
+
+func TestMetricsAbandonment(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Oversized", "--tags", "big")
+	mustCreateTask(t, kanbanDir, "Finished")
+	mustCreateTask(t, kanbanDir, "Just started")
+
+	start := time.Now().UTC().Add(-10 * time.Hour)
+	writeActivityLog(t, kanbanDir, []map[string]any{
+		{"timestamp": start, "action": "claim", "task_id": 1, "detail": "flaky", "actor": "flaky"},
+		{"timestamp": start, "action": "claim", "task_id": 2, "detail": "steady", "actor": "steady"},
+		{"timestamp": start.Add(time.Hour), "action": "move", "task_id": 2, "detail": "todo -> done", "actor": "steady"},
+	})
+	// A move that claims is logged as a claim; it is still running.
+	runKanban(t, kanbanDir, "--json", "move", "3", statusInProgress, "--claim", claimTestAgent)
+
+	var claims []struct {
+		TaskID int    `json:"task_id"`
+		Detail string `json:"detail"`
+	}
+	runKanbanJSON(t, kanbanDir, &claims, "log", "--action", "claim")
+	if len(claims) != 3 || claims[2].TaskID != 3 || claims[2].Detail != claimTestAgent {
+		t.Errorf("claim log = %+v, want the move's claim last", claims)
+	}
+
+	var m struct {
+		Abandonment struct {
+			Claims          int      `json:"claims"`
+			Expired         int      `json:"expired"`
+			AvgClaimedHours *float64 `json:"avg_claimed_hours"`
+			ByAgent         []struct {
+				Name    string `json:"name"`
+				Expired int    `json:"expired"`
+			} `json:"by_agent"`
+			ByTag []struct {
+				Name    string `json:"name"`
+				Expired int    `json:"expired"`
+			} `json:"by_tag"`
+		} `json:"abandonment"`
+	}
+	runKanbanJSON(t, kanbanDir, &m, "metrics")
+	a := m.Abandonment
+	if a.Claims != 2 || a.Expired != 1 {
+		t.Errorf("claims = %d, expired = %d, want 2 and 1", a.Claims, a.Expired)
+	}
+	if a.AvgClaimedHours == nil || math.Abs(*a.AvgClaimedHours-1) > 0.01 {
+		t.Errorf("avg_claimed_hours = %v, want the 1h claim timeout", a.AvgClaimedHours)
+	}
+	if len(a.ByAgent) == 0 || a.ByAgent[0].Name != "flaky" || a.ByAgent[0].Expired != 1 {
+		t.Errorf("by_agent = %+v, want flaky first", a.ByAgent)
+	}
+	if len(a.ByTag) == 0 || a.ByTag[0].Name != "big" || a.ByTag[0].Expired != 1 {
+		t.Errorf("by_tag = %+v, want big first", a.ByTag)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "metrics")
+	if !strings.Contains(r.stdout, "Expired claims") || !strings.Contains(r.stdout, "flaky") {
+		t.Errorf("table output missing expired claims:\n%s", r.stdout)
+	}
+}
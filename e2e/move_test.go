@@ -2,6 +2,7 @@ package e2e_test
 
 import (
 	"bytes"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -406,6 +407,85 @@ func TestMoveCompactOutput(t *testing.T) {
 	}
 }
 
+// setStatusChecklist adds a checklist template to the todo status in config.yml.
+func setStatusChecklist(t *testing.T, kanbanDir string, items ...string) {
+	t.Helper()
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	data, err := os.ReadFile(cfgPath) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading config: %v", err)
+	}
+	checklist := "    - name: todo\n      checklist:\n"
+	for _, item := range items {
+		checklist += "        - " + item + "\n"
+	}
+	content := strings.Replace(string(data), "    - name: todo\n", checklist, 1)
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+}
+
+func TestMoveInjectsStatusChecklist(t *testing.T) {
+	kanbanDir := initBoard(t)
+	setStatusChecklist(t, kanbanDir, "code reviewed", "tests pass")
+	runKanban(t, kanbanDir, "create", "Checklist task", "--body", "- [x] tests pass")
+
+	var task taskJSON
+	runKanbanJSON(t, kanbanDir, &task, "move", "1", statusTodo)
+	want := "- [x] tests pass\n\n- [ ] code reviewed\n"
+	if task.Body != want {
+		t.Errorf("body = %q, want %q", task.Body, want)
+	}
+
+	// Leaving and re-entering the status does not duplicate items.
+	runKanban(t, kanbanDir, "move", "1", "backlog")
+	runKanbanJSON(t, kanbanDir, &task, "move", "1", statusTodo)
+	if task.Body != want {
+		t.Errorf("body after re-entry = %q, want %q", task.Body, want)
+	}
+}
+
+func TestCreateInStatusInjectsChecklist(t *testing.T) {
+	kanbanDir := initBoard(t)
+	setStatusChecklist(t, kanbanDir, "scoped")
+
+	var task taskJSON
+	runKanbanJSON(t, kanbanDir, &task, "create", "Scoped task", "--status", statusTodo)
+	if task.Body != "- [ ] scoped\n" {
+		t.Errorf("body = %q, want checklist", task.Body)
+	}
+}
+
+func TestMoveRecordsStatusHistory(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Tracked")
+	runKanban(t, kanbanDir, "move", "1", statusTodo)
+	runKanban(t, kanbanDir, "move", "1", "done")
+
+	var task struct {
+		StatusHistory []struct {
+			Status    string `json:"status"`
+			EnteredAt string `json:"entered_at"`
+		} `json:"status_history"`
+	}
+	runKanbanJSON(t, kanbanDir, &task, "show", "1")
+	var got []string
+	for _, e := range task.StatusHistory {
+		if e.EnteredAt == "" {
+			t.Errorf("entry %q has no entered_at", e.Status)
+		}
+		got = append(got, e.Status)
+	}
+	if strings.Join(got, ",") != "backlog,todo,done" {
+		t.Errorf("status_history = %v, want backlog,todo,done", got)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "show", "1")
+	if !strings.Contains(r.stdout, "History:") {
+		t.Errorf("show output missing history:\n%s", r.stdout)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Delete command: compact output, JSON output
 // ---------------------------------------------------------------------------
@@ -0,0 +1,136 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// mv-board and tasks_dir relocation tests
+// ---------------------------------------------------------------------------
+
+type mvBoardJSON struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Rewritten []string `json:"rewritten"`
+	Gitignore bool     `json:"gitignore"`
+}
+
+func TestMvBoardMovesEverything(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Carry me")
+	root := filepath.Dir(kanbanDir)
+	if err := os.WriteFile(filepath.Join(root, ".gitignore"), []byte("bin/\nkanban/\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sinkPath := filepath.Join(kanbanDir, "mirror.jsonl")
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	cfgData, err := os.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfgData = append(cfgData, "log:\n    sinks:\n        - name: mirror\n          type: file\n          path: "+sinkPath+"\n"...)
+	if err := os.WriteFile(cfgPath, cfgData, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	newDir := filepath.Join(root, "docs", "kanban")
+	var got mvBoardJSON
+	runKanbanJSON(t, kanbanDir, &got, "mv-board", newDir)
+	if got.From != kanbanDir || got.To != newDir || !got.Gitignore {
+		t.Errorf("mv-board = %+v", got)
+	}
+	if len(got.Rewritten) != 1 || got.Rewritten[0] != "log.sinks.mirror.path" {
+		t.Errorf("rewritten = %v, want the mirror sink path", got.Rewritten)
+	}
+
+	if _, err := os.Stat(kanbanDir); !os.IsNotExist(err) {
+		t.Errorf("old directory still exists: %v", err)
+	}
+	var tasks []taskJSON
+	runKanbanJSON(t, newDir, &tasks, "list")
+	if len(tasks) != 1 || tasks[0].Title != "Carry me" {
+		t.Errorf("tasks after move = %+v", tasks)
+	}
+	log := runKanban(t, newDir, "log")
+	if !strings.Contains(log.stdout, "Carry me") {
+		t.Errorf("activity log not preserved:\n%s", log.stdout)
+	}
+	cfgOut := runKanban(t, newDir, "config", "get", "log.sinks")
+	if !strings.Contains(cfgOut.stdout, filepath.Join(newDir, "mirror.jsonl")) {
+		t.Errorf("sink path not rewritten:\n%s", cfgOut.stdout)
+	}
+
+	oldIgnore, _ := os.ReadFile(filepath.Join(root, ".gitignore"))
+	if string(oldIgnore) != "bin/\n" {
+		t.Errorf("old .gitignore = %q, want kanban/ entry removed", oldIgnore)
+	}
+	newIgnore, _ := os.ReadFile(filepath.Join(root, "docs", ".gitignore"))
+	if string(newIgnore) != "kanban/\n" {
+		t.Errorf("new .gitignore = %q", newIgnore)
+	}
+}
+
+func TestMvBoardRefusesNonEmptyTarget(t *testing.T) {
+	kanbanDir := initBoard(t)
+	busy := filepath.Join(filepath.Dir(kanbanDir), "busy")
+	if err := os.MkdirAll(busy, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(busy, "notes.txt"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "mv-board", busy)
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "mv-board", filepath.Join(kanbanDir, "nested"))
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("nested code = %q, want INVALID_INPUT", errResp.Code)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "config.yml")); err != nil {
+		t.Errorf("board should be untouched: %v", err)
+	}
+}
+
+func TestMvBoardNonStandardNameHint(t *testing.T) {
+	kanbanDir := initBoard(t)
+	newDir := filepath.Join(filepath.Dir(kanbanDir), "board")
+
+	r := runKanban(t, kanbanDir, "--table", "mv-board", newDir)
+	if r.exitCode != 0 {
+		t.Fatalf("mv-board failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "--dir "+newDir) {
+		t.Errorf("output should mention --dir:\n%s", r.stdout)
+	}
+}
+
+func TestConfigSetTasksDirMovesTasks(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Moved task")
+
+	r := runKanban(t, kanbanDir, "config", "set", "tasks_dir", "work/items")
+	if r.exitCode != 0 {
+		t.Fatalf("config set tasks_dir failed: %s", r.stderr)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "work", "items", "001-moved-task.md")); err != nil {
+		t.Errorf("task file not moved: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "tasks")); !os.IsNotExist(err) {
+		t.Errorf("old tasks dir still exists: %v", err)
+	}
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "list")
+	if len(tasks) != 1 {
+		t.Errorf("list after move = %d tasks, want 1", len(tasks))
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "config", "set", "tasks_dir", "../outside")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
@@ -0,0 +1,66 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Next command tests
+// ---------------------------------------------------------------------------
+
+type nextJSON struct {
+	ID        int    `json:"id"`
+	ClaimedBy string `json:"claimed_by"`
+	Score     int    `json:"score"`
+	Factors   []struct {
+		Factor string `json:"factor"`
+		Points int    `json:"points"`
+		Reason string `json:"reason"`
+	} `json:"factors"`
+}
+
+func TestNextRanksWithoutMutating(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Low", "--priority", "low")
+	mustCreateTask(t, kanbanDir, "Late", "--priority", "medium", "--due", "2020-01-01")
+	mustCreateTask(t, kanbanDir, "High", "--priority", "high")
+	mustCreateTask(t, kanbanDir, "Waiting", "--priority", "critical", "--depends-on", "1")
+
+	var recs []nextJSON
+	runKanbanJSON(t, kanbanDir, &recs, "next", "-n", "2")
+	if len(recs) != 2 || recs[0].ID != 2 || recs[1].ID != 3 {
+		t.Fatalf("next = %+v, want tasks 2 then 3", recs)
+	}
+	if recs[0].ClaimedBy != "" {
+		t.Error("next without --claim must not claim")
+	}
+	var reasons []string
+	for _, f := range recs[0].Factors {
+		reasons = append(reasons, f.Reason)
+	}
+	if got := strings.Join(reasons, "; "); !strings.Contains(got, "medium priority") || !strings.Contains(got, "overdue") {
+		t.Errorf("factors = %q, want priority and overdue", got)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "next")
+	if !strings.Contains(r.stdout, "1. #2 Late") || !strings.Contains(r.stdout, "overdue") {
+		t.Errorf("table output:\n%s", r.stdout)
+	}
+}
+
+func TestNextClaim(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Only", "--priority", "high")
+
+	var recs []nextJSON
+	runKanbanJSON(t, kanbanDir, &recs, "next", "--claim", claimTestAgent)
+	if len(recs) != 1 || recs[0].ClaimedBy != claimTestAgent {
+		t.Fatalf("next --claim = %+v", recs)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "next", "--claim", "someone-else")
+	if errResp.Code != "NOTHING_TO_PICK" {
+		t.Errorf("code = %q, want NOTHING_TO_PICK", errResp.Code)
+	}
+}
@@ -0,0 +1,48 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// ops log tests
+// ---------------------------------------------------------------------------
+
+type opsStatJSON struct {
+	Key    string `json:"key"`
+	Count  int    `json:"count"`
+	Errors int    `json:"errors"`
+}
+
+func TestOpsReport(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Before ops")
+	if _, err := os.Stat(filepath.Join(kanbanDir, "ops.jsonl")); !os.IsNotExist(err) {
+		t.Fatalf("ops log written with log.ops off: %v", err)
+	}
+
+	runKanban(t, kanbanDir, "config", "set", "log.ops", "true")
+	mustCreateTask(t, kanbanDir, "First")
+	mustCreateTask(t, kanbanDir, "Second")
+	runKanban(t, kanbanDir, "show", "99")
+
+	var stats []opsStatJSON
+	runKanbanJSON(t, kanbanDir, &stats, "ops", "report")
+	got := make(map[string]opsStatJSON)
+	for _, s := range stats {
+		got[s.Key] = s
+	}
+	if got["create"].Count != 2 || got["create"].Errors != 0 {
+		t.Errorf("create = %+v, want 2 runs", got["create"])
+	}
+	if got["show"].Count != 1 || got["show"].Errors != 1 {
+		t.Errorf("show = %+v, want 1 failed run", got["show"])
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "ops", "report", "--by", "task")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("--by task: code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+}
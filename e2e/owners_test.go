@@ -0,0 +1,58 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Path ownership (create --for-path) tests
+// ---------------------------------------------------------------------------
+
+func TestCreateForPathAppliesOwners(t *testing.T) {
+	kanbanDir := initBoard(t)
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	data, err := os.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, `owners:
+    - path: src/
+      assignee: alice
+    - path: src/api/
+      assignee: bob
+      tags: [api]
+tag_defaults:
+    api:
+        priority: high
+`...)
+	if err := os.WriteFile(cfgPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var api taskJSON
+	runKanbanJSON(t, kanbanDir, &api, "create", "Fix handler", "--for-path", "src/api/handler.go", "--tags", "bug")
+	if api.Assignee != "bob" || !slices.Equal(api.Tags, []string{"bug", "api"}) || api.Priority != "high" {
+		t.Errorf("create --for-path src/api/handler.go = %+v, want bob, [bug api], high", api)
+	}
+
+	var ui taskJSON
+	abs := filepath.Join(filepath.Dir(kanbanDir), "src", "ui", "app.ts")
+	runKanbanJSON(t, kanbanDir, &ui, "create", "Fix UI", "--for-path", abs, "--assignee", "dana")
+	if ui.Assignee != "dana" {
+		t.Errorf("explicit --assignee = %q, want dana", ui.Assignee)
+	}
+
+	var docs taskJSON
+	runKanbanJSON(t, kanbanDir, &docs, "create", "Fix docs", "--for-path", "docs/README.md")
+	if docs.Assignee != "" || len(docs.Tags) != 0 {
+		t.Errorf("unowned path = %+v, want no assignee or tags", docs)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "create", "Outside", "--for-path", "../elsewhere.go")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("outside path code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
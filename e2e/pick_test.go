@@ -166,6 +166,38 @@ func TestPickInvalidStatus(t *testing.T) {
 	}
 }
 
+func TestPickReadyRule(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	runKanban(t, kanbanDir, "config", "set", "ready.pull_from", "todo")
+	runKanban(t, kanbanDir, "config", "set", "ready.target", "in-progress")
+	mustCreateTask(t, kanbanDir, "Backlog item")
+	mustCreateTask(t, kanbanDir, "Todo item", "--status", "todo")
+	mustCreateTask(t, kanbanDir, "Dependent", "--status", "todo", "--depends-on", "1")
+
+	var ready []taskJSON
+	runKanbanJSON(t, kanbanDir, &ready, "list", "--ready")
+	if len(ready) != 1 || ready[0].ID != 2 {
+		t.Fatalf("list --ready = %+v, want task 2 only", ready)
+	}
+
+	// Filling the target column leaves nothing ready, and pick says why.
+	mustCreateTask(t, kanbanDir, "Busy", "--status", "in-progress")
+	runKanbanJSON(t, kanbanDir, &ready, "list", "--ready")
+	if len(ready) != 0 {
+		t.Errorf("list --ready with a full target = %+v, want none", ready)
+	}
+	errResp := runKanbanJSONError(t, kanbanDir, "pick", "--claim", claimAgent1)
+	if errResp.Code != codeWIPLimitExceeded {
+		t.Errorf("pick with a full target: code = %q, want WIP_LIMIT_EXCEEDED", errResp.Code)
+	}
+
+	runKanban(t, kanbanDir, "config", "set", "ready.target", "")
+	var picked taskJSON
+	if r := runKanbanJSON(t, kanbanDir, &picked, "pick", "--claim", claimAgent1); r.exitCode != 0 || picked.ID != 2 {
+		t.Errorf("pick = task %d (exit %d), want task 2", picked.ID, r.exitCode)
+	}
+}
+
 func TestPickInvalidMoveTarget(t *testing.T) {
 	kanbanDir := initBoard(t)
 
@@ -178,3 +210,88 @@ func TestPickInvalidMoveTarget(t *testing.T) {
 // ---------------------------------------------------------------------------
 // Class-aware WIP limit tests
 // ---------------------------------------------------------------------------
+
+func TestPickStrategyUnblocking(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Urgent leaf", "--priority", "critical")
+	mustCreateTask(t, kanbanDir, "Foundation", "--priority", "low")
+	mustCreateTask(t, kanbanDir, "Built on foundation", "--depends-on", "2")
+
+	var picked taskJSON
+	runKanbanJSON(t, kanbanDir, &picked, "pick", "--claim", claimAgent1, "--strategy", "unblocking")
+	if picked.ID != 2 {
+		t.Errorf("picked #%d, want #2", picked.ID)
+	}
+}
+
+func TestPickInvalidStrategy(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Task")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "pick", "--claim", claimAgent1, "--strategy", "random")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
+func TestPickWithContext(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Schema", "--status", "done")
+	mustCreateTask(t, kanbanDir, "Build API", "--priority", "critical", "--parent", "1",
+		"--depends-on", "2", "--body", "Use the new schema.")
+
+	var picked struct {
+		taskJSON
+		Context struct {
+			Dependencies []struct {
+				ID    int    `json:"id"`
+				Title string `json:"title"`
+				Done  bool   `json:"done"`
+			} `json:"dependencies"`
+			Parent *struct {
+				ID    int    `json:"id"`
+				Title string `json:"title"`
+			} `json:"parent"`
+			RecentLog []struct {
+				Action string `json:"action"`
+				TaskID int    `json:"task_id"`
+			} `json:"recent_log"`
+		} `json:"context"`
+	}
+	r := runKanbanJSON(t, kanbanDir, &picked, "pick", "--claim", claimAgent1, "--with-context")
+	if r.exitCode != 0 {
+		t.Fatalf("pick --with-context failed (exit %d): %s", r.exitCode, r.stderr)
+	}
+	if picked.Title != "Build API" || !strings.Contains(picked.Body, "Use the new schema.") {
+		t.Errorf("picked %q with body %q, want Build API with its body", picked.Title, picked.Body)
+	}
+	deps := picked.Context.Dependencies
+	if len(deps) != 1 || deps[0].Title != "Schema" || !deps[0].Done {
+		t.Errorf("dependencies = %+v, want done Schema", deps)
+	}
+	if picked.Context.Parent == nil || picked.Context.Parent.Title != "Epic" {
+		t.Errorf("parent = %+v, want Epic", picked.Context.Parent)
+	}
+	log := picked.Context.RecentLog
+	if len(log) == 0 || log[len(log)-1].Action != "claim" || log[len(log)-1].TaskID != 3 {
+		t.Errorf("recent log = %+v, want the claim last", log)
+	}
+}
+
+func TestPickWithContextTableOutput(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Schema")
+	mustCreateTask(t, kanbanDir, "Build API", "--priority", "critical", "--depends-on", "1")
+	runKanban(t, kanbanDir, "move", "1", "done")
+
+	r := runKanban(t, kanbanDir, "pick", "--claim", claimAgent1, "--with-context")
+	if r.exitCode != 0 {
+		t.Fatalf("pick --with-context failed (exit %d): %s", r.exitCode, r.stderr)
+	}
+	for _, want := range []string{"Dependencies", "#1 [done] Schema", "Recent activity"} {
+		if !strings.Contains(r.stdout, want) {
+			t.Errorf("output missing %q:\n%s", want, r.stdout)
+		}
+	}
+}
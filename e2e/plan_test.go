@@ -0,0 +1,79 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Capacity planning tests
+// ---------------------------------------------------------------------------
+
+func TestPlanCapacity(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "First", "--priority", "high", "--assignee", "alice", "--estimate", "4h")
+	mustCreateTask(t, kanbanDir, "Second", "--priority", "medium", "--assignee", "alice", "--estimate", "1d")
+	mustCreateTask(t, kanbanDir, "Third", "--priority", "low", "--estimate", "2h")
+
+	var plan struct {
+		WorkingDays int `json:"working_days"`
+		People      []struct {
+			Name       string  `json:"name"`
+			Planned    float64 `json:"planned_hours"`
+			Overloaded bool    `json:"overloaded"`
+		} `json:"people"`
+		Fits []struct {
+			ID       int    `json:"id"`
+			Assignee string `json:"assignee"`
+		} `json:"fits"`
+		Slips []struct {
+			ID int `json:"id"`
+		} `json:"slips"`
+	}
+	// Monday 2026-03-02, a single working day.
+	runKanbanJSON(t, kanbanDir, &plan, "plan",
+		"--capacity", "alice=6h/d,bob=4h/d", "--from", "2026-03-02", "--until", "2026-03-02")
+
+	if plan.WorkingDays != 1 {
+		t.Errorf("working_days = %d, want 1", plan.WorkingDays)
+	}
+	if len(plan.Fits) != 2 || plan.Fits[0].ID != 1 || plan.Fits[1].ID != 3 || plan.Fits[1].Assignee != "bob" {
+		t.Errorf("fits = %+v, want #1 (alice) and #3 (bob)", plan.Fits)
+	}
+	if len(plan.Slips) != 1 || plan.Slips[0].ID != 2 {
+		t.Errorf("slips = %+v, want #2", plan.Slips)
+	}
+	if len(plan.People) != 2 || !plan.People[0].Overloaded || plan.People[1].Overloaded {
+		t.Errorf("people = %+v, want alice overloaded only", plan.People)
+	}
+}
+
+func TestPlanTable(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Ship it", "--assignee", "alice", "--estimate", "2h")
+
+	r := runKanban(t, kanbanDir, "--table", "plan",
+		"--capacity", "alice=6h/d", "--from", "2026-03-02", "--until", "2026-03-06")
+	if r.exitCode != 0 {
+		t.Fatalf("plan failed: %s", r.stderr)
+	}
+	for _, want := range []string{"5 working days", "alice", "30h", "Fits (1)", "Ship it", "Slips (0)"} {
+		if !strings.Contains(r.stdout, want) {
+			t.Errorf("output missing %q:\n%s", want, r.stdout)
+		}
+	}
+}
+
+func TestPlanInvalidInput(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "plan", "--capacity", "alice", "--until", "2026-03-02")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("bad capacity code = %q, want INVALID_INPUT", errResp.Code)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "plan", "--capacity", "alice=6h",
+		"--from", "2026-03-05", "--until", "2026-03-02")
+	if errResp.Code != "INVALID_DATE" {
+		t.Errorf("reversed window code = %q, want INVALID_DATE", errResp.Code)
+	}
+}
@@ -0,0 +1,95 @@
+package e2e_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Private (encrypted) task tests
+// ---------------------------------------------------------------------------
+
+const privateTestRecipient = "kanban-e2e@example.com"
+
+// setupGPGHome points GNUPGHOME at a throwaway keyring holding an
+// unprotected key for privateTestRecipient.
+func setupGPGHome(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg not installed")
+	}
+	// Short path: gpg-agent sockets must fit in sun_path.
+	home, err := os.MkdirTemp("", "gpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GNUPGHOME", home)
+	t.Cleanup(func() {
+		_ = exec.Command("gpgconf", "--kill", "gpg-agent").Run()
+		_ = os.RemoveAll(home)
+	})
+	out, err := exec.Command("gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		privateTestRecipient, "default", "default", "never").CombinedOutput()
+	if err != nil {
+		t.Skipf("gpg key generation failed: %v: %s", err, out)
+	}
+}
+
+func TestCreatePrivateRequiresRecipients(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "create", "Secret", "--private", "--body", "client: ACME")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
+func TestPrivateTaskRoundTrip(t *testing.T) {
+	setupGPGHome(t)
+	kanbanDir := initBoard(t)
+
+	r := runKanban(t, kanbanDir, "config", "set", "security.recipients", privateTestRecipient)
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+	mustCreateTask(t, kanbanDir, "Client call", "--private", "--body", "client: ACME")
+
+	files, _ := filepath.Glob(filepath.Join(kanbanDir, "tasks", "001-*.md"))
+	if len(files) != 1 {
+		t.Fatalf("task files = %v, want one", files)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "ACME") || !strings.Contains(string(data), "BEGIN PGP MESSAGE") {
+		t.Fatalf("task file should hold only ciphertext:\n%s", data)
+	}
+
+	r = runKanban(t, kanbanDir, "edit", "1", "--append-body", "budget: 10k")
+	if r.exitCode != 0 {
+		t.Fatalf("edit failed: %s", r.stderr)
+	}
+
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "1")
+	if !strings.Contains(shown.Body, "client: ACME") || !strings.Contains(shown.Body, "budget: 10k") {
+		t.Errorf("show body = %q, want decrypted body with appended note", shown.Body)
+	}
+
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "list")
+	if len(tasks) != 1 || !strings.Contains(tasks[0].Body, "client: ACME") {
+		t.Errorf("list = %+v, want decrypted body", tasks)
+	}
+
+	// Without the key the body is redacted.
+	t.Setenv("GNUPGHOME", t.TempDir())
+	runKanbanJSON(t, kanbanDir, &shown, "show", "1")
+	if shown.Body != "[encrypted]" {
+		t.Errorf("show body without key = %q, want [encrypted]", shown.Body)
+	}
+}
@@ -0,0 +1,68 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Read-only mode tests
+// ---------------------------------------------------------------------------
+
+func TestReadOnlyFlagRejectsMutations(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Existing task")
+
+	for _, args := range [][]string{
+		{"create", "New task"},
+		{"edit", "1", "--title", "Renamed"},
+		{"move", "1", statusTodo},
+		{"delete", "1", "--yes"},
+		{"archive", "1"},
+		{"pick", "--claim", claimTestAgent},
+		{"config", "set", "board.name", "Renamed"},
+		{"config", "set", "board.readonly", "false"},
+	} {
+		errResp := runKanbanJSONError(t, kanbanDir, append([]string{"--readonly"}, args...)...)
+		if errResp.Code != "BOARD_READONLY" {
+			t.Errorf("%s: code = %q, want BOARD_READONLY", strings.Join(args, " "), errResp.Code)
+		}
+	}
+
+	// Reads still work.
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "--readonly", "list")
+	if len(tasks) != 1 || tasks[0].Title != "Existing task" {
+		t.Errorf("list --readonly = %+v, want the unchanged task", tasks)
+	}
+}
+
+func TestBoardReadOnlyConfig(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Published task")
+
+	r := runKanban(t, kanbanDir, "config", "set", "board.readonly", "true")
+	if r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "move", "1", statusTodo)
+	if errResp.Code != "BOARD_READONLY" {
+		t.Errorf("move code = %q, want BOARD_READONLY", errResp.Code)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "config", "set", "board.name", "Other")
+	if errResp.Code != "BOARD_READONLY" {
+		t.Errorf("config set code = %q, want BOARD_READONLY", errResp.Code)
+	}
+
+	// The read-only switch itself can be turned off again.
+	r = runKanban(t, kanbanDir, "config", "set", "board.readonly", "false")
+	if r.exitCode != 0 {
+		t.Fatalf("lifting readonly failed: %s", r.stderr)
+	}
+	var task taskJSON
+	runKanbanJSON(t, kanbanDir, &task, "move", "1", statusTodo)
+	if task.Status != statusTodo {
+		t.Errorf("status = %q, want %q after lifting readonly", task.Status, statusTodo)
+	}
+}
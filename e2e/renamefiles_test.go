@@ -0,0 +1,63 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// files.naming / rename-files tests
+// ---------------------------------------------------------------------------
+
+type renameFilesJSON struct {
+	Naming  string `json:"naming"`
+	Renamed []struct {
+		ID int    `json:"id"`
+		To string `json:"to"`
+	} `json:"renamed"`
+	DryRun bool `json:"dry_run"`
+}
+
+func TestRenameFilesToNamingScheme(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Fix the login page")
+	runKanban(t, kanbanDir, "config", "set", "files.naming", "{date}-{id}-{slug}")
+
+	today := time.Now().Format("2006-01-02")
+	created := mustCreateTask(t, kanbanDir, "Write docs")
+	if want := today + "-002-write-docs.md"; filepath.Base(created.File) != want {
+		t.Errorf("new file = %s, want %s", filepath.Base(created.File), want)
+	}
+
+	var preview renameFilesJSON
+	runKanbanJSON(t, kanbanDir, &preview, "rename-files", "--dry-run")
+	if !preview.DryRun || len(preview.Renamed) != 1 || preview.Renamed[0].ID != 1 {
+		t.Fatalf("dry run = %+v, want task 1 only", preview)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "tasks", "001-fix-the-login-page.md")); err != nil {
+		t.Errorf("dry run renamed the file: %v", err)
+	}
+
+	var result renameFilesJSON
+	runKanbanJSON(t, kanbanDir, &result, "rename-files")
+	if want := today + "-001-fix-the-login-page.md"; len(result.Renamed) != 1 || filepath.Base(result.Renamed[0].To) != want {
+		t.Errorf("renamed = %+v, want %s", result.Renamed, want)
+	}
+
+	// Lookups by ID still work, and a title change follows the scheme.
+	runKanban(t, kanbanDir, "config", "set", "files.naming", "{id}")
+	runKanban(t, kanbanDir, "rename-files")
+	var edited taskJSON
+	runKanbanJSON(t, kanbanDir, &edited, "edit", "1", "--title", "Fix login")
+	if filepath.Base(edited.File) != "001.md" {
+		t.Errorf("file after edit = %s, want 001.md", filepath.Base(edited.File))
+	}
+
+	r := runKanban(t, kanbanDir, "config", "set", "files.naming", "{slug}")
+	if r.exitCode == 0 || !strings.Contains(r.stderr, "files.naming") {
+		t.Errorf("bad scheme: exit %d, stderr %q", r.exitCode, r.stderr)
+	}
+}
@@ -0,0 +1,101 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Renumber tests
+// ---------------------------------------------------------------------------
+
+type renumberJSON struct {
+	Changes []struct {
+		Old int `json:"old"`
+		New int `json:"new"`
+	} `json:"changes"`
+	NextID int  `json:"next_id"`
+	DryRun bool `json:"dry_run"`
+}
+
+func TestRenumberCompactsIDs(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Gone")
+	mustCreateTask(t, kanbanDir, "Keep")
+	mustCreateTask(t, kanbanDir, "Also gone")
+	mustCreateTask(t, kanbanDir, "Child", "--parent", "2", "--depends-on", "2")
+	runKanban(t, kanbanDir, "subscribe", "4", "--exec", "./on-change.sh")
+	// Archived tasks keep their IDs, so remove the files to leave gaps.
+	for _, name := range []string{"001-gone.md", "003-also-gone.md"} {
+		if err := os.Remove(filepath.Join(kanbanDir, "tasks", name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var preview renumberJSON
+	runKanbanJSON(t, kanbanDir, &preview, "renumber", "--dry-run")
+	if !preview.DryRun || len(preview.Changes) != 2 {
+		t.Fatalf("dry run = %+v, want 2 changes", preview)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "tasks", "002-keep.md")); err != nil {
+		t.Fatal("dry run must not touch task files")
+	}
+
+	var got renumberJSON
+	runKanbanJSON(t, kanbanDir, &got, "renumber")
+	if len(got.Changes) != 2 || got.Changes[0].Old != 2 || got.Changes[0].New != 1 ||
+		got.Changes[1].Old != 4 || got.Changes[1].New != 2 || got.NextID != 3 {
+		t.Fatalf("renumber = %+v", got)
+	}
+
+	for _, name := range []string{"001-keep.md", "002-child.md"} {
+		if _, err := os.Stat(filepath.Join(kanbanDir, "tasks", name)); err != nil {
+			t.Errorf("missing %s: %v", name, err)
+		}
+	}
+	entries, _ := os.ReadDir(filepath.Join(kanbanDir, "tasks"))
+	if len(entries) != 2 {
+		t.Errorf("tasks dir has %d entries, want 2", len(entries))
+	}
+
+	var child struct {
+		ID        int   `json:"id"`
+		Parent    *int  `json:"parent"`
+		DependsOn []int `json:"depends_on"`
+	}
+	runKanbanJSON(t, kanbanDir, &child, "show", "2")
+	if child.Parent == nil || *child.Parent != 1 || len(child.DependsOn) != 1 || child.DependsOn[0] != 1 {
+		t.Errorf("child after renumber = %+v, want parent and dependency 1", child)
+	}
+
+	var subs []subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &subs, "subscribe")
+	if len(subs) != 1 || subs[0].Task != 2 {
+		t.Errorf("subscriptions after renumber = %+v, want task 2", subs)
+	}
+
+	var log []logEntry
+	runKanbanJSON(t, kanbanDir, &log, "log", "--action", "create")
+	for _, e := range log {
+		if e.Detail == "Keep" && e.TaskID != 1 {
+			t.Errorf("log entry for Keep has task_id %d, want 1", e.TaskID)
+		}
+	}
+
+	created := mustCreateTask(t, kanbanDir, "Next")
+	if created.ID != 3 {
+		t.Errorf("next created ID = %d, want 3", created.ID)
+	}
+}
+
+func TestRenumberAlreadyContiguous(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Only")
+
+	r := runKanban(t, kanbanDir, "--table", "renumber")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "already contiguous") {
+		t.Errorf("renumber on contiguous board: exit %d, stdout %q", r.exitCode, r.stdout)
+	}
+}
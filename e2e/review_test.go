@@ -0,0 +1,54 @@
+package e2e_test
+
+import (
+	"testing"
+)
+
+type reviewJSON struct {
+	Findings []struct {
+		Kind string `json:"kind"`
+		ID   int    `json:"id"`
+		Fix  string `json:"fix"`
+	} `json:"findings"`
+	Applied []struct {
+		ID    int    `json:"id"`
+		Error string `json:"error"`
+	} `json:"applied"`
+}
+
+func TestReview(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Late", "--due", "2020-01-01")
+	mustCreateTask(t, kanbanDir, "On time")
+
+	var listed reviewJSON
+	runKanbanJSON(t, kanbanDir, &listed, "review")
+	if len(listed.Findings) != 1 || listed.Findings[0].Kind != "overdue" || listed.Findings[0].ID != 1 {
+		t.Fatalf("findings = %+v, want task 1 overdue", listed.Findings)
+	}
+	if len(listed.Applied) != 0 {
+		t.Errorf("review without --yes applied %+v", listed.Applied)
+	}
+
+	var fixed reviewJSON
+	runKanbanJSON(t, kanbanDir, &fixed, "review", "--yes")
+	if len(fixed.Applied) != 1 || fixed.Applied[0].ID != 1 || fixed.Applied[0].Error != "" {
+		t.Fatalf("applied = %+v, want the fix of task 1", fixed.Applied)
+	}
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "show", "1")
+	if tk.Due == "" || tk.Due <= "2020-01-01" {
+		t.Errorf("due = %q, want it postponed", tk.Due)
+	}
+
+	var again reviewJSON
+	runKanbanJSON(t, kanbanDir, &again, "review")
+	if len(again.Findings) != 0 {
+		t.Errorf("findings after the fixes = %+v, want none", again.Findings)
+	}
+
+	r := runKanban(t, kanbanDir, "review", "--yes", "--dry-run")
+	if r.exitCode == 0 {
+		t.Error("--yes with --dry-run should be rejected")
+	}
+}
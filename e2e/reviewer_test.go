@@ -0,0 +1,127 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// requireReviewer turns on require_reviewer for the default review status.
+func requireReviewer(t *testing.T, kanbanDir string) {
+	t.Helper()
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	data, err := os.ReadFile(cfgPath) //nolint:gosec // e2e test file
+	if err != nil {
+		t.Fatalf("reading config: %v", err)
+	}
+	updated := strings.Replace(string(data),
+		"    - name: review\n", "    - name: review\n      require_reviewer: true\n", 1)
+	if err := os.WriteFile(cfgPath, []byte(updated), 0o600); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+}
+
+func TestEditReviewer(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Review me")
+
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "edit", "1", "--reviewer", "bob")
+	if tk.Reviewer != "bob" {
+		t.Errorf("reviewer = %q, want bob", tk.Reviewer)
+	}
+
+	r := runKanbanEnv(t, kanbanDir, []string{"KANBAN_USER=carol"}, "--json", "edit", "1", "--reviewer", "me")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, `"reviewer": "carol"`) {
+		t.Errorf("--reviewer me: exit %d, stdout %s", r.exitCode, r.stdout)
+	}
+
+	var cleared taskJSON
+	runKanbanJSON(t, kanbanDir, &cleared, "edit", "1", "--clear-reviewer")
+	if cleared.Reviewer != "" {
+		t.Errorf("reviewer = %q after --clear-reviewer, want empty", cleared.Reviewer)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--reviewer", "bob", "--clear-reviewer")
+	if errResp.Code != "STATUS_CONFLICT" {
+		t.Errorf("code = %q, want STATUS_CONFLICT", errResp.Code)
+	}
+}
+
+func TestListReviewer(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "For bob")
+	mustCreateTask(t, kanbanDir, "For carol")
+	mustCreateTask(t, kanbanDir, "Unreviewed")
+	runKanban(t, kanbanDir, "edit", "1", "--reviewer", "bob")
+	runKanban(t, kanbanDir, "edit", "2", "--reviewer", "carol")
+
+	var tasks []taskJSON
+	runKanbanJSON(t, kanbanDir, &tasks, "list", "--reviewer", "bob")
+	if len(tasks) != 1 || tasks[0].ID != 1 {
+		t.Errorf("list --reviewer bob = %+v, want only task 1", tasks)
+	}
+
+	r := runKanbanEnv(t, kanbanDir, []string{"KANBAN_USER=carol"}, "--compact", "list", "--reviewer", "me")
+	if r.exitCode != 0 {
+		t.Fatalf("list --reviewer me: exit %d: %s", r.exitCode, r.stderr)
+	}
+	if !strings.Contains(r.stdout, "For carol") || strings.Contains(r.stdout, "For bob") {
+		t.Errorf("list --reviewer me should show only carol's task, got:\n%s", r.stdout)
+	}
+}
+
+func TestRequireReviewerMove(t *testing.T) {
+	kanbanDir := initBoard(t)
+	requireReviewer(t, kanbanDir)
+	mustCreateTask(t, kanbanDir, "Feature", "--assignee", "alice")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "move", "1", statusReview, "--claim", claimTestAgent)
+	if errResp.Code != "REVIEWER_REQUIRED" {
+		t.Errorf("code = %q, want REVIEWER_REQUIRED", errResp.Code)
+	}
+
+	// The assignee cannot review their own task.
+	runKanban(t, kanbanDir, "edit", "1", "--reviewer", "alice")
+	r := runKanban(t, kanbanDir, "move", "1", statusReview, "--claim", claimTestAgent)
+	if r.exitCode != 5 || !strings.Contains(r.stderr, "other than the assignee") {
+		t.Errorf("self-review: exit %d, stderr %s; want exit 5", r.exitCode, r.stderr)
+	}
+
+	// Other statuses are unaffected, and --reviewer on move satisfies the rule.
+	if r := runKanban(t, kanbanDir, "move", "1", statusTodo); r.exitCode != 0 {
+		t.Errorf("move to todo: exit %d: %s", r.exitCode, r.stderr)
+	}
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "move", "1", statusReview, "--claim", claimTestAgent, "--reviewer", "bob")
+	if tk.Status != statusReview || tk.Reviewer != "bob" {
+		t.Errorf("status, reviewer = %q, %q; want review, bob", tk.Status, tk.Reviewer)
+	}
+}
+
+func TestRequireReviewerEditAndHandoff(t *testing.T) {
+	kanbanDir := initBoard(t)
+	requireReviewer(t, kanbanDir)
+	mustCreateTask(t, kanbanDir, "Via edit")
+	mustCreateTask(t, kanbanDir, "Via handoff")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--status", statusReview, "--claim", claimTestAgent)
+	if errResp.Code != "REVIEWER_REQUIRED" {
+		t.Errorf("edit code = %q, want REVIEWER_REQUIRED", errResp.Code)
+	}
+	var tk taskJSON
+	runKanbanJSON(t, kanbanDir, &tk, "edit", "1", "--status", statusReview, "--claim", claimTestAgent, "--reviewer", "bob")
+	if tk.Status != statusReview {
+		t.Errorf("status = %q, want review", tk.Status)
+	}
+
+	errResp = runKanbanJSONError(t, kanbanDir, "handoff", "2", "--claim", claimTestAgent)
+	if errResp.Code != "REVIEWER_REQUIRED" {
+		t.Errorf("handoff code = %q, want REVIEWER_REQUIRED", errResp.Code)
+	}
+	runKanbanJSON(t, kanbanDir, &tk, "handoff", "2", "--claim", claimTestAgent, "--reviewer", "bob")
+	if tk.Status != statusReview || tk.Reviewer != "bob" {
+		t.Errorf("status, reviewer = %q, %q; want review, bob", tk.Status, tk.Reviewer)
+	}
+}
@@ -0,0 +1,145 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// rules command tests
+// ---------------------------------------------------------------------------
+
+type rulesRunJSON struct {
+	Fired []struct {
+		Rule    string   `json:"rule"`
+		ID      int      `json:"id"`
+		Actions []string `json:"actions"`
+		Error   string   `json:"error"`
+	} `json:"fired"`
+	DryRun bool `json:"dry_run"`
+}
+
+const testRules = `rules:
+  - name: blocked-attention
+    when: {blocked: true}
+    then: [escalate_priority, tag needs-attention]
+  - name: release-milestone
+    when: {enters: done}
+    if: {tags: [release]}
+    then: [tag milestone]
+`
+
+func writeRules(t *testing.T, kanbanDir, text string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(kanbanDir, "rules.yml"), []byte(text), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRulesRun(t *testing.T) {
+	kanbanDir := initBoard(t)
+	writeRules(t, kanbanDir, testRules)
+	mustCreateTask(t, kanbanDir, "Stuck", "--priority", "medium")
+	mustCreateTask(t, kanbanDir, "Ship it", "--tags", "release")
+	mustCreateTask(t, kanbanDir, "Bystander")
+	runKanban(t, kanbanDir, "edit", "1", "--block", "waiting on vendor")
+	runKanban(t, kanbanDir, "move", "2", "done")
+
+	var dry rulesRunJSON
+	runKanbanJSON(t, kanbanDir, &dry, "rules", "run", "--dry-run")
+	if !dry.DryRun || len(dry.Fired) != 2 {
+		t.Fatalf("dry run = %+v, want 2 firings", dry)
+	}
+	var stuck taskJSON
+	runKanbanJSON(t, kanbanDir, &stuck, "show", "1")
+	if stuck.Priority != "medium" {
+		t.Errorf("dry run changed priority to %q", stuck.Priority)
+	}
+
+	var run rulesRunJSON
+	runKanbanJSON(t, kanbanDir, &run, "rules", "run")
+	if len(run.Fired) != 2 || run.Fired[0].Rule != "blocked-attention" || run.Fired[1].ID != 2 {
+		t.Fatalf("run = %+v", run)
+	}
+
+	var escalated taskJSON
+	runKanbanJSON(t, kanbanDir, &escalated, "show", "1")
+	if escalated.Priority != "high" || !slices.Contains(escalated.Tags, "needs-attention") {
+		t.Errorf("task 1 = priority %q tags %v, want high with needs-attention", escalated.Priority, escalated.Tags)
+	}
+	var shipped taskJSON
+	runKanbanJSON(t, kanbanDir, &shipped, "show", "2")
+	if !slices.Contains(shipped.Tags, "milestone") {
+		t.Errorf("task 2 tags = %v, want milestone", shipped.Tags)
+	}
+
+	// Each occurrence fires once.
+	var again rulesRunJSON
+	runKanbanJSON(t, kanbanDir, &again, "rules", "run")
+	if len(again.Fired) != 0 {
+		t.Errorf("second run fired %+v", again.Fired)
+	}
+}
+
+func TestRulesCheck(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "rules", "check")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("missing rules file code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+
+	writeRules(t, kanbanDir, testRules)
+	var listed []struct {
+		Name string `json:"name"`
+	}
+	runKanbanJSON(t, kanbanDir, &listed, "rules", "check")
+	if len(listed) != 2 || listed[0].Name != "blocked-attention" {
+		t.Errorf("rules check = %+v", listed)
+	}
+
+	writeRules(t, kanbanDir, "rules:\n  - name: bad\n    when: {enters: nowhere}\n    then: [notify]\n")
+	errResp = runKanbanJSONError(t, kanbanDir, "rules", "run")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("invalid rules code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+}
+
+func TestRulesMoveToChecksAndNotify(t *testing.T) {
+	kanbanDir := initBoard(t)
+	writeRules(t, kanbanDir, "rules:\n  - name: auto-close\n    when: {enters: todo}\n    then: [move_to done, notify]\n")
+	runKanban(t, kanbanDir, "config", "set", "dependencies.on_unblock", "tag ready")
+	mustCreateTask(t, kanbanDir, "Parent")
+	mustCreateTask(t, kanbanDir, "Child", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Upstream")
+	mustCreateTask(t, kanbanDir, "Downstream", "--depends-on", "3")
+	runKanban(t, kanbanDir, "move", "1,3", statusTodo)
+
+	var run rulesRunJSON
+	runKanbanJSON(t, kanbanDir, &run, "rules", "run")
+	if len(run.Fired) != 2 || run.Fired[0].ID != 1 || run.Fired[0].Error == "" || run.Fired[1].Error != "" {
+		t.Fatalf("run = %+v, want the parent's move refused and the upstream task's applied", run)
+	}
+
+	var parent, upstream, downstream taskJSON
+	runKanbanJSON(t, kanbanDir, &parent, "show", "1")
+	if parent.Status != statusTodo {
+		t.Errorf("parent status = %q, want todo: it has an open child", parent.Status)
+	}
+	runKanbanJSON(t, kanbanDir, &upstream, "show", "3")
+	if upstream.Status != "done" {
+		t.Errorf("upstream status = %q, want done", upstream.Status)
+	}
+	runKanbanJSON(t, kanbanDir, &downstream, "show", "4")
+	if !slices.Contains(downstream.Tags, "ready") {
+		t.Errorf("downstream tags = %v, want ready from dependencies.on_unblock", downstream.Tags)
+	}
+
+	var log []logEntry
+	runKanbanJSON(t, kanbanDir, &log, "log", "--task", "3", "--action", "rule")
+	if len(log) != 2 || log[1].Detail != "auto-close: notify" {
+		t.Errorf("rule log for task 3 = %+v, want the move and the notify", log)
+	}
+}
@@ -0,0 +1,78 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type schemaDoc struct {
+	ID         string                    `json:"$id"`
+	Properties map[string]map[string]any `json:"properties"`
+	Required   []string                  `json:"required"`
+}
+
+// checkAgainstSchema reports output keys the schema lacks and required keys
+// the output lacks.
+func checkAgainstSchema(t *testing.T, schema schemaDoc, out map[string]any) {
+	t.Helper()
+	for k := range out {
+		if _, ok := schema.Properties[k]; !ok {
+			t.Errorf("output field %q missing from schema %s", k, schema.ID)
+		}
+	}
+	for _, k := range schema.Required {
+		if _, ok := out[k]; !ok {
+			t.Errorf("required field %q missing from output", k)
+		}
+	}
+}
+
+func TestSchemaMatchesOutput(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Schema task", "--tags", "a", "--due", "2026-05-01", "--body", "text")
+
+	cases := []struct {
+		schema string
+		args   []string
+	}{
+		{"task", []string{"show", "1"}},
+		{"board", []string{"board"}},
+		{"metrics", []string{"metrics"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.schema, func(t *testing.T) {
+			var schema schemaDoc
+			if r := runKanbanJSON(t, kanbanDir, &schema, "schema", tc.schema); r.exitCode != 0 {
+				t.Fatalf("schema %s failed: %s", tc.schema, r.stderr)
+			}
+			if !strings.HasSuffix(schema.ID, "/v1/"+tc.schema+".json") {
+				t.Errorf("$id = %q, want a versioned id", schema.ID)
+			}
+			var out map[string]any
+			if r := runKanbanJSON(t, kanbanDir, &out, tc.args...); r.exitCode != 0 {
+				t.Fatalf("%v failed: %s", tc.args, r.stderr)
+			}
+			checkAgainstSchema(t, schema, out)
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		var schema schemaDoc
+		runKanbanJSON(t, kanbanDir, &schema, "schema", "error")
+		r := runKanban(t, kanbanDir, "--json", "show", "99")
+		var out map[string]any
+		if err := json.Unmarshal([]byte(r.stdout), &out); err != nil {
+			t.Fatalf("parsing error output: %v", err)
+		}
+		checkAgainstSchema(t, schema, out)
+	})
+}
+
+func TestSchemaUnknown(t *testing.T) {
+	kanbanDir := initBoard(t)
+	errResp := runKanbanJSONError(t, kanbanDir, "schema", "nope")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %s, want INVALID_INPUT", errResp.Code)
+	}
+}
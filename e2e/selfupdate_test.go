@@ -0,0 +1,171 @@
+package e2e_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// self-update tests
+// ---------------------------------------------------------------------------
+
+type selfUpdateJSON struct {
+	Current         string `json:"current"`
+	Latest          string `json:"latest"`
+	UpdateAvailable bool   `json:"update_available"`
+	Updated         bool   `json:"updated"`
+	Path            string `json:"path"`
+}
+
+// releaseServer fakes the GitHub releases API with one v9.9.9 release
+// whose archive holds newBinary. badSum publishes a wrong checksum.
+func releaseServer(t *testing.T, newBinary string, badSum bool) *httptest.Server {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "kanban-md", Mode: 0o755, Size: int64(len(newBinary))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(newBinary)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive := buf.Bytes()
+	name := fmt.Sprintf("kanban-md_9.9.9_%s_%s.tar.gz", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(archive)
+	if badSum {
+		sum = sha256.Sum256([]byte("tampered"))
+	}
+
+	mux := http.NewServeMux()
+	var srv *httptest.Server
+	mux.HandleFunc("/repos/antopolskiy/kanban-md/releases", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{{
+			"tag_name": "v9.9.9",
+			"assets": []map[string]string{
+				{"name": name, "browser_download_url": srv.URL + "/dl/" + name},
+				{"name": "kanban-md_9.9.9_checksums.txt", "browser_download_url": srv.URL + "/dl/checksums.txt"},
+			},
+		}})
+	})
+	mux.HandleFunc("/dl/"+name, func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(archive) })
+	mux.HandleFunc("/dl/checksums.txt", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	})
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// runCopiedBinary runs a copy of the kanban-md binary, so self-update can
+// replace it without touching the one other tests use.
+func runCopiedBinary(t *testing.T, exe, api string, args ...string) result {
+	t.Helper()
+	cmd := exec.Command(exe, args...) //nolint:gosec,noctx // e2e test binary
+	cmd.Env = append(os.Environ(), "KANBAN_RELEASES_API="+api)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	r := result{stdout: stdout.String(), stderr: stderr.String()}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		r.exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatalf("running kanban-md: %v", err)
+	}
+	return r
+}
+
+func copyBinary(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(binPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exe := filepath.Join(t.TempDir(), filepath.Base(binPath))
+	if err := os.WriteFile(exe, data, 0o755); err != nil { //nolint:gosec // test executable
+		t.Fatal(err)
+	}
+	return exe
+}
+
+func TestSelfUpdate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake release only ships a tar.gz")
+	}
+	srv := releaseServer(t, "#!/bin/sh\necho updated\n", false)
+	exe := copyBinary(t)
+
+	var check selfUpdateJSON
+	r := runCopiedBinary(t, exe, srv.URL, "self-update", "--check", "--json")
+	if err := json.Unmarshal([]byte(r.stdout), &check); err != nil {
+		t.Fatalf("--check output: %v\n%s%s", err, r.stdout, r.stderr)
+	}
+	if check.Current != "dev" || check.Latest != "9.9.9" || !check.UpdateAvailable || check.Updated {
+		t.Errorf("--check = %+v", check)
+	}
+
+	r = runCopiedBinary(t, exe, srv.URL, "self-update", "--json")
+	if r.exitCode == 0 {
+		t.Error("self-update replaced a development build without --force")
+	}
+
+	var got selfUpdateJSON
+	r = runCopiedBinary(t, exe, srv.URL, "self-update", "--force", "--json")
+	if err := json.Unmarshal([]byte(r.stdout), &got); err != nil {
+		t.Fatalf("self-update output: %v\n%s%s", err, r.stdout, r.stderr)
+	}
+	if !got.Updated {
+		t.Errorf("self-update = %+v, want updated", got)
+	}
+	out, err := exec.Command(exe).Output() //nolint:gosec,noctx // the replaced test binary
+	if err != nil || string(out) != "updated\n" {
+		t.Errorf("replaced binary printed %q, %v", out, err)
+	}
+}
+
+func TestSelfUpdateRejectsBadChecksum(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake release only ships a tar.gz")
+	}
+	srv := releaseServer(t, "#!/bin/sh\necho tampered\n", true)
+	exe := copyBinary(t)
+	before, err := os.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := runCopiedBinary(t, exe, srv.URL, "self-update", "--force")
+	if r.exitCode == 0 {
+		t.Fatal("self-update accepted a bad checksum")
+	}
+	after, err := os.ReadFile(exe)
+	if err != nil || !bytes.Equal(before, after) {
+		t.Error("binary changed despite the checksum mismatch")
+	}
+
+	r = runCopiedBinary(t, exe, srv.URL, "self-update", "--channel", "nightly")
+	if r.exitCode == 0 {
+		t.Error("self-update accepted --channel nightly")
+	}
+}
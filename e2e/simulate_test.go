@@ -0,0 +1,91 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// simulate command tests
+// ---------------------------------------------------------------------------
+
+type simulationJSON struct {
+	Strategy string `json:"strategy"`
+	Columns  []struct {
+		Status                string  `json:"status"`
+		Limit                 int     `json:"limit"`
+		Visits                int     `json:"visits"`
+		ActualAvgHours        float64 `json:"actual_avg_hours"`
+		PredictedAvgHours     float64 `json:"predicted_avg_hours"`
+		PredictedAvgWaitHours float64 `json:"predicted_avg_wait_hours"`
+	} `json:"columns"`
+}
+
+// writeActivityLog replaces the board's activity log with entries.
+func writeActivityLog(t *testing.T, kanbanDir string, entries []map[string]any) {
+	t.Helper()
+	var data []byte
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+	if err := os.WriteFile(filepath.Join(kanbanDir, "activity.jsonl"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSimulateReplaysLog(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "First")
+	mustCreateTask(t, kanbanDir, "Second")
+
+	// Both tasks worked in parallel for two days.
+	start := time.Now().UTC().Add(-10 * 24 * time.Hour)
+	end := start.Add(48 * time.Hour)
+	var entries []map[string]any
+	for _, id := range []int{1, 2} {
+		entries = append(entries,
+			map[string]any{"timestamp": start, "action": "move", "task_id": id, "detail": "todo -> in-progress"},
+			map[string]any{"timestamp": end, "action": "move", "task_id": id, "detail": "in-progress -> done"})
+	}
+	writeActivityLog(t, kanbanDir, entries)
+
+	var sim simulationJSON
+	runKanbanJSON(t, kanbanDir, &sim, "simulate", "--wip", "in-progress:1", "--history", "30d")
+	if sim.Strategy != "fifo" || len(sim.Columns) != 1 {
+		t.Fatalf("simulation = %+v", sim)
+	}
+	col := sim.Columns[0]
+	near := func(a, b float64) bool { return math.Abs(a-b) < 0.01 }
+	// One at a time, each takes a day; the second waits a day for the slot.
+	if col.Visits != 2 || !near(col.ActualAvgHours, 48) || !near(col.PredictedAvgHours, 24) || !near(col.PredictedAvgWaitHours, 12) {
+		t.Errorf("column = %+v, want 48h -> 24h with 12h average wait", col)
+	}
+
+	r := runKanban(t, kanbanDir, "simulate", "--wip", "in-progress:1")
+	if r.exitCode != 0 {
+		t.Fatalf("table output failed: %s", r.stderr)
+	}
+}
+
+func TestSimulateInvalidInput(t *testing.T) {
+	kanbanDir := initBoard(t)
+	for _, args := range [][]string{
+		{"simulate", "--wip", "in-progress"},
+		{"simulate", "--wip", "in-progress:-1"},
+		{"simulate", "--wip", "in-progress:2", "--history", "soon"},
+		{"simulate", "--wip", "in-progress:2", "--strategy", "random"},
+	} {
+		errResp := runKanbanJSONError(t, kanbanDir, args...)
+		if errResp.Code != codeInvalidInput {
+			t.Errorf("%v: code = %q, want %s", args, errResp.Code, codeInvalidInput)
+		}
+	}
+}
@@ -457,3 +457,41 @@ func TestSkillUpdateAllUpToDate(t *testing.T) {
 		t.Errorf("expected 'All skills are already up to date.' summary, got:\n%s", r.stdout)
 	}
 }
+
+func TestSkillGenerate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".claude"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if r := runKanbanNoDir(t, dir, "skill", "install", "--agent", "claude"); r.exitCode != 0 {
+		t.Fatalf("skill install failed: %s", r.stderr)
+	}
+	refPath := filepath.Join(dir, ".claude", "skills", "kanban-md", "references", "json-schemas.md")
+	if err := os.WriteFile(refPath, []byte("stale\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := runKanbanNoDir(t, dir, "skill", "generate", "--agent", "claude"); r.exitCode == 0 {
+		t.Error("skill generate without --from-manifest should fail")
+	}
+	r := runKanbanNoDir(t, dir, "skill", "generate", "--from-manifest", "--agent", "claude")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "Generated 1 reference(s)") {
+		t.Fatalf("skill generate = exit %d\nstdout: %s\nstderr: %s", r.exitCode, r.stdout, r.stderr)
+	}
+	data, err := os.ReadFile(refPath) //nolint:gosec // test path
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The regenerated reference matches the one installed from the binary.
+	embedded := runKanbanNoDir(t, dir, "skill", "install", "--path", filepath.Join(dir, "fresh"), "--skill", "kanban-md")
+	if embedded.exitCode != 0 {
+		t.Fatalf("skill install --path failed: %s", embedded.stderr)
+	}
+	fresh, err := os.ReadFile(filepath.Join(dir, "fresh", "kanban-md", "references", "json-schemas.md")) //nolint:gosec // test path
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(fresh) || !strings.Contains(string(data), "| `id` | integer |") {
+		t.Errorf("generated reference differs from the embedded one:\n%s", data)
+	}
+}
@@ -0,0 +1,81 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Stale task tests
+// ---------------------------------------------------------------------------
+
+type staleJSON struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Action string `json:"action"`
+}
+
+func writeStaleTask(t *testing.T, kanbanDir string) {
+	t.Helper()
+	writeTaskFile(t, kanbanDir, 1, `---
+id: 1
+title: Forgotten
+status: todo
+priority: medium
+created: 2020-01-01T00:00:00Z
+updated: 2020-01-01T00:00:00Z
+---
+`)
+	bumpNextID(t, kanbanDir, 2)
+}
+
+func TestStaleListsWithoutChanging(t *testing.T) {
+	kanbanDir := initBoard(t)
+	writeStaleTask(t, kanbanDir)
+	mustCreateTask(t, kanbanDir, "Fresh", "--status", statusTodo)
+
+	var results []staleJSON
+	runKanbanJSON(t, kanbanDir, &results, "stale", "--threshold", "2w")
+	if len(results) != 1 || results[0].ID != 1 || results[0].Action != "" {
+		t.Fatalf("stale = %+v, want only task 1 with no action", results)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "stale", "--threshold", "soon")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("bad threshold code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
+func TestStaleTagAndMove(t *testing.T) {
+	kanbanDir := initBoard(t)
+	writeStaleTask(t, kanbanDir)
+
+	var results []staleJSON
+	runKanbanJSON(t, kanbanDir, &results, "stale", "--tag", "stale")
+	if len(results) != 1 || results[0].Action != "tagged stale" {
+		t.Fatalf("stale --tag = %+v", results)
+	}
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "1")
+	if len(shown.Tags) != 1 || shown.Tags[0] != "stale" {
+		t.Errorf("tags = %v, want [stale]", shown.Tags)
+	}
+
+	// Tagging updated the task, so it is no longer stale.
+	runKanbanJSON(t, kanbanDir, &results, "stale")
+	if len(results) != 0 {
+		t.Errorf("after tagging, stale = %+v, want none", results)
+	}
+
+	writeStaleTask(t, kanbanDir)
+	runKanbanJSON(t, kanbanDir, &results, "stale", "--move", "backlog")
+	if len(results) != 1 || results[0].Action != "moved todo -> backlog" {
+		t.Fatalf("stale --move = %+v", results)
+	}
+
+	var log []logEntry
+	runKanbanJSON(t, kanbanDir, &log, "log", "--action", "stale")
+	if len(log) != 2 || !strings.Contains(log[1].Detail, "backlog") {
+		t.Errorf("stale log entries = %+v", log)
+	}
+}
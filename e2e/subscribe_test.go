@@ -0,0 +1,79 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+type subscriptionJSON struct {
+	Task int    `json:"task"`
+	Exec string `json:"exec"`
+}
+
+func TestSubscribeAddListRemove(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Critical")
+	mustCreateTask(t, kanbanDir, "Other")
+
+	var added subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &added, "subscribe", "1", "--exec", "./on-change.sh")
+	if added.Task != 1 || added.Exec != "./on-change.sh" {
+		t.Errorf("subscribe = %+v, want task 1 running ./on-change.sh", added)
+	}
+	runKanban(t, kanbanDir, "subscribe", "1", "--exec", "./notify.sh")
+	runKanban(t, kanbanDir, "subscribe", "2", "--exec", "./on-change.sh")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "subscribe", "1", "--exec", "./on-change.sh")
+	if errResp.Code != "NO_CHANGES" {
+		t.Errorf("duplicate subscribe code = %q, want NO_CHANGES", errResp.Code)
+	}
+	errResp = runKanbanJSONError(t, kanbanDir, "subscribe", "99", "--exec", "./on-change.sh")
+	if errResp.Code != "TASK_NOT_FOUND" {
+		t.Errorf("missing task code = %q, want TASK_NOT_FOUND", errResp.Code)
+	}
+
+	var subs []subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &subs, "subscribe", "1")
+	if len(subs) != 2 {
+		t.Fatalf("task 1 has %d subscriptions, want 2", len(subs))
+	}
+
+	runKanban(t, kanbanDir, "subscribe", "1", "--remove", "--exec", "./notify.sh")
+	var all []subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &all, "subscribe")
+	if len(all) != 2 || all[0] != (subscriptionJSON{1, "./on-change.sh"}) {
+		t.Errorf("after removing one = %+v, want task 1 ./on-change.sh and task 2", all)
+	}
+
+	runKanban(t, kanbanDir, "subscribe", "1", "--remove")
+	var rest []subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &rest, "subscribe")
+	if len(rest) != 1 || rest[0].Task != 2 {
+		t.Errorf("after removing task 1 = %+v, want only task 2", rest)
+	}
+}
+
+func TestSubscribePrefixedIDAndUID(t *testing.T) {
+	kanbanDir := initBoard(t)
+	if r := runKanban(t, kanbanDir, "config", "set", "board.id_prefix", "API-"); r.exitCode != 0 {
+		t.Fatalf("config set failed: %s", r.stderr)
+	}
+	mustCreateTask(t, kanbanDir, "Critical")
+	var created uidTaskJSON
+	runKanbanJSON(t, kanbanDir, &created, "create", "Other")
+
+	r := runKanban(t, kanbanDir, "--table", "subscribe", "API-1", "--exec", "./on-change.sh")
+	if r.exitCode != 0 || !strings.Contains(r.stdout, "Subscribed to task API-1") {
+		t.Fatalf("subscribe API-1 = %q (stderr %q), want prefixed ID", r.stdout, r.stderr)
+	}
+	var added subscriptionJSON
+	runKanbanJSON(t, kanbanDir, &added, "subscribe", created.UID, "--exec", "./on-change.sh")
+	if added.Task != 2 {
+		t.Errorf("subscribe by UID = %+v, want task 2", added)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "subscribe", "API-1", "--exec", "./on-change.sh")
+	if errResp.Code != "NO_CHANGES" || !strings.Contains(errResp.Error, "API-1") {
+		t.Errorf("duplicate subscribe = %+v, want NO_CHANGES naming API-1", errResp)
+	}
+}
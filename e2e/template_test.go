@@ -0,0 +1,68 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// template board export / init --from-archive tests
+// ---------------------------------------------------------------------------
+
+func TestTemplateBoardRoundTrip(t *testing.T) {
+	srcDir := filepath.Join(t.TempDir(), "kanban")
+	runKanban(t, srcDir, "init", "--statuses", "open,active,closed", "--wip-limit", "active:2")
+	mustCreateTask(t, srcDir, "Not part of the template")
+	rulesYAML := "rules:\n  - name: ship\n    when: {enters: closed}\n    then: [tag shipped]\n"
+	if err := os.WriteFile(filepath.Join(srcDir, "rules.yml"), []byte(rulesYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := filepath.Join(t.TempDir(), "setup.tar.gz")
+	r := runKanban(t, srcDir, "template", "board", "export", archive)
+	if r.exitCode != 0 {
+		t.Fatalf("export failed: %s", r.stderr)
+	}
+
+	dstDir := filepath.Join(t.TempDir(), "kanban")
+	var got map[string]string
+	runKanbanJSON(t, dstDir, &got, "init", "--from-archive", archive, "--name", "Team board")
+	if got["name"] != "Team board" || got["columns"] != "open,active,closed" {
+		t.Errorf("init --from-archive = %v", got)
+	}
+	data, err := os.ReadFile(filepath.Join(dstDir, "rules.yml"))
+	if err != nil || string(data) != rulesYAML {
+		t.Errorf("rules.yml = %q, %v", data, err)
+	}
+
+	var tasks []taskJSON
+	runKanbanJSON(t, dstDir, &tasks, "list")
+	if len(tasks) != 0 {
+		t.Errorf("tasks copied into the new board: %+v", tasks)
+	}
+	created := mustCreateTask(t, dstDir, "First")
+	if created.ID != 1 {
+		t.Errorf("first task ID = %d, want 1", created.ID)
+	}
+}
+
+func TestInitFromArchiveInvalid(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "junk.tar.gz")
+	if err := os.WriteFile(archive, []byte("not an archive"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kanbanDir := filepath.Join(t.TempDir(), "kanban")
+	errResp := runKanbanJSONError(t, kanbanDir, "init", "--from-archive", archive)
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+	if _, err := os.Stat(kanbanDir); !os.IsNotExist(err) {
+		t.Errorf("board directory left behind: %v", err)
+	}
+
+	errResp = runKanbanJSONError(t, kanbanDir, "init", "--from-archive", archive, "--statuses", "a,b")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("with --statuses: code = %q, want %s", errResp.Code, codeInvalidInput)
+	}
+}
@@ -0,0 +1,44 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Timing diagnostics tests
+// ---------------------------------------------------------------------------
+
+func TestTimingFlagReportsPhases(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Alpha")
+	mustCreateTask(t, kanbanDir, "Beta")
+
+	r := runKanban(t, kanbanDir, "--json", "--timing", "list")
+	if r.exitCode != 0 {
+		t.Fatalf("exit %d: %s", r.exitCode, r.stderr)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(r.stdout), "[") {
+		t.Errorf("stdout should stay pure JSON, got:\n%s", r.stdout)
+	}
+	for _, want := range []string{"timing: total", "scan", "parse", "filter", "write"} {
+		if !strings.Contains(r.stderr, want) {
+			t.Errorf("stderr missing %q:\n%s", want, r.stderr)
+		}
+	}
+}
+
+func TestTimingKanbanDebugEnv(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Alpha")
+
+	r := runKanbanEnv(t, kanbanDir, []string{"KANBAN_DEBUG=1"}, "list")
+	if !strings.Contains(r.stderr, "timing: total") {
+		t.Errorf("KANBAN_DEBUG=1 should print timings, stderr:\n%s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "list")
+	if strings.Contains(r.stderr, "timing:") {
+		t.Errorf("timings printed without --timing:\n%s", r.stderr)
+	}
+}
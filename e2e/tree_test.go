@@ -0,0 +1,151 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Tree tests
+// ---------------------------------------------------------------------------
+
+type treeNodeJSON struct {
+	ID       int            `json:"id"`
+	Title    string         `json:"title"`
+	Children []treeNodeJSON `json:"children"`
+}
+
+func TestTreeJSON(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Story", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Subtask", "--parent", "2")
+	mustCreateTask(t, kanbanDir, "Standalone")
+
+	var nodes []treeNodeJSON
+	runKanbanJSON(t, kanbanDir, &nodes, "tree")
+	if len(nodes) != 2 || nodes[0].ID != 1 || nodes[1].ID != 4 {
+		t.Fatalf("roots = %+v, want #1 and #4", nodes)
+	}
+	if len(nodes[0].Children) != 1 || len(nodes[0].Children[0].Children) != 1 {
+		t.Errorf("tree = %+v, want 1 -> 2 -> 3", nodes[0])
+	}
+
+	var sub []treeNodeJSON
+	runKanbanJSON(t, kanbanDir, &sub, "tree", "2")
+	if len(sub) != 1 || sub[0].ID != 2 || len(sub[0].Children) != 1 {
+		t.Errorf("subtree = %+v, want 2 -> 3", sub)
+	}
+}
+
+func TestTreeTableAndCompact(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "First child", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Second child", "--parent", "1")
+
+	r := runKanban(t, kanbanDir, "--table", "tree")
+	if r.exitCode != 0 {
+		t.Fatalf("tree failed: %s", r.stderr)
+	}
+	if !strings.Contains(r.stdout, "├── ") || !strings.Contains(r.stdout, "└── ") {
+		t.Errorf("table output missing connectors:\n%s", r.stdout)
+	}
+
+	r = runKanban(t, kanbanDir, "--compact", "tree")
+	if !strings.Contains(r.stdout, "\n  #2 [backlog/medium] First child") {
+		t.Errorf("compact output not indented:\n%s", r.stdout)
+	}
+}
+
+func TestTreeNotFound(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "tree", "99")
+	if errResp.Code != "TASK_NOT_FOUND" {
+		t.Errorf("code = %q, want TASK_NOT_FOUND", errResp.Code)
+	}
+}
+
+func TestEditParentRejectsCycle(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Grandparent")
+	mustCreateTask(t, kanbanDir, "Parent", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Child", "--parent", "2")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "edit", "1", "--parent", "3")
+	if errResp.Code != "PARENT_CYCLE" {
+		t.Errorf("code = %q, want PARENT_CYCLE", errResp.Code)
+	}
+}
+
+func TestEditParentBatch(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "A")
+	mustCreateTask(t, kanbanDir, "B")
+
+	r := runKanban(t, kanbanDir, "edit", "2,3", "--parent", "1")
+	if r.exitCode != 0 {
+		t.Fatalf("batch edit --parent failed: %s", r.stderr)
+	}
+
+	var nodes []treeNodeJSON
+	runKanbanJSON(t, kanbanDir, &nodes, "tree", "1")
+	if len(nodes) != 1 || len(nodes[0].Children) != 2 {
+		t.Errorf("tree = %+v, want #1 with two children", nodes)
+	}
+}
+
+func TestReparentMovesSubtree(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Old epic")
+	mustCreateTask(t, kanbanDir, "New epic")
+	mustCreateTask(t, kanbanDir, "Story", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Subtask", "--parent", "3")
+
+	var res struct {
+		Moved []int `json:"moved"`
+	}
+	runKanbanJSON(t, kanbanDir, &res, "reparent", "1", "2")
+	if len(res.Moved) != 1 || res.Moved[0] != 3 {
+		t.Errorf("moved = %v, want [3]", res.Moved)
+	}
+
+	var nodes []treeNodeJSON
+	runKanbanJSON(t, kanbanDir, &nodes, "tree", "2")
+	if len(nodes) != 1 || len(nodes[0].Children) != 1 || len(nodes[0].Children[0].Children) != 1 {
+		t.Errorf("tree = %+v, want 2 -> 3 -> 4", nodes)
+	}
+}
+
+func TestReparentRejectsCycle(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Epic")
+	mustCreateTask(t, kanbanDir, "Story", "--parent", "1")
+	mustCreateTask(t, kanbanDir, "Subtask", "--parent", "2")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "reparent", "1", "3")
+	if errResp.Code != "PARENT_CYCLE" {
+		t.Errorf("code = %q, want PARENT_CYCLE", errResp.Code)
+	}
+
+	var shown struct {
+		Parent int `json:"parent"`
+	}
+	runKanbanJSON(t, kanbanDir, &shown, "show", "2")
+	if shown.Parent != 1 {
+		t.Errorf("parent of #2 = %d, want unchanged 1", shown.Parent)
+	}
+}
+
+func TestReparentNoChildren(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Lonely")
+	mustCreateTask(t, kanbanDir, "Other")
+
+	errResp := runKanbanJSONError(t, kanbanDir, "reparent", "1", "2")
+	if errResp.Code != "NO_CHANGES" {
+		t.Errorf("code = %q, want NO_CHANGES", errResp.Code)
+	}
+}
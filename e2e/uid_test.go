@@ -0,0 +1,57 @@
+package e2e_test
+
+import (
+	"strings"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Task UID tests
+// ---------------------------------------------------------------------------
+
+type uidTaskJSON struct {
+	ID  int    `json:"id"`
+	UID string `json:"uid"`
+}
+
+func TestCreateAssignsUID(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	var a, b uidTaskJSON
+	runKanbanJSON(t, kanbanDir, &a, "create", "First")
+	runKanbanJSON(t, kanbanDir, &b, "create", "Second")
+	if len(a.UID) != 36 || len(b.UID) != 36 || a.UID == b.UID {
+		t.Fatalf("UIDs = %q, %q; want two distinct UUIDs", a.UID, b.UID)
+	}
+
+	r := runKanban(t, kanbanDir, "--table", "show", "1")
+	if !strings.Contains(r.stdout, a.UID) {
+		t.Errorf("show should include the UID:\n%s", r.stdout)
+	}
+}
+
+func TestCommandsAcceptUID(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	var parent, child uidTaskJSON
+	runKanbanJSON(t, kanbanDir, &parent, "create", "Parent")
+	runKanbanJSON(t, kanbanDir, &child, "create", "Child", "--parent", strings.ToUpper(parent.UID))
+
+	var shown struct {
+		ID     int  `json:"id"`
+		Parent *int `json:"parent"`
+	}
+	runKanbanJSON(t, kanbanDir, &shown, "show", child.UID)
+	if shown.ID != child.ID || shown.Parent == nil || *shown.Parent != parent.ID {
+		t.Errorf("show by UID = %+v, want task %d with parent %d", shown, child.ID, parent.ID)
+	}
+
+	if r := runKanban(t, kanbanDir, "move", parent.UID+","+child.UID, statusTodo); r.exitCode != 0 {
+		t.Errorf("batch move by UID failed: %s", r.stderr)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "show", "00000000-0000-4000-8000-000000000000")
+	if errResp.Code != "TASK_NOT_FOUND" {
+		t.Errorf("unknown UID code = %q, want TASK_NOT_FOUND", errResp.Code)
+	}
+}
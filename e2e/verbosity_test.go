@@ -0,0 +1,96 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestQuiet_SuppressesOutput(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	r := runKanban(t, kanbanDir, "-q", "create", "Quiet task")
+	if r.exitCode != 0 {
+		t.Fatalf("quiet create: exit %d, stderr %q", r.exitCode, r.stderr)
+	}
+	if r.stdout != "" || r.stderr != "" {
+		t.Errorf("quiet create printed output: stdout %q, stderr %q", r.stdout, r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "--quiet", "--json", "list")
+	if r.stdout != "" {
+		t.Errorf("quiet --json list printed %q", r.stdout)
+	}
+}
+
+func TestQuiet_ReportsErrors(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	r := runKanban(t, kanbanDir, "-q", "--json", "show", "99")
+	if r.exitCode != 4 {
+		t.Errorf("exit = %d, want 4", r.exitCode)
+	}
+	var errResp errorJSON
+	if err := json.Unmarshal([]byte(r.stdout), &errResp); err != nil || errResp.Code != "TASK_NOT_FOUND" {
+		t.Errorf("quiet JSON error = %q (%v), want TASK_NOT_FOUND", r.stdout, err)
+	}
+
+	r = runKanban(t, kanbanDir, "-q", "show", "99")
+	if !strings.Contains(r.stderr, "not found") {
+		t.Errorf("quiet table error missing from stderr: %q", r.stderr)
+	}
+}
+
+func TestQuiet_HidesWarningsButFailOnStillCounts(t *testing.T) {
+	kanbanDir := initBoard(t)
+	mustCreateTask(t, kanbanDir, "Stuck")
+	runKanban(t, kanbanDir, "edit", "1", "--block", "waiting on vendor")
+
+	r := runKanban(t, kanbanDir, "-q", "--fail-on", "warning", "move", "1", statusTodo)
+	if r.exitCode != 1 {
+		t.Errorf("exit = %d, want 1 from the hidden warning", r.exitCode)
+	}
+	if r.stderr != "" {
+		t.Errorf("quiet printed a warning: %q", r.stderr)
+	}
+}
+
+func TestVerbose_ReportsDecisions(t *testing.T) {
+	kanbanDir := initBoardWithWIP(t, 1)
+	mustCreateTask(t, kanbanDir, "First")
+	mustCreateTask(t, kanbanDir, "Second")
+	runKanban(t, kanbanDir, "move", "1", statusInProgress, "--claim", claimAgent1)
+
+	r := runKanban(t, kanbanDir, "-v", "move", "2", statusInProgress, "--claim", claimAgent1)
+	if r.exitCode == 0 {
+		t.Fatal("expected the WIP limit to reject the move")
+	}
+	for _, want := range []string{
+		"verbose: claim check #2: unclaimed, allowed",
+		"verbose: WIP check in-progress: 1/1, denied",
+	} {
+		if !strings.Contains(r.stderr, want) {
+			t.Errorf("stderr missing %q:\n%s", want, r.stderr)
+		}
+	}
+	if strings.Contains(r.stderr, "verbose: board ") {
+		t.Errorf("-v printed -vv details:\n%s", r.stderr)
+	}
+
+	r = runKanban(t, kanbanDir, "-vv", "edit", "1", "--title", "Renamed", "--claim", "someone-else")
+	if !strings.Contains(r.stderr, "verbose: board ") {
+		t.Errorf("-vv missing board details:\n%s", r.stderr)
+	}
+	if !strings.Contains(r.stderr, `claim check #1: claimed by agent-1, evaluated for someone-else, denied`) {
+		t.Errorf("-vv missing claim decision:\n%s", r.stderr)
+	}
+}
+
+func TestQuietVerboseConflict(t *testing.T) {
+	kanbanDir := initBoard(t)
+
+	errResp := runKanbanJSONError(t, kanbanDir, "-q", "-v", "list")
+	if errResp.Code != "INVALID_INPUT" {
+		t.Errorf("code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
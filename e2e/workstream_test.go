@@ -0,0 +1,103 @@
+package e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Workstream (tasks_dirs) tests
+// ---------------------------------------------------------------------------
+
+func initWorkstreamBoard(t *testing.T) string {
+	t.Helper()
+	kanbanDir := initBoard(t)
+	r := runKanban(t, kanbanDir, "config", "set", "tasks_dirs", "product,infra")
+	if r.exitCode != 0 {
+		t.Fatalf("config set tasks_dirs failed: %s", r.stderr)
+	}
+	cfgPath := filepath.Join(kanbanDir, "config.yml")
+	data, err := os.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, "workstream_defaults:\n    infra:\n        priority: high\n        assignee: ops-bot\n"...)
+	if err := os.WriteFile(cfgPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return kanbanDir
+}
+
+func TestWorkstreamCreateAndList(t *testing.T) {
+	kanbanDir := initWorkstreamBoard(t)
+	mustCreateTask(t, kanbanDir, "Board task")
+
+	var infra taskJSON
+	runKanbanJSON(t, kanbanDir, &infra, "create", "Rotate certs", "--workstream", "infra")
+	if infra.Workstream != "infra" || infra.Priority != "high" || infra.Assignee != "ops-bot" {
+		t.Errorf("create --workstream infra = %+v, want workstream defaults applied", infra)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "infra", "002-rotate-certs.md")); err != nil {
+		t.Errorf("task file not in workstream dir: %v", err)
+	}
+
+	var product taskJSON
+	runKanbanJSON(t, kanbanDir, &product, "create", "Pricing page", "--workstream", "product", "--priority", "low")
+	if product.ID != 3 || product.Priority != "low" {
+		t.Errorf("product task = %+v, want ID 3 with flag priority", product)
+	}
+
+	var all []taskJSON
+	runKanbanJSON(t, kanbanDir, &all, "list")
+	if len(all) != 3 {
+		t.Errorf("list = %d tasks, want 3", len(all))
+	}
+	var onlyInfra []taskJSON
+	runKanbanJSON(t, kanbanDir, &onlyInfra, "list", "--workstream", "infra")
+	if len(onlyInfra) != 1 || onlyInfra[0].Title != "Rotate certs" {
+		t.Errorf("list --workstream infra = %+v", onlyInfra)
+	}
+
+	var shown taskJSON
+	runKanbanJSON(t, kanbanDir, &shown, "show", "2")
+	if shown.Workstream != "infra" {
+		t.Errorf("show workstream = %q, want infra", shown.Workstream)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "list", "--workstream", "ops")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("unknown workstream code = %q, want INVALID_INPUT", errResp.Code)
+	}
+}
+
+func TestWorkstreamEditMovesTask(t *testing.T) {
+	kanbanDir := initWorkstreamBoard(t)
+	mustCreateTask(t, kanbanDir, "Wanderer")
+
+	var moved taskJSON
+	runKanbanJSON(t, kanbanDir, &moved, "edit", "1", "--workstream", "product")
+	if moved.Workstream != "product" {
+		t.Errorf("edit workstream = %q, want product", moved.Workstream)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "product", "001-wanderer.md")); err != nil {
+		t.Errorf("task file not moved: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "tasks", "001-wanderer.md")); !os.IsNotExist(err) {
+		t.Errorf("old task file still exists: %v", err)
+	}
+
+	errResp := runKanbanJSONError(t, kanbanDir, "config", "set", "tasks_dirs", "infra")
+	if errResp.Code != codeInvalidInput {
+		t.Errorf("dropping a non-empty workstream code = %q, want INVALID_INPUT", errResp.Code)
+	}
+
+	var back taskJSON
+	runKanbanJSON(t, kanbanDir, &back, "edit", "1", "--workstream", "")
+	if back.Workstream != "" {
+		t.Errorf("edit back to tasks_dir workstream = %q", back.Workstream)
+	}
+	if _, err := os.Stat(filepath.Join(kanbanDir, "tasks", "001-wanderer.md")); err != nil {
+		t.Errorf("task file not moved back: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package board
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// autoCommits are the paths LogMutation commits to git after each entry,
+// keyed by kanban directory; see SetAutoCommit. Boards without an entry
+// are not committed.
+var autoCommits = map[string][]string{}
+
+// SetAutoCommit records whether subsequent mutations of cfg's board are
+// committed to git, per its git.auto_commit. Each commit covers the task
+// directories, the activity log, and the config, which holds next_id.
+func SetAutoCommit(cfg *config.Config) {
+	if !cfg.Git.AutoCommit {
+		delete(autoCommits, cfg.Dir())
+		return
+	}
+	paths := append([]string{
+		filepath.Join(cfg.Dir(), logFileName),
+		filepath.Join(cfg.Dir(), config.ConfigFileName),
+	}, cfg.TasksPaths()...)
+	autoCommits[cfg.Dir()] = paths
+}
+
+// CommitMessage is the subject of the commit recording entry, such as
+// "kanban: move #42 todo -> in-progress".
+func CommitMessage(entry LogEntry) string {
+	msg := "kanban: " + entry.Action
+	if entry.TaskID > 0 {
+		msg += fmt.Sprintf(" #%d", entry.TaskID)
+	}
+	if detail, _, _ := strings.Cut(entry.Detail, "\n"); detail != "" {
+		msg += " " + detail
+	}
+	return msg
+}
+
+// commitMutation commits the board's files with a message describing
+// entry, when the board has auto-commit on. Only the board's paths are
+// committed, so other changes staged in the repository stay staged.
+func commitMutation(kanbanDir string, entry LogEntry) error {
+	return commitMutations(kanbanDir, []LogEntry{entry})
+}
+
+// commitMutations commits the board's files once for entries. A single
+// entry is described as commitMutation does; several are summarized in the
+// subject and listed in the body.
+func commitMutations(kanbanDir string, entries []LogEntry) error {
+	paths := autoCommits[kanbanDir]
+	if len(paths) == 0 || len(entries) == 0 {
+		return nil
+	}
+	if err := gitRun(kanbanDir, append([]string{"add", "--all", "--"}, paths...)...); err != nil {
+		return err
+	}
+	var args []string
+	if len(entries) == 1 {
+		args = []string{"commit", "--quiet", "-m", CommitMessage(entries[0])}
+	} else {
+		lines := make([]string, len(entries))
+		for i, e := range entries {
+			lines[i] = CommitMessage(e)
+		}
+		args = []string{"commit", "--quiet", "-m", fmt.Sprintf("kanban: %d changes", len(entries)), "-m", strings.Join(lines, "\n")}
+	}
+	if actor := entries[len(entries)-1].Actor; actor != "" {
+		args = append(args, "-m", "Actor: "+actor)
+	}
+	return gitRun(kanbanDir, append(append(args, "--"), paths...)...)
+}
+
+// gitRun runs git in dir.
+func gitRun(dir string, args ...string) error {
+	c := exec.CommandContext(context.Background(), "git", append([]string{"-C", dir}, args...)...) //nolint:gosec // fixed git subcommands
+	var stderr bytes.Buffer
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("git: %s", msg)
+		}
+		return fmt.Errorf("git: %w", err)
+	}
+	return nil
+}
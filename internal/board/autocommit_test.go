@@ -0,0 +1,83 @@
+package board
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func TestCommitMessage(t *testing.T) {
+	tests := []struct {
+		entry LogEntry
+		want  string
+	}{
+		{LogEntry{Action: "move", TaskID: 42, Detail: "todo -> in-progress"}, "kanban: move #42 todo -> in-progress"},
+		{LogEntry{Action: "edit", TaskID: 7, Detail: "Title\nmore"}, "kanban: edit #7 Title"},
+		{LogEntry{Action: "renumber", Detail: ""}, "kanban: renumber"},
+	}
+	for _, tt := range tests {
+		if got := CommitMessage(tt.entry); got != tt.want {
+			t.Errorf("CommitMessage(%+v) = %q, want %q", tt.entry, got, tt.want)
+		}
+	}
+}
+
+// gitOutput runs git in dir for a test and returns its trimmed output.
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput() //nolint:gosec,noctx // test git commands
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestLogMutationAutoCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	repo := t.TempDir()
+	gitOutput(t, repo, "init", "--quiet")
+
+	cfg, err := config.Init(filepath.Join(repo, "kanban"), "Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Git.AutoCommit = true
+	SetAutoCommit(cfg)
+	t.Cleanup(func() { delete(autoCommits, cfg.Dir()) })
+
+	if err := os.WriteFile(filepath.Join(cfg.TasksPath(), "042-task.md"), []byte("---\nid: 42\n---\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "notes.txt"), []byte("wip"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	gitOutput(t, repo, "add", "notes.txt")
+
+	LogMutation(cfg.Dir(), "move", 42, "todo -> in-progress")
+
+	if got := gitOutput(t, repo, "log", "-1", "--format=%s"); got != "kanban: move #42 todo -> in-progress" {
+		t.Errorf("commit subject = %q", got)
+	}
+	files := gitOutput(t, repo, "show", "--name-only", "--format=", "HEAD")
+	for _, want := range []string{"kanban/activity.jsonl", "kanban/config.yml", "kanban/tasks/042-task.md"} {
+		if !strings.Contains(files, want) {
+			t.Errorf("commit files = %q, want %s", files, want)
+		}
+	}
+	if strings.Contains(files, "notes.txt") {
+		t.Error("commit includes a file outside the board")
+	}
+	if staged := gitOutput(t, repo, "diff", "--cached", "--name-only"); staged != "notes.txt" {
+		t.Errorf("staged after commit = %q, want notes.txt", staged)
+	}
+}
@@ -2,7 +2,6 @@ package board
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -18,22 +17,28 @@ type ListOptions struct {
 	Reverse   bool
 	Limit     int
 	Unblocked bool // only tasks with all dependencies at terminal status
+	Ready     bool // only ready tasks, see MarkReady
 }
 
 // List loads all tasks, applies filters and sorting.
 // Uses lenient parsing: malformed task files are skipped and returned as warnings.
 func List(cfg *config.Config, opts ListOptions) ([]*task.Task, []task.ReadWarning, error) {
-	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	tasks := Filter(allTasks, opts.Filter)
+	MarkDependencyBlocked(cfg, tasks, allTasks)
+	MarkReady(cfg, tasks, allTasks, PickOptions{ClaimTimeout: cfg.ClaimTimeoutDuration()})
 
 	if opts.Unblocked {
 		// Use all tasks for dep status lookup so archived deps are found.
 		tasks = FilterUnblockedWithLookup(tasks, allTasks, cfg)
 	}
+	if opts.Ready {
+		tasks = filterReady(tasks)
+	}
 
 	sortField := opts.SortBy
 	if sortField == "" {
@@ -51,7 +56,12 @@ func List(cfg *config.Config, opts ListOptions) ([]*task.Task, []task.ReadWarnin
 // FindDependents returns human-readable messages for tasks that reference the
 // given ID as a parent or dependency. Used to warn before deleting a task.
 func FindDependents(tasksDir string, id int) []string {
-	allTasks, _, err := task.ReadAllLenient(tasksDir)
+	return FindDependentsIn([]string{tasksDir}, id)
+}
+
+// FindDependentsIn is FindDependents over several tasks directories.
+func FindDependentsIn(tasksDirs []string, id int) []string {
+	allTasks, _, err := task.ReadAllLenient(tasksDirs...)
 	if err != nil {
 		return nil
 	}
@@ -99,6 +109,9 @@ type Overview struct {
 	Statuses   []StatusSummary `json:"statuses"`
 	Priorities []PriorityCount `json:"priorities"`
 	Classes    []ClassCount    `json:"classes,omitempty"`
+	// Goals rolls up the tasks linked to each goal in goals.yml; see
+	// GoalRollup.
+	Goals []GoalProgress `json:"goals,omitempty"`
 }
 
 // Summary computes a board summary from all tasks.
@@ -163,6 +176,12 @@ func Summary(cfg *config.Config, tasks []*task.Task, now time.Time) Overview {
 
 // ParseIDs splits a comma-separated ID string into deduplicated int IDs.
 func ParseIDs(arg string) ([]int, error) {
+	return ParseIDsFunc(arg, func(s string) (int, error) { return task.ParseID(s, "") })
+}
+
+// ParseIDsFunc is like ParseIDs but resolves each ID with parse, so callers
+// can accept prefixed IDs ("API-3") or task UIDs.
+func ParseIDsFunc(arg string, parse func(string) (int, error)) ([]int, error) {
 	parts := strings.Split(arg, ",")
 	seen := make(map[int]bool, len(parts))
 	ids := make([]int, 0, len(parts))
@@ -171,9 +190,9 @@ func ParseIDs(arg string) ([]int, error) {
 		if p == "" {
 			continue
 		}
-		id, err := strconv.Atoi(p)
+		id, err := parse(p)
 		if err != nil {
-			return nil, task.ValidateTaskID(p)
+			return nil, err
 		}
 		if !seen[id] {
 			ids = append(ids, id)
@@ -207,6 +226,21 @@ func CheckWIPLimit(cfg *config.Config, statusCounts map[string]int, targetStatus
 	return nil
 }
 
+// AutoStatus returns the first status in cfg.AutoStatusOrder() whose WIP
+// limit has room for one more task. When every status is full it returns
+// WIP_LIMIT_EXCEEDED listing the statuses it tried.
+func AutoStatus(cfg *config.Config, statusCounts map[string]int) (string, error) {
+	order := cfg.AutoStatusOrder()
+	for _, s := range order {
+		if CheckWIPLimit(cfg, statusCounts, s, "") == nil {
+			return s, nil
+		}
+	}
+	return "", clierr.Newf(clierr.WIPLimitExceeded,
+		"no WIP room in any auto status (%s)", strings.Join(order, ", ")).
+		WithDetails(map[string]any{"statuses": order})
+}
+
 // CountByStatus returns the number of tasks in each status.
 func CountByStatus(tasks []*task.Task) map[string]int {
 	counts := make(map[string]int)
@@ -230,7 +230,7 @@ func TestLogMutationSilentOnError(t *testing.T) {
 func TestValidGroupByFields(t *testing.T) {
 	fields := ValidGroupByFields()
 
-	const expectedLen = 5
+	const expectedLen = 7
 	if len(fields) != expectedLen {
 		t.Fatalf("len = %d, want %d", len(fields), expectedLen)
 	}
@@ -241,6 +241,8 @@ func TestValidGroupByFields(t *testing.T) {
 		"class":    false,
 		"priority": false,
 		"status":   false,
+		"due":      false,
+		"age":      false,
 	}
 	for _, f := range fields {
 		if _, ok := required[f]; !ok {
@@ -0,0 +1,54 @@
+package board
+
+import (
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+)
+
+// BusinessDuration returns the part of [from, to) that falls on working days
+// of the board calendar. Weekends and holidays are skipped entirely, so a
+// task started Friday afternoon and finished Monday morning has aged only
+// the Friday and Monday hours. Day boundaries are taken in from's location.
+func BusinessDuration(cfg *config.Config, from, to time.Time) time.Duration {
+	var total time.Duration
+	for cur := from; cur.Before(to); {
+		y, m, d := cur.Date()
+		next := time.Date(y, m, d+1, 0, 0, 0, 0, cur.Location())
+		if next.After(to) {
+			next = to
+		}
+		if cfg.IsWorkday(cur) {
+			total += next.Sub(cur)
+		}
+		cur = next
+	}
+	return total
+}
+
+// WorkingDays counts the working days from from to until inclusive.
+func WorkingDays(cfg *config.Config, from, until date.Date) int {
+	n := 0
+	for d := from.Time; !d.After(until.Time); d = d.AddDate(0, 0, 1) {
+		if cfg.IsWorkday(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// AddWorkdays returns the date n working days after d. Non-working days
+// along the way are skipped; d itself is not counted. A calendar with no
+// working days (possible only in a hand-edited config) stops the walk
+// after 7*n+366 days instead of looping forever.
+func AddWorkdays(cfg *config.Config, d date.Date, n int) date.Date {
+	cur := d.Time
+	for limit := 7*n + 366; n > 0 && limit > 0; limit-- { //nolint:mnd // a week per workday plus a year of holidays
+		cur = cur.AddDate(0, 0, 1)
+		if cfg.IsWorkday(cur) {
+			n--
+		}
+	}
+	return date.Date{Time: cur}
+}
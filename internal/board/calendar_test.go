@@ -0,0 +1,57 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+)
+
+func TestBusinessDurationSkipsWeekend(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	// Friday 16:00 to Monday 10:00: 8h on Friday plus 10h on Monday.
+	from := time.Date(2026, time.March, 6, 16, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 9, 10, 0, 0, 0, time.UTC)
+
+	if got := BusinessDuration(cfg, from, to); got != 18*time.Hour {
+		t.Errorf("BusinessDuration = %v, want 18h", got)
+	}
+}
+
+func TestBusinessDurationSkipsHolidays(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Calendar.Holidays = []string{"2026-03-03"}
+	from := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	if got := BusinessDuration(cfg, from, to); got != 48*time.Hour {
+		t.Errorf("BusinessDuration = %v, want 48h", got)
+	}
+}
+
+func TestWorkingDaysAndAddWorkdays(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Calendar.Holidays = []string{"2026-03-09"}
+	friday := date.New(2026, time.March, 6)
+
+	// Friday, Monday (holiday), Tuesday.
+	if n := WorkingDays(cfg, friday, date.New(2026, time.March, 10)); n != 2 {
+		t.Errorf("WorkingDays = %d, want 2", n)
+	}
+	if got := AddWorkdays(cfg, friday, 2); got.String() != "2026-03-11" {
+		t.Errorf("AddWorkdays(Fri, 2) = %s, want 2026-03-11", got)
+	}
+}
+
+func TestAddWorkdaysWithoutWorkdaysTerminates(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	// Validation rejects this, but a hand-edited config may still load.
+	cfg.Calendar.Weekends = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+	friday := date.New(2026, time.March, 6)
+
+	want := friday.AddDate(0, 0, 7*2+366).Format("2006-01-02")
+	if got := AddWorkdays(cfg, friday, 2); got.String() != want {
+		t.Errorf("AddWorkdays = %s, want %s", got, want)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -21,12 +23,17 @@ const (
 // ContextOptions controls which sections to include.
 type ContextOptions struct {
 	Sections []string // empty = all sections
-	Days     int      // lookback for recently completed (default 7)
+	Days     int      // lookback for recently completed and lookahead for due soon (default 7)
+	// IncludeBodies adds each task's body to its item, cut to MaxBodyChars
+	// characters when that is above zero.
+	IncludeBodies bool
+	MaxBodyChars  int
 }
 
 // ContextData holds all context information for rendering.
 type ContextData struct {
 	BoardName string           `json:"board_name"`
+	IDPrefix  string           `json:"id_prefix,omitempty"`
 	Summary   ContextSummary   `json:"summary"`
 	Sections  []ContextSection `json:"sections"`
 }
@@ -54,6 +61,7 @@ type ContextItem struct {
 	Priority string `json:"priority"`
 	Assignee string `json:"assignee,omitempty"`
 	Note     string `json:"note,omitempty"`
+	Body     string `json:"body,omitempty"`
 }
 
 // sectionName constants for filtering and display.
@@ -62,9 +70,12 @@ const (
 	sectionBlocked           = "blocked"
 	sectionOverdue           = "overdue"
 	sectionRecentlyCompleted = "recently-completed"
+	sectionReady             = "ready"
+	sectionDueSoon           = "due-soon"
 )
 
-// allSectionNames returns the ordered list of section names.
+// allSectionNames returns the ordered list of sections shown by default.
+// The ready and due-soon sections are only shown when asked for.
 func allSectionNames() []string {
 	return []string{
 		sectionInProgress,
@@ -85,6 +96,7 @@ func GenerateContext(cfg *config.Config, tasks []*task.Task, opts ContextOptions
 
 	data := ContextData{
 		BoardName: cfg.Board.Name,
+		IDPrefix:  cfg.Board.IDPrefix,
 		Summary:   computeSummary(cfg, tasks, now),
 	}
 
@@ -96,6 +108,9 @@ func GenerateContext(cfg *config.Config, tasks []*task.Task, opts ContextOptions
 
 	for _, name := range wantedSections {
 		items := buildSection(cfg, tasks, name, now, days)
+		if opts.IncludeBodies {
+			addBodies(items, tasks, opts.MaxBodyChars)
+		}
 		if len(items) > 0 {
 			data.Sections = append(data.Sections, ContextSection{Name: name, Items: items})
 		}
@@ -148,11 +163,15 @@ func buildSection(cfg *config.Config, tasks []*task.Task, name string, now time.
 	case sectionInProgress:
 		return buildInProgressSection(cfg, tasks)
 	case sectionBlocked:
-		return buildBlockedSection(cfg, tasks)
+		return buildBlockedSection(tasks, now)
 	case sectionOverdue:
 		return buildOverdueSection(cfg, tasks, now)
 	case sectionRecentlyCompleted:
 		return buildRecentlyCompletedSection(cfg, tasks, now, days)
+	case sectionReady:
+		return buildReadySection(cfg, tasks, now)
+	case sectionDueSoon:
+		return buildDueSoonSection(cfg, tasks, now, days)
 	default:
 		return nil
 	}
@@ -169,12 +188,24 @@ func buildInProgressSection(cfg *config.Config, tasks []*task.Task) []ContextIte
 	return items
 }
 
-func buildBlockedSection(_ *config.Config, tasks []*task.Task) []ContextItem {
+func buildBlockedSection(tasks []*task.Task, now time.Time) []ContextItem {
+	today := date.New(now.Year(), now.Month(), now.Day())
 	var items []ContextItem
 	for _, t := range tasks {
-		if t.Blocked {
-			items = append(items, taskToItem(t, t.BlockReason))
+		if !t.Blocked {
+			continue
+		}
+		detail := t.BlockReason
+		switch {
+		case task.BlockReviewDue(t, today):
+			detail += " (expected unblocked " + t.BlockedUntil.String() + "; review)"
+		case t.BlockedUntil != nil:
+			detail += " (until " + t.BlockedUntil.String() + ")"
 		}
+		if t.BlockedOn != "" {
+			detail += " [on " + t.BlockedOn + "]"
+		}
+		items = append(items, taskToItem(t, detail))
 	}
 	return items
 }
@@ -200,6 +231,50 @@ func buildRecentlyCompletedSection(cfg *config.Config, tasks []*task.Task, now t
 	return items
 }
 
+// buildReadySection lists the tasks pick would consider, in the order next
+// ranks them.
+func buildReadySection(cfg *config.Config, tasks []*task.Task, now time.Time) []ContextItem {
+	var items []ContextItem
+	for _, r := range Next(cfg, tasks, PickOptions{ClaimTimeout: cfg.ClaimTimeoutDuration()}, now) {
+		items = append(items, taskToItem(r.Task, ""))
+	}
+	return items
+}
+
+// buildDueSoonSection lists open tasks due within the next days that are
+// not yet overdue, soonest first.
+func buildDueSoonSection(cfg *config.Config, tasks []*task.Task, now time.Time, days int) []ContextItem {
+	horizon := now.AddDate(0, 0, days)
+	var due []*task.Task
+	for _, t := range tasks {
+		if t.Due != nil && !t.Due.Before(now) && !t.Due.After(horizon) && !cfg.IsTerminalStatus(t.Status) {
+			due = append(due, t)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].Due.Before(due[j].Due.Time) })
+	items := make([]ContextItem, 0, len(due))
+	for _, t := range due {
+		items = append(items, taskToItem(t, "due "+t.Due.String()))
+	}
+	return items
+}
+
+// addBodies sets the body of each item from its task, cut to maxChars
+// characters when maxChars is above zero.
+func addBodies(items []ContextItem, tasks []*task.Task, maxChars int) {
+	bodies := make(map[int]string, len(tasks))
+	for _, t := range tasks {
+		bodies[t.ID] = strings.TrimSpace(t.Body)
+	}
+	for i := range items {
+		body := bodies[items[i].ID]
+		if r := []rune(body); maxChars > 0 && len(r) > maxChars {
+			body = strings.TrimRightFunc(string(r[:maxChars]), unicode.IsSpace) + "…"
+		}
+		items[i].Body = body
+	}
+}
+
 func taskToItem(t *task.Task, note string) ContextItem {
 	return ContextItem{
 		ID:       t.ID,
@@ -248,7 +323,7 @@ func RenderContextMarkdown(data ContextData) string {
 		b.WriteString(sectionTitle(sec.Name))
 		b.WriteString("\n\n")
 		for _, item := range sec.Items {
-			fmt.Fprintf(&b, "- **#%d** %s", item.ID, item.Title)
+			fmt.Fprintf(&b, "- **%s** %s", contextID(data.IDPrefix, item.ID), item.Title)
 			parts := []string{item.Priority}
 			if item.Assignee != "" {
 				parts = append(parts, "@"+item.Assignee)
@@ -261,6 +336,7 @@ func RenderContextMarkdown(data ContextData) string {
 				b.WriteString(item.Note)
 			}
 			b.WriteString("\n")
+			writeItemBody(&b, item.Body)
 		}
 	}
 
@@ -269,6 +345,31 @@ func RenderContextMarkdown(data ContextData) string {
 	return b.String()
 }
 
+// writeItemBody writes body indented under its list item, so it renders as
+// part of the item.
+func writeItemBody(b *strings.Builder, body string) {
+	if body == "" {
+		return
+	}
+	b.WriteString("\n")
+	for _, line := range strings.Split(body, "\n") {
+		if line != "" {
+			b.WriteString("  ")
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+}
+
+// contextID renders a task ID as "API-12" with an ID prefix, or "#12".
+func contextID(prefix string, id int) string {
+	if prefix == "" {
+		prefix = "#"
+	}
+	return prefix + strconv.Itoa(id)
+}
+
 func sectionTitle(name string) string {
 	switch name {
 	case sectionInProgress:
@@ -279,6 +380,10 @@ func sectionTitle(name string) string {
 		return "Overdue"
 	case sectionRecentlyCompleted:
 		return "Recently Completed"
+	case sectionReady:
+		return "Ready"
+	case sectionDueSoon:
+		return "Due Soon"
 	default:
 		return name
 	}
@@ -170,7 +170,7 @@ func TestComputeSummaryCustomStatuses(t *testing.T) {
 	}
 }
 
-func TestReadySectionFilterIsIgnored(t *testing.T) {
+func TestContextReadyAndDueSoonSections(t *testing.T) {
 	cfg := &config.Config{
 		Board: config.BoardConfig{Name: "Custom"},
 		Statuses: []config.StatusConfig{
@@ -179,16 +179,28 @@ func TestReadySectionFilterIsIgnored(t *testing.T) {
 		Priorities: []string{"low", "medium", "high"},
 	}
 	now := time.Now()
+	inDays := func(n int) *date.Date { d := date.Date{Time: now.AddDate(0, 0, n)}; return &d }
 
 	tasks := []*task.Task{
-		{ID: 1, Title: "Accepted task", Status: "accepted", Priority: "high", Created: now, Updated: now},
-		{ID: 2, Title: "Active task", Status: "active", Priority: "medium", Created: now, Updated: now},
+		{ID: 1, Title: "Accepted task", Status: "accepted", Priority: "high", Created: now, Updated: now, Due: inDays(5)},
+		{ID: 2, Title: "Claimed task", Status: "active", Priority: "medium", ClaimedBy: "bot", ClaimedAt: &now, Created: now, Updated: now},
+		{ID: 3, Title: "Waiting task", Status: "new", Priority: "high", DependsOn: []int{1}, Created: now, Updated: now, Due: inDays(2)},
+		{ID: 4, Title: "Low task", Status: "new", Priority: "low", Created: now, Updated: now, Due: inDays(30)},
+		{ID: 5, Title: "Done task", Status: "done", Priority: "high", Created: now, Updated: now, Due: inDays(1)},
 	}
 
-	data := GenerateContext(cfg, tasks, ContextOptions{Sections: []string{"ready"}}, now)
-
-	if len(data.Sections) != 0 {
-		t.Fatalf("Sections = %d, want 0", len(data.Sections))
+	data := GenerateContext(cfg, tasks, ContextOptions{Sections: []string{"ready", "due-soon"}}, now)
+	if len(data.Sections) != 2 {
+		t.Fatalf("Sections = %+v, want ready and due-soon", data.Sections)
+	}
+	if ready := data.Sections[0].Items; len(ready) != 2 || ready[0].ID != 1 || ready[1].ID != 4 {
+		t.Errorf("ready = %+v, want tasks 1 and 4 in rank order", ready)
+	}
+	if due := data.Sections[1].Items; len(due) != 2 || due[0].ID != 3 || due[1].ID != 1 {
+		t.Errorf("due-soon = %+v, want tasks 3 and 1, soonest first", due)
+	}
+	if md := RenderContextMarkdown(data); !strings.Contains(md, "### Ready") || !strings.Contains(md, "### Due Soon") {
+		t.Errorf("markdown missing section titles:\n%s", md)
 	}
 }
 
@@ -290,3 +302,56 @@ func TestWriteContextToFile_AppendToExisting(t *testing.T) {
 		t.Error("context not appended")
 	}
 }
+
+func TestContextBlockedUntil(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ahead := date.New(2026, 3, 12)
+	passed := date.New(2026, 3, 9)
+	tasks := []*task.Task{
+		{ID: 1, Title: "Waiting", Status: "todo", Blocked: true, BlockReason: "vendor", BlockedUntil: &ahead},
+		{ID: 2, Title: "Overstayed", Status: "todo", Blocked: true, BlockReason: "legal", BlockedUntil: &passed},
+		{ID: 3, Title: "Upstream", Status: "todo", Blocked: true, BlockReason: "fix", BlockedOn: "acme/api#7"},
+	}
+
+	items := buildBlockedSection(tasks, now)
+	if len(items) != 3 {
+		t.Fatalf("blocked items = %d, want 3", len(items))
+	}
+	if items[0].Note != "vendor (until 2026-03-12)" {
+		t.Errorf("note = %q", items[0].Note)
+	}
+	if items[1].Note != "legal (expected unblocked 2026-03-09; review)" {
+		t.Errorf("note = %q", items[1].Note)
+	}
+	if items[2].Note != "fix [on acme/api#7]" {
+		t.Errorf("note = %q", items[2].Note)
+	}
+}
+
+func TestContextIncludeBodies(t *testing.T) {
+	cfg := newTestConfig()
+	now := time.Now()
+	tasks := []*task.Task{
+		{ID: 1, Title: "Login", Status: "in-progress", Priority: "high", Body: "Accept when:\n\n- SSO works\n", Created: now, Updated: now},
+		{ID: 2, Title: "Logout", Status: "in-progress", Priority: "medium", Created: now, Updated: now},
+	}
+
+	data := GenerateContext(cfg, tasks, ContextOptions{Sections: []string{"in-progress"}}, now)
+	if body := data.Sections[0].Items[0].Body; body != "" {
+		t.Errorf("body without IncludeBodies = %q, want empty", body)
+	}
+
+	data = GenerateContext(cfg, tasks, ContextOptions{Sections: []string{"in-progress"}, IncludeBodies: true}, now)
+	if body := data.Sections[0].Items[0].Body; body != "Accept when:\n\n- SSO works" {
+		t.Errorf("body = %q", body)
+	}
+	md := RenderContextMarkdown(data)
+	if !strings.Contains(md, "(high)\n\n  Accept when:\n\n  - SSO works\n\n- **#2**") {
+		t.Errorf("markdown body not indented under its item:\n%s", md)
+	}
+
+	data = GenerateContext(cfg, tasks, ContextOptions{Sections: []string{"in-progress"}, IncludeBodies: true, MaxBodyChars: 7}, now)
+	if body := data.Sections[0].Items[0].Body; body != "Accept…" {
+		t.Errorf("cut body = %q, want %q", body, "Accept…")
+	}
+}
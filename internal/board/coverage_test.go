@@ -1,6 +1,7 @@
 package board
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -8,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/antopolskiy/kanban-md/internal/clierr"
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
@@ -565,3 +567,22 @@ func TestFindDependents_BothParentAndDep(t *testing.T) {
 		t.Errorf("got %d messages, want 2 (parent + dep)", len(msgs))
 	}
 }
+
+func TestAutoStatus(t *testing.T) {
+	cfg := &config.Config{
+		Statuses:  []config.StatusConfig{{Name: "backlog"}, {Name: "todo"}, {Name: "done"}},
+		WIPLimits: map[string]int{"backlog": 2, "todo": 1},
+	}
+	counts := map[string]int{"backlog": 2}
+	if got, err := AutoStatus(cfg, counts); err != nil || got != "todo" {
+		t.Errorf("AutoStatus = %q, %v; want todo (backlog full)", got, err)
+	}
+
+	cfg.Defaults.AutoStatus = []string{"todo", "backlog"}
+	counts["todo"] = 1
+	_, err := AutoStatus(cfg, counts)
+	var cliErr *clierr.Error
+	if !errors.As(err, &cliErr) || cliErr.Code != clierr.WIPLimitExceeded {
+		t.Errorf("AutoStatus with all full = %v, want WIP_LIMIT_EXCEEDED", err)
+	}
+}
@@ -0,0 +1,139 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// CriticalPath is the longest chain of remaining work through the
+// dependency graph, weighted by task estimates.
+type CriticalPath struct {
+	Tasks       []CriticalPathTask `json:"tasks"`
+	TotalHours  float64            `json:"total_hours"`
+	Unestimated []int              `json:"unestimated,omitempty"`
+}
+
+// CriticalPathTask is one step of a critical path, listed in the order the
+// work must happen (dependencies first).
+type CriticalPathTask struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	Status        string  `json:"status"`
+	Estimate      string  `json:"estimate,omitempty"`
+	EstimateHours float64 `json:"estimate_hours"`
+}
+
+// ComputeCriticalPath finds the chain of non-terminal tasks linked by
+// depends_on whose summed estimates are the largest. Tasks with missing or
+// unparseable estimates count as zero hours and are reported in Unestimated.
+// Dependencies on terminal or missing tasks are ignored, and dependency
+// cycles are broken at the first back edge encountered.
+func ComputeCriticalPath(cfg *config.Config, tasks []*task.Task) CriticalPath {
+	remaining := make(map[int]*task.Task)
+	for _, t := range tasks {
+		if !cfg.IsTerminalStatus(t.Status) {
+			remaining[t.ID] = t
+		}
+	}
+
+	ids := make([]int, 0, len(remaining))
+	for id := range remaining {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	cp := &criticalPathSolver{
+		remaining:   remaining,
+		hoursPerDay: cfg.EstimateHoursPerDay(),
+		best:        make(map[int]float64, len(remaining)),
+		next:        make(map[int]int, len(remaining)),
+		state:       make(map[int]int, len(remaining)),
+	}
+
+	startID := 0
+	startHours := -1.0
+	for _, id := range ids {
+		h := cp.solve(id)
+		if h > startHours {
+			startID, startHours = id, h
+		}
+	}
+
+	result := CriticalPath{Tasks: []CriticalPathTask{}}
+	if startHours < 0 {
+		return result
+	}
+
+	// The solver walks from a task towards its dependencies, so the chain
+	// is collected last-to-first and reversed into work order.
+	var chain []CriticalPathTask
+	for id := startID; id != 0; id = cp.next[id] {
+		t := remaining[id]
+		h, ok := t.EstimateHoursWith(cp.hoursPerDay)
+		if !ok {
+			result.Unestimated = append(result.Unestimated, id)
+		}
+		chain = append(chain, CriticalPathTask{
+			ID:            t.ID,
+			Title:         t.Title,
+			Status:        t.Status,
+			Estimate:      t.Estimate,
+			EstimateHours: h,
+		})
+		result.TotalHours += h
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		result.Tasks = append(result.Tasks, chain[i])
+	}
+	sort.Ints(result.Unestimated)
+	return result
+}
+
+const (
+	cpUnvisited = iota
+	cpVisiting
+	cpDone
+)
+
+type criticalPathSolver struct {
+	remaining   map[int]*task.Task
+	hoursPerDay float64
+	best        map[int]float64 // longest chain ending at the task
+	next        map[int]int     // dependency that continues the chain, 0 if none
+	state       map[int]int
+}
+
+func (s *criticalPathSolver) solve(id int) float64 {
+	switch s.state[id] {
+	case cpDone:
+		return s.best[id]
+	case cpVisiting:
+		return -1
+	}
+	s.state[id] = cpVisiting
+
+	t := s.remaining[id]
+	own, _ := t.EstimateHoursWith(s.hoursPerDay)
+
+	var bestDep int
+	bestHours := 0.0
+	for _, depID := range t.DependsOn {
+		if _, ok := s.remaining[depID]; !ok {
+			continue
+		}
+		h := s.solve(depID)
+		if h < 0 {
+			continue // cycle
+		}
+		if bestDep == 0 || h > bestHours || (h == bestHours && depID < bestDep) {
+			bestDep, bestHours = depID, h
+		}
+	}
+
+	s.state[id] = cpDone
+	s.best[id] = own + bestHours
+	s.next[id] = bestDep
+	return s.best[id]
+}
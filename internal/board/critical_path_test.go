@@ -0,0 +1,90 @@
+package board
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func cpIDs(cp CriticalPath) []int {
+	ids := make([]int, len(cp.Tasks))
+	for i, t := range cp.Tasks {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestCriticalPathEmpty(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cp := ComputeCriticalPath(cfg, nil)
+	if len(cp.Tasks) != 0 || cp.TotalHours != 0 {
+		t.Errorf("got %+v, want empty", cp)
+	}
+}
+
+func TestCriticalPathLongestChain(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "Design", Status: "todo", Estimate: "1d"},
+		{ID: 2, Title: "Backend", Status: "todo", Estimate: "3d", DependsOn: []int{1}},
+		{ID: 3, Title: "Frontend", Status: "todo", Estimate: "1d", DependsOn: []int{1}},
+		{ID: 4, Title: "Release", Status: "backlog", Estimate: "4h", DependsOn: []int{2, 3}},
+		{ID: 5, Title: "Docs", Status: "backlog", Estimate: "2h"},
+	}
+
+	cp := ComputeCriticalPath(cfg, tasks)
+	if want := []int{1, 2, 4}; !slices.Equal(cpIDs(cp), want) {
+		t.Errorf("path = %v, want %v", cpIDs(cp), want)
+	}
+	if cp.TotalHours != 36 {
+		t.Errorf("TotalHours = %v, want 36", cp.TotalHours)
+	}
+}
+
+func TestCriticalPathSkipsCompletedWork(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "Done dep", Status: "done", Estimate: "5d"},
+		{ID: 2, Title: "Next", Status: "todo", Estimate: "2h", DependsOn: []int{1, 99}},
+		{ID: 3, Title: "Other", Status: "todo", Estimate: "1h"},
+	}
+
+	cp := ComputeCriticalPath(cfg, tasks)
+	if want := []int{2}; !slices.Equal(cpIDs(cp), want) {
+		t.Errorf("path = %v, want %v", cpIDs(cp), want)
+	}
+	if cp.TotalHours != 2 {
+		t.Errorf("TotalHours = %v, want 2", cp.TotalHours)
+	}
+}
+
+func TestCriticalPathReportsUnestimated(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "Unknown", Status: "todo"},
+		{ID: 2, Title: "Known", Status: "todo", Estimate: "3h", DependsOn: []int{1}},
+	}
+
+	cp := ComputeCriticalPath(cfg, tasks)
+	if want := []int{1, 2}; !slices.Equal(cpIDs(cp), want) {
+		t.Errorf("path = %v, want %v", cpIDs(cp), want)
+	}
+	if want := []int{1}; !slices.Equal(cp.Unestimated, want) {
+		t.Errorf("Unestimated = %v, want %v", cp.Unestimated, want)
+	}
+}
+
+func TestCriticalPathToleratesCycles(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "A", Status: "todo", Estimate: "1h", DependsOn: []int{2}},
+		{ID: 2, Title: "B", Status: "todo", Estimate: "1h", DependsOn: []int{1}},
+	}
+
+	cp := ComputeCriticalPath(cfg, tasks)
+	if len(cp.Tasks) != 2 || cp.TotalHours != 2 {
+		t.Errorf("got %v (%vh), want 2 tasks / 2h", cpIDs(cp), cp.TotalHours)
+	}
+}
@@ -0,0 +1,183 @@
+package board
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Diff compares the board now with its state at Since, reconstructed from
+// the activity log.
+type Diff struct {
+	Since   time.Time    `json:"since"`
+	Changed int          `json:"changed"`
+	Columns []ColumnDiff `json:"columns"`
+	// Incomplete is set when the log has been truncated after since, so
+	// older changes could not be undone.
+	Incomplete bool `json:"incomplete,omitempty"`
+}
+
+// ColumnDiff is how one column changed: how many tasks it held then and
+// now, the tasks that entered it, and the tasks that left it.
+type ColumnDiff struct {
+	Status  string     `json:"status"`
+	Before  int        `json:"before"`
+	After   int        `json:"after"`
+	Added   []DiffTask `json:"added"`
+	Removed []DiffTask `json:"removed"`
+}
+
+// DiffTask is a task that entered or left a column. From is the column an
+// added task came from and To the column a removed task went to; they are
+// empty for a task created in the period and for one whose file is gone.
+// Deleted tasks went to the archived status.
+type DiffTask struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+	Created bool   `json:"created,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
+
+// DiffBoard compares tasks with the board as it was at since. The past
+// state is the present one with the logged moves, creations, and deletions
+// after since undone; a task with no logged creation counts as created in
+// the period when its created time is after since. Only the net change
+// shows: a task that left a column and came back is unchanged.
+func DiffBoard(cfg *config.Config, tasks []*task.Task, log []LogEntry, since time.Time) Diff {
+	entries := slices.Clone(log)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp.Before(entries[j].Timestamp) })
+
+	now := make(map[int]string, len(tasks))
+	titles := make(map[int]string, len(tasks))
+	logged := make(map[int]bool)
+	for _, e := range entries {
+		if e.Action == "create" || e.Action == "delete" {
+			titles[e.TaskID] = e.Detail
+		}
+		if e.Action == "create" {
+			logged[e.TaskID] = true
+		}
+	}
+	for _, t := range tasks {
+		now[t.ID] = t.Status
+		titles[t.ID] = t.Title
+	}
+
+	then, created, deleted := pastStatuses(cfg, entries, now, since)
+	for _, t := range tasks {
+		if !logged[t.ID] && t.Created.After(since) {
+			delete(then, t.ID)
+			created[t.ID] = true
+		}
+	}
+
+	d := Diff{Since: since, Columns: []ColumnDiff{}}
+	d.Incomplete = len(entries) >= maxLogEntries && entries[0].Timestamp.After(since)
+	cols := countColumns(cfg, then, now)
+	for _, id := range changedIDs(then, now) {
+		from, existed := then[id]
+		to, exists := now[id]
+		dt := DiffTask{ID: id, Title: titles[id], From: from, To: to,
+			Created: created[id] && !existed, Deleted: deleted[id] && to == config.ArchivedStatus}
+		d.Changed++
+		if c := cols[from]; existed && c != nil {
+			c.Removed = append(c.Removed, dt)
+		}
+		if c := cols[to]; exists && c != nil {
+			c.Added = append(c.Added, dt)
+		}
+	}
+	for _, status := range cfg.StatusNames() {
+		d.Columns = append(d.Columns, *cols[status])
+	}
+	return d
+}
+
+// countColumns returns an empty diff for each column with the number of
+// tasks in it then and now.
+func countColumns(cfg *config.Config, then, now map[int]string) map[string]*ColumnDiff {
+	cols := make(map[string]*ColumnDiff)
+	for _, status := range cfg.StatusNames() {
+		cols[status] = &ColumnDiff{Status: status, Added: []DiffTask{}, Removed: []DiffTask{}}
+	}
+	for _, s := range then {
+		if c := cols[s]; c != nil {
+			c.Before++
+		}
+	}
+	for _, s := range now {
+		if c := cols[s]; c != nil {
+			c.After++
+		}
+	}
+	return cols
+}
+
+// pastStatuses undoes the entries logged after since, newest first, and
+// returns each task's status at since, along with the tasks logged as
+// created or deleted after it. A deleted task had the status it was last
+// logged moving to, or else the default status.
+func pastStatuses(cfg *config.Config, entries []LogEntry, now map[int]string, since time.Time) (map[int]string, map[int]bool, map[int]bool) {
+	beforeDelete := make(map[int]string) // entry index -> status
+	last := make(map[int]string)
+	for i, e := range entries {
+		if _, to, ok := e.StatusChange(); ok {
+			last[e.TaskID] = to
+		}
+		if e.Action == "delete" {
+			if s, ok := last[e.TaskID]; ok {
+				beforeDelete[i] = s
+			} else {
+				beforeDelete[i] = cfg.Defaults.Status
+			}
+			last[e.TaskID] = config.ArchivedStatus
+		}
+	}
+
+	then := maps.Clone(now)
+	created := make(map[int]bool)
+	deleted := make(map[int]bool)
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if !e.Timestamp.After(since) {
+			break
+		}
+		if from, _, ok := e.StatusChange(); ok {
+			then[e.TaskID] = from
+			continue
+		}
+		switch e.Action {
+		case "create":
+			delete(then, e.TaskID)
+			created[e.TaskID] = true
+		case "delete":
+			then[e.TaskID] = beforeDelete[i]
+			deleted[e.TaskID] = true
+		}
+	}
+	return then, created, deleted
+}
+
+// changedIDs returns the IDs of the tasks whose status differs between
+// then and now, including tasks present in only one of them, in order.
+func changedIDs(then, now map[int]string) []int {
+	var ids []int
+	for id, s := range then {
+		if cur, ok := now[id]; !ok || cur != s {
+			ids = append(ids, id)
+		}
+	}
+	for id := range now {
+		if _, ok := then[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,66 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestDiffBoard(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	since := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	before := since.Add(-48 * time.Hour)
+	at := func(days int) time.Time { return since.Add(time.Duration(days) * 24 * time.Hour) }
+
+	tasks := []*task.Task{
+		{ID: 1, Title: "Moved", Status: "review", Created: before},
+		{ID: 2, Title: "Bounced", Status: "todo", Created: before},
+		{ID: 3, Title: "New", Status: "todo", Created: at(1)},
+		{ID: 4, Title: "Deleted", Status: config.ArchivedStatus, Created: before},
+		{ID: 5, Title: "Untouched", Status: "backlog", Created: before},
+		{ID: 6, Title: "Unlogged", Status: "todo", Created: at(2)},
+	}
+	log := []LogEntry{
+		{Timestamp: before, Action: "move", TaskID: 4, Detail: "backlog -> todo"},
+		{Timestamp: at(1), Action: "move", TaskID: 1, Detail: "todo -> in-progress"},
+		{Timestamp: at(1), Action: "create", TaskID: 3, Detail: "New"},
+		{Timestamp: at(2), Action: "move", TaskID: 2, Detail: "todo -> in-progress"},
+		{Timestamp: at(3), Action: "auto-move", TaskID: 1, Detail: "in-progress -> review (pr opened)"},
+		{Timestamp: at(3), Action: "move", TaskID: 2, Detail: "in-progress -> todo"},
+		{Timestamp: at(4), Action: "delete", TaskID: 4, Detail: "Deleted"},
+	}
+
+	d := DiffBoard(cfg, tasks, log, since)
+	if d.Changed != 4 {
+		t.Errorf("changed = %d, want 4", d.Changed)
+	}
+	cols := make(map[string]ColumnDiff)
+	for _, c := range d.Columns {
+		cols[c.Status] = c
+	}
+
+	todo := cols["todo"]
+	// Then: 1, 2, 4. Now: 2, 3, 6.
+	if todo.Before != 3 || todo.After != 3 {
+		t.Errorf("todo before/after = %d/%d, want 3/3", todo.Before, todo.After)
+	}
+	if len(todo.Added) != 2 || todo.Added[0].ID != 3 || !todo.Added[0].Created || todo.Added[1].ID != 6 || !todo.Added[1].Created {
+		t.Errorf("todo added = %+v, want created 3 and 6", todo.Added)
+	}
+	if len(todo.Removed) != 2 || todo.Removed[0].To != "review" || !todo.Removed[1].Deleted {
+		t.Errorf("todo removed = %+v, want 1 to review and 4 deleted", todo.Removed)
+	}
+
+	review := cols["review"]
+	if len(review.Added) != 1 || review.Added[0].From != "todo" {
+		t.Errorf("review added = %+v, want 1 from todo", review.Added)
+	}
+	if c := cols["in-progress"]; len(c.Added)+len(c.Removed) != 0 {
+		t.Errorf("in-progress should show no net change, got %+v", c)
+	}
+	if c := cols["backlog"]; c.Before != 1 || c.After != 1 || len(c.Removed) != 0 {
+		t.Errorf("backlog = %+v, want unchanged", c)
+	}
+}
@@ -0,0 +1,124 @@
+package board
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+const (
+	fieldEpic = "epic"
+	noEpicKey = "(no epic)"
+)
+
+// EstimateReport sums the estimates of remaining (non-terminal) work,
+// grouped by a task field.
+type EstimateReport struct {
+	GroupBy     string          `json:"group_by"`
+	HoursPerDay float64         `json:"hours_per_day"`
+	Groups      []EstimateGroup `json:"groups"`
+	Tasks       int             `json:"tasks"`
+	TotalHours  float64         `json:"total_hours"`
+	Unestimated []int           `json:"unestimated,omitempty"`
+}
+
+// EstimateGroup is one group of an estimate report. Hours only includes
+// tasks with a parseable estimate; the rest are counted in Unestimated.
+type EstimateGroup struct {
+	Key         string  `json:"key"`
+	Tasks       int     `json:"tasks"`
+	Hours       float64 `json:"hours"`
+	Unestimated int     `json:"unestimated,omitempty"`
+}
+
+// ValidEstimateGroupByFields returns the fields accepted by estimate report --by.
+func ValidEstimateGroupByFields() []string {
+	return []string{"tag", fieldEpic, "assignee", fieldStatus}
+}
+
+// ComputeEstimateReport totals the estimates of non-terminal tasks grouped
+// by field. Estimates are converted to hours using the board's
+// estimates.hours_per_day. A task with several tags is counted in each of its
+// tag groups, so group totals may exceed the report total. For "epic", a
+// task belongs to the top-level ancestor of its parent chain; tasks outside
+// any hierarchy are grouped under "(no epic)".
+func ComputeEstimateReport(cfg *config.Config, tasks []*task.Task, field string) EstimateReport {
+	hoursPerDay := cfg.EstimateHoursPerDay()
+	report := EstimateReport{GroupBy: field, HoursPerDay: hoursPerDay, Groups: []EstimateGroup{}}
+
+	var epics map[int]string
+	if field == fieldEpic {
+		epics = epicKeys(tasks)
+	}
+
+	groups := make(map[string][]*task.Task)
+	for _, t := range tasks {
+		if cfg.IsTerminalStatus(t.Status) {
+			continue
+		}
+		report.Tasks++
+		h, ok := t.EstimateHoursWith(hoursPerDay)
+		if ok {
+			report.TotalHours += h
+		} else {
+			report.Unestimated = append(report.Unestimated, t.ID)
+		}
+
+		keys := extractGroupKeys(t, field)
+		if field == fieldEpic {
+			keys = []string{epics[t.ID]}
+		}
+		for _, key := range keys {
+			groups[key] = append(groups[key], t)
+		}
+	}
+
+	for _, key := range sortGroupKeys(groups, field, cfg) {
+		g := EstimateGroup{Key: key, Tasks: len(groups[key])}
+		for _, t := range groups[key] {
+			if h, ok := t.EstimateHoursWith(hoursPerDay); ok {
+				g.Hours += h
+			} else {
+				g.Unestimated++
+			}
+		}
+		report.Groups = append(report.Groups, g)
+	}
+	sort.Ints(report.Unestimated)
+	return report
+}
+
+// epicKeys maps every task ID to the "#N Title" label of its top-level
+// ancestor. Tasks with neither a parent nor children map to noEpicKey.
+func epicKeys(tasks []*task.Task) map[int]string {
+	byID := make(map[int]*task.Task, len(tasks))
+	hasChildren := make(map[int]bool)
+	for _, t := range tasks {
+		byID[t.ID] = t
+		if t.Parent != nil {
+			hasChildren[*t.Parent] = true
+		}
+	}
+
+	keys := make(map[int]string, len(tasks))
+	for _, t := range tasks {
+		root := t
+		seen := map[int]bool{t.ID: true}
+		for root.Parent != nil {
+			p, ok := byID[*root.Parent]
+			if !ok || seen[p.ID] {
+				break
+			}
+			seen[p.ID] = true
+			root = p
+		}
+		if root == t && !hasChildren[t.ID] {
+			keys[t.ID] = noEpicKey
+			continue
+		}
+		keys[t.ID] = "#" + strconv.Itoa(root.ID) + " " + root.Title
+	}
+	return keys
+}
@@ -0,0 +1,58 @@
+package board
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestEstimateReportByTag(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Estimate: "1d", Tags: []string{"api", "db"}},
+		{ID: 2, Status: "todo", Estimate: "2h", Tags: []string{"api"}},
+		{ID: 3, Status: "backlog", Estimate: "soon"},
+		{ID: 4, Status: "done", Estimate: "1w", Tags: []string{"api"}},
+	}
+
+	r := ComputeEstimateReport(cfg, tasks, "tag")
+	want := []EstimateGroup{
+		{Key: "(untagged)", Tasks: 1, Unestimated: 1},
+		{Key: "api", Tasks: 2, Hours: 10},
+		{Key: "db", Tasks: 1, Hours: 8},
+	}
+	if !slices.Equal(r.Groups, want) {
+		t.Errorf("groups = %+v, want %+v", r.Groups, want)
+	}
+	if r.Tasks != 3 || r.TotalHours != 10 {
+		t.Errorf("totals = %d tasks, %vh; want 3 tasks, 10h", r.Tasks, r.TotalHours)
+	}
+	if !slices.Equal(r.Unestimated, []int{3}) {
+		t.Errorf("unestimated = %v, want [3]", r.Unestimated)
+	}
+}
+
+func TestEstimateReportByEpic(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Estimates.HoursPerDay = 6
+	tasks := []*task.Task{
+		{ID: 1, Title: "Launch", Status: "todo"},
+		{ID: 2, Title: "API", Status: "todo", Parent: intPtr(1), Estimate: "1d"},
+		{ID: 3, Title: "Auth", Status: "todo", Parent: intPtr(2), Estimate: "2h"},
+		{ID: 4, Title: "Solo", Status: "todo", Estimate: "1h"},
+	}
+
+	r := ComputeEstimateReport(cfg, tasks, "epic")
+	want := []EstimateGroup{
+		{Key: "#1 Launch", Tasks: 3, Hours: 8, Unestimated: 1},
+		{Key: "(no epic)", Tasks: 1, Hours: 1},
+	}
+	if !slices.Equal(r.Groups, want) {
+		t.Errorf("groups = %+v, want %+v", r.Groups, want)
+	}
+	if r.HoursPerDay != 6 {
+		t.Errorf("HoursPerDay = %v, want 6", r.HoursPerDay)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/timing"
 )
 
 // FilterOptions defines which tasks to include.
@@ -15,6 +17,7 @@ type FilterOptions struct {
 	ExcludeStatuses []string // statuses to exclude from results
 	Priorities      []string
 	Assignee        string
+	Reviewer        string
 	Tag             string
 	Search          string        // case-insensitive substring match across title, body, and tags
 	Blocked         *bool         // nil=no filter, true=only blocked, false=only not-blocked
@@ -23,10 +26,15 @@ type FilterOptions struct {
 	ClaimedBy       string        // filter to specific claimant
 	ClaimTimeout    time.Duration // claim expiration for unclaimed filter
 	Class           string        // filter by class of service
+	DueBy           *date.Date    // only tasks due (or expected unblocked) on or before this date, overdue included
+	Workstream      string        // filter by workstream (tasks_dirs entry)
+	Goal            string        // filter by goal (goals.yml entry)
 }
 
 // Filter returns tasks matching all specified criteria (AND logic).
 func Filter(tasks []*task.Task, opts FilterOptions) []*task.Task {
+	defer timing.Track(timing.Filter)()
+	timing.Count(timing.Filter, len(tasks))
 	var result []*task.Task
 	for _, t := range tasks {
 		if matchesFilter(t, opts) {
@@ -53,6 +61,9 @@ func matchesCoreFilter(t *task.Task, opts FilterOptions) bool {
 	if opts.Assignee != "" && t.Assignee != opts.Assignee {
 		return false
 	}
+	if opts.Reviewer != "" && t.Reviewer != opts.Reviewer {
+		return false
+	}
 	if opts.Tag != "" && !containsStr(t.Tags, opts.Tag) {
 		return false
 	}
@@ -105,6 +116,15 @@ func matchesExtendedFilter(t *task.Task, opts FilterOptions) bool {
 	if opts.Class != "" && t.Class != opts.Class {
 		return false
 	}
+	if opts.DueBy != nil && !dueBy(t, *opts.DueBy) {
+		return false
+	}
+	if opts.Workstream != "" && t.Workstream != opts.Workstream {
+		return false
+	}
+	if opts.Goal != "" && t.Goal != opts.Goal {
+		return false
+	}
 	return true
 }
 
@@ -145,6 +165,19 @@ func FilterUnblockedWithLookup(candidates, lookupTasks []*task.Task, cfg *config
 	return result
 }
 
+// MarkDependencyBlocked sets BlockedByDependency on each task in tasks,
+// resolving dependency statuses against allTasks so that dependencies
+// filtered out of tasks (e.g. archived ones) are still found.
+func MarkDependencyBlocked(cfg *config.Config, tasks, allTasks []*task.Task) {
+	statusByID := make(map[int]string, len(allTasks))
+	for _, t := range allTasks {
+		statusByID[t.ID] = t.Status
+	}
+	for _, t := range tasks {
+		t.BlockedByDependency = !allDepsSatisfied(t.DependsOn, statusByID, cfg)
+	}
+}
+
 func allDepsSatisfied(deps []int, statusByID map[int]string, cfg *config.Config) bool {
 	for _, depID := range deps {
 		s, ok := statusByID[depID]
@@ -168,3 +201,12 @@ func containsStr(slice []string, item string) bool {
 	}
 	return false
 }
+
+// dueBy reports whether t is due on or before d, or is blocked and was
+// expected to be unblocked by then.
+func dueBy(t *task.Task, d date.Date) bool {
+	if t.Due != nil && !t.Due.After(d.Time) {
+		return true
+	}
+	return t.Blocked && t.BlockedUntil != nil && !t.BlockedUntil.After(d.Time)
+}
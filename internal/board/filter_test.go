@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -368,3 +370,47 @@ func TestFilterByClaimedBy(t *testing.T) {
 		t.Errorf("got task #%d, want #1", result[0].ID)
 	}
 }
+
+func TestMarkDependencyBlocked(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	all := []*task.Task{
+		{ID: 1, Status: "archived"},
+		{ID: 2, Status: "todo"},
+		{ID: 3, Status: "todo", DependsOn: []int{1}},
+		{ID: 4, Status: "todo", DependsOn: []int{1, 2}},
+		{ID: 5, Status: "todo", DependsOn: []int{99}},
+	}
+	visible := all[1:]
+
+	MarkDependencyBlocked(cfg, visible, all)
+	for _, tc := range []struct {
+		tsk  *task.Task
+		want bool
+	}{{all[1], false}, {all[2], false}, {all[3], true}, {all[4], false}} {
+		if tc.tsk.BlockedByDependency != tc.want {
+			t.Errorf("#%d BlockedByDependency = %v, want %v", tc.tsk.ID, tc.tsk.BlockedByDependency, tc.want)
+		}
+	}
+}
+
+func TestFilterDueByIncludesExpectedUnblock(t *testing.T) {
+	due := date.New(2026, 3, 5)
+	until := date.New(2026, 3, 8)
+	late := date.New(2026, 3, 20)
+	tasks := []*task.Task{
+		{ID: 1, Due: &due},
+		{ID: 2, Blocked: true, BlockedUntil: &until},
+		{ID: 3, Blocked: true, BlockedUntil: &late},
+		{ID: 4, BlockedUntil: &until}, // stale date on an unblocked task
+		{ID: 5},
+	}
+	by := date.New(2026, 3, 10)
+	got := Filter(tasks, FilterOptions{DueBy: &by})
+	if len(got) != 2 || got[0].ID != 1 || got[1].ID != 2 {
+		ids := make([]int, len(got))
+		for i, tk := range got {
+			ids[i] = tk.ID
+		}
+		t.Errorf("DueBy filter = %v, want [1 2]", ids)
+	}
+}
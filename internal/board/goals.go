@@ -0,0 +1,62 @@
+package board
+
+import (
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// GoalProgress summarizes how far along the tasks linked to a goal are.
+type GoalProgress struct {
+	Goal           string  `json:"goal"`
+	Title          string  `json:"title,omitempty"`
+	Total          int     `json:"total"`
+	Done           int     `json:"done"`
+	Blocked        int     `json:"blocked"`
+	RemainingHours float64 `json:"remaining_hours"`
+}
+
+// GoalRollup rolls up tasks by goal, one entry per goal in goals order,
+// followed by any goals tasks still name but goals no longer lists. Tasks
+// in a terminal status, archived ones included, count as done; the
+// estimates of the others make up the remaining work.
+func GoalRollup(cfg *config.Config, goals []config.Goal, tasks []*task.Task) []GoalProgress {
+	rollup := make([]GoalProgress, 0, len(goals))
+	index := make(map[string]int, len(goals))
+	for _, g := range goals {
+		index[g.Name] = len(rollup)
+		rollup = append(rollup, GoalProgress{Goal: g.Name, Title: g.Title})
+	}
+	for _, t := range tasks {
+		if t.Goal == "" {
+			continue
+		}
+		i, ok := index[t.Goal]
+		if !ok {
+			i = len(rollup)
+			index[t.Goal] = i
+			rollup = append(rollup, GoalProgress{Goal: t.Goal})
+		}
+		p := &rollup[i]
+		p.Total++
+		if cfg.IsTerminalStatus(t.Status) {
+			p.Done++
+			continue
+		}
+		if t.Blocked {
+			p.Blocked++
+		}
+		if h, ok := t.EstimateHoursWith(cfg.EstimateHoursPerDay()); ok {
+			p.RemainingHours += h
+		}
+	}
+	return rollup
+}
+
+// Percent is the share of the goal's tasks that are done, 0 when it has
+// none.
+func (p GoalProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total //nolint:mnd // percentage
+}
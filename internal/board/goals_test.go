@@ -0,0 +1,41 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestGoalRollup(t *testing.T) {
+	cfg := newPickTestConfig()
+	cfg.Statuses = append(cfg.Statuses, config.StatusConfig{Name: config.ArchivedStatus})
+	goals := []config.Goal{{Name: "Q1-latency", Title: "Cut p99 latency"}, {Name: "Q1-docs"}}
+	tasks := []*task.Task{
+		{ID: 1, Status: "done", Goal: "Q1-latency"},
+		{ID: 2, Status: config.ArchivedStatus, Goal: "Q1-latency"},
+		{ID: 3, Status: "in-progress", Goal: "Q1-latency", Estimate: "4h", Blocked: true},
+		{ID: 4, Status: "todo", Goal: "Q1-latency"},
+		{ID: 5, Status: "todo", Goal: "Q0-old"},
+		{ID: 6, Status: "todo"},
+	}
+
+	got := GoalRollup(cfg, goals, tasks)
+	if len(got) != 3 {
+		t.Fatalf("GoalRollup = %+v, want 3 goals", got)
+	}
+	latency := got[0]
+	if latency.Goal != "Q1-latency" || latency.Title != "Cut p99 latency" || latency.Total != 4 ||
+		latency.Done != 2 || latency.Blocked != 1 || latency.RemainingHours != 4 {
+		t.Errorf("Q1-latency = %+v", latency)
+	}
+	if latency.Percent() != 50 {
+		t.Errorf("Percent() = %d, want 50", latency.Percent())
+	}
+	if got[1].Goal != "Q1-docs" || got[1].Total != 0 || got[1].Percent() != 0 {
+		t.Errorf("Q1-docs = %+v, want listed with no tasks", got[1])
+	}
+	if got[2].Goal != "Q0-old" || got[2].Total != 1 {
+		t.Errorf("unlisted goal = %+v, want Q0-old with 1 task", got[2])
+	}
+}
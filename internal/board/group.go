@@ -1,18 +1,32 @@
 package board
 
 import (
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
 const (
 	fieldPriority = "priority"
 	fieldStatus   = "status"
+	fieldDue      = "due"
+	fieldAge      = "age"
 	classStandard = "standard"
 )
 
+// Due and age buckets, in display order.
+var (
+	dueBuckets = []string{"overdue", "today", "this-week", "later", "none"}
+	ageBuckets = []string{"0-1d", "2-5d", "6-10d", "11-20d", "21d+"}
+)
+
+// dueWeekWorkdays is how many working days ahead count as "this-week".
+const dueWeekWorkdays = 5
+
 // GroupedSummary holds tasks grouped by a field.
 type GroupedSummary struct {
 	Groups []GroupSummary `json:"groups"`
@@ -27,16 +41,12 @@ type GroupSummary struct {
 
 // GroupBy groups tasks by the specified field and returns summaries per group.
 func GroupBy(tasks []*task.Task, field string, cfg *config.Config) GroupedSummary {
-	groups := make(map[string][]*task.Task)
-
-	for _, t := range tasks {
-		keys := extractGroupKeys(t, field)
-		for _, key := range keys {
-			groups[key] = append(groups[key], t)
-		}
-	}
+	return GroupByAt(tasks, field, cfg, time.Now())
+}
 
-	sortedKeys := sortGroupKeys(groups, field, cfg)
+// GroupByAt is like GroupBy but computes due and age buckets relative to now.
+func GroupByAt(tasks []*task.Task, field string, cfg *config.Config, now time.Time) GroupedSummary {
+	sortedKeys, groups := GroupTasks(tasks, field, cfg, now)
 
 	result := GroupedSummary{
 		Groups: make([]GroupSummary, 0, len(sortedKeys)),
@@ -53,6 +63,80 @@ func GroupBy(tasks []*task.Task, field string, cfg *config.Config) GroupedSummar
 	return result
 }
 
+// GroupTasks splits tasks by field, returning the group keys in display
+// order and the tasks in each group. A task with several tags is in
+// several groups.
+func GroupTasks(tasks []*task.Task, field string, cfg *config.Config, now time.Time) ([]string, map[string][]*task.Task) {
+	groups := make(map[string][]*task.Task)
+	for _, t := range tasks {
+		for _, key := range groupKeys(t, field, cfg, now) {
+			groups[key] = append(groups[key], t)
+		}
+	}
+	return sortGroupKeys(groups, field, cfg), groups
+}
+
+// groupKeys returns the group keys of t, including the time-based due and
+// age buckets that need the board calendar and the current time.
+func groupKeys(t *task.Task, field string, cfg *config.Config, now time.Time) []string {
+	switch field {
+	case fieldDue:
+		return []string{dueBucket(t, cfg, now)}
+	case fieldAge:
+		return []string{ageBucket(t, cfg, now)}
+	default:
+		return extractGroupKeys(t, field)
+	}
+}
+
+// dueBucket places a due date relative to today: overdue, today, within
+// the next working week, later, or none.
+func dueBucket(t *task.Task, cfg *config.Config, now time.Time) string {
+	if t.Due == nil {
+		return "none"
+	}
+	today := date.New(now.Year(), now.Month(), now.Day())
+	switch {
+	case t.Due.Before(today.Time):
+		return "overdue"
+	case t.Due.Equal(today.Time):
+		return "today"
+	case !t.Due.After(AddWorkdays(cfg, today, dueWeekWorkdays).Time):
+		return "this-week"
+	default:
+		return "later"
+	}
+}
+
+// ageBucket groups a task by the working days elapsed since it was created.
+func ageBucket(t *task.Task, cfg *config.Config, now time.Time) string {
+	n := workingDaysSince(cfg, t.Created, now)
+	switch {
+	case n <= 1:
+		return ageBuckets[0]
+	case n <= 5: //nolint:mnd // one working week
+		return ageBuckets[1]
+	case n <= 10: //nolint:mnd // two working weeks
+		return ageBuckets[2]
+	case n <= 20: //nolint:mnd // four working weeks
+		return ageBuckets[3]
+	default:
+		return ageBuckets[4]
+	}
+}
+
+// workingDaysSince counts the working days after the calendar day of since
+// up to and including the day of now.
+func workingDaysSince(cfg *config.Config, since, now time.Time) int {
+	s := since.In(now.Location())
+	start := date.New(s.Year(), s.Month(), s.Day())
+	today := date.New(now.Year(), now.Month(), now.Day())
+	if !today.After(start.Time) {
+		return 0
+	}
+	return WorkingDays(cfg, date.Date{Time: start.AddDate(0, 0, 1)}, today)
+}
+
 func extractGroupKeys(t *task.Task, field string) []string {
 	switch field {
 	case "assignee":
@@ -99,6 +183,14 @@ func sortGroupKeys(groups map[string][]*task.Task, field string, cfg *config.Con
 		sort.SliceStable(keys, func(i, j int) bool {
 			return cfg.ClassIndex(keys[i]) < cfg.ClassIndex(keys[j])
 		})
+	case fieldDue, fieldAge:
+		order := dueBuckets
+		if field == fieldAge {
+			order = ageBuckets
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			return slices.Index(order, keys[i]) < slices.Index(order, keys[j])
+		})
 	default:
 		sort.Strings(keys)
 	}
@@ -122,7 +214,81 @@ func groupStatusSummary(tasks []*task.Task, cfg *config.Config) []StatusSummary
 	return statuses
 }
 
+// GroupMatrix holds task counts for every combination of two fields.
+// Counts in each row line up with Columns. Tasks with several tags count
+// once in every tag cell, so totals are task counts rather than cell sums.
+type GroupMatrix struct {
+	RowField     string      `json:"row_field"`
+	ColumnField  string      `json:"column_field"`
+	Columns      []string    `json:"columns"`
+	Rows         []MatrixRow `json:"rows"`
+	ColumnTotals []int       `json:"column_totals"`
+	Total        int         `json:"total"`
+}
+
+// MatrixRow is one row of a GroupMatrix.
+type MatrixRow struct {
+	Key    string `json:"key"`
+	Counts []int  `json:"counts"`
+	Total  int    `json:"total"`
+}
+
+// GroupByMatrix counts tasks by rowField and columnField, with due and age
+// buckets relative to now. Rows and columns are ordered as in GroupBy;
+// status columns include every configured status so the matrix lines up
+// with the board.
+func GroupByMatrix(tasks []*task.Task, rowField, columnField string, cfg *config.Config, now time.Time) GroupMatrix {
+	rowGroups := make(map[string][]*task.Task)
+	colGroups := make(map[string][]*task.Task)
+	for _, t := range tasks {
+		for _, key := range groupKeys(t, rowField, cfg, now) {
+			rowGroups[key] = append(rowGroups[key], t)
+		}
+		for _, key := range groupKeys(t, columnField, cfg, now) {
+			colGroups[key] = append(colGroups[key], t)
+		}
+	}
+	if columnField == fieldStatus {
+		for _, s := range cfg.StatusNames() {
+			if !cfg.IsArchivedStatus(s) {
+				if _, ok := colGroups[s]; !ok {
+					colGroups[s] = nil
+				}
+			}
+		}
+	}
+
+	rows := sortGroupKeys(rowGroups, rowField, cfg)
+	cols := sortGroupKeys(colGroups, columnField, cfg)
+	colIndex := make(map[string]int, len(cols))
+	for i, c := range cols {
+		colIndex[c] = i
+	}
+
+	m := GroupMatrix{
+		RowField:     rowField,
+		ColumnField:  columnField,
+		Columns:      cols,
+		Rows:         make([]MatrixRow, 0, len(rows)),
+		ColumnTotals: make([]int, len(cols)),
+		Total:        len(tasks),
+	}
+	for i, c := range cols {
+		m.ColumnTotals[i] = len(colGroups[c])
+	}
+	for _, r := range rows {
+		row := MatrixRow{Key: r, Counts: make([]int, len(cols)), Total: len(rowGroups[r])}
+		for _, t := range rowGroups[r] {
+			for _, key := range groupKeys(t, columnField, cfg, now) {
+				row.Counts[colIndex[key]]++
+			}
+		}
+		m.Rows = append(m.Rows, row)
+	}
+	return m
+}
+
 // ValidGroupByFields returns the list of valid --group-by field names.
 func ValidGroupByFields() []string {
-	return []string{"assignee", "tag", "class", "priority", "status"}
+	return []string{"assignee", "tag", "class", "priority", "status", fieldDue, fieldAge}
 }
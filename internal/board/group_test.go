@@ -1,9 +1,12 @@
 package board
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -156,3 +159,85 @@ func TestGroupByReturnsStatusSummaryPerGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestGroupByMatrix(t *testing.T) {
+	cfg := newGroupTestConfig()
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Assignee: "alice", Tags: []string{"api", "ui"}},
+		{ID: 2, Status: "done", Assignee: "alice"},
+		{ID: 3, Status: "todo", Tags: []string{"api"}},
+	}
+
+	m := GroupByMatrix(tasks, "assignee", "status", cfg, time.Now())
+	if len(m.Columns) != 4 || m.Columns[0] != "backlog" || m.Columns[3] != "done" {
+		t.Fatalf("Columns = %v, want all statuses in board order", m.Columns)
+	}
+	if len(m.Rows) != 2 || m.Rows[0].Key != "(unassigned)" || m.Rows[1].Key != "alice" {
+		t.Fatalf("Rows = %+v", m.Rows)
+	}
+	if got := m.Rows[1].Counts; got[1] != 1 || got[3] != 1 || m.Rows[1].Total != 2 {
+		t.Errorf("alice = %v total %d, want todo=1 done=1 total 2", got, m.Rows[1].Total)
+	}
+	if m.ColumnTotals[1] != 2 || m.Total != 3 {
+		t.Errorf("ColumnTotals = %v, Total = %d", m.ColumnTotals, m.Total)
+	}
+
+	// A task with two tags counts in both tag columns but once in totals.
+	m = GroupByMatrix(tasks, "assignee", "tag", cfg, time.Now())
+	if m.Rows[1].Key != "alice" || m.Rows[1].Total != 2 {
+		t.Fatalf("alice row = %+v", m.Rows[1])
+	}
+	if m.Columns[0] != "(untagged)" || m.Rows[1].Counts[0] != 1 || m.Rows[1].Counts[1] != 1 || m.Rows[1].Counts[2] != 1 {
+		t.Errorf("alice tag counts = %v over %v", m.Rows[1].Counts, m.Columns)
+	}
+}
+
+func TestGroupByDueBuckets(t *testing.T) {
+	cfg := newGroupTestConfig()
+	// Wednesday 2026-03-04; five working days ahead is Wednesday 2026-03-11.
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	due := func(d int) *date.Date { v := date.New(2026, 3, d); return &v }
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Due: due(3)},
+		{ID: 2, Status: "todo", Due: due(4)},
+		{ID: 3, Status: "todo", Due: due(11)},
+		{ID: 4, Status: "todo", Due: due(12)},
+		{ID: 5, Status: "todo"},
+		{ID: 6, Status: "todo", Due: due(6)},
+	}
+
+	result := GroupByAt(tasks, "due", cfg, now)
+	want := map[string]int{"overdue": 1, "today": 1, "this-week": 2, "later": 1, "none": 1}
+	if len(result.Groups) != len(want) {
+		t.Fatalf("Groups = %+v", result.Groups)
+	}
+	for i, key := range []string{"overdue", "today", "this-week", "later", "none"} {
+		g := result.Groups[i]
+		if g.Key != key || g.Total != want[key] {
+			t.Errorf("Groups[%d] = %s (%d), want %s (%d)", i, g.Key, g.Total, key, want[key])
+		}
+	}
+}
+
+func TestGroupByAgeBuckets(t *testing.T) {
+	cfg := newGroupTestConfig()
+	// Monday 2026-03-16.
+	now := time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)
+	created := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 12, 0, 0, 0, time.UTC) }
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Created: created(2026, 3, 13)}, // Friday: 1 working day
+		{ID: 2, Status: "todo", Created: created(2026, 3, 9)},  // 5 working days
+		{ID: 3, Status: "todo", Created: created(2026, 3, 6)},  // 6 working days
+		{ID: 4, Status: "todo", Created: created(2026, 2, 16)}, // 20 working days
+		{ID: 5, Status: "todo", Created: created(2026, 1, 2)},
+	}
+
+	result := GroupByAt(tasks, "age", cfg, now)
+	var keys []string
+	for _, g := range result.Groups {
+		keys = append(keys, g.Key)
+	}
+	if got := strings.Join(keys, " "); got != "0-1d 2-5d 6-10d 11-20d 21d+" {
+		t.Errorf("age buckets = %s", got)
+	}
+}
@@ -0,0 +1,154 @@
+package board
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Health levels, from best to worst.
+const (
+	HealthOK       = "ok"
+	HealthWarn     = "warn"
+	HealthCritical = "critical"
+)
+
+// Score penalties per indicator level.
+const (
+	healthWarnPenalty     = 10
+	healthCriticalPenalty = 25
+	healthMaxScore        = 100
+	healthPercentile      = 0.95
+)
+
+// HealthReport scores the board from its health indicators.
+type HealthReport struct {
+	Score      int               `json:"score"`
+	Status     string            `json:"status"`
+	Indicators []HealthIndicator `json:"indicators"`
+}
+
+// HealthIndicator is one measured aspect of board health.
+type HealthIndicator struct {
+	Name     string  `json:"name"`
+	Value    float64 `json:"value"`
+	Warn     float64 `json:"warn,omitempty"`
+	Critical float64 `json:"critical,omitempty"`
+	Status   string  `json:"status"`
+	Detail   string  `json:"detail"`
+}
+
+// Healthy reports whether no indicator reached its critical threshold.
+func (r HealthReport) Healthy() bool { return r.Status != HealthCritical }
+
+// ComputeHealth measures the board's open work (non-terminal tasks) against
+// the health thresholds in cfg. The score starts at 100 and loses 10 points
+// per warning and 25 per critical indicator.
+func ComputeHealth(cfg *config.Config, tasks []*task.Task, now time.Time) HealthReport {
+	var open []*task.Task
+	for _, t := range tasks {
+		if !cfg.IsTerminalStatus(t.Status) {
+			open = append(open, t)
+		}
+	}
+
+	values := map[string]func() (float64, string){
+		"wip_utilization": func() (float64, string) { return wipUtilization(cfg, open) },
+		"aging_p95_days":  func() (float64, string) { return agingP95Days(cfg, tasks, now) },
+		"blocked_ratio": func() (float64, string) {
+			return ratio(open, func(t *task.Task) bool { return t.Blocked })
+		},
+		"overdue_ratio": func() (float64, string) {
+			return ratio(open, func(t *task.Task) bool { return dueBucket(t, cfg, now) == "overdue" })
+		},
+		"stale_claims": func() (float64, string) { return staleClaims(cfg, open, now) },
+	}
+
+	report := HealthReport{Score: healthMaxScore, Status: HealthOK}
+	for _, name := range config.HealthIndicators {
+		value, detail := values[name]()
+		th := cfg.HealthThresholdFor(name)
+		ind := HealthIndicator{
+			Name: name, Value: value, Warn: th.Warn, Critical: th.Critical,
+			Status: HealthOK, Detail: detail,
+		}
+		switch {
+		case th.Critical > 0 && value >= th.Critical:
+			ind.Status = HealthCritical
+			report.Score -= healthCriticalPenalty
+			report.Status = HealthCritical
+		case th.Warn > 0 && value >= th.Warn:
+			ind.Status = HealthWarn
+			report.Score -= healthWarnPenalty
+			if report.Status == HealthOK {
+				report.Status = HealthWarn
+			}
+		}
+		report.Indicators = append(report.Indicators, ind)
+	}
+	report.Score = max(report.Score, 0)
+	return report
+}
+
+// wipUtilization returns the highest count/limit ratio over WIP-limited
+// statuses.
+func wipUtilization(cfg *config.Config, open []*task.Task) (float64, string) {
+	counts := CountByStatus(open)
+	best, detail := 0.0, "no WIP limits"
+	for _, s := range cfg.StatusNames() {
+		limit := cfg.WIPLimit(s)
+		if limit == 0 {
+			continue
+		}
+		if u := float64(counts[s]) / float64(limit); u >= best {
+			best, detail = u, fmt.Sprintf("%s %d/%d", s, counts[s], limit)
+		}
+	}
+	return best, detail
+}
+
+// agingP95Days returns the 95th percentile age, in days, of started work.
+func agingP95Days(cfg *config.Config, tasks []*task.Task, now time.Time) (float64, string) {
+	items := ComputeMetrics(cfg, tasks, now).AgingItems
+	if len(items) == 0 {
+		return 0, "no work in progress"
+	}
+	ages := make([]float64, len(items))
+	for i, it := range items {
+		ages[i] = it.AgeHours / hoursPerDay
+	}
+	sort.Float64s(ages)
+	idx := int(math.Ceil(healthPercentile*float64(len(ages)))) - 1
+	p95 := math.Round(ages[idx]*10) / 10 //nolint:mnd // one decimal place
+	return p95, fmt.Sprintf("%d started task(s)", len(ages))
+}
+
+func ratio(open []*task.Task, match func(*task.Task) bool) (float64, string) {
+	n := 0
+	for _, t := range open {
+		if match(t) {
+			n++
+		}
+	}
+	if len(open) == 0 {
+		return 0, "no open tasks"
+	}
+	r := math.Round(float64(n)/float64(len(open))*100) / 100 //nolint:mnd // two decimal places
+	return r, fmt.Sprintf("%d of %d open task(s)", n, len(open))
+}
+
+// staleClaims counts open tasks whose claim has outlived claim_timeout.
+func staleClaims(cfg *config.Config, open []*task.Task, now time.Time) (float64, string) {
+	timeout := cfg.ClaimTimeoutDuration()
+	n := 0
+	for _, t := range open {
+		if t.ClaimedBy != "" && t.ClaimedAt != nil && timeout > 0 && now.Sub(*t.ClaimedAt) > timeout {
+			n++
+		}
+	}
+	return float64(n), fmt.Sprintf("claims older than %s", timeout)
+}
@@ -0,0 +1,72 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func healthIndicator(t *testing.T, r HealthReport, name string) HealthIndicator {
+	t.Helper()
+	for _, ind := range r.Indicators {
+		if ind.Name == name {
+			return ind
+		}
+	}
+	t.Fatalf("indicator %q missing", name)
+	return HealthIndicator{}
+}
+
+func TestComputeHealthHealthyBoard(t *testing.T) {
+	cfg := newPickTestConfig()
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	tasks := []*task.Task{{ID: 1, Status: "todo"}, {ID: 2, Status: "done"}}
+
+	r := ComputeHealth(cfg, tasks, now)
+	if r.Score != 100 || r.Status != HealthOK || !r.Healthy() {
+		t.Errorf("report = %+v, want score 100 ok", r)
+	}
+	if len(r.Indicators) != len(config.HealthIndicators) {
+		t.Errorf("got %d indicators, want %d", len(r.Indicators), len(config.HealthIndicators))
+	}
+}
+
+func TestComputeHealthThresholds(t *testing.T) {
+	cfg := newPickTestConfig()
+	cfg.ClaimTimeout = "1h"
+	cfg.WIPLimits = map[string]int{"in-progress": 2}
+	cfg.Health.Thresholds = map[string]config.HealthThreshold{"blocked_ratio": {Warn: 0.5}}
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	started := now.AddDate(0, 0, -20)
+	claimed := now.Add(-3 * time.Hour)
+	past := date.New(2026, 3, 1)
+	tasks := []*task.Task{
+		{ID: 1, Status: "in-progress", Started: &started},
+		{ID: 2, Status: "in-progress", Blocked: true, ClaimedBy: "a", ClaimedAt: &claimed},
+		{ID: 3, Status: "in-progress", Due: &past},
+		{ID: 4, Status: "todo"},
+	}
+
+	r := ComputeHealth(cfg, tasks, now)
+	if ind := healthIndicator(t, r, "wip_utilization"); ind.Value != 1.5 || ind.Status != HealthCritical {
+		t.Errorf("wip = %+v, want 1.5 critical", ind)
+	}
+	if ind := healthIndicator(t, r, "aging_p95_days"); ind.Value != 20 || ind.Status != HealthCritical {
+		t.Errorf("aging = %+v, want 20 critical", ind)
+	}
+	if ind := healthIndicator(t, r, "blocked_ratio"); ind.Value != 0.25 || ind.Status != HealthOK {
+		t.Errorf("blocked = %+v, want 0.25 ok under configured warn 0.5", ind)
+	}
+	if ind := healthIndicator(t, r, "overdue_ratio"); ind.Value != 0.25 || ind.Status != HealthCritical {
+		t.Errorf("overdue = %+v, want 0.25 critical", ind)
+	}
+	if ind := healthIndicator(t, r, "stale_claims"); ind.Value != 1 || ind.Status != HealthWarn {
+		t.Errorf("stale claims = %+v, want 1 warn", ind)
+	}
+	if r.Healthy() || r.Score != 100-3*25-10 {
+		t.Errorf("report score = %d status %s", r.Score, r.Status)
+	}
+}
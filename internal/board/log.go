@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
 	"strings"
 	"time"
@@ -22,18 +23,37 @@ type LogEntry struct {
 	Action    string    `json:"action"`
 	TaskID    int       `json:"task_id"`
 	Detail    string    `json:"detail"`
+	// Actor is who made the change: the --claim name, KANBAN_AGENT, or the
+	// OS user. Entries written before actors were recorded have none.
+	Actor string `json:"actor,omitempty"`
+}
+
+// StatusChange returns the statuses a "move" or "auto-move" entry moved
+// its task between. Automatic moves append the reason in parentheses.
+func (e LogEntry) StatusChange() (from, to string, ok bool) {
+	if e.Action != "move" && e.Action != actionAutoMove {
+		return "", "", false
+	}
+	from, to, ok = strings.Cut(e.Detail, " -> ")
+	if e.Action == actionAutoMove {
+		to, _, _ = strings.Cut(to, " (")
+	}
+	return from, to, ok
 }
 
 // LogFilterOptions controls how log entries are filtered.
 type LogFilterOptions struct {
 	Since  time.Time
+	Until  time.Time // entries before this time; zero means no bound
 	Limit  int
 	Action string
 	TaskID int
+	Actor  string
 }
 
 // AppendLog appends a log entry to the activity log file.
-// If the log exceeds maxLogEntries, the oldest entries are truncated.
+// Timestamps are stored in UTC. If the log exceeds maxLogEntries, the oldest
+// entries are truncated.
 func AppendLog(kanbanDir string, entry LogEntry) error {
 	path := filepath.Join(kanbanDir, logFileName)
 
@@ -43,6 +63,7 @@ func AppendLog(kanbanDir string, entry LogEntry) error {
 	}
 	defer f.Close()
 
+	entry.Timestamp = entry.Timestamp.UTC()
 	data, err := json.Marshal(entry)
 	if err != nil {
 		return fmt.Errorf("marshaling log entry: %w", err)
@@ -137,27 +158,98 @@ func ReadLog(kanbanDir string, opts LogFilterOptions) ([]LogEntry, error) {
 	return entries, nil
 }
 
-// LogMutation appends an activity log entry. Errors are silently discarded
-// because logging should never fail a command.
+// logActor overrides the actor recorded by LogMutation; see SetLogActor.
+var logActor string
+
+// SetLogActor sets the actor recorded with subsequent log entries, such as
+// the --claim name of the running command. An empty name restores the
+// default from DefaultActor.
+func SetLogActor(name string) {
+	logActor = name
+}
+
+// DefaultActor names who is acting when no claim is given: KANBAN_AGENT if
+// set, otherwise the OS user.
+func DefaultActor() string {
+	if v := os.Getenv("KANBAN_AGENT"); v != "" {
+		return v
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// CurrentActor names who is acting: the actor set by SetLogActor, or else
+// DefaultActor.
+func CurrentActor() string {
+	if logActor != "" {
+		return logActor
+	}
+	return DefaultActor()
+}
+
+// LogMutation appends an activity log entry attributed to the current
+// actor, mirrors it to the configured sinks, and commits it to git when
+// auto-commit is on. Errors are silently discarded because logging should
+// never fail a command.
 func LogMutation(kanbanDir, action string, taskID int, detail string) {
 	entry := LogEntry{
 		Timestamp: time.Now(),
 		Action:    action,
 		TaskID:    taskID,
 		Detail:    detail,
+		Actor:     CurrentActor(),
 	}
 	_ = AppendLog(kanbanDir, entry)
+	if held, ok := heldEntries[kanbanDir]; ok {
+		heldEntries[kanbanDir] = append(held, entry)
+		return
+	}
+	mirrorLog(kanbanDir, entry)
+	_ = commitMutation(kanbanDir, entry)
+}
+
+// heldEntries collects the entries logged while a board's mutations are
+// held, keyed by kanban directory; see HoldMutations.
+var heldEntries = map[string][]LogEntry{}
+
+// HoldMutations makes LogMutation keep the board's entries from its log
+// sinks and git until the returned release is called, so a transaction
+// that may be rolled back publishes nothing early. The activity log is
+// still appended; the transaction's rollback covers it. release(true)
+// mirrors the held entries and commits the board once; release(false)
+// drops them.
+func HoldMutations(kanbanDir string) (release func(publish bool)) {
+	heldEntries[kanbanDir] = []LogEntry{}
+	return func(publish bool) {
+		entries := heldEntries[kanbanDir]
+		delete(heldEntries, kanbanDir)
+		if !publish || len(entries) == 0 {
+			return
+		}
+		for _, s := range logSinks[kanbanDir] {
+			_ = deliverWithPending(kanbanDir, s, entries)
+		}
+		_ = commitMutations(kanbanDir, entries)
+	}
 }
 
 func matchesLogFilter(entry LogEntry, opts LogFilterOptions) bool {
 	if !opts.Since.IsZero() && entry.Timestamp.Before(opts.Since) {
 		return false
 	}
+	if !opts.Until.IsZero() && !entry.Timestamp.Before(opts.Until) {
+		return false
+	}
 	if opts.Action != "" && entry.Action != opts.Action {
 		return false
 	}
 	if opts.TaskID > 0 && entry.TaskID != opts.TaskID {
 		return false
 	}
+	if opts.Actor != "" && entry.Actor != opts.Actor {
+		return false
+	}
 	return true
 }
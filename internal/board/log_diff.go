@@ -0,0 +1,108 @@
+package board
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// LogSummary aggregates the activity log over a period into what happened,
+// rather than listing every entry.
+type LogSummary struct {
+	From      time.Time        `json:"from"`
+	To        time.Time        `json:"to"`
+	Entries   int              `json:"entries"`
+	Moves     int              `json:"moves"`
+	Edits     int              `json:"edits"`
+	Created   []LogSummaryTask `json:"created"`
+	Completed []LogSummaryTask `json:"completed"`
+	Deleted   []LogSummaryTask `json:"deleted"`
+	Blocked   []LogSummaryTask `json:"blocked"`
+}
+
+// LogSummaryTask is a task mentioned in a LogSummary. For blocked tasks,
+// Detail is the block reason and StillBlocked tells whether the task is
+// blocked now.
+type LogSummaryTask struct {
+	ID           int    `json:"id"`
+	Title        string `json:"title"`
+	Detail       string `json:"detail,omitempty"`
+	StillBlocked bool   `json:"still_blocked,omitempty"`
+}
+
+// SummarizeLog aggregates entries logged in [from, to). Titles come from the
+// current tasks, falling back to the title recorded when a task was created
+// or deleted. A task completed more than once counts once.
+func SummarizeLog(cfg *config.Config, entries []LogEntry, tasks []*task.Task, from, to time.Time) LogSummary {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	titles := make(map[int]string)
+	for _, e := range entries {
+		if e.Action == "create" || e.Action == "delete" {
+			titles[e.TaskID] = e.Detail
+		}
+	}
+	title := func(id int) string {
+		if t, ok := byID[id]; ok {
+			return t.Title
+		}
+		return titles[id]
+	}
+
+	s := LogSummary{
+		From: from, To: to,
+		Created: []LogSummaryTask{}, Completed: []LogSummaryTask{},
+		Deleted: []LogSummaryTask{}, Blocked: []LogSummaryTask{},
+	}
+	completed := make(map[int]bool)
+	blocked := make(map[int]int) // task ID -> index in s.Blocked
+	for _, e := range entries {
+		if e.Timestamp.Before(from) || !e.Timestamp.Before(to) {
+			continue
+		}
+		s.Entries++
+		switch e.Action {
+		case "create":
+			s.Created = append(s.Created, LogSummaryTask{ID: e.TaskID, Title: title(e.TaskID)})
+		case "delete":
+			s.Deleted = append(s.Deleted, LogSummaryTask{ID: e.TaskID, Title: title(e.TaskID)})
+		case "edit":
+			s.Edits++
+		case "move":
+			s.Moves++
+			_, newStatus, ok := strings.Cut(e.Detail, " -> ")
+			if ok && cfg.IsTerminalStatus(newStatus) && !cfg.IsArchivedStatus(newStatus) && !completed[e.TaskID] {
+				completed[e.TaskID] = true
+				s.Completed = append(s.Completed, LogSummaryTask{ID: e.TaskID, Title: title(e.TaskID)})
+			}
+		case "block":
+			t := LogSummaryTask{ID: e.TaskID, Title: title(e.TaskID), Detail: e.Detail}
+			if cur, ok := byID[e.TaskID]; ok {
+				t.StillBlocked = cur.Blocked
+			}
+			s.addBlocked(blocked, t)
+		}
+	}
+
+	// Tasks still blocked are the notable ones; list them first.
+	sort.SliceStable(s.Blocked, func(i, j int) bool {
+		return s.Blocked[i].StillBlocked && !s.Blocked[j].StillBlocked
+	})
+	return s
+}
+
+// addBlocked records a blocked task once, keeping the latest reason when it
+// was blocked more than once. seen maps task IDs to their index in Blocked.
+func (s *LogSummary) addBlocked(seen map[int]int, t LogSummaryTask) {
+	if i, ok := seen[t.ID]; ok {
+		s.Blocked[i] = t
+		return
+	}
+	seen[t.ID] = len(s.Blocked)
+	s.Blocked = append(s.Blocked, t)
+}
@@ -0,0 +1,240 @@
+package board
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+)
+
+const (
+	// maxPendingSinkEntries caps each sink's queue of undelivered entries.
+	// Beyond it the oldest are dropped; log replay can backfill them.
+	maxPendingSinkEntries = 1000
+	// replayBatchSize is how many entries ReplayLog sends per delivery.
+	replayBatchSize = 500
+	// maxSinkErrorBody is how much of a failed HTTP response is reported.
+	maxSinkErrorBody = 512
+)
+
+// logSinks are the sinks LogMutation mirrors entries to, keyed by kanban
+// directory; see SetLogSinks.
+var logSinks = map[string][]config.LogSink{}
+
+// SetLogSinks sets the sinks that subsequent log entries of the board in
+// kanbanDir are mirrored to, normally its log.sinks. Boards are kept apart
+// so a process showing several boards mirrors each to its own sinks.
+func SetLogSinks(kanbanDir string, sinks []config.LogSink) {
+	logSinks[kanbanDir] = sinks
+}
+
+// mirrorLog delivers entry to every configured sink. A sink that fails or
+// times out gets the entry queued with its other undelivered entries, which
+// are retried, in order, ahead of its next entry. Failures never reach the
+// caller, so a slow or unreachable sink cannot fail a command.
+func mirrorLog(kanbanDir string, entry LogEntry) {
+	for _, s := range logSinks[kanbanDir] {
+		_ = deliverWithPending(kanbanDir, s, []LogEntry{entry})
+	}
+}
+
+// deliverWithPending sends the sink's pending entries followed by entries.
+// On failure they all become the sink's pending queue.
+func deliverWithPending(kanbanDir string, s config.LogSink, entries []LogEntry) error {
+	unlock, err := filelock.Lock(sinkFile(kanbanDir, s.Name, ".lock"))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = unlock() }()
+
+	pending, _ := PendingSinkEntries(kanbanDir, s.Name)
+	all := append(pending, entries...)
+	if err := SendToSink(kanbanDir, s, all); err != nil {
+		_ = writePending(kanbanDir, s.Name, all)
+		return err
+	}
+	if len(pending) > 0 {
+		return writePending(kanbanDir, s.Name, nil)
+	}
+	return nil
+}
+
+// ReplayLog backfills a sink with entries from the activity log, sending
+// them in batches. Entries still queued for the sink from before the first
+// replayed entry are sent first; later ones are covered by the replay, so
+// the queue is cleared. It returns the number of entries sent.
+func ReplayLog(kanbanDir string, s config.LogSink, entries []LogEntry) (int, error) {
+	unlock, err := filelock.Lock(sinkFile(kanbanDir, s.Name, ".lock"))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = unlock() }()
+
+	pending, err := PendingSinkEntries(kanbanDir, s.Name)
+	if err != nil {
+		return 0, err
+	}
+	var all []LogEntry
+	for _, e := range pending {
+		if len(entries) == 0 || e.Timestamp.Before(entries[0].Timestamp) {
+			all = append(all, e)
+		}
+	}
+	all = append(all, entries...)
+
+	sent := 0
+	for sent < len(all) {
+		end := min(sent+replayBatchSize, len(all))
+		if err := SendToSink(kanbanDir, s, all[sent:end]); err != nil {
+			return sent, err
+		}
+		sent = end
+	}
+	return sent, writePending(kanbanDir, s.Name, nil)
+}
+
+// SendToSink delivers entries to s as JSON lines, giving up after the
+// sink's timeout.
+func SendToSink(kanbanDir string, s config.LogSink, entries []LogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	var lines [][]byte
+	for _, e := range entries {
+		e.Timestamp = e.Timestamp.UTC()
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshaling log entry: %w", err)
+		}
+		lines = append(lines, data)
+	}
+
+	switch s.Type {
+	case config.LogSinkFile:
+		return sendToFile(sinkPath(kanbanDir, s.Path), lines)
+	case config.LogSinkSyslog:
+		return sendToSyslog(s, lines)
+	case config.LogSinkHTTP:
+		return sendToHTTP(s, lines)
+	default:
+		return fmt.Errorf("unknown log sink type %q", s.Type)
+	}
+}
+
+func sendToFile(path string, lines [][]byte) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, logFileMode) //nolint:gosec // sink path from board config
+	if err != nil {
+		return fmt.Errorf("opening sink file: %w", err)
+	}
+	if _, err := f.Write(append(bytes.Join(lines, []byte("\n")), '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("writing sink file: %w", err)
+	}
+	return f.Close()
+}
+
+func sendToHTTP(s config.LogSink, lines [][]byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutDuration())
+	defer cancel()
+
+	body := append(bytes.Join(lines, []byte("\n")), '\n')
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building sink request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting to sink: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxSinkErrorBody))
+		return fmt.Errorf("sink responded %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// PendingSinkEntries returns the entries queued for a sink after failed
+// deliveries, oldest first.
+func PendingSinkEntries(kanbanDir, name string) ([]LogEntry, error) {
+	f, err := os.Open(sinkFile(kanbanDir, name, ".pending.jsonl"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening sink queue: %w", err)
+	}
+	defer f.Close()
+
+	var entries []LogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e LogEntry
+		if json.Unmarshal(scanner.Bytes(), &e) == nil {
+			entries = append(entries, e)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// writePending replaces a sink's queue with the newest entries, up to
+// maxPendingSinkEntries. An empty queue removes the file.
+func writePending(kanbanDir, name string, entries []LogEntry) error {
+	path := sinkFile(kanbanDir, name, ".pending.jsonl")
+	if len(entries) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	if len(entries) > maxPendingSinkEntries {
+		entries = entries[len(entries)-maxPendingSinkEntries:]
+	}
+	var buf bytes.Buffer
+	for _, e := range entries {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return os.WriteFile(path, buf.Bytes(), logFileMode)
+}
+
+// sinkFile is the path of a sink's bookkeeping file in the kanban directory.
+func sinkFile(kanbanDir, name, suffix string) string {
+	return filepath.Join(kanbanDir, ".sink-"+name+suffix)
+}
+
+// sinkPath resolves a file sink path against the kanban directory.
+func sinkPath(kanbanDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(kanbanDir, path)
+}
+
+// withTimeout runs fn, giving up on it after d for clients that take no
+// deadline. fn keeps running in the background when abandoned.
+func withTimeout(d time.Duration, fn func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(d):
+		return fmt.Errorf("timed out after %s", d)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build !windows
+
+package board
+
+import (
+	"fmt"
+	"log/syslog"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// syslogTag identifies kanban-md messages in syslog.
+const syslogTag = "kanban-md"
+
+func sendToSyslog(s config.LogSink, lines [][]byte) error {
+	return withTimeout(s.TimeoutDuration(), func() error {
+		network, addr, _ := strings.Cut(s.Address, "://")
+		w, err := syslog.Dial(network, addr, syslog.LOG_INFO|syslog.LOG_USER, syslogTag)
+		if err != nil {
+			return fmt.Errorf("connecting to syslog: %w", err)
+		}
+		defer w.Close()
+		for _, line := range lines {
+			if err := w.Info(string(line)); err != nil {
+				return fmt.Errorf("writing to syslog: %w", err)
+			}
+		}
+		return nil
+	})
+}
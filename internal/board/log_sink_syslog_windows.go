@@ -0,0 +1,13 @@
+//go:build windows
+
+package board
+
+import (
+	"errors"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func sendToSyslog(config.LogSink, [][]byte) error {
+	return errors.New("syslog log sinks are not supported on Windows")
+}
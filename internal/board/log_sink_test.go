@@ -0,0 +1,196 @@
+package board
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// readSinkFile returns the task IDs of the entries in a file sink.
+func readSinkFile(t *testing.T, path string) []int {
+	t.Helper()
+	f, err := os.Open(path) //nolint:gosec // test path
+	if err != nil {
+		t.Fatalf("opening sink file: %v", err)
+	}
+	defer f.Close()
+	var ids []int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e LogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("sink line %q: %v", scanner.Text(), err)
+		}
+		ids = append(ids, e.TaskID)
+	}
+	return ids
+}
+
+func TestLogMutationMirrorsToFileSink(t *testing.T) {
+	dir := t.TempDir()
+	SetLogSinks(dir, []config.LogSink{{Name: "audit", Type: config.LogSinkFile, Path: "audit.jsonl"}})
+	t.Cleanup(func() { SetLogSinks(dir, nil) })
+
+	LogMutation(dir, "create", 1, "first")
+	LogMutation(dir, "create", 2, "second")
+
+	ids := readSinkFile(t, filepath.Join(dir, "audit.jsonl"))
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Errorf("sink task IDs = %v, want [1 2]", ids)
+	}
+}
+
+func TestHoldMutationsDefersSinks(t *testing.T) {
+	dir := t.TempDir()
+	SetLogSinks(dir, []config.LogSink{{Name: "audit", Type: config.LogSinkFile, Path: "audit.jsonl"}})
+	t.Cleanup(func() { SetLogSinks(dir, nil) })
+	sinkPath := filepath.Join(dir, "audit.jsonl")
+
+	release := HoldMutations(dir)
+	LogMutation(dir, "create", 1, "rolled back")
+	release(false)
+	if _, err := os.Stat(sinkPath); !os.IsNotExist(err) {
+		t.Errorf("sink written for a dropped transaction: %v", err)
+	}
+
+	release = HoldMutations(dir)
+	LogMutation(dir, "create", 2, "kept")
+	LogMutation(dir, "create", 3, "kept")
+	if _, err := os.Stat(sinkPath); !os.IsNotExist(err) {
+		t.Errorf("sink written while mutations are held: %v", err)
+	}
+	release(true)
+	if ids := readSinkFile(t, sinkPath); len(ids) != 2 || ids[0] != 2 || ids[1] != 3 {
+		t.Errorf("sink task IDs = %v, want [2 3]", ids)
+	}
+
+	LogMutation(dir, "create", 4, "after")
+	if ids := readSinkFile(t, sinkPath); len(ids) != 3 {
+		t.Errorf("sink task IDs = %v, want mirroring to resume after release", ids)
+	}
+}
+
+// sinkServer records the task IDs POSTed to it and fails while down is set.
+type sinkServer struct {
+	mu   sync.Mutex
+	down bool
+	ids  []int
+}
+
+func (s *sinkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.down {
+		http.Error(w, "maintenance", http.StatusServiceUnavailable)
+		return
+	}
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var e LogEntry
+		if json.Unmarshal(scanner.Bytes(), &e) == nil {
+			s.ids = append(s.ids, e.TaskID)
+		}
+	}
+}
+
+func TestLogMutationQueuesWhileSinkIsDown(t *testing.T) {
+	dir := t.TempDir()
+	srv := &sinkServer{down: true}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	SetLogSinks(dir, []config.LogSink{{Name: "siem", Type: config.LogSinkHTTP, URL: ts.URL}})
+	t.Cleanup(func() { SetLogSinks(dir, nil) })
+
+	LogMutation(dir, "create", 1, "a")
+	LogMutation(dir, "create", 2, "b")
+
+	pending, err := PendingSinkEntries(dir, "siem")
+	if err != nil {
+		t.Fatalf("PendingSinkEntries: %v", err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("pending = %d entries, want 2", len(pending))
+	}
+	// The activity log itself is unaffected.
+	if entries, _ := ReadLog(dir, LogFilterOptions{}); len(entries) != 2 {
+		t.Errorf("activity log has %d entries, want 2", len(entries))
+	}
+
+	srv.mu.Lock()
+	srv.down = false
+	srv.mu.Unlock()
+	LogMutation(dir, "create", 3, "c")
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.ids) != 3 || srv.ids[0] != 1 || srv.ids[2] != 3 {
+		t.Errorf("delivered task IDs = %v, want [1 2 3]", srv.ids)
+	}
+	if pending, _ := PendingSinkEntries(dir, "siem"); len(pending) != 0 {
+		t.Errorf("pending = %d entries after recovery, want 0", len(pending))
+	}
+}
+
+func TestWritePendingDropsOldest(t *testing.T) {
+	dir := t.TempDir()
+	entries := make([]LogEntry, maxPendingSinkEntries+5)
+	for i := range entries {
+		entries[i] = LogEntry{Timestamp: time.Now(), Action: "edit", TaskID: i + 1}
+	}
+	if err := writePending(dir, "siem", entries); err != nil {
+		t.Fatalf("writePending: %v", err)
+	}
+
+	pending, err := PendingSinkEntries(dir, "siem")
+	if err != nil {
+		t.Fatalf("PendingSinkEntries: %v", err)
+	}
+	if len(pending) != maxPendingSinkEntries {
+		t.Fatalf("pending = %d entries, want %d", len(pending), maxPendingSinkEntries)
+	}
+	if pending[0].TaskID != 6 {
+		t.Errorf("oldest pending task = %d, want 6", pending[0].TaskID)
+	}
+}
+
+func TestReplayLog(t *testing.T) {
+	dir := t.TempDir()
+	sink := config.LogSink{Name: "audit", Type: config.LogSinkFile, Path: "audit.jsonl"}
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Entry 1 was queued before the replayed range; entry 3 is in it.
+	queued := []LogEntry{
+		{Timestamp: base, Action: "create", TaskID: 1},
+		{Timestamp: base.Add(2 * time.Hour), Action: "create", TaskID: 3},
+	}
+	if err := writePending(dir, sink.Name, queued); err != nil {
+		t.Fatalf("writePending: %v", err)
+	}
+	entries := []LogEntry{
+		{Timestamp: base.Add(time.Hour), Action: "create", TaskID: 2},
+		{Timestamp: base.Add(2 * time.Hour), Action: "create", TaskID: 3},
+	}
+
+	n, err := ReplayLog(dir, sink, entries)
+	if err != nil {
+		t.Fatalf("ReplayLog: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("replayed %d entries, want 3", n)
+	}
+	ids := readSinkFile(t, filepath.Join(dir, "audit.jsonl"))
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("sink task IDs = %v, want [1 2 3]", ids)
+	}
+	if pending, _ := PendingSinkEntries(dir, sink.Name); len(pending) != 0 {
+		t.Errorf("pending = %d entries after replay, want 0", len(pending))
+	}
+}
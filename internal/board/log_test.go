@@ -7,6 +7,9 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
 func TestAppendLogCreatesFile(t *testing.T) {
@@ -155,6 +158,46 @@ func TestReadLogFilterTaskID(t *testing.T) {
 	}
 }
 
+func TestReadLogFilterActor(t *testing.T) {
+	dir := t.TempDir()
+
+	mustAppend(t, dir, LogEntry{Timestamp: time.Now(), Action: "create", TaskID: 1, Actor: "alice"})
+	mustAppend(t, dir, LogEntry{Timestamp: time.Now(), Action: "create", TaskID: 2, Actor: "bob"})
+	mustAppend(t, dir, LogEntry{Timestamp: time.Now(), Action: "create", TaskID: 3})
+
+	entries, err := ReadLog(dir, LogFilterOptions{Actor: "bob"})
+	if err != nil {
+		t.Fatalf("ReadLog: %v", err)
+	}
+	if len(entries) != 1 || entries[0].TaskID != 2 {
+		t.Fatalf("entries = %+v, want only task 2", entries)
+	}
+}
+
+func TestLogMutationActor(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("KANBAN_AGENT", "agent-alpha")
+	t.Cleanup(func() { SetLogActor("") })
+
+	LogMutation(dir, "create", 1, "from env")
+	SetLogActor("agent-beta")
+	LogMutation(dir, "move", 1, "from claim")
+
+	entries, err := ReadLog(dir, LogFilterOptions{})
+	if err != nil {
+		t.Fatalf("ReadLog: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Actor != "agent-alpha" {
+		t.Errorf("entries[0].Actor = %q, want agent-alpha", entries[0].Actor)
+	}
+	if entries[1].Actor != "agent-beta" {
+		t.Errorf("entries[1].Actor = %q, want agent-beta", entries[1].Actor)
+	}
+}
+
 func TestReadLogLimit(t *testing.T) {
 	dir := t.TempDir()
 
@@ -262,3 +305,62 @@ func mustAppend(t *testing.T, dir string, entry LogEntry) {
 		t.Fatalf("AppendLog: %v", err)
 	}
 }
+
+func TestSummarizeLog(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 12, 0, 0, 0, time.UTC) }
+	entries := []LogEntry{
+		{Timestamp: day(1), Action: "create", TaskID: 1, Detail: "Before the period"},
+		{Timestamp: day(5), Action: "create", TaskID: 2, Detail: "Old title"},
+		{Timestamp: day(6), Action: "move", TaskID: 1, Detail: "in-progress -> done"},
+		{Timestamp: day(7), Action: "move", TaskID: 1, Detail: "done -> archived"},
+		{Timestamp: day(8), Action: "block", TaskID: 2, Detail: "first"},
+		{Timestamp: day(9), Action: "block", TaskID: 2, Detail: "vendor"},
+		{Timestamp: day(9), Action: "block", TaskID: 3, Detail: "resolved"},
+		{Timestamp: day(10), Action: "edit", TaskID: 2},
+		{Timestamp: day(11), Action: "delete", TaskID: 4, Detail: "Dropped"},
+		{Timestamp: day(20), Action: "create", TaskID: 5, Detail: "After the period"},
+	}
+	tasks := []*task.Task{
+		{ID: 1, Title: "Before the period"},
+		{ID: 2, Title: "Renamed", Blocked: true},
+		{ID: 3, Title: "Unblocked now"},
+	}
+
+	s := SummarizeLog(cfg, entries, tasks, day(2), day(15))
+
+	if s.Entries != 8 || s.Moves != 2 || s.Edits != 1 {
+		t.Errorf("entries, moves, edits = %d, %d, %d; want 8, 2, 1", s.Entries, s.Moves, s.Edits)
+	}
+	if len(s.Created) != 1 || s.Created[0].Title != "Renamed" {
+		t.Errorf("created = %+v, want task 2 with its current title", s.Created)
+	}
+	if len(s.Completed) != 1 || s.Completed[0].ID != 1 {
+		t.Errorf("completed = %+v, want task 1 only (archiving is not completing)", s.Completed)
+	}
+	if len(s.Deleted) != 1 || s.Deleted[0].Title != "Dropped" {
+		t.Errorf("deleted = %+v, want task 4 with its logged title", s.Deleted)
+	}
+	if len(s.Blocked) != 2 || s.Blocked[0].ID != 2 || !s.Blocked[0].StillBlocked || s.Blocked[0].Detail != "vendor" {
+		t.Errorf("blocked = %+v, want task 2 first with its latest reason", s.Blocked)
+	}
+}
+
+func TestLogEntryStatusChange(t *testing.T) {
+	tests := []struct {
+		entry    LogEntry
+		from, to string
+		ok       bool
+	}{
+		{LogEntry{Action: "move", Detail: "todo -> done"}, "todo", "done", true},
+		{LogEntry{Action: "auto-move", Detail: "backlog -> todo (dependencies done)"}, "backlog", "todo", true},
+		{LogEntry{Action: "edit", Detail: "a -> b"}, "", "", false},
+		{LogEntry{Action: "move", Detail: "garbled"}, "garbled", "", false},
+	}
+	for _, tt := range tests {
+		from, to, ok := tt.entry.StatusChange()
+		if from != tt.from || to != tt.to || ok != tt.ok {
+			t.Errorf("%+v.StatusChange() = %q, %q, %v; want %q, %q, %v", tt.entry, from, to, ok, tt.from, tt.to, tt.ok)
+		}
+	}
+}
@@ -20,7 +20,20 @@ type Metrics struct {
 	AvgLeadTimeHours  *float64    `json:"avg_lead_time_hours,omitempty"`
 	AvgCycleTimeHours *float64    `json:"avg_cycle_time_hours,omitempty"`
 	FlowEfficiency    *float64    `json:"flow_efficiency,omitempty"`
+	Reopened          int         `json:"reopened"`
+	Reopens           int         `json:"reopens"`
+	ReopenRate        *float64    `json:"reopen_rate,omitempty"`
 	AgingItems        []AgingItem `json:"aging_items,omitempty"`
+	BusinessDays      bool        `json:"business_days,omitempty"`
+	// Abandonment is set when claims expire (claim_timeout is set).
+	Abandonment *Abandonment `json:"abandonment,omitempty"`
+}
+
+// MetricsOptions controls how metrics are computed.
+type MetricsOptions struct {
+	// BusinessDays measures lead, cycle, and aging times over working days
+	// of the board calendar only.
+	BusinessDays bool
 }
 
 // AgingItem represents a work item that has started but not completed.
@@ -33,15 +46,35 @@ type AgingItem struct {
 
 // ComputeMetrics computes aggregate flow metrics from all tasks.
 func ComputeMetrics(cfg *config.Config, tasks []*task.Task, now time.Time) Metrics {
-	m := Metrics{}
+	return ComputeMetricsWith(cfg, tasks, now, MetricsOptions{})
+}
+
+// ComputeMetricsWith is like ComputeMetrics with explicit options.
+func ComputeMetricsWith(cfg *config.Config, tasks []*task.Task, now time.Time, opts MetricsOptions) Metrics {
+	m := Metrics{BusinessDays: opts.BusinessDays}
+	elapsed := func(from, to time.Time) float64 {
+		if opts.BusinessDays {
+			return BusinessDuration(cfg, from, to).Hours()
+		}
+		return to.Sub(from).Hours()
+	}
 
 	window7 := now.AddDate(0, 0, -days7)
 	window30 := now.AddDate(0, 0, -days30)
 
 	var leadSum, cycleSum float64
-	var leadCount, cycleCount int
+	var leadCount, cycleCount, everDone int
 
 	for _, t := range tasks {
+		// Churn: tasks that were completed, then reopened.
+		if t.Reopened > 0 {
+			m.Reopened++
+			m.Reopens += t.Reopened
+		}
+		if t.Completed != nil || t.Reopened > 0 {
+			everDone++
+		}
+
 		if t.Completed != nil {
 			if t.Completed.After(window7) {
 				m.Throughput7d++
@@ -50,12 +83,12 @@ func ComputeMetrics(cfg *config.Config, tasks []*task.Task, now time.Time) Metri
 				m.Throughput30d++
 			}
 
-			leadHours := t.Completed.Sub(t.Created).Hours()
+			leadHours := elapsed(t.Created, *t.Completed)
 			leadSum += leadHours
 			leadCount++
 
 			if t.Started != nil {
-				cycleHours := t.Completed.Sub(*t.Started).Hours()
+				cycleHours := elapsed(*t.Started, *t.Completed)
 				cycleSum += cycleHours
 				cycleCount++
 			}
@@ -67,7 +100,7 @@ func ComputeMetrics(cfg *config.Config, tasks []*task.Task, now time.Time) Metri
 				ID:       t.ID,
 				Title:    t.Title,
 				Status:   t.Status,
-				AgeHours: now.Sub(*t.Started).Hours(),
+				AgeHours: elapsed(*t.Started, now),
 			})
 		}
 	}
@@ -84,6 +117,10 @@ func ComputeMetrics(cfg *config.Config, tasks []*task.Task, now time.Time) Metri
 		eff := *m.AvgCycleTimeHours / *m.AvgLeadTimeHours
 		m.FlowEfficiency = &eff
 	}
+	if everDone > 0 {
+		rate := float64(m.Reopened) / float64(everDone)
+		m.ReopenRate = &rate
+	}
 
 	return m
 }
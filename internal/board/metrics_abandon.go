@@ -0,0 +1,179 @@
+package board
+
+import (
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Abandonment counts claims that expired before their task was completed,
+// overall and per agent and tag, to spot flaky agents and oversized tasks.
+type Abandonment struct {
+	Claims          int            `json:"claims"`
+	Expired         int            `json:"expired"`
+	AvgClaimedHours *float64       `json:"avg_claimed_hours,omitempty"`
+	ByAgent         []AbandonGroup `json:"by_agent"`
+	ByTag           []AbandonGroup `json:"by_tag"`
+}
+
+// AbandonGroup is the abandonment of the claims of one agent or on tasks
+// with one tag. AvgClaimedHours averages how long the expired claims were
+// held, from the claim until it expired: the work lost.
+type AbandonGroup struct {
+	Name            string   `json:"name"`
+	Claims          int      `json:"claims"`
+	Expired         int      `json:"expired"`
+	AvgClaimedHours *float64 `json:"avg_claimed_hours,omitempty"`
+}
+
+// abandonGroupTally accumulates one AbandonGroup.
+type abandonGroupTally struct {
+	AbandonGroup
+	claimedSum float64
+}
+
+// claimRun is one agent's hold on a task, from its claim to its last
+// activity on the task.
+type claimRun struct {
+	agent       string
+	start, last time.Time
+}
+
+// ComputeAbandonment replays claims from the activity log, oldest entry
+// first. A claim lasts while its agent keeps working on the task and
+// expires timeout after the agent's last activity. A claim ended by a
+// release, handoff, deletion, completion, or another agent's claim counts as
+// expired when its agent had been idle for longer than timeout by then;
+// a claim still held is expired once that much time has passed. Claims
+// still running are not counted.
+func ComputeAbandonment(cfg *config.Config, tasks []*task.Task, entries []LogEntry, timeout time.Duration, now time.Time) Abandonment {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	a := abandonTally{byAgent: map[string]*abandonGroupTally{}, byTag: map[string]*abandonGroupTally{}}
+	finish := func(id int, run *claimRun, expired bool) {
+		a.add(byID[id], run, expired, timeout)
+	}
+
+	open := make(map[int]*claimRun)
+	for _, e := range entries {
+		run := open[e.TaskID]
+		switch {
+		case e.Action == "claim" && (run == nil || run.agent != e.Detail):
+			if run != nil {
+				finish(e.TaskID, run, e.Timestamp.Sub(run.last) > timeout)
+			}
+			open[e.TaskID] = &claimRun{agent: e.Detail, start: e.Timestamp, last: e.Timestamp}
+		case run == nil:
+		case e.Action == "claim":
+			run.last = e.Timestamp
+		case endsClaim(cfg, e):
+			finish(e.TaskID, run, e.Actor != run.agent && e.Timestamp.Sub(run.last) > timeout)
+			delete(open, e.TaskID)
+		case e.Actor == run.agent:
+			run.last = e.Timestamp
+		}
+	}
+
+	for id, run := range open {
+		t := byID[id]
+		if t == nil {
+			continue
+		}
+		if t.ClaimedBy == run.agent && t.ClaimedAt != nil && t.ClaimedAt.After(run.last) {
+			run.last = *t.ClaimedAt
+		}
+		if now.Sub(run.last) > timeout {
+			finish(id, run, t.Completed == nil)
+		}
+	}
+	return a.result()
+}
+
+// endsClaim reports whether e ends the claim on its task.
+func endsClaim(cfg *config.Config, e LogEntry) bool {
+	switch e.Action {
+	case "release", "handoff", "delete":
+		return true
+	}
+	_, to, ok := e.StatusChange()
+	return ok && cfg.CompletesTask(to)
+}
+
+// abandonTally accumulates finished claims.
+type abandonTally struct {
+	Abandonment
+	claimedSum float64
+	byAgent    map[string]*abandonGroupTally
+	byTag      map[string]*abandonGroupTally
+}
+
+// add records a finished claim on t, which is nil if the task is gone.
+func (a *abandonTally) add(t *task.Task, run *claimRun, expired bool, timeout time.Duration) {
+	groups := []*abandonGroupTally{abandonGroup(a.byAgent, run.agent)}
+	if t != nil {
+		for _, tag := range extractGroupKeys(t, "tag") {
+			groups = append(groups, abandonGroup(a.byTag, tag))
+		}
+	}
+	claimed := run.last.Add(timeout).Sub(run.start).Hours()
+
+	a.Claims++
+	if expired {
+		a.Expired++
+		a.claimedSum += claimed
+	}
+	for _, g := range groups {
+		g.Claims++
+		if expired {
+			g.Expired++
+			g.claimedSum += claimed
+		}
+	}
+}
+
+func abandonGroup(groups map[string]*abandonGroupTally, name string) *abandonGroupTally {
+	g := groups[name]
+	if g == nil {
+		g = &abandonGroupTally{AbandonGroup: AbandonGroup{Name: name}}
+		groups[name] = g
+	}
+	return g
+}
+
+// result returns the tally with averages filled in and groups sorted by
+// expired claims, most first.
+func (a *abandonTally) result() Abandonment {
+	r := a.Abandonment
+	r.AvgClaimedHours = average(a.claimedSum, a.Expired)
+	r.ByAgent = sortedAbandonGroups(a.byAgent)
+	r.ByTag = sortedAbandonGroups(a.byTag)
+	return r
+}
+
+func sortedAbandonGroups(groups map[string]*abandonGroupTally) []AbandonGroup {
+	out := make([]AbandonGroup, 0, len(groups))
+	for _, g := range groups {
+		g.AvgClaimedHours = average(g.claimedSum, g.Expired)
+		out = append(out, g.AbandonGroup)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Expired != out[j].Expired {
+			return out[i].Expired > out[j].Expired
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// average returns sum/n, or nil when n is zero.
+func average(sum float64, n int) *float64 {
+	if n == 0 {
+		return nil
+	}
+	avg := sum / float64(n)
+	return &avg
+}
@@ -0,0 +1,54 @@
+package board
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestComputeAbandonment(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return now.Add(time.Duration(h-100) * time.Hour) }
+	done, refreshed := at(20), at(40)
+	tasks := []*task.Task{
+		{ID: 1, Status: "done", Tags: []string{"big"}, Completed: &done},
+		{ID: 2, Status: "in-progress", Tags: []string{"big"}, ClaimedBy: "flaky", ClaimedAt: &refreshed},
+		{ID: 3, Status: "in-progress", ClaimedBy: "steady", ClaimedAt: &now},
+		{ID: 4, Status: "done", Completed: &done},
+	}
+	entries := []LogEntry{
+		// #1: flaky goes idle, steady takes over after the timeout and finishes.
+		{Timestamp: at(0), Action: "claim", TaskID: 1, Detail: "flaky", Actor: "flaky"},
+		{Timestamp: at(1), Action: "edit", TaskID: 1, Actor: "flaky"},
+		{Timestamp: at(10), Action: "claim", TaskID: 1, Detail: "steady", Actor: "steady"},
+		{Timestamp: at(20), Action: "move", TaskID: 1, Detail: "in-progress -> done", Actor: "steady"},
+		// #2: flaky still holds an expired claim, refreshed without a log entry.
+		{Timestamp: at(30), Action: "claim", TaskID: 2, Detail: "flaky", Actor: "flaky"},
+		// #3: steady's claim is still running.
+		{Timestamp: at(50), Action: "claim", TaskID: 3, Detail: "steady", Actor: "steady"},
+		// #4: released in time.
+		{Timestamp: at(60), Action: "claim", TaskID: 4, Detail: "steady", Actor: "steady"},
+		{Timestamp: at(61), Action: "release", TaskID: 4, Detail: "steady", Actor: "steady"},
+	}
+
+	a := ComputeAbandonment(cfg, tasks, entries, 2*time.Hour, now)
+
+	if a.Claims != 4 || a.Expired != 2 {
+		t.Errorf("claims = %d, expired = %d, want 4 and 2", a.Claims, a.Expired)
+	}
+	// #1 held 0h..1h+2h = 3h; #2 held 30h..40h+2h = 12h.
+	if a.AvgClaimedHours == nil || math.Abs(*a.AvgClaimedHours-7.5) > 1e-9 {
+		t.Errorf("avg claimed = %v, want 7.5h", a.AvgClaimedHours)
+	}
+	if len(a.ByAgent) != 2 || a.ByAgent[0].Name != "flaky" || a.ByAgent[0].Expired != 2 ||
+		a.ByAgent[1].Name != "steady" || a.ByAgent[1].Expired != 0 || a.ByAgent[1].Claims != 2 {
+		t.Errorf("by agent = %+v", a.ByAgent)
+	}
+	if len(a.ByTag) != 2 || a.ByTag[0].Name != "big" || a.ByTag[0].Expired != 2 || a.ByTag[0].Claims != 3 {
+		t.Errorf("by tag = %+v", a.ByTag)
+	}
+}
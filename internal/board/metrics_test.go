@@ -193,3 +193,52 @@ func TestMetricsMultipleTasksAverages(t *testing.T) {
 		t.Errorf("FlowEfficiency = %v, want 0.5", m.FlowEfficiency)
 	}
 }
+
+func TestMetricsBusinessDays(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	// Monday noon; the task started the previous Friday at noon.
+	now := time.Date(2026, time.March, 9, 12, 0, 0, 0, time.UTC)
+	started := time.Date(2026, time.March, 6, 12, 0, 0, 0, time.UTC)
+	tasks := []*task.Task{
+		{ID: 1, Title: "Aging", Status: "in-progress", Started: &started, Created: started},
+	}
+
+	m := ComputeMetricsWith(cfg, tasks, now, MetricsOptions{BusinessDays: true})
+	if !m.BusinessDays {
+		t.Error("BusinessDays = false, want true")
+	}
+	if len(m.AgingItems) != 1 || m.AgingItems[0].AgeHours != 24 {
+		t.Errorf("AgingItems = %+v, want one item aged 24 business hours", m.AgingItems)
+	}
+
+	m = ComputeMetrics(cfg, tasks, now)
+	if m.AgingItems[0].AgeHours != 72 {
+		t.Errorf("calendar AgeHours = %v, want 72", m.AgingItems[0].AgeHours)
+	}
+}
+
+func TestMetricsReopened(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	done := now.AddDate(0, 0, -2)
+
+	tasks := []*task.Task{
+		{ID: 1, Status: "done", Completed: &done, Created: done, Reopened: 2},
+		{ID: 2, Status: "in-progress", Created: done, Reopened: 1},
+		{ID: 3, Status: "done", Completed: &done, Created: done},
+		{ID: 4, Status: "done", Completed: &done, Created: done},
+		{ID: 5, Status: "todo", Created: done},
+	}
+
+	m := ComputeMetrics(cfg, tasks, now)
+
+	if m.Reopened != 2 {
+		t.Errorf("Reopened = %d, want 2", m.Reopened)
+	}
+	if m.Reopens != 3 {
+		t.Errorf("Reopens = %d, want 3", m.Reopens)
+	}
+	if m.ReopenRate == nil || math.Abs(*m.ReopenRate-0.5) > 1e-9 {
+		t.Errorf("ReopenRate = %v, want 0.5", m.ReopenRate)
+	}
+}
@@ -0,0 +1,90 @@
+package board
+
+import (
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// TagTrends holds created and completed counts per tag over a period, in
+// weekly buckets from Since. A task with several tags counts for each.
+type TagTrends struct {
+	Since time.Time  `json:"since"`
+	Until time.Time  `json:"until"`
+	Tags  []TagTrend `json:"tags"`
+}
+
+// TagTrend is the activity of one tag over the period.
+type TagTrend struct {
+	Tag               string        `json:"tag"`
+	Created           int           `json:"created"`
+	Completed         int           `json:"completed"`
+	ThroughputPerWeek float64       `json:"throughput_per_week"`
+	Weekly            []TrendBucket `json:"weekly"`
+}
+
+// TrendBucket counts the tasks created and completed in the week from Start.
+// The last week may be partial.
+type TrendBucket struct {
+	Start     date.Date `json:"start"`
+	Created   int       `json:"created"`
+	Completed int       `json:"completed"`
+}
+
+const week = days7 * hoursPerDay * time.Hour
+
+// ComputeTagTrends counts the tasks created and completed per tag between
+// since and now. Tags are sorted by completed tasks, most first.
+func ComputeTagTrends(tasks []*task.Task, since, now time.Time) TagTrends {
+	weeks := max(int((now.Sub(since)+week-1)/week), 1)
+	inPeriod := func(at time.Time) bool { return !at.Before(since) && !at.After(now) }
+	weekOf := func(at time.Time) int { return min(int(at.Sub(since)/week), weeks-1) }
+
+	byTag := make(map[string]*TagTrend)
+	for _, t := range tasks {
+		created := inPeriod(t.Created)
+		completed := t.Completed != nil && inPeriod(*t.Completed)
+		if !created && !completed {
+			continue
+		}
+		for _, tag := range extractGroupKeys(t, "tag") {
+			tr := byTag[tag]
+			if tr == nil {
+				tr = &TagTrend{Tag: tag, Weekly: make([]TrendBucket, weeks)}
+				for i := range tr.Weekly {
+					start := since.Add(time.Duration(i) * week)
+					tr.Weekly[i].Start = date.New(start.Year(), start.Month(), start.Day())
+				}
+				byTag[tag] = tr
+			}
+			if created {
+				tr.Created++
+				tr.Weekly[weekOf(t.Created)].Created++
+			}
+			if completed {
+				tr.Completed++
+				tr.Weekly[weekOf(*t.Completed)].Completed++
+			}
+		}
+	}
+
+	r := TagTrends{Since: since, Until: now, Tags: make([]TagTrend, 0, len(byTag))}
+	periodWeeks := max(now.Sub(since).Hours()/week.Hours(), 1)
+	for _, tr := range byTag {
+		tr.ThroughputPerWeek = float64(tr.Completed) / periodWeeks
+		r.Tags = append(r.Tags, *tr)
+	}
+	sort.Slice(r.Tags, func(i, j int) bool {
+		a, b := r.Tags[i], r.Tags[j]
+		if a.Completed != b.Completed {
+			return a.Completed > b.Completed
+		}
+		if a.Created != b.Created {
+			return a.Created > b.Created
+		}
+		return a.Tag < b.Tag
+	})
+	return r
+}
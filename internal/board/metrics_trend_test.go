@@ -0,0 +1,46 @@
+package board
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestComputeTagTrends(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := since.AddDate(0, 0, 17) // two full weeks and a partial one
+	done := func(days int) *time.Time {
+		at := since.AddDate(0, 0, days)
+		return &at
+	}
+	tasks := []*task.Task{
+		{ID: 1, Tags: []string{"bug"}, Created: since.AddDate(0, 0, -30), Completed: done(2)},
+		{ID: 2, Tags: []string{"bug", "infra"}, Created: since.AddDate(0, 0, 8), Completed: done(16)},
+		{ID: 3, Created: since.AddDate(0, 0, 15)},
+		{ID: 4, Tags: []string{"feature"}, Created: since.AddDate(0, 0, -60), Completed: done(-1)},
+	}
+
+	r := ComputeTagTrends(tasks, since, now)
+	if len(r.Tags) != 3 {
+		t.Fatalf("tags = %+v, want bug, infra, and (untagged)", r.Tags)
+	}
+	bug := r.Tags[0]
+	if bug.Tag != "bug" || bug.Created != 1 || bug.Completed != 2 {
+		t.Errorf("bug = %+v, want 1 created and 2 completed", bug)
+	}
+	if len(bug.Weekly) != 3 || bug.Weekly[0].Completed != 1 || bug.Weekly[2].Completed != 1 || bug.Weekly[1].Created != 1 {
+		t.Errorf("bug weekly = %+v", bug.Weekly)
+	}
+	if got := bug.Weekly[1].Start.String(); got != "2026-01-08" {
+		t.Errorf("second week starts %s, want 2026-01-08", got)
+	}
+	const wantPerWeek = 2 / (17.0 / 7)
+	if math.Abs(bug.ThroughputPerWeek-wantPerWeek) > 1e-9 {
+		t.Errorf("throughput = %v, want %v", bug.ThroughputPerWeek, wantPerWeek)
+	}
+	if r.Tags[1].Tag != "infra" || r.Tags[2].Tag != "(untagged)" {
+		t.Errorf("order = %s, %s; want infra, (untagged)", r.Tags[1].Tag, r.Tags[2].Tag)
+	}
+}
@@ -0,0 +1,92 @@
+package board
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// TriageMetrics measures how long new tasks wait for attention: from
+// creation to their first status change and to their first assignee.
+type TriageMetrics struct {
+	FirstStatusChange TriageStat `json:"first_status_change"`
+	FirstAssignee     TriageStat `json:"first_assignee"`
+	BusinessDays      bool       `json:"business_days,omitempty"`
+}
+
+// TriageStat summarizes the waits until one triage event over the tasks it
+// happened to. Waiting counts the open tasks it has not happened to yet.
+type TriageStat struct {
+	Count    int      `json:"count"`
+	Waiting  int      `json:"waiting"`
+	AvgHours *float64 `json:"avg_hours,omitempty"`
+	P50Hours *float64 `json:"p50_hours,omitempty"`
+	P90Hours *float64 `json:"p90_hours,omitempty"`
+}
+
+const (
+	p50 = 0.5
+	p90 = 0.9
+)
+
+// ComputeTriageMetrics computes triage times over tasks. The first status
+// change comes from the status history, so tasks created before it was
+// recorded are left out; so are tasks that never recorded an assignment.
+func ComputeTriageMetrics(cfg *config.Config, tasks []*task.Task, now time.Time, opts MetricsOptions) TriageMetrics {
+	elapsed := func(from, to time.Time) float64 {
+		if opts.BusinessDays {
+			return BusinessDuration(cfg, from, to).Hours()
+		}
+		return to.Sub(from).Hours()
+	}
+
+	var statusWaits, assigneeWaits []float64
+	var statusWaiting, assigneeWaiting int
+	for _, t := range tasks {
+		open := !cfg.IsTerminalStatus(t.Status) && !cfg.IsArchivedStatus(t.Status)
+		switch {
+		case len(t.StatusHistory) > 1:
+			statusWaits = append(statusWaits, elapsed(t.Created, t.StatusHistory[1].EnteredAt))
+		case len(t.StatusHistory) == 1 && open:
+			statusWaiting++
+		}
+		switch {
+		case t.Assigned != nil:
+			assigneeWaits = append(assigneeWaits, elapsed(t.Created, *t.Assigned))
+		case t.Assignee == "" && open:
+			assigneeWaiting++
+		}
+	}
+
+	return TriageMetrics{
+		FirstStatusChange: triageStat(statusWaits, statusWaiting),
+		FirstAssignee:     triageStat(assigneeWaits, assigneeWaiting),
+		BusinessDays:      opts.BusinessDays,
+	}
+}
+
+// triageStat summarizes waits, in hours.
+func triageStat(waits []float64, waiting int) TriageStat {
+	s := TriageStat{Count: len(waits), Waiting: waiting}
+	if len(waits) == 0 {
+		return s
+	}
+	sort.Float64s(waits)
+	var sum float64
+	for _, w := range waits {
+		sum += w
+	}
+	avg := sum / float64(len(waits))
+	median, high := nearestRank(waits, p50), nearestRank(waits, p90)
+	s.AvgHours, s.P50Hours, s.P90Hours = &avg, &median, &high
+	return s
+}
+
+// nearestRank returns the p-th percentile of sorted values by the
+// nearest-rank method.
+func nearestRank(sorted []float64, p float64) float64 {
+	return sorted[max(int(math.Ceil(p*float64(len(sorted))))-1, 0)]
+}
@@ -0,0 +1,47 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestComputeTriageMetrics(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	after := func(hours int) time.Time { return created.Add(time.Duration(hours) * time.Hour) }
+	at := func(hours int) *time.Time {
+		v := after(hours)
+		return &v
+	}
+	moved := func(hours int) []task.StatusEntry {
+		return []task.StatusEntry{{Status: "backlog", EnteredAt: created}, {Status: "todo", EnteredAt: after(hours)}}
+	}
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Created: created, StatusHistory: moved(2), Assignee: "a", Assigned: at(1)},
+		{ID: 2, Status: "todo", Created: created, StatusHistory: moved(4), Assignee: "b", Assigned: at(3)},
+		{ID: 3, Status: "done", Created: created, StatusHistory: moved(12)},
+		{ID: 4, Status: "backlog", Created: created, StatusHistory: moved(0)[:1]},
+		{ID: 5, Status: "backlog", Created: created, Assignee: "legacy"},
+	}
+
+	m := ComputeTriageMetrics(cfg, tasks, after(24), MetricsOptions{})
+	s := m.FirstStatusChange
+	if s.Count != 3 || s.Waiting != 1 {
+		t.Errorf("first status change count/waiting = %d/%d, want 3/1", s.Count, s.Waiting)
+	}
+	if *s.AvgHours != 6 || *s.P50Hours != 4 || *s.P90Hours != 12 {
+		t.Errorf("first status change avg/p50/p90 = %v/%v/%v, want 6/4/12", *s.AvgHours, *s.P50Hours, *s.P90Hours)
+	}
+	a := m.FirstAssignee
+	if a.Count != 2 || a.Waiting != 1 || *a.AvgHours != 2 {
+		t.Errorf("first assignee = %d tasks, %d waiting, avg %v; want 2, 1, 2", a.Count, a.Waiting, *a.AvgHours)
+	}
+
+	empty := ComputeTriageMetrics(cfg, nil, after(24), MetricsOptions{})
+	if empty.FirstAssignee.AvgHours != nil {
+		t.Errorf("empty avg = %v, want nil", *empty.FirstAssignee.AvgHours)
+	}
+}
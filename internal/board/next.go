@@ -0,0 +1,98 @@
+package board
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Ranking weights used by Next. Priority points scale with the priority's
+// position in the configured list, so the lowest priority scores zero.
+const (
+	nextPriorityPoints  = 10
+	nextExpeditePoints  = 40
+	nextFixedDatePoints = 10
+	nextIntangiblePts   = -10
+	nextOverduePoints   = 30
+	nextDueTodayPoints  = 25
+	nextDueSoonPoints   = 15
+	nextAgeCap          = 10 // at most one point per working day waited
+)
+
+// RankFactor is one contribution to a recommendation's score.
+type RankFactor struct {
+	Factor string `json:"factor"`
+	Points int    `json:"points"`
+	Reason string `json:"reason"`
+}
+
+// Recommendation is a task suggested by Next, with the factors behind its
+// score.
+type Recommendation struct {
+	*task.Task
+	Score   int          `json:"score"`
+	Factors []RankFactor `json:"factors"`
+}
+
+// Next ranks the tasks pick would consider — the ready ones, see MarkReady
+// — by priority, class of service, due date, and age in working days. Ties
+// go to the lower ID.
+func Next(cfg *config.Config, tasks []*task.Task, opts PickOptions, now time.Time) []Recommendation {
+	candidates := pickCandidates(cfg, tasks, opts)
+
+	recs := make([]Recommendation, 0, len(candidates))
+	for _, t := range candidates {
+		factors := rankFactors(cfg, t, now)
+		score := 0
+		for _, f := range factors {
+			score += f.Points
+		}
+		recs = append(recs, Recommendation{Task: t, Score: score, Factors: factors})
+	}
+	sort.SliceStable(recs, func(i, j int) bool {
+		if recs[i].Score != recs[j].Score {
+			return recs[i].Score > recs[j].Score
+		}
+		return recs[i].ID < recs[j].ID
+	})
+	return recs
+}
+
+func rankFactors(cfg *config.Config, t *task.Task, now time.Time) []RankFactor {
+	var factors []RankFactor
+	if idx := cfg.PriorityIndex(t.Priority); idx > 0 {
+		factors = append(factors, RankFactor{"priority", idx * nextPriorityPoints, t.Priority + " priority"})
+	}
+
+	switch t.Class {
+	case "expedite":
+		factors = append(factors, RankFactor{"class", nextExpeditePoints, "expedite class"})
+	case "fixed-date":
+		factors = append(factors, RankFactor{"class", nextFixedDatePoints, "fixed-date class"})
+	case "intangible":
+		factors = append(factors, RankFactor{"class", nextIntangiblePts, "intangible class"})
+	}
+
+	if t.Due != nil {
+		today := date.New(now.Year(), now.Month(), now.Day())
+		days := int(t.Due.Sub(today.Time).Hours() / 24) //nolint:mnd // hours per day
+		switch dueBucket(t, cfg, now) {
+		case "overdue":
+			factors = append(factors, RankFactor{"due", nextOverduePoints, fmt.Sprintf("overdue by %d day(s)", -days)})
+		case "today":
+			factors = append(factors, RankFactor{"due", nextDueTodayPoints, "due today"})
+		case "this-week":
+			factors = append(factors, RankFactor{"due", nextDueSoonPoints, fmt.Sprintf("due in %d day(s)", days)})
+		}
+	}
+
+	if n := workingDaysSince(cfg, t.Created, now); n > 0 {
+		factors = append(factors, RankFactor{"age", min(n, nextAgeCap),
+			fmt.Sprintf("waiting %d working day(s)", n)})
+	}
+	return factors
+}
@@ -0,0 +1,51 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestNextRanking(t *testing.T) {
+	cfg := newPickTestConfig()
+	// Wednesday 2026-03-04.
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	yesterday := date.New(2026, 3, 3)
+	claimedAt := time.Now() // claim expiry is checked against the wall clock
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Priority: "high", Created: now},
+		{ID: 2, Status: "todo", Priority: "low", Due: &yesterday, Created: now},
+		{ID: 3, Status: "todo", Priority: "medium", Class: "expedite", Created: now},
+		{ID: 4, Status: "todo", Priority: "critical", ClaimedBy: "other", ClaimedAt: &claimedAt},
+		{ID: 5, Status: "todo", Priority: "critical", DependsOn: []int{1}},
+		{ID: 6, Status: "done", Priority: "critical"},
+		{ID: 7, Status: "todo", Priority: "low", Created: now.AddDate(0, 0, -7)},
+	}
+
+	recs := Next(cfg, tasks, PickOptions{ClaimTimeout: time.Hour}, now)
+	var ids []int
+	for _, r := range recs {
+		ids = append(ids, r.ID)
+	}
+	// expedite+medium 50, overdue 30, high 20, low aged 5 working days 5.
+	want := []int{3, 2, 1, 7}
+	if len(ids) != len(want) {
+		t.Fatalf("Next IDs = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("Next IDs = %v, want %v", ids, want)
+		}
+	}
+	if recs[0].Score != 50 || len(recs[0].Factors) != 2 {
+		t.Errorf("top recommendation = %d %+v, want score 50 from two factors", recs[0].Score, recs[0].Factors)
+	}
+	if f := recs[1].Factors[0]; f.Factor != "due" || f.Reason != "overdue by 1 day(s)" {
+		t.Errorf("overdue factor = %+v", f)
+	}
+	if f := recs[3].Factors[0]; f.Factor != "age" || f.Points != 5 {
+		t.Errorf("age factor = %+v, want 5 points", f)
+	}
+}
@@ -0,0 +1,30 @@
+package board
+
+import (
+	"fmt"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// SyncNextID brings cfg.NextID up to date before tasks are created, so an
+// ID handed out by another writer of the board (the CLI, the TUI, or serve)
+// is never reused. Long-running writers keep a config loaded earlier, so it
+// takes the larger of cfg's next_id and the one in config.yml, then bumps
+// it past the highest ID among the task files. The caller must hold the
+// board lock until the new tasks are written and next_id is saved.
+func SyncNextID(cfg *config.Config) error {
+	onDisk, err := config.Load(cfg.Dir())
+	if err != nil {
+		return err
+	}
+	cfg.NextID = max(cfg.NextID, onDisk.NextID)
+	maxID, err := task.MaxIDFromFiles(cfg.TasksPaths()...)
+	if err != nil {
+		return fmt.Errorf("scanning task files: %w", err)
+	}
+	if maxID >= cfg.NextID {
+		cfg.NextID = maxID + 1
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package board
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestSyncNextID(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.NextID = 3
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A long-running writer still holds next_id 3 after another writer
+	// created task 3 and saved next_id 4.
+	stale, err := config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.NextID = 4
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if err := SyncNextID(stale); err != nil {
+		t.Fatal(err)
+	}
+	if stale.NextID != 4 {
+		t.Errorf("NextID = %d, want 4 from config.yml", stale.NextID)
+	}
+
+	// A task file past next_id, as after a crash, wins too.
+	writeNextIDTask(t, stale.TasksPath(), 7)
+	if err := SyncNextID(stale); err != nil {
+		t.Fatal(err)
+	}
+	if stale.NextID != 8 {
+		t.Errorf("NextID = %d, want 8 past task 7", stale.NextID)
+	}
+}
+
+func writeNextIDTask(t *testing.T, dir string, id int) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	tk := &task.Task{ID: id, Title: "Task", Status: "todo", Priority: "medium"}
+	if err := task.Write(filepath.Join(dir, task.GenerateFilename(id, "task")), tk); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+package board
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+)
+
+const opsFileName = "ops.jsonl"
+
+// Ops report groupings.
+const (
+	OpsByCommand = "command"
+	OpsByActor   = "actor"
+)
+
+// OpsEntry records one command run against the board.
+type OpsEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Command   string    `json:"command"`
+	// DurationMS is the wall time of the command in milliseconds.
+	DurationMS int64 `json:"duration_ms"`
+	// Exit is the process exit code and Code the error code, if any.
+	Exit  int    `json:"exit"`
+	Code  string `json:"code,omitempty"`
+	Actor string `json:"actor,omitempty"`
+}
+
+// OpsStat aggregates the ops log entries sharing a key: a command or an
+// actor.
+type OpsStat struct {
+	Key     string `json:"key"`
+	Count   int    `json:"count"`
+	Errors  int    `json:"errors"`
+	TotalMS int64  `json:"total_ms"`
+	MeanMS  int64  `json:"mean_ms"`
+	P95MS   int64  `json:"p95_ms"`
+	MaxMS   int64  `json:"max_ms"`
+}
+
+// AppendOps appends an entry to the ops log, which is kept apart from the
+// activity log and truncated the same way.
+func AppendOps(kanbanDir string, entry OpsEntry) error {
+	path := filepath.Join(kanbanDir, opsFileName)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, logFileMode) //nolint:gosec // log path from trusted kanban dir
+	if err != nil {
+		return fmt.Errorf("opening ops log: %w", err)
+	}
+	defer f.Close()
+
+	entry.Timestamp = entry.Timestamp.UTC()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshaling ops entry: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing ops entry: %w", err)
+	}
+
+	_ = truncateLogIfNeeded(path)
+	return nil
+}
+
+// ReadOps reads the ops log entries after since. A zero since reads all.
+func ReadOps(kanbanDir string, since time.Time) ([]OpsEntry, error) {
+	f, err := os.Open(filepath.Join(kanbanDir, opsFileName)) //nolint:gosec // log path from trusted kanban dir
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening ops log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []OpsEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e OpsEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue // skip malformed lines
+		}
+		if e.Timestamp.After(since) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading ops log: %w", err)
+	}
+	return entries, nil
+}
+
+// OpsReport groups entries by command or actor (see OpsByCommand) and
+// returns their counts and durations, busiest first.
+func OpsReport(entries []OpsEntry, by string) []OpsStat {
+	durations := make(map[string][]int64)
+	stats := make(map[string]*OpsStat)
+	for _, e := range entries {
+		key := e.Command
+		if by == OpsByActor {
+			key = e.Actor
+		}
+		s := stats[key]
+		if s == nil {
+			s = &OpsStat{Key: key}
+			stats[key] = s
+		}
+		s.Count++
+		if e.Exit != 0 {
+			s.Errors++
+		}
+		s.TotalMS += e.DurationMS
+		s.MaxMS = max(s.MaxMS, e.DurationMS)
+		durations[key] = append(durations[key], e.DurationMS)
+	}
+
+	report := make([]OpsStat, 0, len(stats))
+	for key, s := range stats {
+		d := durations[key]
+		slices.Sort(d)
+		s.MeanMS = s.TotalMS / int64(s.Count)
+		s.P95MS = d[int(math.Ceil(healthPercentile*float64(len(d))))-1]
+		report = append(report, *s)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Count != report[j].Count {
+			return report[i].Count > report[j].Count
+		}
+		return report[i].Key < report[j].Key
+	})
+	return report
+}
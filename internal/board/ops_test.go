@@ -0,0 +1,54 @@
+package board
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOpsLog(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	for i, e := range []OpsEntry{
+		{Timestamp: now.Add(-48 * time.Hour), Command: "list", DurationMS: 500, Actor: "alice"},
+		{Timestamp: now, Command: "list", DurationMS: 10, Actor: "bot"},
+		{Timestamp: now, Command: "list", DurationMS: 30, Actor: "bot"},
+		{Timestamp: now, Command: "move", DurationMS: 20, Exit: 2, Code: "WIP_LIMIT_EXCEEDED", Actor: "bot"},
+	} {
+		if err := AppendOps(dir, e); err != nil {
+			t.Fatalf("AppendOps %d: %v", i, err)
+		}
+	}
+
+	all, err := ReadOps(dir, time.Time{})
+	if err != nil || len(all) != 4 {
+		t.Fatalf("ReadOps() = %d entries, %v; want 4", len(all), err)
+	}
+	recent, _ := ReadOps(dir, now.Add(-time.Hour))
+	if len(recent) != 3 {
+		t.Errorf("ReadOps(since 1h) = %d entries, want 3", len(recent))
+	}
+
+	byCommand := OpsReport(all, OpsByCommand)
+	if len(byCommand) != 2 || byCommand[0].Key != "list" || byCommand[1].Key != "move" {
+		t.Fatalf("OpsReport(command) = %+v, want list then move", byCommand)
+	}
+	list := byCommand[0]
+	if list.Count != 3 || list.TotalMS != 540 || list.MeanMS != 180 || list.P95MS != 500 || list.MaxMS != 500 {
+		t.Errorf("list = %+v", list)
+	}
+	if byCommand[1].Errors != 1 {
+		t.Errorf("move errors = %d, want 1", byCommand[1].Errors)
+	}
+
+	byActor := OpsReport(all, OpsByActor)
+	if len(byActor) != 2 || byActor[0].Key != "bot" || byActor[0].Count != 3 {
+		t.Errorf("OpsReport(actor) = %+v, want bot first with 3 runs", byActor)
+	}
+}
+
+func TestReadOpsMissing(t *testing.T) {
+	entries, err := ReadOps(t.TempDir(), time.Time{})
+	if err != nil || entries != nil {
+		t.Errorf("ReadOps() = %v, %v; want nil, nil", entries, err)
+	}
+}
@@ -0,0 +1,194 @@
+package board
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// PageOptions selects one page of a listing.
+type PageOptions struct {
+	Limit  int    // page size; 0 means all remaining items
+	Offset int    // items to skip after the cursor
+	Cursor string // next_cursor of the previous page; empty starts at the top
+}
+
+// TaskPage is one page of a task listing. NextCursor is empty on the last page.
+type TaskPage struct {
+	Tasks      []*task.Task `json:"tasks"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+// LogPage is one page of the activity log. NextCursor is empty on the last page.
+type LogPage struct {
+	Entries    []LogEntry `json:"entries"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+// taskCursor records the sort and the sort keys of the last task of a page.
+// The next page starts after the position such a task would take, so tasks
+// added, moved, or deleted meanwhile do not shift it.
+type taskCursor struct {
+	Sort     string     `json:"sort"`
+	Reverse  bool       `json:"reverse,omitempty"`
+	ID       int        `json:"id"`
+	Status   string     `json:"status,omitempty"`
+	Priority string     `json:"priority,omitempty"`
+	Created  time.Time  `json:"created,omitzero"`
+	Updated  time.Time  `json:"updated,omitzero"`
+	Due      *date.Date `json:"due,omitempty"`
+}
+
+// logCursor records the timestamp of the last entry of a page and how many
+// listed entries share it, as entries have no IDs.
+type logCursor struct {
+	Time time.Time `json:"time"`
+	Seen int       `json:"seen"`
+}
+
+// ListPage is List for one page: tasks after page.Cursor, skipping
+// page.Offset, at most page.Limit of them. opts.Limit is ignored.
+func ListPage(cfg *config.Config, opts ListOptions, page PageOptions) (TaskPage, []task.ReadWarning, error) {
+	sortField := opts.SortBy
+	if sortField == "" {
+		sortField = "id"
+	}
+	var after *taskCursor
+	if page.Cursor != "" {
+		after = &taskCursor{}
+		if err := decodeCursor(page.Cursor, after); err != nil {
+			return TaskPage{}, nil, err
+		}
+		if after.Sort != sortField || after.Reverse != opts.Reverse {
+			return TaskPage{}, nil, clierr.New(clierr.InvalidInput,
+				"cursor belongs to a listing with another sort; repeat the --sort and --reverse flags of the first page")
+		}
+	}
+
+	opts.Limit = 0
+	tasks, warnings, err := List(cfg, opts)
+	if err != nil {
+		return TaskPage{}, nil, err
+	}
+	if after != nil {
+		last := after.task()
+		tasks = tasks[sort.Search(len(tasks), func(i int) bool {
+			return sortsBefore(last, tasks[i], sortField, opts.Reverse, cfg)
+		}):]
+	}
+	tasks = tasks[min(page.Offset, len(tasks)):]
+
+	result := TaskPage{Tasks: tasks}
+	if result.Tasks == nil {
+		result.Tasks = []*task.Task{}
+	}
+	if page.Limit > 0 && len(tasks) > page.Limit {
+		result.Tasks = tasks[:page.Limit]
+		last := result.Tasks[page.Limit-1]
+		result.NextCursor = encodeCursor(taskCursor{
+			Sort: sortField, Reverse: opts.Reverse, ID: last.ID, Status: last.Status,
+			Priority: last.Priority, Created: last.Created, Updated: last.Updated, Due: last.Due,
+		})
+	}
+	return result, warnings, nil
+}
+
+// task returns a task with the cursor's sort keys, to compare against.
+func (c taskCursor) task() *task.Task {
+	return &task.Task{
+		ID: c.ID, Status: c.Status, Priority: c.Priority,
+		Created: c.Created, Updated: c.Updated, Due: c.Due,
+	}
+}
+
+// ReadLogPage is ReadLog for one page, oldest entries first: entries after
+// page.Cursor, skipping page.Offset, at most page.Limit of them.
+// opts.Limit is ignored.
+func ReadLogPage(kanbanDir string, opts LogFilterOptions, page PageOptions) (LogPage, error) {
+	var after *logCursor
+	if page.Cursor != "" {
+		after = &logCursor{}
+		if err := decodeCursor(page.Cursor, after); err != nil {
+			return LogPage{}, err
+		}
+	}
+
+	opts.Limit = 0
+	entries, err := ReadLog(kanbanDir, opts)
+	if err != nil {
+		return LogPage{}, err
+	}
+	start := 0
+	if after != nil {
+		start = after.position(entries)
+	}
+	start = min(start+page.Offset, len(entries))
+
+	result := LogPage{Entries: entries[start:]}
+	if result.Entries == nil {
+		result.Entries = []LogEntry{}
+	}
+	if page.Limit > 0 && len(result.Entries) > page.Limit {
+		end := start + page.Limit
+		result.Entries = entries[start:end]
+		last := entries[end-1].Timestamp
+		seen := 0
+		for _, e := range entries[:end] {
+			if e.Timestamp.Equal(last) {
+				seen++
+			}
+		}
+		result.NextCursor = encodeCursor(logCursor{Time: last, Seen: seen})
+	}
+	return result, nil
+}
+
+// position returns the index of the first entry after the cursor: past its
+// Seen-th entry at its time, or else past every entry up to its time.
+func (c logCursor) position(entries []LogEntry) int {
+	seen := 0
+	for i, e := range entries {
+		if e.Timestamp.Equal(c.Time) {
+			seen++
+			if seen == c.Seen {
+				return i + 1
+			}
+		}
+	}
+	for i, e := range entries {
+		if e.Timestamp.After(c.Time) {
+			return i
+		}
+	}
+	return len(entries)
+}
+
+// encodeCursor turns a cursor into an opaque URL-safe token.
+func encodeCursor(c any) string {
+	data, _ := json.Marshal(c) // cursor structs always marshal
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor reads a token made by encodeCursor into c. Unknown fields
+// are rejected, so a log cursor cannot be used to page tasks.
+func decodeCursor(token string, c any) error {
+	invalid := clierr.New(clierr.InvalidInput, "invalid cursor: pass the next_cursor of the previous page").
+		WithDetails(map[string]any{"cursor": token})
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return invalid
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(c); err != nil {
+		return invalid
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package board
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func pageIDs(tasks []*task.Task) []int {
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestListPage(t *testing.T) {
+	dir := t.TempDir()
+	tasksDir := filepath.Join(dir, "tasks")
+	if err := os.MkdirAll(tasksDir, 0o750); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	cfg := config.NewDefault("Test Board")
+	cfg.SetDir(dir)
+	for i, priority := range []string{"high", "low", "high", "low", "high"} {
+		writeTestTask(t, tasksDir, &task.Task{
+			ID: i + 1, Title: "Task", Status: "backlog", Priority: priority,
+			Created: time.Now(), Updated: time.Now(),
+		})
+	}
+	opts := ListOptions{SortBy: "priority", Reverse: true}
+
+	first, _, err := ListPage(cfg, opts, PageOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListPage: %v", err)
+	}
+	if got := pageIDs(first.Tasks); !slices.Equal(got, []int{5, 3}) {
+		t.Errorf("first page = %v, want [5 3]", got)
+	}
+	if first.NextCursor == "" {
+		t.Fatal("first page has no next cursor")
+	}
+
+	// A task added before the cursor does not shift the next page.
+	writeTestTask(t, tasksDir, &task.Task{
+		ID: 6, Title: "Task", Status: "backlog", Priority: "high",
+		Created: time.Now(), Updated: time.Now(),
+	})
+	second, _, err := ListPage(cfg, opts, PageOptions{Limit: 2, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("ListPage: %v", err)
+	}
+	if got := pageIDs(second.Tasks); !slices.Equal(got, []int{1, 4}) {
+		t.Errorf("second page = %v, want [1 4]", got)
+	}
+
+	last, _, err := ListPage(cfg, opts, PageOptions{Limit: 2, Offset: 1, Cursor: second.NextCursor})
+	if err != nil {
+		t.Fatalf("ListPage: %v", err)
+	}
+	if len(last.Tasks) != 0 || last.NextCursor != "" {
+		t.Errorf("last page = %v, %q; want empty with no cursor", pageIDs(last.Tasks), last.NextCursor)
+	}
+
+	_, _, err = ListPage(cfg, ListOptions{}, PageOptions{Cursor: first.NextCursor})
+	var cliErr *clierr.Error
+	if !errors.As(err, &cliErr) || cliErr.Code != clierr.InvalidInput {
+		t.Errorf("cursor with another sort: err = %v, want INVALID_INPUT", err)
+	}
+}
+
+func TestReadLogPage(t *testing.T) {
+	dir := t.TempDir()
+	same := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	for i, ts := range []time.Time{same.Add(-time.Hour), same, same, same.Add(time.Hour)} {
+		if err := AppendLog(dir, LogEntry{Timestamp: ts, Action: "create", TaskID: i + 1}); err != nil {
+			t.Fatalf("AppendLog: %v", err)
+		}
+	}
+
+	var ids []int
+	page := PageOptions{Limit: 2}
+	for {
+		p, err := ReadLogPage(dir, LogFilterOptions{}, page)
+		if err != nil {
+			t.Fatalf("ReadLogPage: %v", err)
+		}
+		for _, e := range p.Entries {
+			ids = append(ids, e.TaskID)
+		}
+		if p.NextCursor == "" {
+			break
+		}
+		page.Cursor = p.NextCursor
+	}
+	if !slices.Equal(ids, []int{1, 2, 3, 4}) {
+		t.Errorf("paged entries = %v, want [1 2 3 4]", ids)
+	}
+
+	// Entries sharing a timestamp are told apart by their count.
+	p, err := ReadLogPage(dir, LogFilterOptions{}, PageOptions{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("ReadLogPage: %v", err)
+	}
+	p, err = ReadLogPage(dir, LogFilterOptions{}, PageOptions{Cursor: p.NextCursor})
+	if err != nil {
+		t.Fatalf("ReadLogPage: %v", err)
+	}
+	if len(p.Entries) != 1 || p.Entries[0].TaskID != 4 {
+		t.Errorf("after the second same-time entry = %v, want task 4", p.Entries)
+	}
+
+	taskPage, _, err := ListPage(config.NewDefault("Test"), ListOptions{}, PageOptions{Cursor: page.Cursor})
+	if err == nil {
+		t.Errorf("log cursor accepted for tasks: %v", taskPage)
+	}
+}
@@ -10,41 +10,49 @@ import (
 
 // PickOptions controls how the pick algorithm selects a task.
 type PickOptions struct {
-	Statuses     []string      // status columns to pick from (empty = all non-terminal)
+	Statuses     []string      // status columns to pick from (empty = ready.pull_from)
+	Target       string        // column the task is pulled into (empty = ready.target)
 	ClaimTimeout time.Duration // claim expiration for filtering
 	Tags         []string      // optional tag filter (OR logic: task must have at least one)
+	Strategy     string        // ordering strategy (empty = PickStrategyPriority)
 }
 
-// Pick finds the highest-priority unclaimed, unblocked task matching criteria.
-// Returns nil if no task matches.
+// Pick strategies.
+const (
+	// PickStrategyPriority orders candidates by class of service, then priority.
+	PickStrategyPriority = "priority"
+	// PickStrategyUnblocking prefers candidates that unblock the most open
+	// tasks (directly or transitively), falling back to priority order.
+	PickStrategyUnblocking = "unblocking"
+)
+
+// ValidPickStrategies returns the accepted values for PickOptions.Strategy.
+func ValidPickStrategies() []string {
+	return []string{PickStrategyPriority, PickStrategyUnblocking}
+}
+
+// Pick finds the highest-priority ready task (see MarkReady) matching
+// criteria. Returns nil if no task matches.
 func Pick(cfg *config.Config, tasks []*task.Task, opts PickOptions) *task.Task {
 	candidates := pickCandidates(cfg, tasks, opts)
-	candidates = filterPickDeps(cfg, tasks, candidates)
 
 	if len(candidates) == 0 {
 		return nil
 	}
 
 	sortPickCandidates(candidates, cfg)
+	if opts.Strategy == PickStrategyUnblocking {
+		sortByUnblocking(cfg, tasks, candidates)
+	}
 	return candidates[0]
 }
 
-// pickCandidates filters tasks by status, claim, block, and tag.
+// pickCandidates returns the ready tasks matching the tag filter.
 func pickCandidates(cfg *config.Config, tasks []*task.Task, opts PickOptions) []*task.Task {
-	statuses := opts.Statuses
-	if len(statuses) == 0 {
-		statuses = cfg.ActiveStatuses()
-	}
-
+	rule := newReadyRule(cfg, tasks, opts)
 	var candidates []*task.Task
 	for _, t := range tasks {
-		if !containsStr(statuses, t.Status) {
-			continue
-		}
-		if !IsUnclaimed(t, opts.ClaimTimeout) {
-			continue
-		}
-		if t.Blocked {
+		if !rule.ready(t) {
 			continue
 		}
 		if len(opts.Tags) > 0 && !hasAnyTag(t.Tags, opts.Tags) {
@@ -93,6 +101,50 @@ func sortPickCandidates(candidates []*task.Task, cfg *config.Config) {
 	})
 }
 
+// sortByUnblocking stably reorders candidates by how many open tasks each
+// one gates, so ties keep the priority order from sortPickCandidates.
+func sortByUnblocking(cfg *config.Config, allTasks, candidates []*task.Task) {
+	counts := make(map[int]int, len(candidates))
+	for _, c := range candidates {
+		counts[c.ID] = len(OpenDependents(cfg, allTasks, c.ID))
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return counts[candidates[i].ID] > counts[candidates[j].ID]
+	})
+}
+
+// OpenDependents returns the IDs of non-terminal tasks that depend on id,
+// directly or through other tasks, in ascending order.
+func OpenDependents(cfg *config.Config, allTasks []*task.Task, id int) []int {
+	dependents := make(map[int][]int)
+	for _, t := range allTasks {
+		if cfg.IsTerminalStatus(t.Status) {
+			continue
+		}
+		for _, dep := range t.DependsOn {
+			dependents[dep] = append(dependents[dep], t.ID)
+		}
+	}
+
+	seen := map[int]bool{id: true}
+	queue := []int{id}
+	var result []int
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, d := range dependents[cur] {
+			if seen[d] {
+				continue
+			}
+			seen[d] = true
+			result = append(result, d)
+			queue = append(queue, d)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
 // hasAnyTag returns true if the task has at least one of the given tags.
 func hasAnyTag(taskTags, filterTags []string) bool {
 	for _, ft := range filterTags {
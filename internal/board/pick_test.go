@@ -244,3 +244,77 @@ func parseTestDate(t *testing.T, s string) *date.Date {
 	}
 	return &d
 }
+
+func TestPickStrategyUnblocking(t *testing.T) {
+	cfg := newPickTestConfig()
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Priority: "critical"},
+		{ID: 2, Status: "todo", Priority: "low"},
+		{ID: 3, Status: "backlog", Priority: "medium", DependsOn: []int{2}},
+		{ID: 4, Status: "backlog", Priority: "medium", DependsOn: []int{3}},
+		{ID: 5, Status: "done", Priority: "medium", DependsOn: []int{1}},
+	}
+
+	if picked := Pick(cfg, tasks, PickOptions{}); picked == nil || picked.ID != 1 {
+		t.Fatalf("default Pick() = %+v, want #1", picked)
+	}
+	picked := Pick(cfg, tasks, PickOptions{Strategy: PickStrategyUnblocking})
+	if picked == nil || picked.ID != 2 {
+		t.Errorf("unblocking Pick() = %+v, want #2 (gates #3 and #4)", picked)
+	}
+}
+
+func TestOpenDependents(t *testing.T) {
+	cfg := newPickTestConfig()
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo"},
+		{ID: 2, Status: "todo", DependsOn: []int{1}},
+		{ID: 3, Status: "todo", DependsOn: []int{2, 1}},
+		{ID: 4, Status: "done", DependsOn: []int{1}},
+	}
+
+	got := OpenDependents(cfg, tasks, 1)
+	if len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("OpenDependents(1) = %v, want [2 3]", got)
+	}
+}
+
+func TestBuildWorkContext(t *testing.T) {
+	cfg := newPickTestConfig()
+	parent := 10
+	picked := &task.Task{ID: 1, Status: "todo", DependsOn: []int{2, 3, 99}, Parent: &parent}
+	tasks := []*task.Task{
+		picked,
+		{ID: 2, Title: "Schema", Status: "done"},
+		{ID: 3, Title: "API", Status: "in-progress", ClaimedBy: "bot"},
+		{ID: 10, Title: "Epic", Status: "in-progress"},
+		{ID: 20, Title: "Unrelated", Status: "todo"},
+	}
+	log := []LogEntry{
+		{Action: "create", TaskID: 20},
+		{Action: "move", TaskID: 2},
+		{Action: "edit", TaskID: 10},
+		{Action: "claim", TaskID: 1},
+	}
+
+	wc := BuildWorkContext(cfg, picked, tasks, log, 2)
+
+	if len(wc.Dependencies) != 3 {
+		t.Fatalf("dependencies = %d, want 3", len(wc.Dependencies))
+	}
+	if !wc.Dependencies[0].Done || wc.Dependencies[1].Done {
+		t.Errorf("done flags = %v, %v, want true, false", wc.Dependencies[0].Done, wc.Dependencies[1].Done)
+	}
+	if wc.Dependencies[1].ClaimedBy != "bot" {
+		t.Errorf("dependency #3 claimed by %q, want bot", wc.Dependencies[1].ClaimedBy)
+	}
+	if !wc.Dependencies[2].Missing {
+		t.Error("dependency #99 should be missing")
+	}
+	if wc.Parent == nil || wc.Parent.Title != "Epic" {
+		t.Errorf("parent = %+v, want Epic", wc.Parent)
+	}
+	if len(wc.RecentLog) != 2 || wc.RecentLog[0].TaskID != 10 || wc.RecentLog[1].TaskID != 1 {
+		t.Errorf("recent log = %+v, want the last two related entries (#10, #1)", wc.RecentLog)
+	}
+}
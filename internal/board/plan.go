@@ -0,0 +1,183 @@
+package board
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+var errBadCapacity = errors.New("expected name=hours per day, e.g. alice=6h/d,bob=30h/w")
+
+// ParseCapacity parses a capacity spec such as "alice=6h/d,bob=30h/w" into
+// working hours per day for each person. Amounts use estimate syntax and may
+// be suffixed with /d (per day, the default) or /w (per week of
+// task.EstimateDaysPerWeek days).
+func ParseCapacity(s string, hoursPerDay float64) (map[string]float64, error) {
+	capacity := make(map[string]float64)
+	for _, part := range strings.Split(s, ",") {
+		name, amount, ok := strings.Cut(strings.TrimSpace(part), "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, errBadCapacity
+		}
+		amount = strings.ToLower(strings.TrimSpace(amount))
+		perWeek := false
+		if a, found := strings.CutSuffix(amount, "/w"); found {
+			amount, perWeek = a, true
+		} else {
+			amount = strings.TrimSuffix(amount, "/d")
+		}
+		h, err := task.ParseEstimateWith(amount, hoursPerDay)
+		if err != nil || h <= 0 {
+			return nil, errBadCapacity
+		}
+		if perWeek {
+			h /= task.EstimateDaysPerWeek
+		}
+		capacity[name] = h
+	}
+	return capacity, nil
+}
+
+// Plan is the result of matching remaining work against team capacity.
+type Plan struct {
+	From        date.Date    `json:"from"`
+	Until       date.Date    `json:"until"`
+	WorkingDays int          `json:"working_days"`
+	People      []PlanPerson `json:"people"`
+	Fits        []PlanItem   `json:"fits"`
+	Slips       []PlanItem   `json:"slips"`
+	Unestimated []int        `json:"unestimated,omitempty"`
+}
+
+// PlanPerson is one person's capacity and load over the planning window.
+// Assigned is the estimate of all ready work already assigned to them; they
+// are overloaded when it exceeds their capacity.
+type PlanPerson struct {
+	Name        string  `json:"name"`
+	HoursPerDay float64 `json:"hours_per_day"`
+	Capacity    float64 `json:"capacity_hours"`
+	Planned     float64 `json:"planned_hours"`
+	Assigned    float64 `json:"assigned_hours"`
+	Overloaded  bool    `json:"overloaded"`
+}
+
+// PlanItem is a task placed in (or slipping out of) the plan. Suggested is
+// set when an unassigned task was given to the person with the most spare
+// capacity.
+type PlanItem struct {
+	ID        int     `json:"id"`
+	Title     string  `json:"title"`
+	Priority  string  `json:"priority"`
+	Assignee  string  `json:"assignee,omitempty"`
+	Suggested bool    `json:"suggested,omitempty"`
+	Hours     float64 `json:"hours"`
+	Reason    string  `json:"reason,omitempty"`
+}
+
+// ComputePlan walks ready tasks (non-terminal, not blocked, dependencies
+// satisfied) in pick order and fills each person's capacity over the working
+// days of the board calendar between from and until inclusive. Assigned
+// tasks use their assignee's capacity; unassigned tasks go to whoever has the
+// most capacity left. Tasks that do not fit slip; tasks without a parseable
+// estimate are listed as unestimated and not planned.
+func ComputePlan(cfg *config.Config, tasks []*task.Task, capacity map[string]float64,
+	from, until date.Date,
+) Plan {
+	plan := Plan{
+		From:        from,
+		Until:       until,
+		WorkingDays: WorkingDays(cfg, from, until),
+		Fits:        []PlanItem{},
+		Slips:       []PlanItem{},
+	}
+
+	people := make(map[string]*PlanPerson, len(capacity))
+	names := make([]string, 0, len(capacity))
+	for name, perDay := range capacity {
+		people[name] = &PlanPerson{Name: name, HoursPerDay: perDay, Capacity: perDay * float64(plan.WorkingDays)}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hoursPerDay := cfg.EstimateHoursPerDay()
+	for _, t := range readyTasks(cfg, tasks) {
+		h, ok := t.EstimateHoursWith(hoursPerDay)
+		if !ok {
+			plan.Unestimated = append(plan.Unestimated, t.ID)
+			continue
+		}
+		item := PlanItem{ID: t.ID, Title: t.Title, Priority: t.Priority, Assignee: t.Assignee, Hours: h}
+
+		var p *PlanPerson
+		if t.Assignee != "" {
+			p = people[t.Assignee]
+			if p == nil {
+				item.Reason = "no capacity for " + t.Assignee
+				plan.Slips = append(plan.Slips, item)
+				continue
+			}
+			p.Assigned += h
+		} else {
+			p = mostAvailable(people, names)
+			if p == nil {
+				item.Reason = "no capacity"
+				plan.Slips = append(plan.Slips, item)
+				continue
+			}
+			item.Assignee, item.Suggested = p.Name, true
+		}
+
+		if p.Capacity-p.Planned < h {
+			item.Reason = "exceeds " + p.Name + "'s remaining capacity"
+			if item.Suggested {
+				item.Assignee, item.Suggested, item.Reason = "", false, "exceeds remaining capacity"
+			}
+			plan.Slips = append(plan.Slips, item)
+			continue
+		}
+		p.Planned += h
+		plan.Fits = append(plan.Fits, item)
+	}
+
+	plan.People = make([]PlanPerson, 0, len(names))
+	for _, name := range names {
+		p := people[name]
+		p.Overloaded = p.Assigned > p.Capacity
+		plan.People = append(plan.People, *p)
+	}
+	sort.Ints(plan.Unestimated)
+	return plan
+}
+
+// readyTasks returns non-terminal, unblocked tasks whose dependencies are
+// satisfied, sorted in pick order.
+func readyTasks(cfg *config.Config, tasks []*task.Task) []*task.Task {
+	var candidates []*task.Task
+	for _, t := range tasks {
+		if !cfg.IsTerminalStatus(t.Status) && !t.Blocked {
+			candidates = append(candidates, t)
+		}
+	}
+	candidates = filterPickDeps(cfg, tasks, candidates)
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].ID < candidates[j].ID })
+	sortPickCandidates(candidates, cfg)
+	return candidates
+}
+
+// mostAvailable returns the person with the most remaining capacity,
+// breaking ties by name, or nil if there is nobody.
+func mostAvailable(people map[string]*PlanPerson, names []string) *PlanPerson {
+	var best *PlanPerson
+	for _, name := range names {
+		p := people[name]
+		if best == nil || p.Capacity-p.Planned > best.Capacity-best.Planned {
+			best = p
+		}
+	}
+	return best
+}
@@ -0,0 +1,80 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestParseCapacity(t *testing.T) {
+	got, err := ParseCapacity("alice=6h/d, bob=30h/w,carol=1d", 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]float64{"alice": 6, "bob": 6, "carol": 8}
+	for name, h := range want {
+		if got[name] != h {
+			t.Errorf("capacity[%s] = %v, want %v", name, got[name], h)
+		}
+	}
+
+	for _, bad := range []string{"", "alice", "=6h", "alice=lots", "alice=0h"} {
+		if _, err := ParseCapacity(bad, 8); err == nil {
+			t.Errorf("ParseCapacity(%q) expected error", bad)
+		}
+	}
+}
+
+func TestComputePlan(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "Urgent", Status: "todo", Priority: "critical", Assignee: "alice", Estimate: "1d"},
+		{ID: 2, Title: "Big", Status: "todo", Priority: "high", Assignee: "alice", Estimate: "1w"},
+		{ID: 3, Title: "Open", Status: "backlog", Priority: "medium", Estimate: "4h"},
+		{ID: 4, Title: "Waiting", Status: "todo", Priority: "critical", Estimate: "1h", DependsOn: []int{3}},
+		{ID: 5, Title: "Held", Status: "todo", Priority: "critical", Estimate: "1h", Blocked: true},
+		{ID: 6, Title: "Vague", Status: "todo", Priority: "low"},
+		{ID: 7, Title: "Stranger", Status: "todo", Priority: "low", Assignee: "zed", Estimate: "1h"},
+	}
+	// Monday through Tuesday: two working days.
+	from := date.New(2026, time.March, 2)
+	until := date.New(2026, time.March, 3)
+
+	p := ComputePlan(cfg, tasks, map[string]float64{"alice": 6, "bob": 4}, from, until)
+
+	if p.WorkingDays != 2 {
+		t.Errorf("WorkingDays = %d, want 2", p.WorkingDays)
+	}
+	fits := map[int]PlanItem{}
+	for _, it := range p.Fits {
+		fits[it.ID] = it
+	}
+	if _, ok := fits[1]; !ok || len(p.Fits) != 2 {
+		t.Errorf("fits = %+v, want #1 and #3", p.Fits)
+	}
+	if it := fits[3]; it.Assignee != "bob" || !it.Suggested {
+		t.Errorf("#3 = %+v, want suggested for bob", it)
+	}
+
+	slips := map[int]string{}
+	for _, it := range p.Slips {
+		slips[it.ID] = it.Reason
+	}
+	if slips[2] == "" || slips[7] != "no capacity for zed" || len(slips) != 2 {
+		t.Errorf("slips = %+v, want #2 and #7", p.Slips)
+	}
+	if len(p.Unestimated) != 1 || p.Unestimated[0] != 6 {
+		t.Errorf("unestimated = %v, want [6]", p.Unestimated)
+	}
+
+	alice := p.People[0]
+	if alice.Name != "alice" || alice.Capacity != 12 || alice.Planned != 8 || alice.Assigned != 48 || !alice.Overloaded {
+		t.Errorf("alice = %+v", alice)
+	}
+	if bob := p.People[1]; bob.Overloaded || bob.Planned != 4 {
+		t.Errorf("bob = %+v", bob)
+	}
+}
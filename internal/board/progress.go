@@ -0,0 +1,58 @@
+package board
+
+import (
+	"strconv"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// ChildProgress summarizes how far along a parent task's children are.
+// Archived children are not counted.
+type ChildProgress struct {
+	Total          int     `json:"total"`
+	Done           int     `json:"done"`
+	RemainingHours float64 `json:"remaining_hours"`
+	Unestimated    int     `json:"unestimated,omitempty"`
+	Incomplete     []int   `json:"incomplete,omitempty"`
+}
+
+// ComputeChildProgress rolls up the children of parentID. Children in a
+// terminal status count as done; the estimates of the others make up the
+// remaining work. Returns nil if the task has no (non-archived) children.
+func ComputeChildProgress(cfg *config.Config, tasks []*task.Task, parentID int) *ChildProgress {
+	var p ChildProgress
+	for _, t := range tasks {
+		if t.Parent == nil || *t.Parent != parentID || cfg.IsArchivedStatus(t.Status) {
+			continue
+		}
+		p.Total++
+		if cfg.IsTerminalStatus(t.Status) {
+			p.Done++
+			continue
+		}
+		p.Incomplete = append(p.Incomplete, t.ID)
+		if h, ok := t.EstimateHoursWith(cfg.EstimateHoursPerDay()); ok {
+			p.RemainingHours += h
+		} else {
+			p.Unestimated++
+		}
+	}
+	if p.Total == 0 {
+		return nil
+	}
+	return &p
+}
+
+// String renders the rollup as "3/5 done, 12h remaining", noting children
+// whose remaining work has no estimate.
+func (p *ChildProgress) String() string {
+	s := strconv.Itoa(p.Done) + "/" + strconv.Itoa(p.Total) + " done"
+	if p.Done < p.Total {
+		s += ", " + strconv.FormatFloat(p.RemainingHours, 'f', -1, 64) + "h remaining"
+	}
+	if p.Unestimated > 0 {
+		s += " (" + strconv.Itoa(p.Unestimated) + " unestimated)"
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+package board
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestComputeChildProgress(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	parent := 1
+	tasks := []*task.Task{
+		{ID: 1, Title: "Epic", Status: "in-progress"},
+		{ID: 2, Status: "done", Parent: &parent, Estimate: "1d"},
+		{ID: 3, Status: "in-progress", Parent: &parent, Estimate: "4h"},
+		{ID: 4, Status: "todo", Parent: &parent, Estimate: "1d"},
+		{ID: 5, Status: "todo", Parent: &parent},
+		{ID: 6, Status: "archived", Parent: &parent, Estimate: "1w"},
+		{ID: 7, Status: "todo", Estimate: "1w"},
+	}
+
+	p := ComputeChildProgress(cfg, tasks, 1)
+	if p == nil {
+		t.Fatal("expected progress, got nil")
+	}
+	if p.Total != 4 || p.Done != 1 {
+		t.Errorf("Done/Total = %d/%d, want 1/4", p.Done, p.Total)
+	}
+	if p.RemainingHours != 12 {
+		t.Errorf("RemainingHours = %v, want 12", p.RemainingHours)
+	}
+	if p.Unestimated != 1 {
+		t.Errorf("Unestimated = %d, want 1", p.Unestimated)
+	}
+	if want := []int{3, 4, 5}; !slices.Equal(p.Incomplete, want) {
+		t.Errorf("Incomplete = %v, want %v", p.Incomplete, want)
+	}
+	if got, want := p.String(), "1/4 done, 12h remaining (1 unestimated)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestComputeChildProgressNoChildren(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{{ID: 1, Status: "todo"}}
+	if p := ComputeChildProgress(cfg, tasks, 1); p != nil {
+		t.Errorf("got %+v, want nil", p)
+	}
+}
+
+func TestChildProgressStringAllDone(t *testing.T) {
+	p := &ChildProgress{Total: 2, Done: 2}
+	if got := p.String(); got != "2/2 done" {
+		t.Errorf("String() = %q, want %q", got, "2/2 done")
+	}
+}
@@ -0,0 +1,91 @@
+package board
+
+import (
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// readyRule answers "can I start this?" the same way for list --ready,
+// pick, and next: a task is ready when it waits in a pull-from status, is
+// not blocked by hand or by a dependency, is unclaimed or its claim has
+// expired, and the target column has room under its WIP limit.
+type readyRule struct {
+	cfg          *config.Config
+	statuses     []string
+	claimTimeout time.Duration
+	statusByID   map[int]string
+	target       string
+	counts       map[string]int
+}
+
+// newReadyRule resolves opts against the board: empty Statuses means
+// cfg.ReadyPullFrom() and an empty Target means ready.target. Dependencies
+// and WIP counts are looked up in allTasks.
+func newReadyRule(cfg *config.Config, allTasks []*task.Task, opts PickOptions) readyRule {
+	r := readyRule{
+		cfg:          cfg,
+		statuses:     opts.Statuses,
+		claimTimeout: opts.ClaimTimeout,
+		target:       opts.Target,
+	}
+	if len(r.statuses) == 0 {
+		r.statuses = cfg.ReadyPullFrom()
+	}
+	if r.target == "" {
+		r.target = cfg.Ready.Target
+	}
+	if len(cfg.StatusNames()) > 0 {
+		r.statusByID = make(map[int]string, len(allTasks))
+		for _, t := range allTasks {
+			r.statusByID[t.ID] = t.Status
+		}
+	}
+	if r.target != "" {
+		r.counts = CountByStatus(allTasks)
+	}
+	return r
+}
+
+func (r readyRule) ready(t *task.Task) bool {
+	if !containsStr(r.statuses, t.Status) || t.Blocked || !IsUnclaimed(t, r.claimTimeout) {
+		return false
+	}
+	if r.statusByID != nil && !allDepsSatisfied(t.DependsOn, r.statusByID, r.cfg) {
+		return false
+	}
+	return r.target == "" || CheckWIPLimit(r.cfg, r.counts, r.target, t.Status) == nil
+}
+
+// MarkReady sets Ready on each task in tasks, resolving dependencies and
+// WIP counts against allTasks. See PickOptions for opts; Tags and Strategy
+// are ignored.
+func MarkReady(cfg *config.Config, tasks, allTasks []*task.Task, opts PickOptions) {
+	rule := newReadyRule(cfg, allTasks, opts)
+	for _, t := range tasks {
+		t.Ready = rule.ready(t)
+	}
+}
+
+// filterReady keeps the tasks MarkReady marked ready.
+func filterReady(tasks []*task.Task) []*task.Task {
+	var result []*task.Task
+	for _, t := range tasks {
+		if t.Ready {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// FullReadyTarget returns the ready target column — opts.Target, or
+// ready.target — when it is at its WIP limit, so that no task outside it is
+// ready. It returns "" otherwise.
+func FullReadyTarget(cfg *config.Config, allTasks []*task.Task, opts PickOptions) string {
+	rule := newReadyRule(cfg, allTasks, opts)
+	if rule.target == "" || CheckWIPLimit(cfg, rule.counts, rule.target, "") == nil {
+		return ""
+	}
+	return rule.target
+}
@@ -0,0 +1,60 @@
+package board
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func readyIDs(tasks []*task.Task) []int {
+	var ids []int
+	for _, t := range tasks {
+		if t.Ready {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids
+}
+
+func TestMarkReady(t *testing.T) {
+	cfg := newPickTestConfig()
+	cfg.WIPLimits = map[string]int{"in-progress": 1}
+	old := time.Now().Add(-2 * time.Hour)
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo"},
+		{ID: 2, Status: "backlog"},
+		{ID: 3, Status: "todo", Blocked: true},
+		{ID: 4, Status: "todo", DependsOn: []int{2}},
+		{ID: 5, Status: "todo", ClaimedBy: "a", ClaimedAt: &old},
+		{ID: 6, Status: "done"},
+		{ID: 7, Status: "in-progress"},
+	}
+	opts := PickOptions{ClaimTimeout: time.Hour}
+
+	// Defaults: every non-terminal status, no target column.
+	MarkReady(cfg, tasks, tasks, opts)
+	if got := readyIDs(tasks); !slices.Equal(got, []int{1, 2, 5, 7}) {
+		t.Errorf("ready = %v, want [1 2 5 7]", got)
+	}
+
+	// A full target column leaves only the tasks already in it ready.
+	cfg.Ready.PullFrom = []string{"todo", "in-progress"}
+	cfg.Ready.Target = "in-progress"
+	MarkReady(cfg, tasks, tasks, opts)
+	if got := readyIDs(tasks); !slices.Equal(got, []int{7}) {
+		t.Errorf("ready with a full target = %v, want [7]", got)
+	}
+	if full := FullReadyTarget(cfg, tasks, opts); full != "in-progress" {
+		t.Errorf("FullReadyTarget = %q, want in-progress", full)
+	}
+
+	cfg.WIPLimits["in-progress"] = 2
+	if picked := Pick(cfg, tasks, opts); picked == nil || picked.ID != 1 {
+		t.Errorf("Pick() = %v, want task #1", picked)
+	}
+	if full := FullReadyTarget(cfg, tasks, opts); full != "" {
+		t.Errorf("FullReadyTarget = %q, want none", full)
+	}
+}
@@ -0,0 +1,149 @@
+package board
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Redactor masks sensitive task text according to the board's redact
+// config. It is applied to output that leaves the board, such as context
+// files, so secrets in task bodies are not copied elsewhere.
+type Redactor struct {
+	patterns []*regexp.Regexp
+	fields   []string
+}
+
+// NewRedactor compiles the redact patterns of cfg.
+func NewRedactor(cfg *config.Config) (*Redactor, error) {
+	r := &Redactor{fields: cfg.Redact.Fields}
+	for _, p := range cfg.Redact.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("redact pattern %q: %w", p, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// Enabled reports whether any patterns or fields are configured.
+func (r *Redactor) Enabled() bool {
+	return len(r.patterns) > 0 || len(r.fields) > 0
+}
+
+// Text replaces every pattern match in s with config.RedactedText.
+func (r *Redactor) Text(s string) string {
+	for _, re := range r.patterns {
+		s = re.ReplaceAllLiteralString(s, config.RedactedText)
+	}
+	return s
+}
+
+// Tasks returns redacted copies of tasks. The originals are not modified,
+// and the copies must never be written back.
+func (r *Redactor) Tasks(tasks []*task.Task) []*task.Task {
+	if !r.Enabled() {
+		return tasks
+	}
+	out := make([]*task.Task, len(tasks))
+	for i, t := range tasks {
+		out[i] = r.Task(t)
+	}
+	return out
+}
+
+// Task returns a redacted copy of t: fields named in redact.fields are
+// replaced whole, and pattern matches are masked in the remaining text.
+func (r *Redactor) Task(t *task.Task) *task.Task {
+	c := *t
+	c.Title = r.field("title", c.Title)
+	c.Body = r.field("body", c.Body)
+	c.Assignee = r.field("assignee", c.Assignee)
+	c.Reviewer = r.field("reviewer", c.Reviewer)
+	c.ClaimedBy = r.field("claimed_by", c.ClaimedBy)
+	c.BlockReason = r.field("block_reason", c.BlockReason)
+	c.BlockedOn = r.field("blocked_on", c.BlockedOn)
+	c.Branch = r.field("branch", c.Branch)
+	c.Worktree = r.field("worktree", c.Worktree)
+	// The file name carries a slug of the title.
+	if slices.Contains(r.fields, "title") {
+		c.File = ""
+	} else {
+		c.File = r.Text(c.File)
+	}
+	if len(c.Tags) > 0 {
+		tags := make([]string, len(c.Tags))
+		for i, tag := range c.Tags {
+			tags[i] = r.field("tags", tag)
+		}
+		c.Tags = tags
+	}
+	return &c
+}
+
+// Message masks a free-form message, such as a warning, that may quote
+// task fields: the values of redacted fields of tasks are replaced whole,
+// and pattern matches are masked in the rest.
+func (r *Redactor) Message(s string, tasks []*task.Task) string {
+	if len(r.fields) > 0 {
+		var pairs []string
+		for _, t := range tasks {
+			for _, v := range r.fieldValues(t) {
+				if v != "" {
+					pairs = append(pairs, v, config.RedactedText)
+				}
+			}
+		}
+		if len(pairs) > 0 {
+			s = strings.NewReplacer(pairs...).Replace(s)
+		}
+	}
+	return r.Text(s)
+}
+
+// fieldValues returns the values of t in the redacted fields.
+func (r *Redactor) fieldValues(t *task.Task) []string {
+	var values []string
+	for _, name := range r.fields {
+		switch name {
+		case "title":
+			values = append(values, t.Title)
+		case "body":
+			values = append(values, t.Body)
+		case "assignee":
+			values = append(values, t.Assignee)
+		case "reviewer":
+			values = append(values, t.Reviewer)
+		case "claimed_by":
+			values = append(values, t.ClaimedBy)
+		case "block_reason":
+			values = append(values, t.BlockReason)
+		case "blocked_on":
+			values = append(values, t.BlockedOn)
+		case "tags":
+			values = append(values, t.Tags...)
+		case "branch":
+			values = append(values, t.Branch)
+		case "worktree":
+			values = append(values, t.Worktree)
+		}
+	}
+	return values
+}
+
+// field masks value whole if name is a redacted field, or masks pattern
+// matches in it otherwise. Empty values stay empty.
+func (r *Redactor) field(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	if slices.Contains(r.fields, name) {
+		return config.RedactedText
+	}
+	return r.Text(value)
+}
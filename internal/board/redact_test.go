@@ -0,0 +1,92 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestRedactorTask(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Redact.Patterns = []string{`sk-[A-Za-z0-9]{8,}`, `(?i)acme`}
+	cfg.Redact.Fields = []string{"assignee"}
+	r, err := NewRedactor(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orig := &task.Task{
+		ID:          1,
+		Title:       "Call ACME about renewal",
+		Body:        "key: sk-abcdef123456\n",
+		Assignee:    "alice",
+		BlockReason: "waiting on Acme legal",
+		Tags:        []string{"acme", "sales"},
+		File:        "/board/tasks/001-call-acme-about-renewal.md",
+	}
+	got := r.Task(orig)
+
+	if got.Title != "Call [redacted] about renewal" {
+		t.Errorf("Title = %q", got.Title)
+	}
+	if got.Body != "key: [redacted]\n" {
+		t.Errorf("Body = %q", got.Body)
+	}
+	if got.Assignee != config.RedactedText {
+		t.Errorf("Assignee = %q, want %q", got.Assignee, config.RedactedText)
+	}
+	if got.BlockReason != "waiting on [redacted] legal" {
+		t.Errorf("BlockReason = %q", got.BlockReason)
+	}
+	if got.Tags[0] != config.RedactedText || got.Tags[1] != "sales" {
+		t.Errorf("Tags = %v", got.Tags)
+	}
+	if got.File != "/board/tasks/001-call-[redacted]-about-renewal.md" {
+		t.Errorf("File = %q", got.File)
+	}
+	if orig.Title != "Call ACME about renewal" || orig.Tags[0] != "acme" {
+		t.Errorf("original task modified: %+v", orig)
+	}
+}
+
+func TestRedactorDisabled(t *testing.T) {
+	r, err := NewRedactor(newTestConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Enabled() {
+		t.Error("Enabled() = true without redact config")
+	}
+	tasks := []*task.Task{{ID: 1, Title: "ACME"}}
+	if got := r.Tasks(tasks); got[0] != tasks[0] {
+		t.Error("Tasks should return the input unchanged when disabled")
+	}
+}
+
+func TestRedactorEmptyFieldStaysEmpty(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Redact.Fields = []string{"assignee"}
+	r, err := NewRedactor(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Task(&task.Task{ID: 1}); got.Assignee != "" {
+		t.Errorf("Assignee = %q, want empty", got.Assignee)
+	}
+}
+
+func TestRedactorMessage(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Redact.Patterns = []string{`sk-[A-Za-z0-9]{8,}`}
+	cfg.Redact.Fields = []string{"block_reason"}
+	r, err := NewRedactor(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks := []*task.Task{{ID: 1, BlockReason: "waiting on Globex"}}
+	got := r.Message("task #1 is blocked (waiting on Globex); key sk-abcdefgh12", tasks)
+	if want := "task #1 is blocked ([redacted]); key [redacted]"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+}
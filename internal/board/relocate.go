@@ -0,0 +1,105 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const relocateDirMode = 0o750
+
+// ErrDestinationNotEmpty is returned by MoveDir when dst already has
+// contents.
+var ErrDestinationNotEmpty = errors.New("destination is not empty")
+
+// Within reports whether path is dir or lies inside it. Both must be
+// absolute and clean.
+func Within(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// MoveDir moves directory src to dst, creating dst's parents. dst must not
+// exist or be an empty directory, and must not lie inside src. The move is a
+// rename when possible; across filesystems the tree is copied and src removed
+// only after the copy succeeds.
+func MoveDir(src, dst string) error {
+	if Within(dst, src) {
+		return fmt.Errorf("cannot move %s into itself", src)
+	}
+	entries, err := os.ReadDir(dst)
+	switch {
+	case err == nil && len(entries) > 0:
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, dst)
+	case err == nil:
+		if err := os.Remove(dst); err != nil {
+			return fmt.Errorf("replacing empty %s: %w", dst, err)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("checking %s: %w", dst, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), relocateDirMode); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(dst), err)
+	}
+
+	err = os.Rename(src, dst)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+	if err := copyTree(src, dst); err != nil {
+		_ = os.RemoveAll(dst)
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+// copyTree copies the directory tree at src to dst, keeping file modes.
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, mode fs.FileMode) error {
+	in, err := os.Open(src) //nolint:gosec // path comes from walking the board directory
+	if err != nil {
+		return err
+	}
+	defer in.Close() //nolint:errcheck // read-only file
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode) //nolint:gosec // destination chosen by the caller
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("copying %s: %w", src, err)
+	}
+	return out.Close()
+}
@@ -0,0 +1,91 @@
+package board
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithin(t *testing.T) {
+	tests := []struct {
+		path, dir string
+		want      bool
+	}{
+		{"/a/b", "/a/b", true},
+		{"/a/b/c", "/a/b", true},
+		{"/a/bc", "/a/b", false},
+		{"/a", "/a/b", false},
+		{"/a/..b", "/a", true},
+	}
+	for _, tt := range tests {
+		if got := Within(tt.path, tt.dir); got != tt.want {
+			t.Errorf("Within(%q, %q) = %v, want %v", tt.path, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestMoveDir(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "kanban")
+	if err := os.MkdirAll(filepath.Join(src, "tasks"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "tasks", "001-a.md"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	empty := filepath.Join(root, "new", "board")
+	if err := os.MkdirAll(empty, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MoveDir(src, empty); err != nil {
+		t.Fatalf("MoveDir: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(empty, "tasks", "001-a.md")); err != nil {
+		t.Errorf("task file not moved: %v", err)
+	}
+	if _, err := os.Stat(src); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("source still exists: %v", err)
+	}
+}
+
+func TestMoveDir_Refuses(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "kanban")
+	busy := filepath.Join(root, "busy")
+	for _, d := range []string{src, busy} {
+		if err := os.MkdirAll(d, 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(busy, "f"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MoveDir(src, busy); !errors.Is(err, ErrDestinationNotEmpty) {
+		t.Errorf("MoveDir to non-empty dir = %v, want ErrDestinationNotEmpty", err)
+	}
+	if err := MoveDir(src, filepath.Join(src, "sub")); err == nil {
+		t.Error("MoveDir into itself succeeded")
+	}
+}
+
+func TestCopyTree(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "src")
+	if err := os.MkdirAll(filepath.Join(src, "tasks"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "tasks", "001-a.md"), []byte("body"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(root, "dst")
+	if err := copyTree(src, dst); err != nil {
+		t.Fatalf("copyTree: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dst, "tasks", "001-a.md"))
+	if err != nil || string(data) != "body" {
+		t.Errorf("copied file = %q, %v", data, err)
+	}
+}
@@ -0,0 +1,134 @@
+package board
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// IDChange records a task whose ID changes when the board is renumbered.
+type IDChange struct {
+	Old int `json:"old"`
+	New int `json:"new"`
+}
+
+// PlanRenumber assigns contiguous IDs starting at 1 to tasks in their current
+// ID order and returns the IDs that change. Tasks that already have their new
+// ID are not listed.
+func PlanRenumber(tasks []*task.Task) []IDChange {
+	ids := make([]int, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	slices.Sort(ids)
+
+	var changes []IDChange
+	for i, id := range ids {
+		if id != i+1 {
+			changes = append(changes, IDChange{Old: id, New: i + 1})
+		}
+	}
+	return changes
+}
+
+// IDMapping turns a list of changes into an old-to-new ID map.
+func IDMapping(changes []IDChange) map[int]int {
+	m := make(map[int]int, len(changes))
+	for _, c := range changes {
+		m[c.Old] = c.New
+	}
+	return m
+}
+
+// ApplyRenumber rewrites task IDs, parents, and dependencies in place using
+// mapping, and reports which tasks changed. References to IDs that are not
+// in mapping are left as is.
+func ApplyRenumber(tasks []*task.Task, mapping map[int]int) []*task.Task {
+	var changed []*task.Task
+	for _, t := range tasks {
+		touched := false
+		if n, ok := mapping[t.ID]; ok {
+			t.ID = n
+			touched = true
+		}
+		if t.Parent != nil {
+			if n, ok := mapping[*t.Parent]; ok {
+				t.Parent = &n
+				touched = true
+			}
+		}
+		for i, d := range t.DependsOn {
+			if n, ok := mapping[d]; ok {
+				t.DependsOn[i] = n
+				touched = true
+			}
+		}
+		if touched {
+			changed = append(changed, t)
+		}
+	}
+	return changed
+}
+
+// RenumberSubscriptions rewrites the task IDs of subscriptions in place
+// using mapping, and reports whether any changed.
+func RenumberSubscriptions(subs []config.Subscription, mapping map[int]int) bool {
+	changed := false
+	for i, s := range subs {
+		if n, ok := mapping[s.Task]; ok {
+			subs[i].Task = n
+			changed = true
+		}
+	}
+	return changed
+}
+
+// RenumberLog rewrites the task IDs in the activity log using mapping. The
+// log is replaced atomically; lines that cannot be parsed are kept verbatim.
+func RenumberLog(kanbanDir string, mapping map[int]int) error {
+	path := filepath.Join(kanbanDir, logFileName)
+	f, err := os.Open(path) //nolint:gosec // log path from trusted kanban dir
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("opening log file: %w", err)
+	}
+
+	var out []byte
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var entry LogEntry
+		if json.Unmarshal(line, &entry) == nil {
+			if n, ok := mapping[entry.TaskID]; ok {
+				entry.TaskID = n
+				if data, mErr := json.Marshal(entry); mErr == nil {
+					line = data
+				}
+			}
+		}
+		out = append(out, line...)
+		out = append(out, '\n')
+	}
+	_ = f.Close()
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading log file: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, logFileMode); err != nil {
+		return fmt.Errorf("writing log file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("replacing log file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package board
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestPlanRenumber(t *testing.T) {
+	tasks := []*task.Task{{ID: 7}, {ID: 1}, {ID: 4}}
+	got := PlanRenumber(tasks)
+	want := []IDChange{{Old: 4, New: 2}, {Old: 7, New: 3}}
+	if len(got) != len(want) {
+		t.Fatalf("PlanRenumber = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if c := PlanRenumber([]*task.Task{{ID: 1}, {ID: 2}}); len(c) != 0 {
+		t.Errorf("contiguous board changes = %v, want none", c)
+	}
+}
+
+func TestApplyRenumber(t *testing.T) {
+	parent := 4
+	tasks := []*task.Task{
+		{ID: 1},
+		{ID: 4},
+		{ID: 7, Parent: &parent, DependsOn: []int{1, 4}},
+	}
+	changed := ApplyRenumber(tasks, map[int]int{4: 2, 7: 3})
+
+	if len(changed) != 2 {
+		t.Fatalf("changed %d tasks, want 2", len(changed))
+	}
+	if tasks[1].ID != 2 || tasks[2].ID != 3 {
+		t.Errorf("IDs = %d, %d; want 2, 3", tasks[1].ID, tasks[2].ID)
+	}
+	if *tasks[2].Parent != 2 {
+		t.Errorf("Parent = %d, want 2", *tasks[2].Parent)
+	}
+	if tasks[2].DependsOn[0] != 1 || tasks[2].DependsOn[1] != 2 {
+		t.Errorf("DependsOn = %v, want [1 2]", tasks[2].DependsOn)
+	}
+	if parent != 4 {
+		t.Error("ApplyRenumber must not write through a shared parent pointer")
+	}
+}
+
+func TestRenumberLog(t *testing.T) {
+	dir := t.TempDir()
+	for _, id := range []int{1, 4, 7} {
+		LogMutation(dir, "create", id, "task")
+	}
+	path := filepath.Join(dir, "activity.jsonl")
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // test file path
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("not json\n")
+	_ = f.Close()
+
+	if err := RenumberLog(dir, map[int]int{4: 2, 7: 3}); err != nil {
+		t.Fatalf("RenumberLog: %v", err)
+	}
+
+	entries, err := ReadLog(dir, LogFilterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int
+	for _, e := range entries {
+		ids = append(ids, e.TaskID)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("log task IDs = %v, want [1 2 3]", ids)
+	}
+	data, _ := os.ReadFile(path) //nolint:gosec // test file path
+	if !strings.Contains(string(data), "not json") {
+		t.Error("unparseable lines should be kept")
+	}
+}
+
+func TestRenumberLogMissingFile(t *testing.T) {
+	if err := RenumberLog(t.TempDir(), map[int]int{2: 1}); err != nil {
+		t.Errorf("RenumberLog without log = %v, want nil", err)
+	}
+}
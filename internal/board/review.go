@@ -0,0 +1,104 @@
+package board
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Review finding kinds, in the order Review reports them.
+const (
+	ReviewStale        = "stale"
+	ReviewExpiredClaim = "expired_claim"
+	ReviewOverdue      = "overdue"
+	ReviewArchive      = "archive"
+	ReviewWIP          = "wip"
+)
+
+// ReviewStaleTag is the tag the fix for a stale task adds.
+const ReviewStaleTag = "stale"
+
+// ReviewOptions sets the thresholds of a review.
+type ReviewOptions struct {
+	StaleAfter   time.Duration // idle time before an open task is stale
+	ArchiveAfter time.Duration // time since completion before a done task is archived
+}
+
+// ReviewItem is one finding of a review and the fix proposed for it. WIP
+// findings are about a column, not a task, and have no fix.
+type ReviewItem struct {
+	Kind   string `json:"kind"`
+	ID     int    `json:"id,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+// Review checks the board for what a periodic review tidies up: stale
+// tasks, expired claims, overdue tasks, done tasks ready to archive, and
+// columns over their WIP limit.
+func Review(cfg *config.Config, tasks []*task.Task, opts ReviewOptions, now time.Time) []ReviewItem {
+	var items []ReviewItem
+	for _, t := range FindStale(cfg, tasks, nil, opts.StaleAfter, now) {
+		items = append(items, reviewItem(ReviewStale, t,
+			fmt.Sprintf("idle %dd", int(now.Sub(t.Updated).Hours()/hoursPerDay)), "tag "+ReviewStaleTag))
+	}
+	timeout := cfg.ClaimTimeoutDuration()
+	for _, t := range tasks {
+		if !cfg.IsTerminalStatus(t.Status) && timeout > 0 && t.ClaimedBy != "" && t.ClaimedAt != nil &&
+			now.Sub(*t.ClaimedAt) > timeout {
+			items = append(items, reviewItem(ReviewExpiredClaim, t,
+				fmt.Sprintf("claimed by %s %s ago", t.ClaimedBy, now.Sub(*t.ClaimedAt).Truncate(time.Minute)), "release claim"))
+		}
+	}
+	postpone := ReviewPostponeDate(cfg, now)
+	for _, t := range tasks {
+		if !cfg.IsTerminalStatus(t.Status) && dueBucket(t, cfg, now) == "overdue" {
+			items = append(items, reviewItem(ReviewOverdue, t, "due "+t.Due.String(), "postpone due to "+postpone.String()))
+		}
+	}
+	for _, t := range tasks {
+		if done := completedAt(t); cfg.IsTerminalStatus(t.Status) && t.Status != config.ArchivedStatus &&
+			now.Sub(done) >= opts.ArchiveAfter {
+			items = append(items, reviewItem(ReviewArchive, t, "done since "+done.Format("2006-01-02"), "archive"))
+		}
+	}
+	return append(items, wipViolations(cfg, tasks)...)
+}
+
+// ReviewPostponeDate is the due date the fix for an overdue task sets: a
+// working week from now.
+func ReviewPostponeDate(cfg *config.Config, now time.Time) date.Date {
+	return AddWorkdays(cfg, date.New(now.Year(), now.Month(), now.Day()), dueWeekWorkdays)
+}
+
+func reviewItem(kind string, t *task.Task, detail, fix string) ReviewItem {
+	return ReviewItem{Kind: kind, ID: t.ID, Title: t.Title, Status: t.Status, Detail: detail, Fix: fix}
+}
+
+// completedAt is when t was completed, or its last update for tasks
+// finished before completion was recorded.
+func completedAt(t *task.Task) time.Time {
+	if t.Completed != nil {
+		return *t.Completed
+	}
+	return t.Updated
+}
+
+// wipViolations reports the columns holding more tasks than their WIP limit.
+func wipViolations(cfg *config.Config, tasks []*task.Task) []ReviewItem {
+	counts := CountByStatus(tasks)
+	var items []ReviewItem
+	for _, s := range cfg.StatusNames() {
+		if limit := cfg.WIPLimit(s); limit > 0 && counts[s] > limit {
+			items = append(items, ReviewItem{
+				Kind: ReviewWIP, Status: s, Detail: fmt.Sprintf("%d tasks over a WIP limit of %d", counts[s], limit),
+			})
+		}
+	}
+	return items
+}
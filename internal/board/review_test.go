@@ -0,0 +1,52 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestReview(t *testing.T) {
+	cfg := newPickTestConfig()
+	cfg.ClaimTimeout = "1h"
+	cfg.WIPLimits = map[string]int{"in-progress": 1}
+	cfg.Statuses = append(cfg.Statuses, config.StatusConfig{Name: config.ArchivedStatus})
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC) // a Wednesday
+	claimedAt := now.Add(-2 * time.Hour)
+	completed := now.AddDate(0, 0, -10)
+	overdue := date.New(2026, 3, 1)
+	tasks := []*task.Task{
+		{ID: 1, Title: "Idle", Status: "todo", Updated: now.AddDate(0, 0, -40)},
+		{ID: 2, Title: "Abandoned", Status: "in-progress", Updated: now, ClaimedBy: "bot", ClaimedAt: &claimedAt},
+		{ID: 3, Title: "Late", Status: "in-progress", Updated: now, Due: &overdue},
+		{ID: 4, Title: "Shipped", Status: "done", Updated: now, Completed: &completed},
+		{ID: 5, Title: "Just shipped", Status: "done", Updated: now, Completed: &now},
+		{ID: 6, Title: "Gone", Status: config.ArchivedStatus, Updated: completed, Completed: &completed},
+	}
+
+	items := Review(cfg, tasks, ReviewOptions{StaleAfter: 30 * 24 * time.Hour, ArchiveAfter: 7 * 24 * time.Hour}, now)
+	want := []struct {
+		kind, fix string
+		id        int
+	}{
+		{ReviewStale, "tag stale", 1},
+		{ReviewExpiredClaim, "release claim", 2},
+		{ReviewOverdue, "postpone due to 2026-03-11", 3},
+		{ReviewArchive, "archive", 4},
+		{ReviewWIP, "", 0},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("Review = %+v, want %d findings", items, len(want))
+	}
+	for i, w := range want {
+		if items[i].Kind != w.kind || items[i].ID != w.id || items[i].Fix != w.fix {
+			t.Errorf("finding %d = %+v, want %s on #%d fixed by %q", i, items[i], w.kind, w.id, w.fix)
+		}
+	}
+	if items[4].Status != "in-progress" || items[4].Detail != "2 tasks over a WIP limit of 1" {
+		t.Errorf("WIP finding = %+v", items[4])
+	}
+}
@@ -0,0 +1,343 @@
+package board
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Simulation strategies: the order in which queued tasks enter a column
+// when a WIP slot frees up.
+const (
+	SimulateFIFO     = "fifo"
+	SimulatePriority = "priority"
+)
+
+// SimulateStrategies lists the valid simulation strategies.
+var SimulateStrategies = []string{SimulateFIFO, SimulatePriority}
+
+const hoursPerWeek = 7 * hoursPerDay
+
+// SimulateOptions configures a WIP limit simulation.
+type SimulateOptions struct {
+	From, To time.Time
+	// Limits maps the statuses to simulate to their hypothetical WIP
+	// limits; 0 means unlimited.
+	Limits   map[string]int
+	Strategy string
+}
+
+// Simulation compares the flow the activity log recorded over a period
+// with the flow predicted under hypothetical WIP limits.
+type Simulation struct {
+	From      time.Time         `json:"from"`
+	To        time.Time         `json:"to"`
+	Strategy  string            `json:"strategy"`
+	Completed int               `json:"completed"`
+	Columns   []SimulatedColumn `json:"columns"`
+	Actual    SimulatedFlow     `json:"actual"`
+	Predicted SimulatedFlow     `json:"predicted"`
+}
+
+// SimulatedColumn compares one column's actual and predicted load. Times
+// are averaged over the visits to the column that began and ended in the
+// period.
+type SimulatedColumn struct {
+	Status            string  `json:"status"`
+	CurrentLimit      int     `json:"current_limit"`
+	Limit             int     `json:"limit"`
+	Visits            int     `json:"visits"`
+	ActualAvgWIP      float64 `json:"actual_avg_wip"`
+	PredictedAvgWIP   float64 `json:"predicted_avg_wip"`
+	ActualAvgHours    float64 `json:"actual_avg_hours"`
+	PredictedAvgHours float64 `json:"predicted_avg_hours"`
+	// PredictedAvgWaitHours is the time a task would spend queued for a
+	// slot before entering the column.
+	PredictedAvgWaitHours float64 `json:"predicted_avg_wait_hours"`
+}
+
+// SimulatedFlow is the board-wide outcome of a period.
+type SimulatedFlow struct {
+	AvgCycleTimeHours *float64 `json:"avg_cycle_time_hours,omitempty"`
+	ThroughputPerWeek float64  `json:"throughput_per_week"`
+}
+
+// visit is a task's stay in a column, as logged and as simulated.
+type visit struct {
+	taskID   int
+	priority int
+	enter    time.Time
+	leave    time.Time
+	// open visits have not ended; leave is the end of the period.
+	open bool
+	// work is the share of the column's capacity the visit consumed, in
+	// hours.
+	work float64
+	// start and end are when the visit would have begun (after queueing
+	// for a slot) and ended under the simulated limit.
+	start, end time.Time
+}
+
+// Simulate replays the activity log under hypothetical WIP limits.
+//
+// The model assumes a column's capacity is fixed and shared equally among
+// the tasks in it, so a task's work is the capacity it actually received.
+// Replaying the same arrivals with a limit queues tasks beyond it before
+// the column and shares the capacity among fewer. Columns are simulated
+// independently, and each task's completion moves by the change in when it
+// would have left them.
+func Simulate(cfg *config.Config, tasks []*task.Task, log []LogEntry, opts SimulateOptions) Simulation {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	entries := slices.Clone(log)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp.Before(entries[j].Timestamp) })
+
+	sim := Simulation{From: opts.From, To: opts.To, Strategy: opts.Strategy, Columns: []SimulatedColumn{}}
+	shift := make(map[int]time.Duration)
+	for _, status := range cfg.StatusNames() {
+		limit, ok := opts.Limits[status]
+		if !ok {
+			continue
+		}
+		visits := columnVisits(cfg, entries, status, byID, opts)
+		assignWork(visits)
+		replayColumn(visits, limit, opts.Strategy)
+		for _, v := range visits {
+			if !v.open {
+				shift[v.taskID] += v.end.Sub(v.leave)
+			}
+		}
+		col := summarizeColumn(visits, opts)
+		col.Status, col.Limit, col.CurrentLimit = status, limit, cfg.WIPLimit(status)
+		sim.Columns = append(sim.Columns, col)
+	}
+	sim.Completed, sim.Actual, sim.Predicted = summarizeFlow(tasks, shift, opts)
+	return sim
+}
+
+// columnVisits returns the logged visits to status that overlap the
+// period, in order of arrival. A task still in the column at the end of
+// the period has an open visit.
+func columnVisits(cfg *config.Config, entries []LogEntry, status string, byID map[int]*task.Task, opts SimulateOptions) []*visit {
+	current := make(map[int]*visit)
+	var all []*visit
+	for _, e := range entries {
+		from, to, ok := e.StatusChange()
+		if !ok || e.Timestamp.After(opts.To) {
+			continue
+		}
+		if v := current[e.TaskID]; v != nil && from == status {
+			v.leave = e.Timestamp
+			delete(current, e.TaskID)
+		}
+		if to == status && current[e.TaskID] == nil {
+			v := &visit{taskID: e.TaskID, enter: e.Timestamp}
+			current[e.TaskID] = v
+			all = append(all, v)
+		}
+	}
+	visits := make([]*visit, 0, len(all))
+	for _, v := range all {
+		t := byID[v.taskID]
+		if current[v.taskID] == v {
+			// Only a task that is still there has an open visit; others
+			// left without a logged move.
+			if t == nil || t.Status != status {
+				continue
+			}
+			v.leave, v.open = opts.To, true
+		}
+		if !v.leave.After(opts.From) {
+			continue
+		}
+		if t != nil {
+			v.priority = cfg.PriorityIndex(t.Priority)
+		}
+		visits = append(visits, v)
+	}
+	return visits
+}
+
+// assignWork sets each visit's work to the capacity it received: the time
+// it spent in the column, divided at each moment among the visits there.
+func assignWork(visits []*visit) {
+	var times []time.Time
+	for _, v := range visits {
+		times = append(times, v.enter, v.leave)
+	}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	times = slices.CompactFunc(times, time.Time.Equal)
+	for i := 1; i < len(times); i++ {
+		from, to := times[i-1], times[i]
+		var in []*visit
+		for _, v := range visits {
+			if !v.enter.After(from) && !v.leave.Before(to) {
+				in = append(in, v)
+			}
+		}
+		for _, v := range in {
+			v.work += to.Sub(from).Hours() / float64(len(in))
+		}
+	}
+}
+
+// replayColumn sets each visit's simulated start and end when at most
+// limit visits (0: any number) share the column and the rest queue in
+// strategy order.
+func replayColumn(visits []*visit, limit int, strategy string) {
+	if len(visits) == 0 {
+		return
+	}
+	const eps = 1e-9
+	t0 := visits[0].enter
+	at := func(h float64) time.Time { return t0.Add(time.Duration(h * float64(time.Hour))) }
+	remaining := make(map[*visit]float64, len(visits))
+	var active, queue []*visit
+	now, next := 0.0, 0
+	for next < len(visits) || len(active) > 0 || len(queue) > 0 {
+		for len(queue) > 0 && (limit == 0 || len(active) < limit) {
+			i := nextQueued(queue, strategy)
+			v := queue[i]
+			queue = slices.Delete(queue, i, i+1)
+			v.start = at(now)
+			remaining[v] = v.work
+			active = append(active, v)
+		}
+		arrival := math.Inf(1)
+		if next < len(visits) {
+			arrival = visits[next].enter.Sub(t0).Hours()
+		}
+		if len(active) == 0 {
+			now = arrival
+			queue, next = enqueueArrivals(queue, visits, next, t0, now)
+			continue
+		}
+		share := float64(len(active))
+		finish := now + share*minRemaining(active, remaining)
+		step := min(arrival, finish) - now
+		for _, v := range active {
+			remaining[v] -= step / share
+		}
+		now += step
+		if arrival < finish {
+			queue, next = enqueueArrivals(queue, visits, next, t0, now)
+			continue
+		}
+		active = slices.DeleteFunc(active, func(v *visit) bool {
+			if remaining[v] > eps {
+				return false
+			}
+			v.end = at(now)
+			return true
+		})
+	}
+}
+
+// enqueueArrivals queues the visits from next on that have arrived by now
+// (hours after t0), so simultaneous arrivals queue together.
+func enqueueArrivals(queue, visits []*visit, next int, t0 time.Time, now float64) ([]*visit, int) {
+	for next < len(visits) && visits[next].enter.Sub(t0).Hours() <= now {
+		queue = append(queue, visits[next])
+		next++
+	}
+	return queue, next
+}
+
+func minRemaining(active []*visit, remaining map[*visit]float64) float64 {
+	m := math.Inf(1)
+	for _, v := range active {
+		m = min(m, remaining[v])
+	}
+	return max(m, 0)
+}
+
+// nextQueued returns the index of the queued visit to admit next.
+func nextQueued(queue []*visit, strategy string) int {
+	best := 0
+	for i, v := range queue[1:] {
+		b := queue[best]
+		if strategy == SimulatePriority && v.priority != b.priority {
+			if v.priority > b.priority {
+				best = i + 1
+			}
+			continue
+		}
+		if v.enter.Before(b.enter) {
+			best = i + 1
+		}
+	}
+	return best
+}
+
+func summarizeColumn(visits []*visit, opts SimulateOptions) SimulatedColumn {
+	var col SimulatedColumn
+	period := opts.To.Sub(opts.From).Hours()
+	var actual, predicted, wait float64
+	for _, v := range visits {
+		col.ActualAvgWIP += overlapHours(v.enter, v.leave, opts.From, opts.To) / period
+		col.PredictedAvgWIP += overlapHours(v.start, v.end, opts.From, opts.To) / period
+		if v.open || v.enter.Before(opts.From) {
+			continue
+		}
+		col.Visits++
+		actual += v.leave.Sub(v.enter).Hours()
+		predicted += v.end.Sub(v.start).Hours()
+		wait += v.start.Sub(v.enter).Hours()
+	}
+	if col.Visits > 0 {
+		n := float64(col.Visits)
+		col.ActualAvgHours, col.PredictedAvgHours, col.PredictedAvgWaitHours = actual/n, predicted/n, wait/n
+	}
+	return col
+}
+
+func overlapHours(start, end, from, to time.Time) float64 {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	return max(end.Sub(start).Hours(), 0)
+}
+
+// summarizeFlow returns the number of tasks completed in the period and
+// the actual and predicted cycle time and throughput, moving each task's
+// completion by its shift.
+func summarizeFlow(tasks []*task.Task, shift map[int]time.Duration, opts SimulateOptions) (int, SimulatedFlow, SimulatedFlow) {
+	in := func(t time.Time) bool { return !t.Before(opts.From) && t.Before(opts.To) }
+	var completed, predictedCompleted, cycles int
+	var actualCycle, predictedCycle float64
+	for _, t := range tasks {
+		if t.Completed == nil {
+			continue
+		}
+		moved := t.Completed.Add(shift[t.ID])
+		if in(moved) {
+			predictedCompleted++
+		}
+		if !in(*t.Completed) {
+			continue
+		}
+		completed++
+		if t.Started != nil {
+			cycles++
+			actualCycle += t.Completed.Sub(*t.Started).Hours()
+			predictedCycle += moved.Sub(*t.Started).Hours()
+		}
+	}
+	weeks := opts.To.Sub(opts.From).Hours() / hoursPerWeek
+	actual := SimulatedFlow{ThroughputPerWeek: float64(completed) / weeks}
+	predicted := SimulatedFlow{ThroughputPerWeek: float64(predictedCompleted) / weeks}
+	if cycles > 0 {
+		a, p := actualCycle/float64(cycles), predictedCycle/float64(cycles)
+		actual.AvgCycleTimeHours, predicted.AvgCycleTimeHours = &a, &p
+	}
+	return completed, actual, predicted
+}
@@ -0,0 +1,106 @@
+package board
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// simulateBoard has four tasks that entered in-progress together and, each
+// getting a quarter of the column, all finished four days later.
+func simulateBoard() ([]*task.Task, []LogEntry, SimulateOptions) {
+	start := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	done := start.Add(4 * 24 * time.Hour)
+	priorities := []string{"low", "medium", "high", "critical"}
+	var tasks []*task.Task
+	var log []LogEntry
+	for i, p := range priorities {
+		id := i + 1
+		tasks = append(tasks, &task.Task{ID: id, Status: "done", Priority: p, Started: &start, Completed: &done})
+		log = append(log,
+			LogEntry{Timestamp: start, Action: "move", TaskID: id, Detail: "todo -> in-progress"},
+			LogEntry{Timestamp: done, Action: "move", TaskID: id, Detail: "in-progress -> done"})
+	}
+	opts := SimulateOptions{From: start.Add(-24 * time.Hour), To: start.Add(13 * 24 * time.Hour), Strategy: SimulateFIFO}
+	return tasks, log, opts
+}
+
+func approx(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+func TestSimulateUnlimitedReproducesHistory(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks, log, opts := simulateBoard()
+	opts.Limits = map[string]int{"in-progress": 0}
+
+	s := Simulate(cfg, tasks, log, opts)
+	if s.Completed != 4 || len(s.Columns) != 1 {
+		t.Fatalf("completed = %d, columns = %+v", s.Completed, s.Columns)
+	}
+	col := s.Columns[0]
+	if col.Visits != 4 || !approx(col.ActualAvgHours, 96) || !approx(col.PredictedAvgHours, 96) || col.PredictedAvgWaitHours != 0 {
+		t.Errorf("column = %+v, want 96h in column both ways", col)
+	}
+	if !approx(*s.Predicted.AvgCycleTimeHours, *s.Actual.AvgCycleTimeHours) || s.Predicted.ThroughputPerWeek != s.Actual.ThroughputPerWeek {
+		t.Errorf("actual %+v, predicted %+v; want equal", s.Actual, s.Predicted)
+	}
+}
+
+func TestSimulateLimitOne(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks, log, opts := simulateBoard()
+	opts.Limits = map[string]int{"in-progress": 1}
+
+	s := Simulate(cfg, tasks, log, opts)
+	col := s.Columns[0]
+	// One at a time, each task takes a day; they wait 0, 1, 2, and 3 days.
+	if !approx(col.PredictedAvgHours, 24) || !approx(col.PredictedAvgWaitHours, 36) {
+		t.Errorf("column = %+v, want 24h in column after 36h wait", col)
+	}
+	if !approx(col.ActualAvgWIP, 4*4.0/14) || !approx(col.PredictedAvgWIP, 4.0/14) {
+		t.Errorf("avg WIP actual %v predicted %v", col.ActualAvgWIP, col.PredictedAvgWIP)
+	}
+	if !approx(*s.Actual.AvgCycleTimeHours, 96) || !approx(*s.Predicted.AvgCycleTimeHours, 60) {
+		t.Errorf("cycle time actual %v predicted %v, want 96h and 60h", *s.Actual.AvgCycleTimeHours, *s.Predicted.AvgCycleTimeHours)
+	}
+}
+
+func TestReplayColumnStrategies(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks, log, opts := simulateBoard()
+	byID := map[int]*task.Task{}
+	for _, tk := range tasks {
+		byID[tk.ID] = tk
+	}
+
+	for strategy, first := range map[string]int{SimulateFIFO: 1, SimulatePriority: 4} {
+		visits := columnVisits(cfg, log, "in-progress", byID, opts)
+		assignWork(visits)
+		replayColumn(visits, 1, strategy)
+		for _, v := range visits {
+			if v.start.Equal(v.enter) != (v.taskID == first) {
+				t.Errorf("%s: task %d started at %v; want task %d first", strategy, v.taskID, v.start, first)
+			}
+		}
+	}
+}
+
+func TestSimulateOpenVisit(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks, log, opts := simulateBoard()
+	open := &task.Task{ID: 5, Status: "in-progress"}
+	tasks = append(tasks, open)
+	log = append(log, LogEntry{Timestamp: opts.From.Add(time.Hour), Action: "move", TaskID: 5, Detail: "todo -> in-progress"})
+	opts.Limits = map[string]int{"in-progress": 0}
+
+	s := Simulate(cfg, tasks, log, opts)
+	col := s.Columns[0]
+	if col.Visits != 4 {
+		t.Errorf("visits = %d, want only the 4 finished ones averaged", col.Visits)
+	}
+	if col.ActualAvgWIP <= 4*4.0/14 {
+		t.Errorf("avg WIP %v should count the open visit", col.ActualAvgWIP)
+	}
+}
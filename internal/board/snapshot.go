@@ -0,0 +1,112 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// lockFileName is the board lock, which must survive a restore untouched.
+const lockFileName = ".lock"
+
+// Snapshot holds the contents of every file under a set of directories so
+// they can be put back exactly as they were.
+type Snapshot struct {
+	roots []string
+	files map[string]snapshotFile
+}
+
+type snapshotFile struct {
+	data []byte
+	mode fs.FileMode
+}
+
+// TakeSnapshot reads every regular file under the given directories into
+// memory. Nested roots are only walked once.
+func TakeSnapshot(roots ...string) (*Snapshot, error) {
+	s := &Snapshot{files: make(map[string]snapshotFile)}
+	for _, root := range roots {
+		if s.covers(root) {
+			continue
+		}
+		s.roots = append(s.roots, root)
+		err := walkFiles(root, func(path string, info fs.FileInfo) error {
+			data, err := os.ReadFile(path) //nolint:gosec // path from walking the board directory
+			if err != nil {
+				return err
+			}
+			s.files[path] = snapshotFile{data: data, mode: info.Mode().Perm()}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("snapshotting %s: %w", root, err)
+		}
+	}
+	return s, nil
+}
+
+// Restore removes files created since the snapshot and rewrites every
+// snapshotted file with its original contents.
+func (s *Snapshot) Restore() error {
+	var errs []error
+	for _, root := range s.roots {
+		err := walkFiles(root, func(path string, _ fs.FileInfo) error {
+			if _, ok := s.files[path]; !ok {
+				return os.Remove(path)
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for path, f := range s.files {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil { //nolint:mnd // directory permissions
+			errs = append(errs, err)
+			continue
+		}
+		if err := os.WriteFile(path, f.data, f.mode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("restoring snapshot: %w", err)
+	}
+	return nil
+}
+
+// covers reports whether dir is inside a root already snapshotted.
+func (s *Snapshot) covers(dir string) bool {
+	for _, root := range s.roots {
+		rel, err := filepath.Rel(root, dir)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkFiles calls fn for each regular file under root except the lock file.
+// A missing root has no files.
+func walkFiles(root string, fn func(path string, info fs.FileInfo) error) error {
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() || d.Name() == lockFileName {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(path, info)
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package board
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	dir := t.TempDir()
+	tasksDir := filepath.Join(dir, "tasks")
+	if err := os.MkdirAll(tasksDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfgPath := filepath.Join(dir, "config.yml")
+	oldTask := filepath.Join(tasksDir, "001-a.md")
+	lock := filepath.Join(dir, lockFileName)
+	write(cfgPath, "next_id: 2\n")
+	write(oldTask, "original")
+	write(lock, "")
+
+	snap, err := TakeSnapshot(dir, tasksDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snap.roots) != 1 {
+		t.Errorf("roots = %v, want tasks dir folded into board dir", snap.roots)
+	}
+
+	// Change, add, rename, and delete files.
+	write(cfgPath, "next_id: 9\n")
+	newTask := filepath.Join(tasksDir, "002-b.md")
+	write(newTask, "new")
+	if err := os.Rename(oldTask, filepath.Join(tasksDir, "001-renamed.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := snap.Restore(); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, _ := os.ReadFile(cfgPath); string(data) != "next_id: 2\n" {
+		t.Errorf("config = %q, want original", data)
+	}
+	if data, _ := os.ReadFile(oldTask); string(data) != "original" {
+		t.Errorf("task = %q, want original", data)
+	}
+	for _, gone := range []string{newTask, filepath.Join(tasksDir, "001-renamed.md")} {
+		if _, err := os.Stat(gone); !os.IsNotExist(err) {
+			t.Errorf("%s should be removed on restore", filepath.Base(gone))
+		}
+	}
+	if _, err := os.Stat(lock); err != nil {
+		t.Errorf("lock file must survive restore: %v", err)
+	}
+}
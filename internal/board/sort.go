@@ -5,20 +5,33 @@ import (
 
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/timing"
 )
 
 // Sort sorts tasks by the given field. For status and priority,
-// the config order is used (not alphabetical).
+// the config order is used (not alphabetical). Ties are broken by ID, so
+// the order is the same on every run and pages of a listing are stable.
 func Sort(tasks []*task.Task, field string, reverse bool, cfg *config.Config) {
+	defer timing.Track(timing.Filter)()
 	sort.SliceStable(tasks, func(i, j int) bool {
-		less := compareTasks(tasks[i], tasks[j], field, cfg)
-		if reverse {
-			return !less
-		}
-		return less
+		return sortsBefore(tasks[i], tasks[j], field, reverse, cfg)
 	})
 }
 
+// sortsBefore reports whether a comes before b in the order Sort gives.
+func sortsBefore(a, b *task.Task, field string, reverse bool, cfg *config.Config) bool {
+	if reverse {
+		a, b = b, a
+	}
+	if compareTasks(a, b, field, cfg) {
+		return true
+	}
+	if compareTasks(b, a, field, cfg) {
+		return false
+	}
+	return a.ID < b.ID
+}
+
 func compareTasks(a, b *task.Task, field string, cfg *config.Config) bool {
 	switch field {
 	case "id":
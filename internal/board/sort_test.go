@@ -65,6 +65,22 @@ func TestSortByPriority(t *testing.T) {
 	}
 }
 
+func TestSortTiesByID(t *testing.T) {
+	tasks := []*task.Task{
+		{ID: 3, Priority: "high"},
+		{ID: 1, Priority: "high"},
+		{ID: 2, Priority: "low"},
+	}
+	Sort(tasks, "priority", false, testConfig())
+	if got := taskIDs(tasks); got != [3]int{2, 1, 3} {
+		t.Errorf("sort by priority = %v, want [2, 1, 3]", got)
+	}
+	Sort(tasks, "priority", true, testConfig())
+	if got := taskIDs(tasks); got != [3]int{3, 1, 2} {
+		t.Errorf("reverse sort by priority = %v, want [3, 1, 2]", got)
+	}
+}
+
 func TestSortByDue(t *testing.T) {
 	d1 := date.New(2026, time.February, 10)
 	d2 := date.New(2026, time.February, 20)
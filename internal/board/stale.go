@@ -0,0 +1,31 @@
+package board
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// FindStale returns tasks in statuses that have not been updated for at
+// least threshold, least recently updated first. Empty statuses means every
+// non-terminal status.
+func FindStale(cfg *config.Config, tasks []*task.Task, statuses []string, threshold time.Duration, now time.Time) []*task.Task {
+	if len(statuses) == 0 {
+		statuses = cfg.ActiveStatuses()
+	}
+	cutoff := now.Add(-threshold)
+
+	var stale []*task.Task
+	for _, t := range tasks {
+		if slices.Contains(statuses, t.Status) && !t.Updated.After(cutoff) {
+			stale = append(stale, t)
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].Updated.Before(stale[j].Updated)
+	})
+	return stale
+}
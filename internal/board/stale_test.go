@@ -0,0 +1,39 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestFindStale(t *testing.T) {
+	cfg := newPickTestConfig()
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -40)
+	older := now.AddDate(0, 0, -60)
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo", Updated: old},
+		{ID: 2, Status: "todo", Updated: now},
+		{ID: 3, Status: "done", Updated: older},
+		{ID: 4, Status: "in-progress", Updated: older},
+	}
+
+	stale := FindStale(cfg, tasks, nil, 30*24*time.Hour, now)
+	if len(stale) != 2 || stale[0].ID != 4 || stale[1].ID != 1 {
+		t.Errorf("FindStale = %v, want tasks 4 then 1", staleIDs(stale))
+	}
+
+	stale = FindStale(cfg, tasks, []string{"todo"}, 30*24*time.Hour, now)
+	if len(stale) != 1 || stale[0].ID != 1 {
+		t.Errorf("FindStale(todo) = %v, want task 1", staleIDs(stale))
+	}
+}
+
+func staleIDs(tasks []*task.Task) []int {
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+	return ids
+}
@@ -0,0 +1,66 @@
+package board
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Task change events.
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// TaskChange describes how a task differs between two reads of the board.
+// Old is nil for a created task and New is nil for a deleted one.
+type TaskChange struct {
+	TaskID int        `json:"task_id"`
+	Event  string     `json:"event"`
+	Old    *task.Task `json:"old"`
+	New    *task.Task `json:"new"`
+}
+
+// DiffTasks compares two reads of the board and returns the changes to the
+// tasks whose IDs are in ids, ordered by task ID. A task counts as updated
+// when any of its fields or its file path differ.
+func DiffTasks(before, after []*task.Task, ids map[int]bool) []TaskChange {
+	old := make(map[int]*task.Task, len(before))
+	for _, t := range before {
+		if ids[t.ID] {
+			old[t.ID] = t
+		}
+	}
+	cur := make(map[int]*task.Task, len(after))
+	for _, t := range after {
+		if ids[t.ID] {
+			cur[t.ID] = t
+		}
+	}
+
+	var changes []TaskChange
+	for id, n := range cur {
+		o, ok := old[id]
+		switch {
+		case !ok:
+			changes = append(changes, TaskChange{TaskID: id, Event: ChangeCreated, New: n})
+		case !sameTask(o, n):
+			changes = append(changes, TaskChange{TaskID: id, Event: ChangeUpdated, Old: o, New: n})
+		}
+	}
+	for id, o := range old {
+		if _, ok := cur[id]; !ok {
+			changes = append(changes, TaskChange{TaskID: id, Event: ChangeDeleted, Old: o})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].TaskID < changes[j].TaskID })
+	return changes
+}
+
+func sameTask(a, b *task.Task) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
@@ -0,0 +1,43 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestDiffTasks(t *testing.T) {
+	before := []*task.Task{
+		{ID: 1, Title: "Same", Status: "todo"},
+		{ID: 2, Title: "Moves", Status: "todo"},
+		{ID: 3, Title: "Deleted", Status: "todo"},
+		{ID: 4, Title: "Unwatched", Status: "todo"},
+	}
+	after := []*task.Task{
+		{ID: 1, Title: "Same", Status: "todo"},
+		{ID: 2, Title: "Moves", Status: "done"},
+		{ID: 4, Title: "Unwatched", Status: "done"},
+		{ID: 5, Title: "Created", Status: "todo"},
+	}
+	ids := map[int]bool{1: true, 2: true, 3: true, 5: true}
+
+	changes := DiffTasks(before, after, ids)
+	want := []struct {
+		id    int
+		event string
+	}{{2, ChangeUpdated}, {3, ChangeDeleted}, {5, ChangeCreated}}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i, w := range want {
+		if changes[i].TaskID != w.id || changes[i].Event != w.event {
+			t.Errorf("changes[%d] = #%d %s, want #%d %s", i, changes[i].TaskID, changes[i].Event, w.id, w.event)
+		}
+	}
+	if changes[0].Old.Status != "todo" || changes[0].New.Status != "done" {
+		t.Errorf("update old/new = %s/%s, want todo/done", changes[0].Old.Status, changes[0].New.Status)
+	}
+	if changes[1].New != nil || changes[2].Old != nil {
+		t.Error("deleted change should have no new task and created change no old task")
+	}
+}
@@ -0,0 +1,202 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// TreeNode is a task within the parent/child hierarchy.
+type TreeNode struct {
+	ID       int         `json:"id"`
+	Title    string      `json:"title"`
+	Status   string      `json:"status"`
+	Priority string      `json:"priority"`
+	Children []*TreeNode `json:"children,omitempty"`
+
+	// Display glyphs derived from the board configuration.
+	StatusGlyph   string `json:"-"`
+	PriorityGlyph string `json:"-"`
+}
+
+// Status glyphs: not started, in flight, finished.
+const (
+	glyphTodo  = "○"
+	glyphDoing = "◐"
+	glyphDone  = "●"
+)
+
+// Priority glyphs keyed by the default priority names.
+var priorityGlyphs = map[string]string{
+	"critical": "‼",
+	"high":     "↑",
+	"medium":   "·",
+	"low":      "↓",
+}
+
+// BuildTree arranges tasks into their parent/child hierarchy, ordered by ID.
+// With rootID 0 it returns every top-level task (no parent, or a parent that
+// is not among tasks); otherwise it returns the subtree rooted at rootID, or
+// nil if that task is not in tasks. Parent cycles are cut where they close.
+func BuildTree(cfg *config.Config, tasks []*task.Task, rootID int) []*TreeNode {
+	byID := make(map[int]*task.Task, len(tasks))
+	children := make(map[int][]*task.Task)
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	var roots []*task.Task
+	for _, t := range tasks {
+		if t.Parent != nil && byID[*t.Parent] != nil && *t.Parent != t.ID {
+			children[*t.Parent] = append(children[*t.Parent], t)
+		} else {
+			roots = append(roots, t)
+		}
+	}
+	for _, kids := range children {
+		sortByID(kids)
+	}
+
+	if rootID != 0 {
+		root, ok := byID[rootID]
+		if !ok {
+			return nil
+		}
+		roots = []*task.Task{root}
+	} else {
+		sortByID(roots)
+	}
+
+	visited := make(map[int]bool, len(tasks))
+	nodes := make([]*TreeNode, 0, len(roots))
+	for _, r := range roots {
+		nodes = append(nodes, buildTreeNode(cfg, r, children, visited))
+	}
+	if rootID == 0 {
+		// Tasks caught in a parent cycle have no top-level ancestor; list
+		// them as roots so nothing disappears from the full tree.
+		sortByID(tasks)
+		for _, t := range tasks {
+			if !visited[t.ID] {
+				nodes = append(nodes, buildTreeNode(cfg, t, children, visited))
+			}
+		}
+	}
+	return nodes
+}
+
+func buildTreeNode(cfg *config.Config, t *task.Task, children map[int][]*task.Task, visited map[int]bool) *TreeNode {
+	visited[t.ID] = true
+	node := &TreeNode{
+		ID:            t.ID,
+		Title:         t.Title,
+		Status:        t.Status,
+		Priority:      t.Priority,
+		StatusGlyph:   statusGlyph(cfg, t.Status),
+		PriorityGlyph: priorityGlyph(t.Priority),
+	}
+	for _, c := range children[t.ID] {
+		if visited[c.ID] {
+			continue
+		}
+		node.Children = append(node.Children, buildTreeNode(cfg, c, children, visited))
+	}
+	return node
+}
+
+// Children returns the tasks whose parent is id, ordered by ID. Archived
+// tasks are left out.
+func Children(tasks []*task.Task, id int) []*task.Task {
+	var kids []*task.Task
+	for _, t := range tasks {
+		if t.Parent != nil && *t.Parent == id && t.ID != id && t.Status != config.ArchivedStatus {
+			kids = append(kids, t)
+		}
+	}
+	sortByID(kids)
+	return kids
+}
+
+// Descendants returns every task below id in the parent/child hierarchy,
+// level by level and ordered by ID within a level. Archived tasks are left
+// out, but tasks below them are not. Parent cycles are cut where they close.
+func Descendants(tasks []*task.Task, id int) []*task.Task {
+	children := make(map[int][]*task.Task)
+	for _, t := range tasks {
+		if t.Parent != nil && *t.Parent != t.ID {
+			children[*t.Parent] = append(children[*t.Parent], t)
+		}
+	}
+	var found []*task.Task
+	seen := map[int]bool{id: true}
+	for level := []int{id}; len(level) > 0; {
+		var next []*task.Task
+		for _, p := range level {
+			for _, c := range children[p] {
+				if !seen[c.ID] {
+					seen[c.ID] = true
+					next = append(next, c)
+				}
+			}
+		}
+		sortByID(next)
+		level = level[:0]
+		for _, t := range next {
+			level = append(level, t.ID)
+			if t.Status != config.ArchivedStatus {
+				found = append(found, t)
+			}
+		}
+	}
+	return found
+}
+
+func statusGlyph(cfg *config.Config, status string) string {
+	switch {
+	case cfg.IsTerminalStatus(status):
+		return glyphDone
+	case cfg.StatusIndex(status) <= 0:
+		return glyphTodo
+	default:
+		return glyphDoing
+	}
+}
+
+func priorityGlyph(priority string) string {
+	if g, ok := priorityGlyphs[priority]; ok {
+		return g
+	}
+	return "·"
+}
+
+func sortByID(tasks []*task.Task) {
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+}
+
+// ParentCycle reports whether making parentID the parent of childID would
+// create a loop in the parent chain. It returns the offending chain, starting
+// at parentID and ending at childID, or nil if the assignment is safe.
+func ParentCycle(tasks []*task.Task, childID, parentID int) []int {
+	parents := make(map[int]int, len(tasks))
+	for _, t := range tasks {
+		if t.Parent != nil {
+			parents[t.ID] = *t.Parent
+		}
+	}
+	parents[childID] = parentID
+
+	chain := []int{parentID}
+	seen := map[int]bool{parentID: true}
+	for id := parentID; ; {
+		if id == childID {
+			return chain
+		}
+		next, ok := parents[id]
+		if !ok || seen[next] {
+			return nil
+		}
+		seen[next] = true
+		chain = append(chain, next)
+		id = next
+	}
+}
@@ -0,0 +1,118 @@
+package board
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestBuildTreeFull(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 3, Title: "Grandchild", Status: "done", Priority: "low", Parent: intPtr(2)},
+		{ID: 1, Title: "Epic", Status: "in-progress", Priority: "high"},
+		{ID: 2, Title: "Child", Status: "backlog", Priority: "medium", Parent: intPtr(1)},
+		{ID: 4, Title: "Orphan", Status: "todo", Priority: "critical", Parent: intPtr(99)},
+	}
+
+	nodes := BuildTree(cfg, tasks, 0)
+	if len(nodes) != 2 || nodes[0].ID != 1 || nodes[1].ID != 4 {
+		t.Fatalf("roots = %+v, want #1 and #4", nodes)
+	}
+	child := nodes[0].Children
+	if len(child) != 1 || child[0].ID != 2 {
+		t.Fatalf("children of #1 = %+v, want #2", child)
+	}
+	if gc := child[0].Children; len(gc) != 1 || gc[0].ID != 3 {
+		t.Fatalf("children of #2 = %+v, want #3", gc)
+	}
+
+	if nodes[0].StatusGlyph != glyphDoing || child[0].StatusGlyph != glyphTodo ||
+		child[0].Children[0].StatusGlyph != glyphDone {
+		t.Error("unexpected status glyphs")
+	}
+	if nodes[1].PriorityGlyph != "‼" {
+		t.Errorf("PriorityGlyph = %q, want ‼", nodes[1].PriorityGlyph)
+	}
+}
+
+func TestBuildTreeSubtree(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "Epic", Status: "todo"},
+		{ID: 2, Title: "Child", Status: "todo", Parent: intPtr(1)},
+		{ID: 3, Title: "Other", Status: "todo"},
+	}
+
+	nodes := BuildTree(cfg, tasks, 2)
+	if len(nodes) != 1 || nodes[0].ID != 2 || len(nodes[0].Children) != 0 {
+		t.Errorf("subtree = %+v, want only #2", nodes)
+	}
+	if nodes := BuildTree(cfg, tasks, 42); nodes != nil {
+		t.Errorf("missing root = %+v, want nil", nodes)
+	}
+}
+
+func TestBuildTreeParentCycle(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	tasks := []*task.Task{
+		{ID: 1, Title: "A", Status: "todo", Parent: intPtr(2)},
+		{ID: 2, Title: "B", Status: "todo", Parent: intPtr(1)},
+	}
+
+	nodes := BuildTree(cfg, tasks, 0)
+	if len(nodes) != 1 || nodes[0].ID != 1 || len(nodes[0].Children) != 1 {
+		t.Fatalf("nodes = %+v, want #1 -> #2", nodes)
+	}
+	if len(nodes[0].Children[0].Children) != 0 {
+		t.Error("cycle was not cut")
+	}
+}
+
+func TestParentCycle(t *testing.T) {
+	tasks := []*task.Task{
+		{ID: 1},
+		{ID: 2, Parent: intPtr(1)},
+		{ID: 3, Parent: intPtr(2)},
+		{ID: 4},
+	}
+
+	if chain := ParentCycle(tasks, 1, 3); len(chain) != 3 || chain[0] != 3 || chain[2] != 1 {
+		t.Errorf("ParentCycle(1, 3) = %v, want [3 2 1]", chain)
+	}
+	if chain := ParentCycle(tasks, 3, 4); chain != nil {
+		t.Errorf("ParentCycle(3, 4) = %v, want nil", chain)
+	}
+	if chain := ParentCycle(tasks, 4, 3); chain != nil {
+		t.Errorf("ParentCycle(4, 3) = %v, want nil", chain)
+	}
+}
+
+func TestDescendants(t *testing.T) {
+	tasks := []*task.Task{
+		{ID: 1, Status: "todo"},
+		{ID: 2, Status: "todo", Parent: intPtr(1)},
+		{ID: 3, Status: config.ArchivedStatus, Parent: intPtr(1)},
+		{ID: 4, Status: "done", Parent: intPtr(3)},
+		{ID: 5, Status: "todo", Parent: intPtr(2)},
+		{ID: 6, Status: "todo", Parent: intPtr(6)},
+	}
+
+	var ids []int
+	for _, d := range Descendants(tasks, 1) {
+		ids = append(ids, d.ID)
+	}
+	if fmt.Sprint(ids) != "[2 4 5]" {
+		t.Errorf("Descendants(1) = %v, want [2 4 5]", ids)
+	}
+	if kids := Children(tasks, 1); len(kids) != 1 || kids[0].ID != 2 {
+		t.Errorf("Children(1) = %+v, want #2 only", kids)
+	}
+	if d := Descendants(tasks, 6); len(d) != 0 {
+		t.Errorf("Descendants(6) = %+v, want none for a self-parent", d)
+	}
+}
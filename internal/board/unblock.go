@@ -0,0 +1,132 @@
+package board
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Unblocked describes the dependencies.on_unblock action applied to a task
+// whose last outstanding dependency just reached a terminal status.
+type Unblocked struct {
+	ID     int    `json:"id"`
+	Title  string `json:"title"`
+	Action string `json:"action"`
+	Detail string `json:"detail"`
+}
+
+// Log actions recorded for dependency automation.
+const (
+	actionAutoMove   = "auto-move"
+	actionAutoTag    = "auto-tag"
+	actionAutoNotify = "auto-notify"
+)
+
+// ApplyOnUnblock runs the configured dependencies.on_unblock action for every
+// non-terminal task that depends on completedID and now has all of its
+// dependencies satisfied. It is a no-op when the automation is disabled or
+// completedID is not in a terminal status. Each action is recorded in the
+// activity log.
+//
+// move_to only moves tasks forward in the status order and respects the
+// target column's WIP limit; tasks that cannot move are left untouched.
+func ApplyOnUnblock(cfg *config.Config, completedID int, now time.Time) ([]Unblocked, error) {
+	action, arg := cfg.OnUnblockAction()
+	if action == "" {
+		return nil, nil
+	}
+
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPaths()...)
+	if err != nil {
+		return nil, fmt.Errorf("reading tasks for dependency automation: %w", err)
+	}
+
+	statusByID := make(map[int]string, len(tasks))
+	for _, t := range tasks {
+		statusByID[t.ID] = t.Status
+	}
+	if !cfg.IsTerminalStatus(statusByID[completedID]) {
+		return nil, nil
+	}
+	counts := CountByStatus(tasks)
+
+	var results []Unblocked
+	for _, t := range tasks {
+		if cfg.IsTerminalStatus(t.Status) || !slices.Contains(t.DependsOn, completedID) {
+			continue
+		}
+		if !allDepsSatisfied(t.DependsOn, statusByID, cfg) {
+			continue
+		}
+
+		reason := "dependencies done (last: #" + strconv.Itoa(completedID) + ")"
+		var u *Unblocked
+		switch action {
+		case config.OnUnblockMoveTo:
+			u, err = autoMove(cfg, t, arg, counts, reason, now)
+		case config.OnUnblockTag:
+			u, err = autoTag(t, arg, reason, now)
+		case config.OnUnblockNotify:
+			u = &Unblocked{ID: t.ID, Title: t.Title, Action: actionAutoNotify, Detail: "unblocked: " + reason}
+		}
+		if err != nil {
+			return results, err
+		}
+		if u == nil {
+			continue
+		}
+		LogMutation(cfg.Dir(), u.Action, u.ID, u.Detail)
+		results = append(results, *u)
+	}
+	return results, nil
+}
+
+func autoMove(cfg *config.Config, t *task.Task, target string, counts map[string]int,
+	reason string, now time.Time,
+) (*Unblocked, error) {
+	if cfg.StatusIndex(t.Status) >= cfg.StatusIndex(target) {
+		return nil, nil
+	}
+	if CheckWIPLimit(cfg, counts, target, t.Status) != nil {
+		return nil, nil
+	}
+
+	oldStatus := t.Status
+	t.Status = target
+	task.UpdateTimestamps(t, oldStatus, target, cfg)
+	task.ApplyChecklist(t, cfg)
+	t.Updated = now
+	if err := task.Write(t.File, t); err != nil {
+		return nil, fmt.Errorf("writing task #%d: %w", t.ID, err)
+	}
+	counts[oldStatus]--
+	counts[target]++
+
+	return &Unblocked{
+		ID:     t.ID,
+		Title:  t.Title,
+		Action: actionAutoMove,
+		Detail: oldStatus + " -> " + target + " (" + reason + ")",
+	}, nil
+}
+
+func autoTag(t *task.Task, tag, reason string, now time.Time) (*Unblocked, error) {
+	if slices.Contains(t.Tags, tag) {
+		return nil, nil
+	}
+	t.Tags = append(t.Tags, tag)
+	t.Updated = now
+	if err := task.Write(t.File, t); err != nil {
+		return nil, fmt.Errorf("writing task #%d: %w", t.ID, err)
+	}
+	return &Unblocked{
+		ID:     t.ID,
+		Title:  t.Title,
+		Action: actionAutoTag,
+		Detail: "+" + tag + " (" + reason + ")",
+	}, nil
+}
@@ -0,0 +1,142 @@
+package board
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func setupUnblockBoard(t *testing.T, onUnblock string, tasks ...*task.Task) *config.Config {
+	t.Helper()
+	cfg, err := config.Init(t.TempDir(), "Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Dependencies.OnUnblock = onUnblock
+	now := time.Now()
+	for _, tsk := range tasks {
+		tsk.Priority = "medium"
+		tsk.Created, tsk.Updated = now, now
+		writeTestTask(t, cfg.TasksPath(), tsk)
+	}
+	return cfg
+}
+
+func readTestTask(t *testing.T, cfg *config.Config, id int) *task.Task {
+	t.Helper()
+	path, err := task.FindByID(cfg.TasksPath(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tsk
+}
+
+func TestApplyOnUnblockMoveTo(t *testing.T) {
+	cfg := setupUnblockBoard(t, "move_to todo",
+		&task.Task{ID: 1, Title: "Dep A", Status: "done"},
+		&task.Task{ID: 2, Title: "Dep B", Status: "in-progress"},
+		&task.Task{ID: 3, Title: "Waiting on both", Status: "backlog", DependsOn: []int{1, 2}},
+		&task.Task{ID: 4, Title: "Waiting on A", Status: "backlog", DependsOn: []int{1}},
+		&task.Task{ID: 5, Title: "Already ahead", Status: "review", DependsOn: []int{1}},
+	)
+
+	results, err := ApplyOnUnblock(cfg, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != 4 || results[0].Action != actionAutoMove {
+		t.Fatalf("results = %+v, want only #4 auto-moved", results)
+	}
+	if got := readTestTask(t, cfg, 4).Status; got != "todo" {
+		t.Errorf("#4 status = %q, want todo", got)
+	}
+	if got := readTestTask(t, cfg, 3).Status; got != "backlog" {
+		t.Errorf("#3 status = %q, want backlog (still blocked by #2)", got)
+	}
+	if got := readTestTask(t, cfg, 5).Status; got != "review" {
+		t.Errorf("#5 status = %q, want review (never moved backwards)", got)
+	}
+
+	entries, err := ReadLog(cfg.Dir(), LogFilterOptions{Action: actionAutoMove})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].TaskID != 4 {
+		t.Errorf("log entries = %+v, want one auto-move for #4", entries)
+	}
+}
+
+func TestApplyOnUnblockTag(t *testing.T) {
+	cfg := setupUnblockBoard(t, "tag ready",
+		&task.Task{ID: 1, Title: "Dep", Status: "done"},
+		&task.Task{ID: 2, Title: "Dependent", Status: "backlog", DependsOn: []int{1}},
+	)
+
+	results, err := ApplyOnUnblock(cfg, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Action != actionAutoTag {
+		t.Fatalf("results = %+v, want one auto-tag", results)
+	}
+	if tags := readTestTask(t, cfg, 2).Tags; !slices.Contains(tags, "ready") {
+		t.Errorf("tags = %v, want ready", tags)
+	}
+}
+
+func TestApplyOnUnblockNotify(t *testing.T) {
+	cfg := setupUnblockBoard(t, "notify",
+		&task.Task{ID: 1, Title: "Dep", Status: "done"},
+		&task.Task{ID: 2, Title: "Dependent", Status: "backlog", DependsOn: []int{1}},
+	)
+
+	results, err := ApplyOnUnblock(cfg, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Action != actionAutoNotify {
+		t.Fatalf("results = %+v, want one auto-notify", results)
+	}
+	if got := readTestTask(t, cfg, 2).Status; got != "backlog" {
+		t.Errorf("status = %q, want unchanged backlog", got)
+	}
+}
+
+func TestApplyOnUnblockDisabledOrNotTerminal(t *testing.T) {
+	cfg := setupUnblockBoard(t, "",
+		&task.Task{ID: 1, Title: "Dep", Status: "done"},
+		&task.Task{ID: 2, Title: "Dependent", Status: "backlog", DependsOn: []int{1}},
+	)
+	if results, err := ApplyOnUnblock(cfg, 1, time.Now()); err != nil || results != nil {
+		t.Errorf("disabled: results = %+v, err = %v; want nil, nil", results, err)
+	}
+
+	cfg.Dependencies.OnUnblock = "move_to todo"
+	if results, _ := ApplyOnUnblock(cfg, 2, time.Now()); len(results) != 0 {
+		t.Errorf("non-terminal trigger: results = %+v, want none", results)
+	}
+}
+
+func TestApplyOnUnblockRespectsWIPLimit(t *testing.T) {
+	cfg := setupUnblockBoard(t, "move_to todo",
+		&task.Task{ID: 1, Title: "Dep", Status: "done"},
+		&task.Task{ID: 2, Title: "Occupant", Status: "todo"},
+		&task.Task{ID: 3, Title: "Dependent", Status: "backlog", DependsOn: []int{1}},
+	)
+	cfg.WIPLimits = map[string]int{"todo": 1}
+
+	results, err := ApplyOnUnblock(cfg, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 {
+		t.Errorf("results = %+v, want none (todo is full)", results)
+	}
+}
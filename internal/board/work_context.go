@@ -0,0 +1,73 @@
+package board
+
+import (
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// WorkContext is what an agent needs to start on a task besides the task
+// itself: where its dependencies stand, its parent, and recent activity on
+// all of them.
+type WorkContext struct {
+	Dependencies []RelatedTask `json:"dependencies"`
+	Parent       *RelatedTask  `json:"parent,omitempty"`
+	RecentLog    []LogEntry    `json:"recent_log"`
+}
+
+// RelatedTask summarizes a task referenced by another. Missing marks a
+// reference to a task that no longer exists.
+type RelatedTask struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Priority  string `json:"priority,omitempty"`
+	ClaimedBy string `json:"claimed_by,omitempty"`
+	Done      bool   `json:"done"`
+	Missing   bool   `json:"missing,omitempty"`
+}
+
+// BuildWorkContext collects t's work context from the board's tasks and its
+// activity log, keeping the last logLimit entries about t, its parent, or
+// its dependencies (all of them when logLimit is 0).
+func BuildWorkContext(cfg *config.Config, t *task.Task, tasks []*task.Task, log []LogEntry, logLimit int) WorkContext {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, other := range tasks {
+		byID[other.ID] = other
+	}
+	related := func(id int) RelatedTask {
+		other, ok := byID[id]
+		if !ok {
+			return RelatedTask{ID: id, Missing: true}
+		}
+		return RelatedTask{
+			ID:        other.ID,
+			Title:     other.Title,
+			Status:    other.Status,
+			Priority:  other.Priority,
+			ClaimedBy: other.ClaimedBy,
+			Done:      cfg.IsTerminalStatus(other.Status),
+		}
+	}
+
+	wc := WorkContext{Dependencies: []RelatedTask{}, RecentLog: []LogEntry{}}
+	ids := map[int]bool{t.ID: true}
+	for _, id := range t.DependsOn {
+		wc.Dependencies = append(wc.Dependencies, related(id))
+		ids[id] = true
+	}
+	if t.Parent != nil {
+		p := related(*t.Parent)
+		wc.Parent = &p
+		ids[*t.Parent] = true
+	}
+
+	for _, e := range log {
+		if ids[e.TaskID] {
+			wc.RecentLog = append(wc.RecentLog, e)
+		}
+	}
+	if logLimit > 0 && len(wc.RecentLog) > logLimit {
+		wc.RecentLog = wc.RecentLog[len(wc.RecentLog)-logLimit:]
+	}
+	return wc
+}
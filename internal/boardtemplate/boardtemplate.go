@@ -0,0 +1,148 @@
+// Package boardtemplate bundles a board's setup — its config and rules,
+// without tasks — into a .tar.gz archive, and unpacks one into a new board.
+package boardtemplate
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/rules"
+)
+
+// Files lists the board files a template archive may hold. config.yml is
+// required; the others are included when the board has them.
+var Files = []string{config.ConfigFileName, rules.FileName}
+
+// ErrInvalid is returned by Extract for an archive that is not a board
+// template.
+var ErrInvalid = errors.New("invalid board template")
+
+const (
+	// maxFileSize caps the size of each file in an archive.
+	maxFileSize = 1 << 20
+	fileMode    = 0o600
+)
+
+// Export writes cfg's board to w as a template archive and returns the
+// names of the files it includes. The config is stripped of what belongs
+// to the board rather than its setup: the next task ID, the readonly flag,
+// the serve tokens, subscriptions to its tasks, the inbox directories it
+// reads, and the log sinks its activity is mirrored to.
+func Export(w io.Writer, cfg *config.Config) ([]string, error) {
+	c := *cfg
+	c.NextID = 1
+	c.Board.ReadOnly = false
+	c.Serve.Tokens = nil
+	c.Serve.TokensFile = ""
+	c.Subscriptions = nil
+	c.Inbox.Sources = nil
+	c.Log.Sinks = nil
+	data, err := c.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	contents := map[string][]byte{config.ConfigFileName: data}
+	for _, name := range Files[1:] {
+		data, err := os.ReadFile(filepath.Join(cfg.Dir(), name)) //nolint:gosec // board file path
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", name, err)
+		}
+		contents[name] = data
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	var names []string
+	now := time.Now()
+	for _, name := range Files {
+		data, ok := contents[name]
+		if !ok {
+			continue
+		}
+		hdr := &tar.Header{Name: name, Mode: fileMode, Size: int64(len(data)), ModTime: now}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, fmt.Errorf("writing archive: %w", err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, fmt.Errorf("writing archive: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("writing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("writing archive: %w", err)
+	}
+	return names, nil
+}
+
+// Extract unpacks a template archive into dir and returns the names of the
+// files written. Entries other than Files are ignored, and nothing is
+// written unless the archive holds a config.
+func Extract(r io.Reader, dir string) ([]string, error) {
+	contents, err := read(r)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, name := range Files {
+		data, ok := contents[name]
+		if !ok {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, fileMode); err != nil {
+			return names, fmt.Errorf("writing %s: %w", name, err)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// read returns the contents of the archive's board files.
+func read(r io.Reader) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	defer gz.Close() //nolint:errcheck // read-only stream
+	tr := tar.NewReader(gz)
+	contents := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+		}
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || !slices.Contains(Files, name) {
+			continue
+		}
+		if hdr.Size > maxFileSize {
+			return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalid, name, maxFileSize)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+		}
+		contents[name] = data
+	}
+	if _, ok := contents[config.ConfigFileName]; !ok {
+		return nil, fmt.Errorf("%w: no %s", ErrInvalid, config.ConfigFileName)
+	}
+	return contents, nil
+}
@@ -0,0 +1,107 @@
+package boardtemplate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/rules"
+)
+
+func TestExportExtractRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	cfg := config.NewDefault("source")
+	cfg.SetDir(src)
+	cfg.NextID = 42
+	cfg.Board.ReadOnly = true
+	cfg.Templates = map[string]string{"bug": "## Steps\n"}
+	cfg.Serve.Tokens = []config.ServeToken{{Name: "ci", Token: "secret", Scope: config.ServeScopes[0]}}
+	cfg.Subscriptions = []config.Subscription{{Task: 7, Exec: "./notify.sh"}}
+	cfg.Inbox.Status = "todo"
+	cfg.Inbox.Sources = []config.InboxSource{{Name: "notes", Type: config.InboxFolder, Path: "inbox"}}
+	cfg.Log.Sinks = []config.LogSink{{Name: "audit", Type: config.LogSinkFile, Path: "audit.jsonl"}}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	rulesYAML := []byte("rules: []\n")
+	if err := os.WriteFile(rules.Path(src), rulesYAML, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	names, err := Export(&buf, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(names, Files) {
+		t.Errorf("exported %v, want %v", names, Files)
+	}
+	if cfg.NextID != 42 || len(cfg.Serve.Tokens) != 1 {
+		t.Error("Export must not change the board's config")
+	}
+
+	dst := t.TempDir()
+	if _, err := Extract(&buf, dst); err != nil {
+		t.Fatal(err)
+	}
+	got, err := config.Load(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.NextID != 1 || got.Board.ReadOnly || len(got.Serve.Tokens) != 0 {
+		t.Errorf("board state leaked into the template: next_id %d, readonly %v, tokens %v",
+			got.NextID, got.Board.ReadOnly, got.Serve.Tokens)
+	}
+	if len(got.Subscriptions) != 0 || len(got.Inbox.Sources) != 0 || len(got.Log.Sinks) != 0 {
+		t.Errorf("board state leaked into the template: subscriptions %v, inbox sources %v, log sinks %v",
+			got.Subscriptions, got.Inbox.Sources, got.Log.Sinks)
+	}
+	if got.Inbox.Status != "todo" {
+		t.Errorf("inbox status = %q, want todo", got.Inbox.Status)
+	}
+	if got.Templates["bug"] != "## Steps\n" {
+		t.Errorf("templates = %v", got.Templates)
+	}
+	data, err := os.ReadFile(filepath.Join(dst, rules.FileName))
+	if err != nil || !bytes.Equal(data, rulesYAML) {
+		t.Errorf("rules.yml = %q, %v", data, err)
+	}
+}
+
+func TestExtractRequiresConfig(t *testing.T) {
+	dst := t.TempDir()
+	if _, err := Extract(bytes.NewReader([]byte("not an archive")), dst); !errors.Is(err, ErrInvalid) {
+		t.Errorf("garbage: err = %v, want ErrInvalid", err)
+	}
+
+	// An archive with rules but no config writes nothing.
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	data := []byte("rules: []\n")
+	if err := tw.WriteHeader(&tar.Header{Name: rules.FileName, Mode: 0o600, Size: int64(len(data))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Extract(&buf, dst); !errors.Is(err, ErrInvalid) {
+		t.Errorf("no config: err = %v, want ErrInvalid", err)
+	}
+	entries, _ := os.ReadDir(dst)
+	if len(entries) != 0 {
+		t.Errorf("files written from an invalid archive: %v", entries)
+	}
+}
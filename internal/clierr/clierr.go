@@ -6,6 +6,7 @@ package clierr
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Error code constants — uppercase, underscore-separated, stable across minor versions.
@@ -31,14 +32,33 @@ const (
 	ClaimRequired      = "CLAIM_REQUIRED"
 	NothingToPick      = "NOTHING_TO_PICK"
 	InvalidGroupBy     = "INVALID_GROUP_BY"
+	ChildrenIncomplete = "CHILDREN_INCOMPLETE"
+	ParentCycle        = "PARENT_CYCLE"
+	BoardReadOnly      = "BOARD_READONLY"
+	EstimateRequired   = "ESTIMATE_REQUIRED"
+	ReviewerRequired   = "REVIEWER_REQUIRED"
+	HasChildren        = "HAS_CHILDREN"
 	InternalError      = "INTERNAL_ERROR"
 )
 
+// Codes lists every error code, in declaration order. Keep it in sync with
+// the constants above; the manifest command publishes it.
+var Codes = []string{
+	TaskNotFound, BoardNotFound, BoardAlreadyExists, InvalidInput, InvalidStatus,
+	InvalidPriority, InvalidDate, InvalidTaskID, WIPLimitExceeded, DependencyNotFound,
+	SelfReference, NoChanges, BoundaryError, StatusConflict, ConfirmationReq,
+	TaskClaimed, InvalidClass, ClassWIPExceeded, ClaimRequired, NothingToPick,
+	InvalidGroupBy, ChildrenIncomplete, ParentCycle, BoardReadOnly, EstimateRequired,
+	ReviewerRequired, HasChildren, InternalError,
+}
+
 // Error represents a structured CLI error with a machine-readable code.
 type Error struct {
 	Code    string
 	Message string
 	Details map[string]any
+	// RetryAfter is how long to wait before retrying, when it is known.
+	RetryAfter time.Duration
 }
 
 // Error implements the error interface.
@@ -60,12 +80,51 @@ func (e *Error) WithDetails(details map[string]any) *Error {
 	return e
 }
 
-// ExitCode returns 2 for InternalError, 1 for all others.
+// WithRetryAfter returns the error with the wait before a retry attached.
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	e.RetryAfter = d
+	return e
+}
+
+// Retryable reports whether the same command may succeed if retried later:
+// the board state that refused it — another agent's claim, a full WIP
+// limit, an empty queue — changes without the caller doing anything.
+func (e *Error) Retryable() bool {
+	switch e.Code {
+	case TaskClaimed, WIPLimitExceeded, ClassWIPExceeded, NothingToPick:
+		return true
+	}
+	return false
+}
+
+// Exit codes by error class. Scripts can branch on the class without parsing
+// output; the code itself is in the JSON error response.
+const (
+	ExitGeneral    = 1 // unclassified failure, partial batch failure, or --fail-on warning
+	ExitInternal   = 2 // bug or unexpected I/O failure
+	ExitValidation = 3 // bad arguments, flags, or values
+	ExitNotFound   = 4 // task, board, or dependency does not exist
+	ExitConflict   = 5 // board state forbids the operation
+	ExitWIP        = 6 // a WIP limit would be exceeded
+)
+
+// ExitCode returns the exit code for the error's class.
 func (e *Error) ExitCode() int {
-	if e.Code == InternalError {
-		return 2 //nolint:mnd // exit code 2 for internal errors
+	switch e.Code {
+	case InternalError:
+		return ExitInternal
+	case InvalidInput, InvalidStatus, InvalidPriority, InvalidDate, InvalidTaskID,
+		InvalidClass, InvalidGroupBy, SelfReference, ParentCycle, NoChanges, ConfirmationReq:
+		return ExitValidation
+	case TaskNotFound, BoardNotFound, DependencyNotFound, NothingToPick:
+		return ExitNotFound
+	case BoardAlreadyExists, BoundaryError, StatusConflict, TaskClaimed, ClaimRequired,
+		ChildrenIncomplete, BoardReadOnly, EstimateRequired, ReviewerRequired, HasChildren:
+		return ExitConflict
+	case WIPLimitExceeded, ClassWIPExceeded:
+		return ExitWIP
 	}
-	return 1
+	return ExitGeneral
 }
 
 // SilentError signals an exit code without additional output.
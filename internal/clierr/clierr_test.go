@@ -2,7 +2,13 @@ package clierr_test
 
 import (
 	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 )
@@ -28,12 +34,19 @@ func TestErrorsAs(t *testing.T) {
 }
 
 func TestExitCode(t *testing.T) {
-	tests := [2]struct {
+	tests := []struct {
 		code string
 		want int
 	}{
-		{clierr.TaskNotFound, 1},
-		{clierr.InternalError, 2},
+		{clierr.TaskNotFound, clierr.ExitNotFound},
+		{clierr.DependencyNotFound, clierr.ExitNotFound},
+		{clierr.InternalError, clierr.ExitInternal},
+		{clierr.InvalidStatus, clierr.ExitValidation},
+		{clierr.TaskClaimed, clierr.ExitConflict},
+		{clierr.BoardReadOnly, clierr.ExitConflict},
+		{clierr.WIPLimitExceeded, clierr.ExitWIP},
+		{clierr.ClassWIPExceeded, clierr.ExitWIP},
+		{"SOMETHING_NEW", clierr.ExitGeneral},
 	}
 	for _, tt := range tests {
 		err := clierr.New(tt.code, "msg")
@@ -61,6 +74,22 @@ func TestWithDetails(t *testing.T) {
 	}
 }
 
+func TestRetryable(t *testing.T) {
+	for _, code := range []string{clierr.TaskClaimed, clierr.WIPLimitExceeded, clierr.ClassWIPExceeded, clierr.NothingToPick} {
+		if !clierr.New(code, "").Retryable() {
+			t.Errorf("%s should be retryable", code)
+		}
+	}
+	for _, code := range []string{clierr.InvalidInput, clierr.TaskNotFound, clierr.BoardReadOnly, clierr.InternalError} {
+		if clierr.New(code, "").Retryable() {
+			t.Errorf("%s should not be retryable", code)
+		}
+	}
+	if err := clierr.New(clierr.TaskClaimed, "claimed").WithRetryAfter(time.Minute); err.RetryAfter != time.Minute {
+		t.Errorf("RetryAfter = %v, want 1m", err.RetryAfter)
+	}
+}
+
 func TestSilentError(t *testing.T) {
 	err := &clierr.SilentError{Code: 1}
 	if err.Error() != "exit 1" {
@@ -72,3 +101,35 @@ func TestSilentError(t *testing.T) {
 		t.Fatal("errors.As failed to unwrap *SilentError")
 	}
 }
+
+func TestCodesListsEveryConstant(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "clierr.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var consts []string
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, v := range spec.(*ast.ValueSpec).Values {
+				if lit, ok := v.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					consts = append(consts, strings.Trim(lit.Value, `"`))
+				}
+			}
+		}
+	}
+	if !slices.Equal(consts, clierr.Codes) {
+		t.Errorf("Codes = %v\nconstants = %v", clierr.Codes, consts)
+	}
+}
+
+func TestEveryCodeHasExitClass(t *testing.T) {
+	for _, code := range clierr.Codes {
+		if got := clierr.New(code, "msg").ExitCode(); got == clierr.ExitGeneral {
+			t.Errorf("%s has no exit class", code)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// commentedHeader starts a config file written with comments. Load looks
+// for it so later saves keep the comments.
+const commentedHeader = "kanban-md board configuration."
+
+// fileHeader is the comment block at the top of a commented config file.
+const fileHeader = "# " + commentedHeader + `
+# Change values with "kanban-md config set KEY VALUE" or edit this file.
+# "kanban-md config" lists every key and its current value.
+
+`
+
+// keyComments documents config keys in commented files, by dotted path.
+// Keys without an entry are written without a comment.
+var keyComments = map[string]string{
+	"version":                 "Config schema version. Older files are migrated automatically; do not edit.",
+	"board":                   "Board metadata.",
+	"board.name":              "Name shown in the TUI and board summaries.",
+	"board.description":       "Optional one-line description.",
+	"board.readonly":          "Reject every command that modifies the board.",
+	"board.id_prefix":         `Prefix shown before task IDs, e.g. "API-" for API-12.`,
+	"tasks_dir":               "Directory, relative to this file, that holds one Markdown file per task.",
+	"tasks_dirs":              "Extra task directories, one per workstream, named by their last path element.",
+	"statuses":                "Columns in board order. require_claim makes entering a status need --claim;\nshow_duration: false hides time-in-status on TUI cards.",
+	"priorities":              "Priorities from lowest to highest.",
+	"defaults":                "Values for new tasks when create is not given them.",
+	"defaults.status":         "Status of new tasks.",
+	"defaults.priority":       "Priority of new tasks.",
+	"defaults.class":          "Class of service of new tasks.",
+	"defaults.auto_status":    "Search order for create --status auto.",
+	"wip_limits":              "Maximum number of tasks per status. Statuses not listed are unlimited.",
+	"claim_timeout":           "How long a claim lasts before others may take the task, e.g. 1h or 30m.\nEmpty means claims never expire.",
+	"classes":                 "Classes of service. wip_limit caps a class board-wide; bypass_column_wip\nlets its tasks ignore column WIP limits.",
+	"tui":                     "Interactive TUI settings.",
+	"tui.title_lines":         "Title lines shown on each card.",
+	"tui.age_thresholds":      "Card age colors: tasks older than after (in their status) use color.",
+	"tui.hide_empty_columns":  "Hide columns with no tasks.",
+	"tui.keys":                "Key bindings per action; an empty list disables the action.",
+	"tui.collapsed_columns":   "Statuses whose columns start collapsed.",
+	"dependencies":            "Dependency automation.",
+	"dependencies.on_unblock": `Action when a task's last dependency completes: "move_to STATUS", "tag TAG", or "notify".`,
+	"estimates":               "Estimate settings.",
+	"display":                 "Output display settings.",
+	"calendar":                "Working days for business-day metrics.",
+	"require_estimate_for":    "Statuses a task can only enter with an estimate.",
+	"workstream_defaults":     "Values for new tasks in a workstream, in place of defaults.",
+	"owners":                  "Default assignee and tags by repository path, like CODEOWNERS; the last\nmatching rule wins. Used by create --for-path.",
+	"inbox":                   "Sources the inbox command files new tasks from: folder (.md notes), email\n(.eml files from a bridge), or webhook (spooled JSON items). Items land in\ninbox.status and are deduplicated by content hash.",
+	"automation":              "Settings for scripts and agents.",
+	"automation.assume_yes":   "Answer confirmation prompts with yes when stdin is not a terminal.",
+	"next_id":                 "ID given to the next task. Managed by kanban-md; do not edit.",
+}
+
+// marshalCommented renders c as YAML with fileHeader and keyComments.
+func (c *Config) marshalCommented() ([]byte, error) {
+	var root yaml.Node
+	if err := root.Encode(c); err != nil {
+		return nil, fmt.Errorf("marshaling config: %w", err)
+	}
+	annotate(&root, "")
+
+	var buf bytes.Buffer
+	buf.WriteString(fileHeader)
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(4) //nolint:mnd // match yaml.Marshal, which Save uses
+	if err := enc.Encode(&root); err != nil {
+		return nil, fmt.Errorf("marshaling config: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshaling config: %w", err)
+	}
+	return spaceSections(buf.Bytes()), nil
+}
+
+// spaceSections puts a blank line before each top-level comment block so
+// sections stand out.
+func spaceSections(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	out := make([]string, 0, len(lines))
+	for i, line := range lines {
+		if i > 0 && strings.HasPrefix(line, "#") && !strings.HasPrefix(lines[i-1], "#") && lines[i-1] != "" {
+			out = append(out, "")
+		}
+		out = append(out, line)
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+// annotate sets the comment of each key in mapping node n that has one.
+func annotate(n *yaml.Node, prefix string) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, val := n.Content[i], n.Content[i+1]
+		path := key.Value
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if comment, ok := keyComments[path]; ok {
+			key.HeadComment = comment
+		}
+		annotate(val, path)
+	}
+}
+
+// hasCommentedHeader reports whether config file data was written with
+// comments.
+func hasCommentedHeader(data []byte) bool {
+	return strings.HasPrefix(string(data), "# "+commentedHeader)
+}
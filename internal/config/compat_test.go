@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
 	"go.yaml.in/yaml/v3"
 )
@@ -634,6 +636,1352 @@ func TestCompatV9ConfigMigratesToV10(t *testing.T) {
 	}
 }
 
+func TestCompatV10Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v10")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v10 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v10" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v10")
+	}
+}
+
+func TestCompatV10ConfigMigratesToV11(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v10")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v10 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v10→v11 introduces dependencies.on_unblock; no automation by default.
+	if cfg.Dependencies.OnUnblock != "" {
+		t.Errorf("Dependencies.OnUnblock = %q, want empty", cfg.Dependencies.OnUnblock)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.TUI.HideEmptyColumns {
+		t.Error("TUI.HideEmptyColumns = false, want true (preserved)")
+	}
+}
+
+func TestCompatV11Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v11")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v11 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v11" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v11")
+	}
+}
+
+func TestCompatV11ConfigMigratesToV12(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v11")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v11 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v11→v12 introduces estimates.hours_per_day; unset means the default.
+	if cfg.Estimates.HoursPerDay != 0 {
+		t.Errorf("Estimates.HoursPerDay = %v, want 0", cfg.Estimates.HoursPerDay)
+	}
+	if got := cfg.EstimateHoursPerDay(); got != DefaultEstimateHoursPerDay {
+		t.Errorf("EstimateHoursPerDay() = %v, want %v", got, DefaultEstimateHoursPerDay)
+	}
+
+	// Existing fields should be preserved.
+	if cfg.Dependencies.OnUnblock != "move_to todo" {
+		t.Errorf("Dependencies.OnUnblock = %q, want %q (preserved)", cfg.Dependencies.OnUnblock, "move_to todo")
+	}
+}
+
+func TestCompatV12Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v12")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v12 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v12" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v12")
+	}
+}
+
+func TestCompatV12ConfigMigratesToV13(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v12")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v12 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v12→v13 introduces display.timezone; unset means local time.
+	if cfg.Display.Timezone != "" {
+		t.Errorf("Display.Timezone = %q, want empty", cfg.Display.Timezone)
+	}
+	if cfg.DisplayLocation() != time.Local {
+		t.Errorf("DisplayLocation() = %v, want Local", cfg.DisplayLocation())
+	}
+
+	// Existing fields should be preserved.
+	if cfg.Estimates.HoursPerDay != 6 {
+		t.Errorf("Estimates.HoursPerDay = %v, want 6 (preserved)", cfg.Estimates.HoursPerDay)
+	}
+}
+
+func TestCompatV13Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v13")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v13 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v13" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v13")
+	}
+}
+
+func TestCompatV13ConfigMigratesToV14(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v13")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v13 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v13→v14 introduces the calendar section; defaults to Mon-Fri workdays.
+	if len(cfg.Calendar.Weekends) != 0 || len(cfg.Calendar.Holidays) != 0 {
+		t.Errorf("Calendar = %+v, want empty", cfg.Calendar)
+	}
+	saturday := time.Date(2026, time.March, 7, 12, 0, 0, 0, time.UTC)
+	if cfg.IsWorkday(saturday) || !cfg.IsWorkday(saturday.AddDate(0, 0, 2)) {
+		t.Error("default calendar should treat Saturday as off and Monday as a workday")
+	}
+
+	// Existing fields should be preserved.
+	if cfg.Display.Timezone != "UTC" {
+		t.Errorf("Display.Timezone = %q, want UTC (preserved)", cfg.Display.Timezone)
+	}
+}
+
+func TestCompatV14Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v14")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v14 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v14" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v14")
+	}
+}
+
+func TestCompatV14ConfigMigratesToV15(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v14")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v14 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v14→v15 introduces per-status checklists; none are set by default.
+	for _, s := range cfg.Statuses {
+		if len(s.Checklist) != 0 {
+			t.Errorf("status %q Checklist = %v, want empty", s.Name, s.Checklist)
+		}
+	}
+
+	// Existing fields should be preserved.
+	if len(cfg.Calendar.Holidays) != 1 || cfg.Calendar.Holidays[0] != "2026-12-25" {
+		t.Errorf("Calendar.Holidays = %v, want [2026-12-25] (preserved)", cfg.Calendar.Holidays)
+	}
+}
+
+func TestCompatV15Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v15")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v15 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v15" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v15")
+	}
+}
+
+func TestCompatV15ConfigMigratesToV16(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v15")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v15 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v15→v16 introduces tag styles; none are set by default.
+	if len(cfg.Tags.Styles) != 0 {
+		t.Errorf("Tags.Styles = %v, want empty", cfg.Tags.Styles)
+	}
+
+	// Existing fields should be preserved.
+	if got := cfg.StatusChecklist("review"); len(got) != 2 || got[0] != "code reviewed" {
+		t.Errorf("review checklist = %v, want [code reviewed tests pass] (preserved)", got)
+	}
+}
+
+func TestCompatV16Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v16")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v16 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v16" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v16")
+	}
+}
+
+func TestCompatV16ConfigMigratesToV17(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v16")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v16 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v16→v17 introduces board.readonly; existing boards stay writable.
+	if cfg.Board.ReadOnly {
+		t.Error("Board.ReadOnly = true, want false")
+	}
+
+	// Existing fields should be preserved.
+	if got := cfg.Tags.Styles["bug"]; got.Color != "196" || got.Icon != "🐛" {
+		t.Errorf("Tags.Styles[bug] = %+v, want color 196 and icon (preserved)", got)
+	}
+}
+
+func TestCompatV17Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v17")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v17 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v17" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v17")
+	}
+}
+
+func TestCompatV17ConfigMigratesToV18(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v17")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v17 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v17→v18 introduces serve tokens; none are set by default.
+	if len(cfg.Serve.Tokens) != 0 || cfg.Serve.TokensFile != "" {
+		t.Errorf("Serve = %+v, want empty", cfg.Serve)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.Board.ReadOnly {
+		t.Error("Board.ReadOnly = false, want true (preserved)")
+	}
+}
+
+func TestCompatV18Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v18")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v18 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v18" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v18")
+	}
+}
+
+func TestCompatV18ConfigMigratesToV19(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v18")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v18 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v18→v19 introduces security recipients; none are set by default.
+	if len(cfg.Security.Recipients) != 0 {
+		t.Errorf("Security.Recipients = %v, want empty", cfg.Security.Recipients)
+	}
+
+	// Existing fields should be preserved.
+	if cfg.Serve.TokensFile != "tokens.yml" {
+		t.Errorf("Serve.TokensFile = %q, want tokens.yml (preserved)", cfg.Serve.TokensFile)
+	}
+}
+
+func TestCompatV19Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v19")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v19 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v19" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v19")
+	}
+}
+
+func TestCompatV19ConfigMigratesToV20(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v19")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v19 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v19→v20 introduces redaction rules; nothing is redacted by default.
+	if len(cfg.Redact.Patterns) != 0 || len(cfg.Redact.Fields) != 0 {
+		t.Errorf("Redact = %+v, want empty", cfg.Redact)
+	}
+
+	// Existing fields should be preserved.
+	if len(cfg.Security.Recipients) != 1 || cfg.Security.Recipients[0] != "ops@example.com" {
+		t.Errorf("Security.Recipients = %v, want [ops@example.com] (preserved)", cfg.Security.Recipients)
+	}
+}
+
+func TestCompatV20Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v20")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v20 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v20" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v20")
+	}
+}
+
+func TestCompatV20ConfigMigratesToV21(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v20")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v20 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v20→v21 introduces board.id_prefix; IDs stay unprefixed by default.
+	if cfg.Board.IDPrefix != "" {
+		t.Errorf("Board.IDPrefix = %q, want empty", cfg.Board.IDPrefix)
+	}
+	if got := cfg.FormatID(7); got != "#7" {
+		t.Errorf("FormatID(7) = %q, want #7", got)
+	}
+
+	// Existing fields should be preserved.
+	if len(cfg.Redact.Patterns) != 1 || len(cfg.Redact.Fields) != 1 {
+		t.Errorf("Redact = %+v, want one pattern and one field (preserved)", cfg.Redact)
+	}
+}
+
+func TestCompatV21Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v21")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v21 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v21" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v21")
+	}
+}
+
+func TestCompatV21ConfigMigratesToV22(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v21")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v21 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v21→v22 introduces defaults.auto_status; the default order is every
+	// non-terminal board status.
+	if cfg.Defaults.AutoStatus != nil {
+		t.Errorf("Defaults.AutoStatus = %v, want nil", cfg.Defaults.AutoStatus)
+	}
+	want := []string{"backlog", "todo", "in-progress", "review"}
+	if got := cfg.AutoStatusOrder(); !slices.Equal(got, want) {
+		t.Errorf("AutoStatusOrder() = %v, want %v", got, want)
+	}
+
+	// Existing fields should be preserved.
+	if cfg.Board.IDPrefix != "API-" {
+		t.Errorf("Board.IDPrefix = %q, want API- (preserved)", cfg.Board.IDPrefix)
+	}
+}
+
+func TestCompatV22Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v22")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v22 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v22" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v22")
+	}
+}
+
+func TestCompatV22ConfigMigratesToV23(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v22")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v22 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v22→v23 introduces health.thresholds; defaults apply when unset.
+	if cfg.Health.Thresholds != nil {
+		t.Errorf("Health.Thresholds = %v, want nil", cfg.Health.Thresholds)
+	}
+	if got := cfg.HealthThresholdFor("blocked_ratio"); got != DefaultHealthThresholds["blocked_ratio"] {
+		t.Errorf("HealthThresholdFor(blocked_ratio) = %+v, want default", got)
+	}
+
+	// Existing fields should be preserved.
+	if !slices.Equal(cfg.Defaults.AutoStatus, []string{"todo", "backlog"}) {
+		t.Errorf("Defaults.AutoStatus = %v, want [todo backlog] (preserved)", cfg.Defaults.AutoStatus)
+	}
+}
+
+func TestCompatV23Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v23")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v23 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v23" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v23")
+	}
+}
+
+func TestCompatV23ConfigMigratesToV24(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v23")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v23 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v23→v24 introduces tag_defaults and templates; both start empty.
+	if cfg.TagDefaults != nil || cfg.Templates != nil {
+		t.Errorf("TagDefaults = %v, Templates = %v, want nil", cfg.TagDefaults, cfg.Templates)
+	}
+
+	// Existing fields should be preserved.
+	if got := cfg.HealthThresholdFor("blocked_ratio"); got.Warn != 0.3 || got.Critical != 0.5 {
+		t.Errorf("HealthThresholdFor(blocked_ratio) = %+v, want 0.3/0.5 (preserved)", got)
+	}
+}
+
+func TestCompatV24Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v24")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v24 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v24" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v24")
+	}
+}
+
+func TestCompatV24ConfigMigratesToV25(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v24")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v24 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v24→v25 introduces require_estimate_for; nothing is required by default.
+	if cfg.StatusRequiresEstimate("in-progress") {
+		t.Error("StatusRequiresEstimate(in-progress) = true, want false")
+	}
+
+	// Existing fields should be preserved.
+	if d := cfg.TagDefaults["bug"]; d.Priority != "high" || d.Template != "bug" {
+		t.Errorf("TagDefaults[bug] = %+v, want priority high, template bug (preserved)", d)
+	}
+	if cfg.Templates["bug"] != "## Steps to reproduce\n" {
+		t.Errorf("Templates[bug] = %q, want preserved", cfg.Templates["bug"])
+	}
+}
+
+func TestCompatV25Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v25")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v25 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v25" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v25")
+	}
+}
+
+func TestCompatV25ConfigMigratesToV26(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v25")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v25 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v25→v26 introduces require_reviewer; no status requires one by default.
+	for _, s := range cfg.StatusNames() {
+		if cfg.StatusRequiresReviewer(s) {
+			t.Errorf("StatusRequiresReviewer(%q) = true, want false", s)
+		}
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.StatusRequiresEstimate("review") {
+		t.Error("StatusRequiresEstimate(review) = false, want true (preserved)")
+	}
+}
+
+func TestCompatV26Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v26")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v26 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v26" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v26")
+	}
+}
+
+func TestCompatV26ConfigMigratesToV27(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v26")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v26 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v26→v27 introduces log.sinks; none are configured by default.
+	if len(cfg.Log.Sinks) != 0 {
+		t.Errorf("Log.Sinks = %v, want none", cfg.Log.Sinks)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.StatusRequiresReviewer("review") {
+		t.Error("StatusRequiresReviewer(review) = false, want true (preserved)")
+	}
+}
+
+func TestCompatV27Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v27")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v27 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v27" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v27")
+	}
+}
+
+func TestCompatV27ConfigMigratesToV28(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v27")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v27 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v27→v28 introduces subscriptions; there are none by default.
+	if len(cfg.Subscriptions) != 0 {
+		t.Errorf("Subscriptions = %v, want none", cfg.Subscriptions)
+	}
+
+	// Existing fields should be preserved.
+	if s, ok := cfg.LogSink("audit"); !ok || s.Path != "audit.jsonl" {
+		t.Errorf("LogSink(audit) = %+v, %v; want the file sink (preserved)", s, ok)
+	}
+}
+
+func TestCompatV28Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v28")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v28 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v28" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v28")
+	}
+}
+
+func TestCompatV28ConfigMigratesToV29(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v28")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v28 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v28→v29 introduces tui.keys; without it every action keeps its defaults.
+	if got := cfg.TUIKeys()["create"]; len(got) != 1 || got[0] != "c" {
+		t.Errorf("TUIKeys()[create] = %v, want [c]", got)
+	}
+
+	// Existing fields should be preserved.
+	if subs := cfg.SubscriptionsFor(1); len(subs) != 1 || subs[0].Exec != "./notify.sh" {
+		t.Errorf("SubscriptionsFor(1) = %v, want the ./notify.sh subscription (preserved)", subs)
+	}
+}
+
+func TestCompatV29Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v29")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v29 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v29" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v29")
+	}
+}
+
+func TestCompatV29ConfigMigratesToV30(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v29")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v29 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v29→v30 introduces tui.collapsed_columns; no column starts collapsed.
+	if len(cfg.TUI.CollapsedColumns) != 0 {
+		t.Errorf("TUI.CollapsedColumns = %v, want none", cfg.TUI.CollapsedColumns)
+	}
+
+	// Existing fields should be preserved.
+	keys := cfg.TUIKeys()
+	if len(keys["quick_add"]) != 1 || keys["quick_add"][0] != "A" || len(keys["delete"]) != 0 {
+		t.Errorf("TUIKeys() quick_add = %v, delete = %v; want [A] and [] (preserved)",
+			keys["quick_add"], keys["delete"])
+	}
+}
+
+func TestCompatV30Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v30")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v30 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v30" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v30")
+	}
+}
+
+func TestCompatV30ConfigMigratesToV31(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v30")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v30 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v30→v31 introduces automation.assume_yes; prompts stay on by default.
+	if cfg.Automation.AssumeYes {
+		t.Error("Automation.AssumeYes = true, want false")
+	}
+
+	// Existing fields should be preserved.
+	if len(cfg.TUI.CollapsedColumns) != 1 || cfg.TUI.CollapsedColumns[0] != "review" {
+		t.Errorf("TUI.CollapsedColumns = %v, want [review] (preserved)", cfg.TUI.CollapsedColumns)
+	}
+}
+
+func TestCompatV31Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v31")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v31 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v31" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v31")
+	}
+}
+
+func TestCompatV31ConfigMigratesToV32(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v31")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v31 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v31→v32 introduces tasks_dirs; boards keep a single tasks directory.
+	if len(cfg.TasksDirs) != 0 || len(cfg.Workstreams()) != 0 {
+		t.Errorf("TasksDirs = %v, want none", cfg.TasksDirs)
+	}
+	if paths := cfg.TasksPaths(); len(paths) != 1 || paths[0] != cfg.TasksPath() {
+		t.Errorf("TasksPaths() = %v, want only tasks_dir", paths)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.Automation.AssumeYes {
+		t.Error("Automation.AssumeYes = false, want true (preserved)")
+	}
+}
+
+func TestCompatV32Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v32")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v32 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v32" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v32")
+	}
+}
+
+func TestCompatV32ConfigMigratesToV33(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v32")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v32 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v32→v33 introduces owners; no path has an owner.
+	if len(cfg.Owners) != 0 {
+		t.Errorf("Owners = %v, want none", cfg.Owners)
+	}
+	if _, ok := cfg.OwnerFor("src/api/handler.go"); ok {
+		t.Error("OwnerFor() matched with no owners configured")
+	}
+
+	// Existing fields should be preserved.
+	if ws := cfg.Workstreams(); len(ws) != 1 || ws[0] != "infra" {
+		t.Errorf("Workstreams() = %v, want [infra] (preserved)", ws)
+	}
+	if d := cfg.WorkstreamDefaults["infra"]; d.Priority != "high" || d.Assignee != "ops-bot" {
+		t.Errorf("WorkstreamDefaults[infra] = %+v, want preserved", d)
+	}
+}
+
+func TestCompatV33Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v33")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v33 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v33" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v33")
+	}
+}
+
+func TestCompatV33ConfigMigratesToV34(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v33")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v33 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v33→v34 introduces inbox; no sources, and intake uses the default status.
+	if len(cfg.Inbox.Sources) != 0 {
+		t.Errorf("Inbox.Sources = %v, want none", cfg.Inbox.Sources)
+	}
+	if got := cfg.InboxStatus(); got != cfg.Defaults.Status {
+		t.Errorf("InboxStatus() = %q, want %q", got, cfg.Defaults.Status)
+	}
+
+	// Existing fields should be preserved.
+	if r, ok := cfg.OwnerFor("src/api/handler.go"); !ok || r.Assignee != "bob" {
+		t.Errorf("OwnerFor(src/api/handler.go) = %+v, %v, want bob (preserved)", r, ok)
+	}
+}
+
+func TestCompatV34Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v34")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v34 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v34" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v34")
+	}
+}
+
+func TestCompatV34ConfigMigratesToV35(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v34")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v34 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v34→v35 introduces timestamps; without it the first and terminal
+	// statuses set started and completed, as before.
+	if !cfg.StartsTask("backlog", "todo") || cfg.StartsTask("todo", "in-progress") {
+		t.Error("StartsTask should fire only on leaving the first status")
+	}
+	if !cfg.CompletesTask("done") || cfg.CompletesTask("review") {
+		t.Error("CompletesTask should fire only on the terminal status")
+	}
+
+	// Existing fields should be preserved.
+	if got := cfg.InboxStatus(); got != "todo" {
+		t.Errorf("InboxStatus() = %q, want todo (preserved)", got)
+	}
+	if len(cfg.Inbox.Sources) != 1 || cfg.Inbox.Sources[0].Name != "notes" {
+		t.Errorf("Inbox.Sources = %+v, want notes (preserved)", cfg.Inbox.Sources)
+	}
+}
+
+func TestCompatV35Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v35")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v35 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v35" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v35")
+	}
+}
+
+func TestCompatV35ConfigMigratesToV36(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v35")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v35 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v35→v36 introduces files; task files keep the {id}-{slug} names.
+	if got := cfg.FileNaming(); got != NamingIDSlug {
+		t.Errorf("FileNaming() = %q, want %q", got, NamingIDSlug)
+	}
+	if got := cfg.SlugLength(); got != DefaultSlugLength {
+		t.Errorf("SlugLength() = %d, want %d", got, DefaultSlugLength)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.StartsTask("todo", "in-progress") || cfg.StartsTask("backlog", "todo") {
+		t.Error("timestamps.start_on not preserved")
+	}
+}
+
+func TestCompatV36Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v36")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v36 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v36" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v36")
+	}
+}
+
+func TestCompatV36ConfigMigratesToV37(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v36")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v36 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v36→v37 introduces lint with the default rules.
+	if got := cfg.LintTitleMaxLength(); got != DefaultLintTitleMaxLength {
+		t.Errorf("LintTitleMaxLength() = %d, want %d", got, DefaultLintTitleMaxLength)
+	}
+	if got := cfg.LintSeverity(LintRuleTitleLength); got != LintWarning {
+		t.Errorf("LintSeverity(title-length) = %q, want %q", got, LintWarning)
+	}
+
+	// Existing fields should be preserved.
+	if cfg.FileNaming() != NamingDateIDSlug || cfg.SlugLength() != 30 {
+		t.Errorf("files = %+v, not preserved", cfg.Files)
+	}
+}
+
+func TestCompatV37Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v37")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v37 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v37" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v37")
+	}
+}
+
+func TestCompatV37ConfigMigratesToV38(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v37")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v37 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v37→v38 introduces log.ops, off by default.
+	if cfg.Log.Ops {
+		t.Error("Log.Ops = true, want false")
+	}
+
+	// Existing fields should be preserved.
+	if cfg.LintTitleMaxLength() != 80 || len(cfg.Lint.RequiredTags) != 2 || cfg.LintSeverity(LintRuleEmptyBody) != LintError {
+		t.Errorf("lint = %+v, not preserved", cfg.Lint)
+	}
+}
+
+func TestCompatV38Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v38")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v38 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v38" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v38")
+	}
+}
+
+func TestCompatV38ConfigMigratesToV39(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v38")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v38 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v38→v39 introduces the ready section; without it every non-terminal
+	// status is pulled from and no target column is checked.
+	if got := cfg.ReadyPullFrom(); !slices.Equal(got, cfg.ActiveStatuses()) || cfg.Ready.Target != "" {
+		t.Errorf("ready = %v / %q, want the active statuses and no target", got, cfg.Ready.Target)
+	}
+
+	// Existing fields should be preserved.
+	if !cfg.Log.Ops {
+		t.Error("Log.Ops = false, want true (not preserved)")
+	}
+}
+
+func TestCompatV39Config(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v39")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v39 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Board.Name != "Test Project v39" {
+		t.Errorf("Board.Name = %q, want %q", cfg.Board.Name, "Test Project v39")
+	}
+}
+
+func TestCompatV39ConfigMigratesToV40(t *testing.T) {
+	tmp := t.TempDir()
+	fixture := filepath.Join("testdata", "compat", "v39")
+	copyDir(t, fixture, tmp)
+
+	cfg, err := Load(tmp)
+	if err != nil {
+		t.Fatalf("Load() v39 fixture: %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d (after migration)", cfg.Version, CurrentVersion)
+	}
+
+	// v39→v40 introduces git.auto_commit, off by default.
+	if cfg.Git.AutoCommit {
+		t.Error("Git.AutoCommit = true, want false")
+	}
+
+	// Existing fields should be preserved.
+	if !slices.Equal(cfg.Ready.PullFrom, []string{"todo"}) || cfg.Ready.Target != "in-progress" {
+		t.Errorf("ready = %+v, not preserved", cfg.Ready)
+	}
+}
+
 func TestCompatV1TasksReadable(t *testing.T) {
 	// This test verifies that the current task reader can parse v1 task files.
 	// We only check that files exist and are well-formed here; detailed task
@@ -3,8 +3,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.yaml.in/yaml/v3"
@@ -22,26 +26,58 @@ var (
 
 // Config represents the kanban board configuration.
 type Config struct {
-	Version      int            `yaml:"version"`
-	Board        BoardConfig    `yaml:"board"`
-	TasksDir     string         `yaml:"tasks_dir"`
-	Statuses     []StatusConfig `yaml:"statuses"`
-	Priorities   []string       `yaml:"priorities"`
-	Defaults     DefaultsConfig `yaml:"defaults"`
-	WIPLimits    map[string]int `yaml:"wip_limits,omitempty"`
-	ClaimTimeout string         `yaml:"claim_timeout,omitempty"`
-	Classes      []ClassConfig  `yaml:"classes,omitempty"`
-	TUI          TUIConfig      `yaml:"tui,omitempty"`
-	NextID       int            `yaml:"next_id"`
+	Version            int                           `yaml:"version"`
+	Board              BoardConfig                   `yaml:"board"`
+	TasksDir           string                        `yaml:"tasks_dir"`
+	TasksDirs          []string                      `yaml:"tasks_dirs,omitempty"`
+	Statuses           []StatusConfig                `yaml:"statuses"`
+	Priorities         []string                      `yaml:"priorities"`
+	Defaults           DefaultsConfig                `yaml:"defaults"`
+	WIPLimits          map[string]int                `yaml:"wip_limits,omitempty"`
+	ClaimTimeout       string                        `yaml:"claim_timeout,omitempty"`
+	Classes            []ClassConfig                 `yaml:"classes,omitempty"`
+	TUI                TUIConfig                     `yaml:"tui,omitempty"`
+	Dependencies       DepsConfig                    `yaml:"dependencies,omitempty"`
+	Estimates          EstimateConfig                `yaml:"estimates,omitempty"`
+	Display            DisplayConfig                 `yaml:"display,omitempty"`
+	Calendar           CalendarConfig                `yaml:"calendar,omitempty"`
+	Tags               TagsConfig                    `yaml:"tags,omitempty"`
+	Serve              ServeConfig                   `yaml:"serve,omitempty"`
+	Security           SecurityConfig                `yaml:"security,omitempty"`
+	Redact             RedactConfig                  `yaml:"redact,omitempty"`
+	Health             HealthConfig                  `yaml:"health,omitempty"`
+	Log                LogConfig                     `yaml:"log,omitempty"`
+	Subscriptions      []Subscription                `yaml:"subscriptions,omitempty"`
+	Templates          map[string]string             `yaml:"templates,omitempty"`
+	TagDefaults        map[string]TagDefaults        `yaml:"tag_defaults,omitempty"`
+	WorkstreamDefaults map[string]WorkstreamDefaults `yaml:"workstream_defaults,omitempty"`
+	Owners             []OwnerRule                   `yaml:"owners,omitempty"`
+	Inbox              InboxConfig                   `yaml:"inbox,omitempty"`
+	RequireEstimateFor []string                      `yaml:"require_estimate_for,omitempty"`
+	Automation         AutomationConfig              `yaml:"automation,omitempty"`
+	Timestamps         TimestampsConfig              `yaml:"timestamps,omitempty"`
+	Files              FilesConfig                   `yaml:"files,omitempty"`
+	Lint               LintConfig                    `yaml:"lint,omitempty"`
+	Ready              ReadyConfig                   `yaml:"ready,omitempty"`
+	Git                GitConfig                     `yaml:"git,omitempty"`
+	NextID             int                           `yaml:"next_id"`
 
 	// dir is the absolute path to the kanban directory (not serialized).
 	dir string `yaml:"-"`
+	// commented is set for files written by SaveCommented, so Save keeps
+	// their comments.
+	commented bool `yaml:"-"`
 }
 
 // BoardConfig holds board metadata.
 type BoardConfig struct {
 	Name        string `yaml:"name"`
 	Description string `yaml:"description,omitempty"`
+	// ReadOnly rejects all mutating commands with BOARD_READONLY.
+	ReadOnly bool `yaml:"readonly,omitempty"`
+	// IDPrefix, e.g. "API-", is shown before task IDs ("API-12") and
+	// accepted wherever a task ID is expected.
+	IDPrefix string `yaml:"id_prefix,omitempty"`
 }
 
 // DefaultsConfig holds default values for new tasks.
@@ -49,6 +85,9 @@ type DefaultsConfig struct {
 	Status   string `yaml:"status"`
 	Priority string `yaml:"priority"`
 	Class    string `yaml:"class,omitempty"`
+	// AutoStatus is the search order for create --status auto. Empty means
+	// every non-terminal board status in board order.
+	AutoStatus []string `yaml:"auto_status,omitempty"`
 }
 
 // AgeThreshold maps a duration threshold to an ANSI color code.
@@ -63,6 +102,328 @@ type TUIConfig struct {
 	TitleLines       int            `yaml:"title_lines,omitempty"`
 	AgeThresholds    []AgeThreshold `yaml:"age_thresholds,omitempty"`
 	HideEmptyColumns bool           `yaml:"hide_empty_columns,omitempty"`
+	// Keys rebinds board-view actions: each entry replaces the action's
+	// default keys, and an empty list disables the action.
+	Keys map[string][]string `yaml:"keys,omitempty"`
+	// CollapsedColumns are the statuses whose columns show only their
+	// header and task count.
+	CollapsedColumns []string `yaml:"collapsed_columns,omitempty"`
+}
+
+// DepsConfig holds dependency automation settings.
+type DepsConfig struct {
+	// OnUnblock is the action applied to a task when its last outstanding
+	// dependency reaches a terminal status: "move_to <status>", "tag <tag>",
+	// or "notify". Empty disables the automation.
+	OnUnblock string `yaml:"on_unblock,omitempty"`
+}
+
+// EstimateConfig holds settings for interpreting task estimates.
+type EstimateConfig struct {
+	// HoursPerDay is the number of working hours in an estimated day ("1d").
+	// Zero means DefaultEstimateHoursPerDay.
+	HoursPerDay float64 `yaml:"hours_per_day,omitempty"`
+}
+
+// TagsConfig holds per-tag display settings.
+type TagsConfig struct {
+	// Styles maps a tag name to the color and icon it is shown with in
+	// table output and TUI cards.
+	Styles map[string]TagStyle `yaml:"styles,omitempty"`
+}
+
+// TagStyle is how a tag is rendered. Color is an ANSI 256 color code (e.g.
+// "196") or a hex color (e.g. "#ff8800"); Icon is a short prefix such as an
+// emoji. Either may be empty.
+type TagStyle struct {
+	Color string `yaml:"color,omitempty" json:"color,omitempty"`
+	Icon  string `yaml:"icon,omitempty" json:"icon,omitempty"`
+}
+
+// ServeConfig holds settings for the serve HTTP API.
+type ServeConfig struct {
+	// Tokens grant API access. With no tokens (here or in TokensFile),
+	// the API is open to anyone who can reach it.
+	Tokens []ServeToken `yaml:"tokens,omitempty"`
+	// TokensFile is a YAML file with a tokens list, kept out of config.yml
+	// so secrets need not be committed. Relative paths are resolved against
+	// the kanban directory.
+	TokensFile string `yaml:"tokens_file,omitempty"`
+}
+
+// ServeToken grants the bearer of Token the given scope. Token is either the
+// secret itself or "sha256:" followed by the hex SHA-256 of the secret.
+type ServeToken struct {
+	Name  string `yaml:"name" json:"name"`
+	Token string `yaml:"token" json:"-"`
+	Scope string `yaml:"scope" json:"scope"`
+}
+
+// SecurityConfig holds settings for private tasks.
+type SecurityConfig struct {
+	// Recipients are the keys private task bodies are encrypted to: age
+	// public keys ("age1...") or GPG key IDs/emails. All recipients must
+	// use the same tool.
+	Recipients []string `yaml:"recipients,omitempty"`
+}
+
+// RedactConfig lists what to mask in output that leaves the board, such as
+// context files and exports.
+type RedactConfig struct {
+	// Patterns are regular expressions; matches in task text are replaced
+	// with RedactedText.
+	Patterns []string `yaml:"patterns,omitempty"`
+	// Fields are task fields whose whole value is replaced (see RedactFields).
+	Fields []string `yaml:"fields,omitempty"`
+}
+
+// RedactedText replaces redacted values in output.
+const RedactedText = "[redacted]"
+
+// HealthConfig holds thresholds for the health report, keyed by indicator
+// name (see HealthIndicators). Indicators not listed use
+// DefaultHealthThresholds.
+type HealthConfig struct {
+	Thresholds map[string]HealthThreshold `yaml:"thresholds,omitempty"`
+}
+
+// LogConfig holds settings for the activity log.
+type LogConfig struct {
+	// Sinks mirror each activity log entry to an external system as it is
+	// written.
+	Sinks []LogSink `yaml:"sinks,omitempty"`
+	// Ops records every command run against the board, with its duration
+	// and exit code, in a separate ops log for diagnosing load.
+	Ops bool `yaml:"ops,omitempty"`
+}
+
+// Inbox source types.
+const (
+	InboxFolder  = "folder"
+	InboxEmail   = "email"
+	InboxWebhook = "webhook"
+)
+
+// InboxSourceTypes lists the valid inbox source types.
+var InboxSourceTypes = []string{InboxFolder, InboxEmail, InboxWebhook}
+
+// InboxConfig holds the sources the inbox command ingests new tasks from.
+type InboxConfig struct {
+	// Status is the triage status new items land in. Empty means
+	// defaults.status.
+	Status  string        `yaml:"status,omitempty"`
+	Sources []InboxSource `yaml:"sources,omitempty"`
+}
+
+// InboxSource is a directory the inbox command reads items from. Type says
+// what the files are: Markdown notes ("folder"), .eml messages written by an
+// email-to-file bridge ("email"), or JSON items spooled by a webhook
+// receiver ("webhook").
+type InboxSource struct {
+	Name string `yaml:"name" json:"name"`
+	Type string `yaml:"type" json:"type"`
+	// Path is the directory to read. Relative paths are resolved against
+	// the kanban directory.
+	Path string `yaml:"path" json:"path"`
+	// Tags are added to every task created from this source.
+	Tags []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	// Keep leaves ingested files in place instead of removing them.
+	Keep bool `yaml:"keep,omitempty" json:"keep,omitempty"`
+}
+
+// LogSink is an external destination for activity log entries. Type picks
+// which of Path, Address, and URL is used.
+type LogSink struct {
+	Name string `yaml:"name" json:"name"`
+	Type string `yaml:"type" json:"type"`
+	// Path is the file a "file" sink appends JSON lines to. Relative paths
+	// are resolved against the kanban directory.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+	// Address is the server of a "syslog" sink, as "udp://host:514" or
+	// "tcp://host:514". Empty means the local syslog daemon.
+	Address string `yaml:"address,omitempty" json:"address,omitempty"`
+	// URL is where an "http" sink POSTs entries as JSON lines.
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+	// Timeout bounds each delivery, e.g. "5s". Empty means
+	// DefaultLogSinkTimeout.
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// TimeoutDuration returns the sink's delivery timeout.
+func (s LogSink) TimeoutDuration() time.Duration {
+	if d, err := time.ParseDuration(s.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return DefaultLogSinkTimeout
+}
+
+// LogSink returns the sink with the given name, if configured.
+func (c *Config) LogSink(name string) (LogSink, bool) {
+	for _, s := range c.Log.Sinks {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return LogSink{}, false
+}
+
+// RebasePaths rewrites absolute file paths that point inside directory from
+// so they point to the same place under to, for when the kanban directory
+// moves. Relative paths already follow the board. It returns the config keys
+// it changed.
+func (c *Config) RebasePaths(from, to string) []string {
+	var changed []string
+	rebase := func(key string, p *string) {
+		if !filepath.IsAbs(*p) {
+			return
+		}
+		rel, err := filepath.Rel(from, *p)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return
+		}
+		*p = filepath.Join(to, rel)
+		changed = append(changed, key)
+	}
+	for i := range c.Log.Sinks {
+		rebase("log.sinks."+c.Log.Sinks[i].Name+".path", &c.Log.Sinks[i].Path)
+	}
+	rebase("serve.tokens_file", &c.Serve.TokensFile)
+	for i := range c.Inbox.Sources {
+		rebase("inbox.sources."+c.Inbox.Sources[i].Name+".path", &c.Inbox.Sources[i].Path)
+	}
+	return changed
+}
+
+// Subscription runs Exec whenever task Task changes while the board is
+// watched (subscribe --watch or board --watch).
+type Subscription struct {
+	Task int    `yaml:"task" json:"task"`
+	Exec string `yaml:"exec" json:"exec"`
+}
+
+// SubscriptionsFor returns the subscriptions to the given task.
+func (c *Config) SubscriptionsFor(id int) []Subscription {
+	var subs []Subscription
+	for _, s := range c.Subscriptions {
+		if s.Task == id {
+			subs = append(subs, s)
+		}
+	}
+	return subs
+}
+
+// TagDefaults are field values create applies when a new task carries the
+// tag. Explicit flags win, and when several tagged defaults set the same
+// field the first tag on the task wins.
+type TagDefaults struct {
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Class    string `yaml:"class,omitempty" json:"class,omitempty"`
+	Assignee string `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	// Template names an entry in templates used as the body.
+	Template string `yaml:"template,omitempty" json:"template,omitempty"`
+}
+
+// WorkstreamDefaults are field values create applies to new tasks in a
+// workstream, in place of the board defaults. Tag defaults and explicit
+// flags win over them.
+type WorkstreamDefaults struct {
+	Status   string `yaml:"status,omitempty" json:"status,omitempty"`
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Class    string `yaml:"class,omitempty" json:"class,omitempty"`
+	Assignee string `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+}
+
+// HealthThreshold sets the values at which an indicator becomes a warning
+// and a critical breach. Zero disables that level.
+type HealthThreshold struct {
+	Warn     float64 `yaml:"warn,omitempty" json:"warn,omitempty"`
+	Critical float64 `yaml:"critical,omitempty" json:"critical,omitempty"`
+}
+
+// DisplayConfig holds settings for rendering output. Timestamps are always
+// stored in UTC; these settings only affect how they are shown.
+type DisplayConfig struct {
+	// Timezone is an IANA zone name (e.g. "Europe/Berlin"), "UTC", or
+	// "local". Empty means local time.
+	Timezone string `yaml:"timezone,omitempty"`
+}
+
+// AutomationConfig holds settings for unattended use by scripts and agents.
+type AutomationConfig struct {
+	// AssumeYes answers confirmation prompts with yes when stdin is not a
+	// terminal, as if --yes had been given. Interactive sessions still
+	// prompt.
+	AssumeYes bool `yaml:"assume_yes,omitempty"`
+}
+
+// TimestampsConfig says which moves set a task's started and completed
+// times.
+type TimestampsConfig struct {
+	// StartOn lists the statuses that set started when a task first enters
+	// one. Empty means the first move out of the first status.
+	StartOn []string `yaml:"start_on,omitempty"`
+	// CompleteOn lists the statuses that set completed; moving out of them
+	// clears it again. Empty means the terminal status.
+	CompleteOn []string `yaml:"complete_on,omitempty"`
+}
+
+// NamingSchemes lists the valid files.naming values.
+var NamingSchemes = []string{NamingIDSlug, NamingID, NamingDateIDSlug}
+
+// FilesConfig controls how task files are named.
+type FilesConfig struct {
+	// Naming is one of NamingSchemes. Empty means NamingIDSlug.
+	Naming string `yaml:"naming,omitempty"`
+	// SlugLength caps the slug part of the name. Zero means
+	// DefaultSlugLength.
+	SlugLength int `yaml:"slug_length,omitempty"`
+}
+
+// LintConfig holds the rules checked by the lint command.
+type LintConfig struct {
+	// TitleMaxLength is the longest title, in characters. Zero means
+	// DefaultLintTitleMaxLength.
+	TitleMaxLength int `yaml:"title_max_length,omitempty"`
+	// RequiredTags lists tags of which every task needs at least one.
+	// Empty turns the rule off.
+	RequiredTags []string `yaml:"required_tags,omitempty"`
+	// BodyRequiredFrom is the lowest priority whose tasks need a non-empty
+	// body. Empty means DefaultLintBodyRequiredFrom.
+	BodyRequiredFrom string `yaml:"body_required_from,omitempty"`
+	// ForbiddenWords lists words, matched whole and ignoring case, that may
+	// not appear in a title or body.
+	ForbiddenWords []string `yaml:"forbidden_words,omitempty"`
+	// Severity maps a rule name (see LintRules) to error, warning, or off.
+	// Rules not listed use DefaultLintSeverity.
+	Severity map[string]string `yaml:"severity,omitempty"`
+}
+
+// GitConfig holds settings for keeping the board in a git repository.
+type GitConfig struct {
+	// AutoCommit commits every mutation, with the task files and activity
+	// log it changed, as it is logged.
+	AutoCommit bool `yaml:"auto_commit,omitempty"`
+}
+
+// ReadyConfig defines when a task is ready to start: see ReadyPullFrom and
+// Target.
+type ReadyConfig struct {
+	// PullFrom lists the statuses ready tasks wait in. Empty means every
+	// non-terminal status.
+	PullFrom []string `yaml:"pull_from,omitempty"`
+	// Target is the status ready tasks are pulled into. When set, a task is
+	// only ready while Target has room under its WIP limit.
+	Target string `yaml:"target,omitempty"`
+}
+
+// CalendarConfig defines the board's working days for business-day
+// calculations.
+type CalendarConfig struct {
+	// Weekends lists non-working weekdays by English name (e.g. "saturday").
+	// Empty means DefaultWeekends.
+	Weekends []string `yaml:"weekends,omitempty"`
+	// Holidays lists non-working dates as YYYY-MM-DD.
+	Holidays []string `yaml:"holidays,omitempty"`
 }
 
 // StatusConfig defines a status column and its enforcement rules.
@@ -70,6 +431,12 @@ type StatusConfig struct {
 	Name         string `yaml:"name" json:"name"`
 	RequireClaim bool   `yaml:"require_claim,omitempty" json:"require_claim,omitempty"`
 	ShowDuration *bool  `yaml:"show_duration,omitempty" json:"show_duration,omitempty"`
+	// Checklist items are added to a task's body as "- [ ] item" lines when
+	// the task enters this status, unless already present.
+	Checklist []string `yaml:"checklist,omitempty" json:"checklist,omitempty"`
+	// RequireReviewer makes entering this status need a task reviewer who
+	// is not also its assignee.
+	RequireReviewer bool `yaml:"require_reviewer,omitempty" json:"require_reviewer,omitempty"`
 }
 
 // UnmarshalYAML allows StatusConfig to be parsed from either a plain string
@@ -101,6 +468,72 @@ func (c *Config) TasksPath() string {
 	return filepath.Join(c.dir, c.TasksDir)
 }
 
+// WorkstreamName returns the name of the workstream a tasks_dirs entry
+// holds: its last path element ("streams/infra/" is "infra").
+func WorkstreamName(dir string) string {
+	return filepath.Base(filepath.Clean(filepath.FromSlash(dir)))
+}
+
+// Workstreams returns the workstream names in tasks_dirs order.
+func (c *Config) Workstreams() []string {
+	names := make([]string, len(c.TasksDirs))
+	for i, d := range c.TasksDirs {
+		names[i] = WorkstreamName(d)
+	}
+	return names
+}
+
+// WorkstreamPath returns the absolute directory of the named workstream.
+// The empty name is tasks_dir, which holds tasks outside any workstream.
+func (c *Config) WorkstreamPath(name string) (string, bool) {
+	if name == "" {
+		return c.TasksPath(), true
+	}
+	for _, d := range c.TasksDirs {
+		if WorkstreamName(d) == name {
+			return filepath.Join(c.dir, filepath.FromSlash(d)), true
+		}
+	}
+	return "", false
+}
+
+// WorkstreamOf returns the workstream holding the task file at path, or ""
+// for tasks_dir and paths outside every tasks directory.
+func (c *Config) WorkstreamOf(path string) string {
+	dir := filepath.Dir(path)
+	for _, d := range c.TasksDirs {
+		if filepath.Join(c.dir, filepath.FromSlash(d)) == dir {
+			return WorkstreamName(d)
+		}
+	}
+	return ""
+}
+
+// TasksPaths returns the absolute paths of every directory holding tasks:
+// tasks_dir first, then the tasks_dirs entries in order.
+func (c *Config) TasksPaths() []string {
+	paths := make([]string, 0, 1+len(c.TasksDirs))
+	paths = append(paths, c.TasksPath())
+	for _, d := range c.TasksDirs {
+		paths = append(paths, filepath.Join(c.dir, filepath.FromSlash(d)))
+	}
+	return paths
+}
+
+// ExistingTasksPaths is TasksPaths without the workstream directories that
+// have not been created yet, for callers that cannot handle missing paths,
+// such as file watchers.
+func (c *Config) ExistingTasksPaths() []string {
+	paths := c.TasksPaths()
+	out := paths[:1]
+	for _, p := range paths[1:] {
+		if info, err := os.Stat(p); err == nil && info.IsDir() {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
 // ConfigPath returns the absolute path to the config file.
 func (c *Config) ConfigPath() string {
 	return filepath.Join(c.dir, ConfigFileName)
@@ -154,6 +587,22 @@ func (c *Config) StatusRequiresClaim(status string) bool {
 	return false
 }
 
+// StatusRequiresReviewer returns true if the given status has require_reviewer set.
+func (c *Config) StatusRequiresReviewer(status string) bool {
+	for _, s := range c.Statuses {
+		if s.Name == status {
+			return s.RequireReviewer
+		}
+	}
+	return false
+}
+
+// StatusRequiresEstimate reports whether the status is listed in
+// require_estimate_for.
+func (c *Config) StatusRequiresEstimate(status string) bool {
+	return contains(c.RequireEstimateFor, status)
+}
+
 // StatusShowDuration returns whether the given status column should display
 // task age/duration. If not explicitly configured, returns true (show by default).
 func (c *Config) StatusShowDuration(status string) bool {
@@ -168,6 +617,16 @@ func (c *Config) StatusShowDuration(status string) bool {
 	return true
 }
 
+// StatusChecklist returns the checklist template for the given status.
+func (c *Config) StatusChecklist(status string) []string {
+	for _, s := range c.Statuses {
+		if s.Name == status {
+			return s.Checklist
+		}
+	}
+	return nil
+}
+
 // Validate checks the config for errors.
 func (c *Config) Validate() error {
 	if c.Version != CurrentVersion {
@@ -176,6 +635,9 @@ func (c *Config) Validate() error {
 	if c.Board.Name == "" {
 		return fmt.Errorf("%w: board.name is required", ErrInvalid)
 	}
+	if p := c.Board.IDPrefix; p != "" && !idPrefixRe.MatchString(p) {
+		return fmt.Errorf("%w: board.id_prefix %q must start with a letter and not end with a digit", ErrInvalid, p)
+	}
 	if c.TasksDir == "" {
 		return fmt.Errorf("%w: tasks_dir is required", ErrInvalid)
 	}
@@ -198,24 +660,88 @@ func (c *Config) Validate() error {
 	if !contains(c.Priorities, c.Defaults.Priority) {
 		return fmt.Errorf("%w: default priority %q not in priorities list", ErrInvalid, c.Defaults.Priority)
 	}
-	if err := c.validateWIPLimits(); err != nil {
-		return err
+	for _, validate := range []func() error{
+		c.validateAutoStatus,
+		c.validateWIPLimits,
+		c.validateChecklists,
+		c.validateClasses,
+		c.validateClaimTimeout,
+		c.validateTUI,
+		c.validateDependencies,
+		c.validateEstimates,
+		c.validateRequireEstimate,
+		c.validateDisplay,
+		c.validateCalendar,
+		c.validateTags,
+		c.validateServe,
+		c.validateSecurity,
+		c.validateRedact,
+		c.validateHealth,
+		c.validateLogSinks,
+		c.validateSubscriptions,
+		c.validateTagDefaults,
+		c.validateWorkstreams,
+		c.validateOwners,
+		c.validateInbox,
+		c.validateTimestamps,
+		c.validateFiles,
+		c.validateLint,
+		c.validateReady,
+	} {
+		if err := validate(); err != nil {
+			return err
+		}
 	}
-	if err := c.validateClasses(); err != nil {
-		return err
+	if c.NextID < 1 {
+		return fmt.Errorf("%w: next_id must be >= 1", ErrInvalid)
 	}
-	if err := c.validateClaimTimeout(); err != nil {
-		return err
+	return nil
+}
+
+func (c *Config) validateAutoStatus() error {
+	names := c.StatusNames()
+	for _, s := range c.Defaults.AutoStatus {
+		if !contains(names, s) {
+			return fmt.Errorf("%w: defaults.auto_status references unknown status %q", ErrInvalid, s)
+		}
+		if c.IsArchivedStatus(s) {
+			return fmt.Errorf("%w: defaults.auto_status cannot include the archived status %q", ErrInvalid, s)
+		}
 	}
-	if err := c.validateTUI(); err != nil {
-		return err
+	if hasDuplicates(c.Defaults.AutoStatus) {
+		return fmt.Errorf("%w: defaults.auto_status contains duplicates", ErrInvalid)
 	}
-	if c.NextID < 1 {
-		return fmt.Errorf("%w: next_id must be >= 1", ErrInvalid)
+	return nil
+}
+
+func (c *Config) validateRequireEstimate() error {
+	names := c.StatusNames()
+	for _, s := range c.RequireEstimateFor {
+		if !contains(names, s) {
+			return fmt.Errorf("%w: require_estimate_for references unknown status %q", ErrInvalid, s)
+		}
+	}
+	if hasDuplicates(c.RequireEstimateFor) {
+		return fmt.Errorf("%w: require_estimate_for contains duplicates", ErrInvalid)
 	}
 	return nil
 }
 
+// AutoStatusOrder returns the statuses create --status auto tries, in order:
+// defaults.auto_status if set, otherwise every non-terminal board status.
+func (c *Config) AutoStatusOrder() []string {
+	if len(c.Defaults.AutoStatus) > 0 {
+		return c.Defaults.AutoStatus
+	}
+	var order []string
+	for _, s := range c.BoardStatuses() {
+		if !c.IsTerminalStatus(s) {
+			order = append(order, s)
+		}
+	}
+	return order
+}
+
 func (c *Config) validateWIPLimits() error {
 	names := c.StatusNames()
 	for status, limit := range c.WIPLimits {
@@ -229,6 +755,20 @@ func (c *Config) validateWIPLimits() error {
 	return nil
 }
 
+func (c *Config) validateChecklists() error {
+	for _, s := range c.Statuses {
+		for _, item := range s.Checklist {
+			if strings.TrimSpace(item) == "" || strings.ContainsAny(item, "\r\n") {
+				return fmt.Errorf("%w: status %q checklist items must be non-empty single lines", ErrInvalid, s.Name)
+			}
+		}
+		if hasDuplicates(s.Checklist) {
+			return fmt.Errorf("%w: status %q checklist contains duplicates", ErrInvalid, s.Name)
+		}
+	}
+	return nil
+}
+
 func (c *Config) validateClasses() error {
 	if len(c.Classes) == 0 {
 		return nil // classes are optional
@@ -275,9 +815,601 @@ func (c *Config) validateTUI() error {
 			return fmt.Errorf("%w: tui.age_thresholds[%d].color is required", ErrInvalid, i)
 		}
 	}
+	names := c.BoardStatuses()
+	for _, s := range c.TUI.CollapsedColumns {
+		if !contains(names, s) {
+			return fmt.Errorf("%w: tui.collapsed_columns references unknown status %q", ErrInvalid, s)
+		}
+	}
+	if hasDuplicates(c.TUI.CollapsedColumns) {
+		return fmt.Errorf("%w: tui.collapsed_columns contains duplicates", ErrInvalid)
+	}
+	return c.validateTUIKeys()
+}
+
+func (c *Config) validateTUIKeys() error {
+	for action, keys := range c.TUI.Keys {
+		if _, ok := DefaultTUIKeys[action]; !ok {
+			return fmt.Errorf("%w: tui.keys: unknown action %q (valid: %s)",
+				ErrInvalid, action, strings.Join(TUIActions, ", "))
+		}
+		for _, k := range keys {
+			if strings.TrimSpace(k) == "" {
+				return fmt.Errorf("%w: tui.keys.%s: empty key", ErrInvalid, action)
+			}
+			if k == TUIForceQuitKey {
+				return fmt.Errorf("%w: tui.keys.%s: %s is reserved for force quit", ErrInvalid, action, k)
+			}
+		}
+	}
+	bound := make(map[string]string)
+	keys := c.TUIKeys()
+	for _, action := range TUIActions {
+		for _, k := range keys[action] {
+			if other, ok := bound[k]; ok && other != action {
+				return fmt.Errorf("%w: tui.keys: %q is bound to both %s and %s",
+					ErrInvalid, k, other, action)
+			}
+			bound[k] = action
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateDependencies() error {
+	action, arg := c.OnUnblockAction()
+	switch action {
+	case "":
+		return nil
+	case OnUnblockMoveTo:
+		if !contains(c.StatusNames(), arg) || arg == ArchivedStatus {
+			return fmt.Errorf("%w: dependencies.on_unblock: unknown status %q", ErrInvalid, arg)
+		}
+	case OnUnblockTag:
+		if arg == "" {
+			return fmt.Errorf("%w: dependencies.on_unblock: tag requires a tag name", ErrInvalid)
+		}
+	case OnUnblockNotify:
+		if arg != "" {
+			return fmt.Errorf("%w: dependencies.on_unblock: notify takes no argument", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: dependencies.on_unblock: unknown action %q (use move_to, tag, or notify)",
+			ErrInvalid, action)
+	}
+	return nil
+}
+
+func (c *Config) validateEstimates() error {
+	const hoursInDay = 24
+	if h := c.Estimates.HoursPerDay; h < 0 || h > hoursInDay {
+		return fmt.Errorf("%w: estimates.hours_per_day must be between 0 and %d", ErrInvalid, hoursInDay)
+	}
+	return nil
+}
+
+func (c *Config) validateDisplay() error {
+	if _, err := loadDisplayLocation(c.Display.Timezone); err != nil {
+		return fmt.Errorf("%w: display.timezone %q: %w", ErrInvalid, c.Display.Timezone, err)
+	}
+	return nil
+}
+
+func (c *Config) validateTags() error {
+	for tag, style := range c.Tags.Styles {
+		if tag == "" {
+			return fmt.Errorf("%w: tags.styles contains an empty tag name", ErrInvalid)
+		}
+		if style.Color == "" && style.Icon == "" {
+			return fmt.Errorf("%w: tags.styles.%s needs a color or an icon", ErrInvalid, tag)
+		}
+		if style.Color != "" && !validColor(style.Color) {
+			return fmt.Errorf("%w: tags.styles.%s color %q must be an ANSI code 0-255 or #rgb/#rrggbb",
+				ErrInvalid, tag, style.Color)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateServe() error {
+	return ValidateServeTokens(c.Serve.Tokens)
+}
+
+// ValidateServeTokens checks that every token has a unique name, a secret,
+// and a known scope.
+func ValidateServeTokens(tokens []ServeToken) error {
+	seen := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		if t.Name == "" {
+			return fmt.Errorf("%w: serve token name is required", ErrInvalid)
+		}
+		if seen[t.Name] {
+			return fmt.Errorf("%w: duplicate serve token %q", ErrInvalid, t.Name)
+		}
+		seen[t.Name] = true
+		if t.Token == "" {
+			return fmt.Errorf("%w: serve token %q has no token", ErrInvalid, t.Name)
+		}
+		if IndexOf(ServeScopes, t.Scope) < 0 {
+			return fmt.Errorf("%w: serve token %q scope %q must be one of %s",
+				ErrInvalid, t.Name, t.Scope, strings.Join(ServeScopes, ", "))
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSecurity() error {
+	age := 0
+	for _, r := range c.Security.Recipients {
+		if strings.TrimSpace(r) == "" {
+			return fmt.Errorf("%w: security.recipients contains an empty recipient", ErrInvalid)
+		}
+		if strings.HasPrefix(r, "age1") {
+			age++
+		}
+	}
+	if age > 0 && age < len(c.Security.Recipients) {
+		return fmt.Errorf("%w: security.recipients cannot mix age and GPG recipients", ErrInvalid)
+	}
+	return nil
+}
+
+func (c *Config) validateRedact() error {
+	for _, p := range c.Redact.Patterns {
+		if p == "" {
+			return fmt.Errorf("%w: redact.patterns contains an empty pattern", ErrInvalid)
+		}
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("%w: redact pattern %q: %v", ErrInvalid, p, err)
+		}
+	}
+	for _, f := range c.Redact.Fields {
+		if IndexOf(RedactFields, f) < 0 {
+			return fmt.Errorf("%w: redact field %q must be one of %s",
+				ErrInvalid, f, strings.Join(RedactFields, ", "))
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateHealth() error {
+	for name, th := range c.Health.Thresholds {
+		if IndexOf(HealthIndicators, name) < 0 {
+			return fmt.Errorf("%w: health.thresholds: unknown indicator %q (valid: %s)",
+				ErrInvalid, name, strings.Join(HealthIndicators, ", "))
+		}
+		if th.Warn < 0 || th.Critical < 0 {
+			return fmt.Errorf("%w: health.thresholds.%s must be >= 0", ErrInvalid, name)
+		}
+		if th.Warn > 0 && th.Critical > 0 && th.Critical < th.Warn {
+			return fmt.Errorf("%w: health.thresholds.%s: critical must be >= warn", ErrInvalid, name)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateLogSinks() error {
+	seen := make(map[string]bool, len(c.Log.Sinks))
+	for _, s := range c.Log.Sinks {
+		if !sinkNameRe.MatchString(s.Name) {
+			return fmt.Errorf("%w: log sink name %q must be letters, digits, '-' or '_'", ErrInvalid, s.Name)
+		}
+		if seen[s.Name] {
+			return fmt.Errorf("%w: duplicate log sink %q", ErrInvalid, s.Name)
+		}
+		seen[s.Name] = true
+		if err := validateLogSinkTarget(s); err != nil {
+			return err
+		}
+		if s.Timeout != "" {
+			if d, err := time.ParseDuration(s.Timeout); err != nil || d <= 0 {
+				return fmt.Errorf("%w: log sink %q timeout %q must be a positive duration", ErrInvalid, s.Name, s.Timeout)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateInbox() error {
+	if st := c.Inbox.Status; st != "" && !contains(c.StatusNames(), st) {
+		return fmt.Errorf("%w: inbox.status %q not in statuses list", ErrInvalid, st)
+	}
+	seen := make(map[string]bool, len(c.Inbox.Sources))
+	for _, s := range c.Inbox.Sources {
+		if !sinkNameRe.MatchString(s.Name) {
+			return fmt.Errorf("%w: inbox source name %q must be letters, digits, '-' or '_'", ErrInvalid, s.Name)
+		}
+		if seen[s.Name] {
+			return fmt.Errorf("%w: duplicate inbox source %q", ErrInvalid, s.Name)
+		}
+		seen[s.Name] = true
+		if !contains(InboxSourceTypes, s.Type) {
+			return fmt.Errorf("%w: inbox source %q has unknown type %q (want one of: %s)",
+				ErrInvalid, s.Name, s.Type, strings.Join(InboxSourceTypes, ", "))
+		}
+		if strings.TrimSpace(s.Path) == "" {
+			return fmt.Errorf("%w: inbox source %q has no path", ErrInvalid, s.Name)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateTimestamps() error {
+	names := c.StatusNames()
+	for key, list := range map[string][]string{
+		"timestamps.start_on":    c.Timestamps.StartOn,
+		"timestamps.complete_on": c.Timestamps.CompleteOn,
+	} {
+		for _, s := range list {
+			if !contains(names, s) {
+				return fmt.Errorf("%w: %s references unknown status %q", ErrInvalid, key, s)
+			}
+		}
+		if hasDuplicates(list) {
+			return fmt.Errorf("%w: %s contains duplicates", ErrInvalid, key)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateFiles() error {
+	if n := c.Files.Naming; n != "" && !contains(NamingSchemes, n) {
+		return fmt.Errorf("%w: files.naming %q must be one of: %s", ErrInvalid, n, strings.Join(NamingSchemes, ", "))
+	}
+	if c.Files.SlugLength < 0 {
+		return fmt.Errorf("%w: files.slug_length must be >= 0", ErrInvalid)
+	}
+	return nil
+}
+
+func (c *Config) validateReady() error {
+	names := c.StatusNames()
+	for _, s := range c.Ready.PullFrom {
+		if !contains(names, s) {
+			return fmt.Errorf("%w: ready.pull_from references unknown status %q", ErrInvalid, s)
+		}
+	}
+	if hasDuplicates(c.Ready.PullFrom) {
+		return fmt.Errorf("%w: ready.pull_from contains duplicates", ErrInvalid)
+	}
+	if t := c.Ready.Target; t != "" && !contains(names, t) {
+		return fmt.Errorf("%w: ready.target %q not in statuses list", ErrInvalid, t)
+	}
+	return nil
+}
+
+func (c *Config) validateLint() error {
+	if c.Lint.TitleMaxLength < 0 {
+		return fmt.Errorf("%w: lint.title_max_length must be >= 0", ErrInvalid)
+	}
+	if p := c.Lint.BodyRequiredFrom; p != "" && !contains(c.Priorities, p) {
+		return fmt.Errorf("%w: lint.body_required_from %q is not a configured priority", ErrInvalid, p)
+	}
+	for rule, severity := range c.Lint.Severity {
+		if !contains(LintRules, rule) {
+			return fmt.Errorf("%w: lint.severity: unknown rule %q (valid: %s)",
+				ErrInvalid, rule, strings.Join(LintRules, ", "))
+		}
+		if !contains(LintSeverities, severity) {
+			return fmt.Errorf("%w: lint.severity.%s %q must be one of: %s",
+				ErrInvalid, rule, severity, strings.Join(LintSeverities, ", "))
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSubscriptions() error {
+	seen := make(map[Subscription]bool, len(c.Subscriptions))
+	for _, s := range c.Subscriptions {
+		if s.Task < 1 {
+			return fmt.Errorf("%w: subscription task must be >= 1", ErrInvalid)
+		}
+		if strings.TrimSpace(s.Exec) == "" {
+			return fmt.Errorf("%w: subscription to task %d has no exec command", ErrInvalid, s.Task)
+		}
+		if seen[s] {
+			return fmt.Errorf("%w: duplicate subscription to task %d: %q", ErrInvalid, s.Task, s.Exec)
+		}
+		seen[s] = true
+	}
+	return nil
+}
+
+func validateLogSinkTarget(s LogSink) error {
+	switch s.Type {
+	case LogSinkFile:
+		if s.Path == "" {
+			return fmt.Errorf("%w: log sink %q needs a path", ErrInvalid, s.Name)
+		}
+	case LogSinkSyslog:
+		if s.Address == "" {
+			return nil
+		}
+		network, _, ok := strings.Cut(s.Address, "://")
+		if !ok || (network != "udp" && network != "tcp") {
+			return fmt.Errorf("%w: log sink %q address %q must look like udp://host:port or tcp://host:port",
+				ErrInvalid, s.Name, s.Address)
+		}
+	case LogSinkHTTP:
+		u, err := url.Parse(s.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: log sink %q needs an http(s) url", ErrInvalid, s.Name)
+		}
+	default:
+		return fmt.Errorf("%w: log sink %q type %q must be one of %s",
+			ErrInvalid, s.Name, s.Type, strings.Join(LogSinkTypes, ", "))
+	}
+	return nil
+}
+
+func (c *Config) validateTagDefaults() error {
+	for name := range c.Templates {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("%w: templates has an empty name", ErrInvalid)
+		}
+	}
+	for tag, d := range c.TagDefaults {
+		if d.Priority != "" && !contains(c.Priorities, d.Priority) {
+			return fmt.Errorf("%w: tag_defaults.%s: priority %q not in priorities list", ErrInvalid, tag, d.Priority)
+		}
+		if d.Class != "" && c.ClassByName(d.Class) == nil {
+			return fmt.Errorf("%w: tag_defaults.%s: unknown class %q", ErrInvalid, tag, d.Class)
+		}
+		if _, ok := c.Templates[d.Template]; d.Template != "" && !ok {
+			return fmt.Errorf("%w: tag_defaults.%s: unknown template %q", ErrInvalid, tag, d.Template)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateWorkstreams() error {
+	names := c.Workstreams()
+	tasksDir := filepath.Clean(filepath.FromSlash(c.TasksDir))
+	for i, d := range c.TasksDirs {
+		clean := filepath.Clean(filepath.FromSlash(d))
+		if !filepath.IsLocal(clean) || clean == "." {
+			return fmt.Errorf("%w: tasks_dirs entry %q must be a subdirectory of the kanban directory", ErrInvalid, d)
+		}
+		if clean == tasksDir {
+			return fmt.Errorf("%w: tasks_dirs entry %q is tasks_dir", ErrInvalid, d)
+		}
+		if contains(names[:i], names[i]) {
+			return fmt.Errorf("%w: tasks_dirs has two workstreams named %q", ErrInvalid, names[i])
+		}
+	}
+	for ws, d := range c.WorkstreamDefaults {
+		if !contains(names, ws) {
+			return fmt.Errorf("%w: workstream_defaults: unknown workstream %q", ErrInvalid, ws)
+		}
+		if d.Status != "" && !contains(c.StatusNames(), d.Status) {
+			return fmt.Errorf("%w: workstream_defaults.%s: status %q not in statuses list", ErrInvalid, ws, d.Status)
+		}
+		if d.Priority != "" && !contains(c.Priorities, d.Priority) {
+			return fmt.Errorf("%w: workstream_defaults.%s: priority %q not in priorities list", ErrInvalid, ws, d.Priority)
+		}
+		if d.Class != "" && c.ClassByName(d.Class) == nil {
+			return fmt.Errorf("%w: workstream_defaults.%s: unknown class %q", ErrInvalid, ws, d.Class)
+		}
+	}
+	return nil
+}
+
+// HealthThresholdFor returns the configured threshold for a health
+// indicator, falling back to DefaultHealthThresholds.
+func (c *Config) HealthThresholdFor(name string) HealthThreshold {
+	if th, ok := c.Health.Thresholds[name]; ok {
+		return th
+	}
+	return DefaultHealthThresholds[name]
+}
+
+// FormatID renders a task ID for display: "API-12" with board.id_prefix
+// set, "#12" otherwise.
+func (c *Config) FormatID(id int) string {
+	if c.Board.IDPrefix != "" {
+		return c.Board.IDPrefix + strconv.Itoa(id)
+	}
+	return "#" + strconv.Itoa(id)
+}
+
+// ServeTokensPath returns the absolute path of serve.tokens_file, or "" if
+// none is configured.
+func (c *Config) ServeTokensPath() string {
+	p := c.Serve.TokensFile
+	if p == "" || filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(c.dir, p)
+}
+
+// StartsTask reports whether a move from oldStatus to newStatus sets a
+// task's started time: entering a timestamps.start_on status, or without
+// one, leaving the first status.
+func (c *Config) StartsTask(oldStatus, newStatus string) bool {
+	if len(c.Timestamps.StartOn) > 0 {
+		return contains(c.Timestamps.StartOn, newStatus)
+	}
+	first := c.StatusNames()[0]
+	return oldStatus == first && newStatus != first
+}
+
+// CompletesTask reports whether a task in status counts as completed: the
+// status is in timestamps.complete_on, or without it, is terminal. The
+// archived status always counts.
+func (c *Config) CompletesTask(status string) bool {
+	if len(c.Timestamps.CompleteOn) > 0 {
+		return status == ArchivedStatus || contains(c.Timestamps.CompleteOn, status)
+	}
+	return c.IsTerminalStatus(status)
+}
+
+// FileNaming returns the task file name scheme.
+func (c *Config) FileNaming() string {
+	if c.Files.Naming != "" {
+		return c.Files.Naming
+	}
+	return NamingIDSlug
+}
+
+// SlugLength returns the longest slug in a task file name.
+func (c *Config) SlugLength() int {
+	if c.Files.SlugLength > 0 {
+		return c.Files.SlugLength
+	}
+	return DefaultSlugLength
+}
+
+// ReadyPullFrom returns the statuses ready tasks wait in.
+func (c *Config) ReadyPullFrom() []string {
+	if len(c.Ready.PullFrom) > 0 {
+		return c.Ready.PullFrom
+	}
+	return c.ActiveStatuses()
+}
+
+// LintTitleMaxLength returns the longest title lint accepts.
+func (c *Config) LintTitleMaxLength() int {
+	if c.Lint.TitleMaxLength > 0 {
+		return c.Lint.TitleMaxLength
+	}
+	return DefaultLintTitleMaxLength
+}
+
+// LintBodyRequiredFrom returns the lowest priority whose tasks need a body.
+func (c *Config) LintBodyRequiredFrom() string {
+	if c.Lint.BodyRequiredFrom != "" {
+		return c.Lint.BodyRequiredFrom
+	}
+	return DefaultLintBodyRequiredFrom
+}
+
+// LintSeverity returns the severity of a lint rule.
+func (c *Config) LintSeverity(rule string) string {
+	if s, ok := c.Lint.Severity[rule]; ok {
+		return s
+	}
+	return DefaultLintSeverity[rule]
+}
+
+// InboxStatus returns the status inbox items are created in.
+func (c *Config) InboxStatus() string {
+	if c.Inbox.Status != "" {
+		return c.Inbox.Status
+	}
+	return c.Defaults.Status
+}
+
+// InboxSourcePath returns the absolute directory of an inbox source.
+func (c *Config) InboxSourcePath(s InboxSource) string {
+	if filepath.IsAbs(s.Path) {
+		return s.Path
+	}
+	return filepath.Join(c.dir, filepath.FromSlash(s.Path))
+}
+
+// hexColorRe matches #rgb and #rrggbb colors.
+var hexColorRe = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// sinkNameRe matches log sink names, which are also used in file names.
+var sinkNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// idPrefixRe matches board.id_prefix values such as "API-" or "WEB".
+var idPrefixRe = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_-]*[A-Za-z_-])?$`)
+
+// validColor reports whether s is an ANSI 256 color code or a hex color.
+func validColor(s string) bool {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n >= 0 && n <= 255
+	}
+	return hexColorRe.MatchString(s)
+}
+
+func (c *Config) validateCalendar() error {
+	off := make(map[time.Weekday]bool, len(c.Calendar.Weekends))
+	for _, name := range c.Calendar.Weekends {
+		wd, ok := weekdayByName(name)
+		if !ok {
+			return fmt.Errorf("%w: calendar.weekends: unknown weekday %q", ErrInvalid, name)
+		}
+		off[wd] = true
+	}
+	if len(off) == daysPerWeek {
+		return fmt.Errorf("%w: calendar.weekends must leave at least one workday", ErrInvalid)
+	}
+	for _, h := range c.Calendar.Holidays {
+		if _, err := time.Parse(dateFormat, h); err != nil {
+			return fmt.Errorf("%w: calendar.holidays: invalid date %q (expected YYYY-MM-DD)", ErrInvalid, h)
+		}
+	}
 	return nil
 }
 
+// IsWorkday reports whether the calendar date of t is a working day: not a
+// configured weekend day and not a holiday.
+func (c *Config) IsWorkday(t time.Time) bool {
+	weekends := c.Calendar.Weekends
+	if len(weekends) == 0 {
+		weekends = DefaultWeekends
+	}
+	for _, name := range weekends {
+		if wd, ok := weekdayByName(name); ok && wd == t.Weekday() {
+			return false
+		}
+	}
+	day := t.Format(dateFormat)
+	for _, h := range c.Calendar.Holidays {
+		if h == day {
+			return false
+		}
+	}
+	return true
+}
+
+func weekdayByName(name string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(name, wd.String()) {
+			return wd, true
+		}
+	}
+	return 0, false
+}
+
+// DisplayLocation returns the time zone used to render timestamps. It falls
+// back to local time when display.timezone is unset or invalid.
+func (c *Config) DisplayLocation() *time.Location {
+	loc, err := loadDisplayLocation(c.Display.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+func loadDisplayLocation(name string) (*time.Location, error) {
+	if name == "" || strings.EqualFold(name, "local") {
+		return time.Local, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// EstimateHoursPerDay returns the working hours in an estimated day,
+// falling back to DefaultEstimateHoursPerDay when unset.
+func (c *Config) EstimateHoursPerDay() float64 {
+	if c.Estimates.HoursPerDay > 0 {
+		return c.Estimates.HoursPerDay
+	}
+	return DefaultEstimateHoursPerDay
+}
+
+// OnUnblockAction splits dependencies.on_unblock into its action and argument,
+// e.g. "move_to todo" yields ("move_to", "todo"). Both are empty when unset.
+func (c *Config) OnUnblockAction() (action, arg string) {
+	fields := strings.Fields(c.Dependencies.OnUnblock)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	return fields[0], strings.Join(fields[1:], " ")
+}
+
 // AgeThresholdsDuration returns the age thresholds as parsed durations with color codes,
 // sorted by duration ascending. Returns DefaultAgeThresholds parsed if none are configured.
 func (c *Config) AgeThresholdsDuration() []struct {
@@ -326,6 +1458,21 @@ func (c *Config) ClaimTimeoutDuration() time.Duration {
 	return d
 }
 
+// TUIKeys returns the keys bound to each TUI board-view action: the
+// defaults, with tui.keys entries replacing them. Disabled actions map to
+// an empty list.
+func (c *Config) TUIKeys() map[string][]string {
+	keys := make(map[string][]string, len(DefaultTUIKeys))
+	for action, def := range DefaultTUIKeys {
+		if custom, ok := c.TUI.Keys[action]; ok {
+			keys[action] = append([]string{}, custom...)
+		} else {
+			keys[action] = append([]string{}, def...)
+		}
+	}
+	return keys
+}
+
 // TitleLines returns the configured number of title lines for TUI cards.
 // Returns DefaultTitleLines if the value is unset (zero).
 func (c *Config) TitleLines() int {
@@ -388,15 +1535,35 @@ func Init(dir, name string) (*Config, error) {
 	return cfg, nil
 }
 
-// Save writes the config to its config file.
+// Save writes the config to its config file. A file first written by
+// SaveCommented keeps its comments.
 func (c *Config) Save() error {
-	data, err := yaml.Marshal(c)
+	data, err := c.Marshal()
 	if err != nil {
-		return fmt.Errorf("marshaling config: %w", err)
+		return err
 	}
 	return os.WriteFile(c.ConfigPath(), data, fileMode)
 }
 
+// Marshal returns the config file contents Save writes.
+func (c *Config) Marshal() ([]byte, error) {
+	if c.commented {
+		return c.marshalCommented()
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling config: %w", err)
+	}
+	return data, nil
+}
+
+// SaveCommented writes the config with a comment on each key explaining
+// it. Later saves keep the comments.
+func (c *Config) SaveCommented() error {
+	c.commented = true
+	return c.Save()
+}
+
 // Load reads and validates a config from the given kanban directory.
 func Load(dir string) (*Config, error) {
 	absDir, err := filepath.Abs(dir)
@@ -419,6 +1586,7 @@ func Load(dir string) (*Config, error) {
 	}
 
 	cfg.dir = absDir
+	cfg.commented = hasCommentedHeader(data)
 
 	// Migrate old config versions forward before validating.
 	oldVersion := cfg.Version
@@ -424,3 +424,127 @@ func TestIndexOf_Empty(t *testing.T) {
 		t.Errorf("IndexOf(nil) = %d, want -1", idx)
 	}
 }
+
+func TestRebasePaths(t *testing.T) {
+	from := filepath.Join(string(filepath.Separator), "old", "kanban")
+	to := filepath.Join(string(filepath.Separator), "new", "board")
+	cfg := NewDefault("Test")
+	cfg.Log.Sinks = []LogSink{
+		{Name: "inside", Type: "file", Path: filepath.Join(from, "mirror.jsonl")},
+		{Name: "outside", Type: "file", Path: filepath.Join(string(filepath.Separator), "var", "log", "k.jsonl")},
+		{Name: "relative", Type: "file", Path: "mirror.jsonl"},
+	}
+	cfg.Serve.TokensFile = filepath.Join(from, "tokens.yml")
+
+	changed := cfg.RebasePaths(from, to)
+	if len(changed) != 2 || changed[0] != "log.sinks.inside.path" || changed[1] != "serve.tokens_file" {
+		t.Errorf("changed = %v", changed)
+	}
+	if want := filepath.Join(to, "mirror.jsonl"); cfg.Log.Sinks[0].Path != want {
+		t.Errorf("inside sink path = %q, want %q", cfg.Log.Sinks[0].Path, want)
+	}
+	if cfg.Log.Sinks[1].Path != filepath.Join(string(filepath.Separator), "var", "log", "k.jsonl") {
+		t.Errorf("outside sink path changed to %q", cfg.Log.Sinks[1].Path)
+	}
+	if cfg.Log.Sinks[2].Path != "mirror.jsonl" {
+		t.Errorf("relative sink path changed to %q", cfg.Log.Sinks[2].Path)
+	}
+	if want := filepath.Join(to, "tokens.yml"); cfg.Serve.TokensFile != want {
+		t.Errorf("tokens_file = %q, want %q", cfg.Serve.TokensFile, want)
+	}
+}
+
+func TestWorkstreamPaths(t *testing.T) {
+	cfg := NewDefault("Test")
+	cfg.SetDir(filepath.Join(string(filepath.Separator), "repo", "kanban"))
+	cfg.TasksDirs = []string{"product/", "streams/infra"}
+
+	if got := cfg.Workstreams(); len(got) != 2 || got[0] != "product" || got[1] != "infra" {
+		t.Errorf("Workstreams() = %v, want [product infra]", got)
+	}
+	paths := cfg.TasksPaths()
+	if len(paths) != 3 || paths[0] != cfg.TasksPath() || paths[2] != filepath.Join(cfg.Dir(), "streams", "infra") {
+		t.Errorf("TasksPaths() = %v", paths)
+	}
+	if p, ok := cfg.WorkstreamPath("infra"); !ok || p != paths[2] {
+		t.Errorf("WorkstreamPath(infra) = %q, %v", p, ok)
+	}
+	if _, ok := cfg.WorkstreamPath("ops"); ok {
+		t.Error("WorkstreamPath(ops) found an unknown workstream")
+	}
+	if ws := cfg.WorkstreamOf(filepath.Join(paths[2], "001-a.md")); ws != "infra" {
+		t.Errorf("WorkstreamOf(infra task) = %q", ws)
+	}
+	if ws := cfg.WorkstreamOf(filepath.Join(paths[0], "001-a.md")); ws != "" {
+		t.Errorf("WorkstreamOf(tasks_dir task) = %q, want empty", ws)
+	}
+}
+
+func TestOwnerFor(t *testing.T) {
+	cfg := NewDefault("Test")
+	cfg.Owners = []OwnerRule{
+		{Path: "src/", Assignee: "alice"},
+		{Path: "src/api/", Assignee: "bob", Tags: []string{"api"}},
+		{Path: "*.sql", Tags: []string{"db"}},
+		{Path: "/cmd/*/main.go", Assignee: "carol"},
+	}
+	tests := []struct {
+		path, want string
+		ok         bool
+	}{
+		{"src/api/handler.go", "src/api/", true},
+		{"./src/api", "src/api/", true},
+		{"src/ui/app.ts", "src/", true},
+		{"src/db/001.sql", "*.sql", true},
+		{"cmd/tool/main.go", "/cmd/*/main.go", true},
+		{"cmd/tool/util.go", "", false},
+		{"srcs/x.go", "", false},
+		{"docs/README.md", "", false},
+	}
+	for _, tt := range tests {
+		r, ok := cfg.OwnerFor(tt.path)
+		if ok != tt.ok || r.Path != tt.want {
+			t.Errorf("OwnerFor(%q) = %q, %v; want %q, %v", tt.path, r.Path, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// --- Goals tests ---
+
+func TestGoals(t *testing.T) {
+	cfg := NewDefault("Test")
+	cfg.SetDir(t.TempDir())
+
+	goals, err := cfg.Goals()
+	if err != nil || goals != nil {
+		t.Fatalf("Goals() without goals.yml = %v, %v; want none", goals, err)
+	}
+
+	data := "goals:\n  - name: Q1-latency\n    title: Cut p99 latency\n  - name: Q1-docs\n"
+	if err := os.WriteFile(cfg.GoalsPath(), []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	goals, err = cfg.Goals()
+	if err != nil {
+		t.Fatalf("Goals() error: %v", err)
+	}
+	if names := GoalNames(goals); len(names) != 2 || names[0] != "Q1-latency" || names[1] != "Q1-docs" {
+		t.Errorf("GoalNames = %v, want [Q1-latency Q1-docs]", names)
+	}
+	if goals[0].Title != "Cut p99 latency" {
+		t.Errorf("Title = %q", goals[0].Title)
+	}
+
+	for _, bad := range []string{
+		"goals:\n  - name: Q1 latency\n",
+		"goals:\n  - name: a\n  - name: a\n",
+		"goals: [",
+	} {
+		if err := os.WriteFile(cfg.GoalsPath(), []byte(bad), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cfg.Goals(); err == nil {
+			t.Errorf("Goals() accepted %q", bad)
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/clierr"
 )
@@ -60,6 +62,181 @@ func TestValidate(t *testing.T) {
 		{"tui.title_lines=0", func(c *Config) { c.TUI.TitleLines = 0 }, true},
 		{"tui.title_lines=4", func(c *Config) { c.TUI.TitleLines = 4 }, true},
 		{"tui.title_lines=-1", func(c *Config) { c.TUI.TitleLines = -1 }, true},
+		{"on_unblock move_to", func(c *Config) { c.Dependencies.OnUnblock = "move_to todo" }, false},
+		{"on_unblock tag", func(c *Config) { c.Dependencies.OnUnblock = "tag ready" }, false},
+		{"on_unblock notify", func(c *Config) { c.Dependencies.OnUnblock = "notify" }, false},
+		{"on_unblock unknown status", func(c *Config) { c.Dependencies.OnUnblock = "move_to bogus" }, true},
+		{"on_unblock archived", func(c *Config) { c.Dependencies.OnUnblock = "move_to archived" }, true},
+		{"on_unblock tag without name", func(c *Config) { c.Dependencies.OnUnblock = "tag" }, true},
+		{"on_unblock notify with arg", func(c *Config) { c.Dependencies.OnUnblock = "notify me" }, true},
+		{"on_unblock unknown action", func(c *Config) { c.Dependencies.OnUnblock = "explode" }, true},
+		{"estimates hours_per_day", func(c *Config) { c.Estimates.HoursPerDay = 6 }, false},
+		{"estimates hours_per_day negative", func(c *Config) { c.Estimates.HoursPerDay = -1 }, true},
+		{"estimates hours_per_day too large", func(c *Config) { c.Estimates.HoursPerDay = 25 }, true},
+		{"display timezone UTC", func(c *Config) { c.Display.Timezone = "UTC" }, false},
+		{"display timezone local", func(c *Config) { c.Display.Timezone = "local" }, false},
+		{"display timezone IANA", func(c *Config) { c.Display.Timezone = "Europe/Berlin" }, false},
+		{"display timezone unknown", func(c *Config) { c.Display.Timezone = "Mars/Olympus" }, true},
+		{"calendar weekends", func(c *Config) { c.Calendar.Weekends = []string{"Friday", "saturday"} }, false},
+		{"calendar unknown weekend", func(c *Config) { c.Calendar.Weekends = []string{"funday"} }, true},
+		{"calendar no workdays", func(c *Config) {
+			c.Calendar.Weekends = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+		}, true},
+		{"calendar holidays", func(c *Config) { c.Calendar.Holidays = []string{"2026-12-25"} }, false},
+		{"calendar bad holiday", func(c *Config) { c.Calendar.Holidays = []string{"Dec 25"} }, true},
+		{"tag style ansi", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"bug": {Color: "196"}} }, false},
+		{"tag style hex and icon", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"ui": {Color: "#88f", Icon: "🎨"}} }, false},
+		{"tag style icon only", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"docs": {Icon: "📝"}} }, false},
+		{"tag style empty", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"bug": {}} }, true},
+		{"tag style named color", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"bug": {Color: "red"}} }, true},
+		{"tag style ansi out of range", func(c *Config) { c.Tags.Styles = map[string]TagStyle{"bug": {Color: "256"}} }, true},
+		{"serve token", func(c *Config) { c.Serve.Tokens = []ServeToken{{Name: "ci", Token: "s", Scope: "write"}} }, false},
+		{"serve token bad scope", func(c *Config) { c.Serve.Tokens = []ServeToken{{Name: "ci", Token: "s", Scope: "root"}} }, true},
+		{"serve token no secret", func(c *Config) { c.Serve.Tokens = []ServeToken{{Name: "ci", Scope: "read"}} }, true},
+		{"serve token duplicate", func(c *Config) {
+			c.Serve.Tokens = []ServeToken{{Name: "ci", Token: "a", Scope: "read"}, {Name: "ci", Token: "b", Scope: "read"}}
+		}, true},
+		{"security age recipients", func(c *Config) { c.Security.Recipients = []string{"age1abc", "age1def"} }, false},
+		{"security gpg recipients", func(c *Config) { c.Security.Recipients = []string{"ops@example.com"} }, false},
+		{"security mixed recipients", func(c *Config) { c.Security.Recipients = []string{"age1abc", "ops@example.com"} }, true},
+		{"security empty recipient", func(c *Config) { c.Security.Recipients = []string{" "} }, true},
+		{"id prefix", func(c *Config) { c.Board.IDPrefix = "API-" }, false},
+		{"id prefix without separator", func(c *Config) { c.Board.IDPrefix = "WEB" }, false},
+		{"id prefix ending in digit", func(c *Config) { c.Board.IDPrefix = "V2" }, true},
+		{"id prefix with space", func(c *Config) { c.Board.IDPrefix = "A B-" }, true},
+		{"redact patterns", func(c *Config) { c.Redact.Patterns = []string{`sk-[A-Za-z0-9]{20,}`, "ACME"} }, false},
+		{"redact bad pattern", func(c *Config) { c.Redact.Patterns = []string{"(unclosed"} }, true},
+		{"redact empty pattern", func(c *Config) { c.Redact.Patterns = []string{""} }, true},
+		{"redact fields", func(c *Config) { c.Redact.Fields = []string{"assignee", "body"} }, false},
+		{"redact unknown field", func(c *Config) { c.Redact.Fields = []string{"priority"} }, true},
+		{"auto status order", func(c *Config) { c.Defaults.AutoStatus = []string{"todo", "backlog"} }, false},
+		{"auto status unknown", func(c *Config) { c.Defaults.AutoStatus = []string{"nope"} }, true},
+		{"auto status archived", func(c *Config) { c.Defaults.AutoStatus = []string{"archived"} }, true},
+		{"auto status duplicate", func(c *Config) { c.Defaults.AutoStatus = []string{"todo", "todo"} }, true},
+		{"tasks_dirs", func(c *Config) { c.TasksDirs = []string{"product/", "streams/infra"} }, false},
+		{"tasks_dirs outside board", func(c *Config) { c.TasksDirs = []string{"../infra"} }, true},
+		{"tasks_dirs is tasks_dir", func(c *Config) { c.TasksDirs = []string{"./tasks"} }, true},
+		{"tasks_dirs duplicate name", func(c *Config) { c.TasksDirs = []string{"a/infra", "b/infra"} }, true},
+		{"workstream defaults", func(c *Config) {
+			c.TasksDirs = []string{"infra"}
+			c.WorkstreamDefaults = map[string]WorkstreamDefaults{"infra": {Status: "todo", Priority: "high"}}
+		}, false},
+		{"workstream defaults unknown workstream", func(c *Config) {
+			c.WorkstreamDefaults = map[string]WorkstreamDefaults{"infra": {Priority: "high"}}
+		}, true},
+		{"owners", func(c *Config) {
+			c.Owners = []OwnerRule{{Path: "src/api/", Assignee: "alice"}, {Path: "*.sql", Tags: []string{"db"}}}
+		}, false},
+		{"owners empty path", func(c *Config) { c.Owners = []OwnerRule{{Path: "/", Assignee: "alice"}} }, true},
+		{"owners bad pattern", func(c *Config) { c.Owners = []OwnerRule{{Path: "src/[", Assignee: "alice"}} }, true},
+		{"owners nothing to apply", func(c *Config) { c.Owners = []OwnerRule{{Path: "src/"}} }, true},
+		{"inbox", func(c *Config) {
+			c.Inbox = InboxConfig{Status: "backlog", Sources: []InboxSource{{Name: "drop", Type: InboxFolder, Path: "inbox"}}}
+		}, false},
+		{"inbox bad status", func(c *Config) { c.Inbox.Status = "triage" }, true},
+		{"inbox bad source type", func(c *Config) {
+			c.Inbox.Sources = []InboxSource{{Name: "drop", Type: "ftp", Path: "inbox"}}
+		}, true},
+		{"inbox source without path", func(c *Config) {
+			c.Inbox.Sources = []InboxSource{{Name: "drop", Type: InboxFolder}}
+		}, true},
+		{"inbox duplicate source", func(c *Config) {
+			c.Inbox.Sources = []InboxSource{{Name: "a", Type: InboxFolder, Path: "x"}, {Name: "a", Type: InboxEmail, Path: "y"}}
+		}, true},
+		{"workstream defaults bad status", func(c *Config) {
+			c.TasksDirs = []string{"infra"}
+			c.WorkstreamDefaults = map[string]WorkstreamDefaults{"infra": {Status: "bogus"}}
+		}, true},
+		{"health threshold", func(c *Config) {
+			c.Health.Thresholds = map[string]HealthThreshold{"blocked_ratio": {Warn: 0.1, Critical: 0.3}}
+		}, false},
+		{"health unknown indicator", func(c *Config) {
+			c.Health.Thresholds = map[string]HealthThreshold{"happiness": {Warn: 1}}
+		}, true},
+		{"health critical below warn", func(c *Config) {
+			c.Health.Thresholds = map[string]HealthThreshold{"stale_claims": {Warn: 3, Critical: 1}}
+		}, true},
+		{"log sinks", func(c *Config) {
+			c.Log.Sinks = []LogSink{
+				{Name: "audit", Type: "file", Path: "audit.jsonl"},
+				{Name: "siem", Type: "http", URL: "https://siem.example.com/ingest", Timeout: "5s"},
+				{Name: "local", Type: "syslog"},
+				{Name: "remote", Type: "syslog", Address: "udp://logs:514"},
+			}
+		}, false},
+		{"log sink bad name", func(c *Config) { c.Log.Sinks = []LogSink{{Name: "a/b", Type: "file", Path: "x"}} }, true},
+		{"log sink duplicate", func(c *Config) {
+			c.Log.Sinks = []LogSink{{Name: "a", Type: "syslog"}, {Name: "a", Type: "syslog"}}
+		}, true},
+		{"log sink unknown type", func(c *Config) { c.Log.Sinks = []LogSink{{Name: "a", Type: "kafka"}} }, true},
+		{"log sink file no path", func(c *Config) { c.Log.Sinks = []LogSink{{Name: "a", Type: "file"}} }, true},
+		{"log sink http bad url", func(c *Config) { c.Log.Sinks = []LogSink{{Name: "a", Type: "http", URL: "ftp://x"}} }, true},
+		{"log sink syslog bad address", func(c *Config) {
+			c.Log.Sinks = []LogSink{{Name: "a", Type: "syslog", Address: "logs:514"}}
+		}, true},
+		{"log sink bad timeout", func(c *Config) {
+			c.Log.Sinks = []LogSink{{Name: "a", Type: "syslog", Timeout: "soon"}}
+		}, true},
+		{"subscriptions", func(c *Config) {
+			c.Subscriptions = []Subscription{{Task: 3, Exec: "./on-change.sh"}, {Task: 3, Exec: "notify-send changed"}}
+		}, false},
+		{"subscription bad task", func(c *Config) { c.Subscriptions = []Subscription{{Task: 0, Exec: "x"}} }, true},
+		{"subscription no exec", func(c *Config) { c.Subscriptions = []Subscription{{Task: 1, Exec: " "}} }, true},
+		{"subscription duplicate", func(c *Config) {
+			c.Subscriptions = []Subscription{{Task: 1, Exec: "x"}, {Task: 1, Exec: "x"}}
+		}, true},
+		{"tui keys", func(c *Config) {
+			c.TUI.Keys = map[string][]string{"quick_add": {"A"}, "create": {"a", "c"}, "debug": {}}
+		}, false},
+		{"tui keys swap", func(c *Config) { c.TUI.Keys = map[string][]string{"delete": {"x"}, "refresh": {"d"}} }, false},
+		{"tui collapsed columns", func(c *Config) { c.TUI.CollapsedColumns = []string{"backlog", "done"} }, false},
+		{"tui collapsed unknown status", func(c *Config) { c.TUI.CollapsedColumns = []string{"icebox"} }, true},
+		{"tui collapsed archived", func(c *Config) { c.TUI.CollapsedColumns = []string{"archived"} }, true},
+		{"tui collapsed duplicate", func(c *Config) { c.TUI.CollapsedColumns = []string{"done", "done"} }, true},
+		{"tui keys unknown action", func(c *Config) { c.TUI.Keys = map[string][]string{"archive": {"x"}} }, true},
+		{"tui keys empty key", func(c *Config) { c.TUI.Keys = map[string][]string{"create": {""}} }, true},
+		{"tui keys force quit", func(c *Config) { c.TUI.Keys = map[string][]string{"quit": {"ctrl+c"}} }, true},
+		{"tui keys conflict with default", func(c *Config) { c.TUI.Keys = map[string][]string{"quick_add": {"c"}} }, true},
+		{"require estimate", func(c *Config) { c.RequireEstimateFor = []string{"in-progress", "review"} }, false},
+		{"require estimate unknown", func(c *Config) { c.RequireEstimateFor = []string{"doing"} }, true},
+		{"require estimate duplicate", func(c *Config) { c.RequireEstimateFor = []string{"review", "review"} }, true},
+		{"timestamps", func(c *Config) {
+			c.Timestamps = TimestampsConfig{StartOn: []string{"in-progress"}, CompleteOn: []string{"review", "done"}}
+		}, false},
+		{"files naming", func(c *Config) { c.Files = FilesConfig{Naming: NamingDateIDSlug, SlugLength: 20} }, false},
+		{"files naming unknown", func(c *Config) { c.Files.Naming = "{slug}" }, true},
+		{"files slug length negative", func(c *Config) { c.Files.SlugLength = -1 }, true},
+		{"lint", func(c *Config) {
+			c.Lint = LintConfig{TitleMaxLength: 60, BodyRequiredFrom: "critical", Severity: map[string]string{LintRuleEmptyBody: LintError}}
+		}, false},
+		{"lint title length negative", func(c *Config) { c.Lint.TitleMaxLength = -1 }, true},
+		{"lint body priority unknown", func(c *Config) { c.Lint.BodyRequiredFrom = "urgent" }, true},
+		{"lint severity unknown rule", func(c *Config) { c.Lint.Severity = map[string]string{"spelling": LintError} }, true},
+		{"lint severity invalid", func(c *Config) { c.Lint.Severity = map[string]string{LintRuleEmptyBody: "fatal"} }, true},
+		{"ready valid", func(c *Config) { c.Ready = ReadyConfig{PullFrom: []string{"todo"}, Target: "in-progress"} }, false},
+		{"ready unknown pull_from", func(c *Config) { c.Ready.PullFrom = []string{"triage"} }, true},
+		{"ready duplicate pull_from", func(c *Config) { c.Ready.PullFrom = []string{"todo", "todo"} }, true},
+		{"ready unknown target", func(c *Config) { c.Ready.Target = "doing" }, true},
+		{"timestamps start_on unknown", func(c *Config) { c.Timestamps.StartOn = []string{"doing"} }, true},
+		{"timestamps complete_on duplicate", func(c *Config) { c.Timestamps.CompleteOn = []string{"done", "done"} }, true},
+		{"tag defaults", func(c *Config) {
+			c.Templates = map[string]string{"bug": "## Steps"}
+			c.TagDefaults = map[string]TagDefaults{"bug": {Priority: "high", Class: "expedite", Template: "bug"}}
+		}, false},
+		{"tag defaults bad priority", func(c *Config) {
+			c.TagDefaults = map[string]TagDefaults{"bug": {Priority: "urgent"}}
+		}, true},
+		{"tag defaults unknown class", func(c *Config) {
+			c.TagDefaults = map[string]TagDefaults{"bug": {Class: "vip"}}
+		}, true},
+		{"tag defaults unknown template", func(c *Config) {
+			c.TagDefaults = map[string]TagDefaults{"bug": {Template: "bug"}}
+		}, true},
+		{"template empty name", func(c *Config) { c.Templates = map[string]string{" ": "x"} }, true},
+		{"status checklist", func(c *Config) { c.Statuses[3].Checklist = []string{"code reviewed", "tests pass"} }, false},
+		{"status checklist empty item", func(c *Config) { c.Statuses[3].Checklist = []string{" "} }, true},
+		{"status checklist multiline item", func(c *Config) { c.Statuses[3].Checklist = []string{"a\nb"} }, true},
+		{"status checklist duplicates", func(c *Config) { c.Statuses[3].Checklist = []string{"a", "a"} }, true},
 	}
 
 	for _, tt := range tests {
@@ -106,6 +283,47 @@ func TestSaveAndLoad(t *testing.T) {
 	}
 }
 
+func TestSaveCommented(t *testing.T) {
+	dir := t.TempDir()
+	cfg := NewDefault("Test Project")
+	cfg.SetDir(dir)
+	cfg.WIPLimits = map[string]int{"in-progress": 3}
+
+	if err := cfg.SaveCommented(); err != nil {
+		t.Fatalf("SaveCommented() error: %v", err)
+	}
+	data, err := os.ReadFile(cfg.ConfigPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# " + commentedHeader,
+		"# " + keyComments["wip_limits"] + "\nwip_limits:",
+		"    # " + keyComments["board.name"] + "\n    name: Test Project",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("commented config missing %q:\n%s", want, data)
+		}
+	}
+
+	// A later plain Save keeps the comments.
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if loaded.WIPLimits["in-progress"] != 3 {
+		t.Errorf("loaded WIPLimits = %v, want in-progress: 3", loaded.WIPLimits)
+	}
+	loaded.NextID = 7
+	if err := loaded.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	data, _ = os.ReadFile(cfg.ConfigPath())
+	if !strings.Contains(string(data), "# "+keyComments["next_id"]+"\nnext_id: 7") {
+		t.Errorf("Save dropped comments:\n%s", data)
+	}
+}
+
 func TestLoadNotFound(t *testing.T) {
 	_, err := Load(t.TempDir())
 	if !errors.Is(err, ErrNotFound) {
@@ -182,6 +400,23 @@ func TestIsTerminalStatus(t *testing.T) {
 	}
 }
 
+func TestTimestampTriggers(t *testing.T) {
+	cfg := NewDefault("Test")
+	cfg.Timestamps = TimestampsConfig{StartOn: []string{"in-progress"}, CompleteOn: []string{"review", "done"}}
+	if cfg.StartsTask("backlog", "todo") {
+		t.Error("StartsTask(backlog, todo) = true, want false with start_on set")
+	}
+	if !cfg.StartsTask("todo", "in-progress") {
+		t.Error("StartsTask(todo, in-progress) = false, want true")
+	}
+	if !cfg.CompletesTask("review") || !cfg.CompletesTask(ArchivedStatus) {
+		t.Error("CompletesTask should hold for complete_on statuses and archived")
+	}
+	if cfg.CompletesTask("in-progress") {
+		t.Error("CompletesTask(in-progress) = true, want false")
+	}
+}
+
 func TestIsTerminalStatusEmptyStatuses(t *testing.T) {
 	cfg := NewDefault("Test")
 	cfg.Statuses = nil
@@ -224,3 +459,25 @@ func TestStatusShowDuration(t *testing.T) {
 		t.Error("unknown status should default to ShowDuration=true")
 	}
 }
+
+func TestIsWorkday(t *testing.T) {
+	cfg := NewDefault("Test")
+	cfg.Calendar.Weekends = []string{"friday", "saturday"}
+	cfg.Calendar.Holidays = []string{"2026-03-08"}
+
+	for _, tc := range []struct {
+		day  int
+		want bool
+	}{
+		{5, true},  // Thursday
+		{6, false}, // Friday (weekend)
+		{7, false}, // Saturday (weekend)
+		{8, false}, // Sunday (holiday)
+		{9, true},  // Monday
+	} {
+		d := time.Date(2026, time.March, tc.day, 10, 0, 0, 0, time.UTC)
+		if got := cfg.IsWorkday(d); got != tc.want {
+			t.Errorf("IsWorkday(%s) = %v, want %v", d.Format("Mon Jan 2"), got, tc.want)
+		}
+	}
+}
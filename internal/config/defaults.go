@@ -1,6 +1,8 @@
 // Package config handles kanban board configuration.
 package config
 
+import "time"
+
 const (
 	// DefaultDir is the default kanban directory name.
 	DefaultDir = "kanban"
@@ -18,12 +20,73 @@ const (
 	DefaultTitleLines = 2
 	// DefaultHideEmptyColumns controls whether TUI hides empty status columns.
 	DefaultHideEmptyColumns = false
+	// DefaultEstimateHoursPerDay is the number of working hours in an
+	// estimated day ("1d") when estimates.hours_per_day is not set.
+	DefaultEstimateHoursPerDay = 8
+	// DefaultSlugLength is the longest slug in a task file name when
+	// files.slug_length is not set.
+	DefaultSlugLength = 50
+	// DefaultLintTitleMaxLength is the longest title lint accepts when
+	// lint.title_max_length is not set.
+	DefaultLintTitleMaxLength = 100
+	// DefaultLintBodyRequiredFrom is the lowest priority whose tasks need a
+	// body when lint.body_required_from is not set.
+	DefaultLintBodyRequiredFrom = "high"
+
+	// dateFormat is the layout of calendar dates in config (YYYY-MM-DD).
+	dateFormat = "2006-01-02"
+	// daysPerWeek bounds calendar.weekends, which must leave a workday.
+	daysPerWeek = 7
 
 	// ConfigFileName is the name of the config file within the kanban directory.
 	ConfigFileName = "config.yml"
 
 	// CurrentVersion is the current config schema version.
-	CurrentVersion = 10
+	CurrentVersion = 40
+
+	// NamingIDSlug, NamingID, and NamingDateIDSlug are the task file name
+	// schemes accepted by files.naming. {date} is the created date.
+	NamingIDSlug     = "{id}-{slug}"
+	NamingID         = "{id}"
+	NamingDateIDSlug = "{date}-{id}-{slug}"
+
+	// LintError, LintWarning, and LintOff are the lint rule severities.
+	LintError   = "error"
+	LintWarning = "warning"
+	LintOff     = "off"
+
+	// Lint rules. Frontmatter covers files that cannot be parsed and fields
+	// covers values not in the config (status, priority, class).
+	LintRuleFrontmatter    = "frontmatter"
+	LintRuleFields         = "fields"
+	LintRuleTitleLength    = "title-length"
+	LintRuleRequiredTags   = "required-tags"
+	LintRuleEmptyBody      = "empty-body"
+	LintRuleForbiddenWords = "forbidden-words"
+
+	// OnUnblockMoveTo, OnUnblockTag, and OnUnblockNotify are the actions
+	// accepted by dependencies.on_unblock.
+	OnUnblockMoveTo = "move_to"
+	OnUnblockTag    = "tag"
+	OnUnblockNotify = "notify"
+
+	// ScopeRead, ScopeWrite, and ScopeAdmin are the serve token scopes. Each
+	// scope includes the ones before it.
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+
+	// LogSinkFile, LogSinkSyslog, and LogSinkHTTP are the log sink types.
+	LogSinkFile   = "file"
+	LogSinkSyslog = "syslog"
+	LogSinkHTTP   = "http"
+
+	// DefaultLogSinkTimeout bounds a log sink delivery when the sink sets
+	// no timeout.
+	DefaultLogSinkTimeout = 2 * time.Second
+
+	// TUIForceQuitKey always quits the TUI and cannot be rebound.
+	TUIForceQuitKey = "ctrl+c"
 
 	// ArchivedStatus is the reserved status name for soft-deleted tasks.
 	ArchivedStatus = "archived"
@@ -58,6 +121,42 @@ var (
 		{After: "168h", Color: "196"}, // red (1 week)
 	}
 
+	// TUIActions lists the TUI board-view actions that tui.keys can rebind,
+	// in help order.
+	TUIActions = []string{
+		"left", "right", "down", "up", "open", "quick_add", "create", "edit",
+		"move", "next_status", "prev_status", "raise_priority", "lower_priority",
+		"delete", "jump", "collapse", "yank", "yank_path", "refresh", "sample_tasks", "help", "quit", "debug",
+	}
+
+	// DefaultTUIKeys are the keys bound to each TUI action, named as
+	// bubbletea reports them ("a", "enter", "ctrl+d", "shift+tab").
+	DefaultTUIKeys = map[string][]string{
+		"left":           {"left", "h"},
+		"right":          {"right", "l"},
+		"down":           {"down", "j"},
+		"up":             {"up", "k"},
+		"open":           {"enter"},
+		"quick_add":      {"a"},
+		"create":         {"c"},
+		"edit":           {"e"},
+		"move":           {"m"},
+		"next_status":    {"n"},
+		"prev_status":    {"p"},
+		"raise_priority": {"+", "="},
+		"lower_priority": {"-", "_"},
+		"delete":         {"d"},
+		"jump":           {"#"},
+		"collapse":       {"z"},
+		"yank":           {"y"},
+		"yank_path":      {"Y"},
+		"refresh":        {"r"},
+		"sample_tasks":   {"S"},
+		"help":           {"?"},
+		"quit":           {"q", "esc"},
+		"debug":          {"ctrl+d"},
+	}
+
 	// DefaultClasses defines the default classes of service.
 	DefaultClasses = []ClassConfig{
 		{Name: "expedite", WIPLimit: 1, BypassColumnWIP: true},
@@ -65,6 +164,50 @@ var (
 		{Name: "standard"},
 		{Name: "intangible"},
 	}
+
+	// DefaultWeekends are the non-working weekdays when calendar.weekends
+	// is not set.
+	DefaultWeekends = []string{"saturday", "sunday"}
+
+	// ServeScopes lists the serve token scopes from least to most access.
+	ServeScopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+	// RedactFields lists the task fields that redact.fields may name.
+	RedactFields = []string{"title", "body", "assignee", "reviewer", "claimed_by", "block_reason", "blocked_on", "tags", "branch", "worktree"}
+
+	// LogSinkTypes lists the kinds of log.sinks entries.
+	LogSinkTypes = []string{LogSinkFile, LogSinkSyslog, LogSinkHTTP}
+
+	// HealthIndicators lists the indicators of the health report, in
+	// report order.
+	HealthIndicators = []string{"wip_utilization", "aging_p95_days", "blocked_ratio", "overdue_ratio", "stale_claims"}
+
+	// DefaultHealthThresholds are used for indicators without a
+	// health.thresholds entry.
+	DefaultHealthThresholds = map[string]HealthThreshold{
+		"wip_utilization": {Warn: 1, Critical: 1.2},
+		"aging_p95_days":  {Warn: 7, Critical: 14},
+		"blocked_ratio":   {Warn: 0.2, Critical: 0.4},
+		"overdue_ratio":   {Warn: 0.1, Critical: 0.25},
+		"stale_claims":    {Warn: 1, Critical: 3},
+	}
+
+	// LintRules lists the lint rules, in report order.
+	LintRules = []string{LintRuleFrontmatter, LintRuleFields, LintRuleTitleLength,
+		LintRuleRequiredTags, LintRuleEmptyBody, LintRuleForbiddenWords}
+
+	// LintSeverities lists the valid lint.severity values.
+	LintSeverities = []string{LintError, LintWarning, LintOff}
+
+	// DefaultLintSeverity is used for rules without a lint.severity entry.
+	DefaultLintSeverity = map[string]string{
+		LintRuleFrontmatter:    LintError,
+		LintRuleFields:         LintError,
+		LintRuleTitleLength:    LintWarning,
+		LintRuleRequiredTags:   LintError,
+		LintRuleEmptyBody:      LintWarning,
+		LintRuleForbiddenWords: LintError,
+	}
 )
 
 // boolPtr returns a pointer to the given bool value.
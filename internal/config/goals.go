@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// GoalsFileName is the file, next to config.yml, that lists the board's
+// goals. A board without one has no goals.
+const GoalsFileName = "goals.yml"
+
+// Goal is a named objective, such as a quarterly OKR, that tasks are linked
+// to by their goal field.
+type Goal struct {
+	Name  string `yaml:"name" json:"name"`
+	Title string `yaml:"title,omitempty" json:"title,omitempty"`
+}
+
+// goalNameRe matches valid goal names, which are typed on the command line.
+var goalNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// goalsFile is the layout of goals.yml.
+type goalsFile struct {
+	Goals []Goal `yaml:"goals"`
+}
+
+// GoalsPath returns the absolute path of the board's goals.yml.
+func (c *Config) GoalsPath() string {
+	return filepath.Join(c.dir, GoalsFileName)
+}
+
+// Goals reads the board's goals from goals.yml, in file order. A missing
+// file means no goals.
+func (c *Config) Goals() ([]Goal, error) {
+	data, err := os.ReadFile(c.GoalsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", GoalsFileName, err)
+	}
+	var f goalsFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", GoalsFileName, err)
+	}
+	seen := make(map[string]bool, len(f.Goals))
+	for _, g := range f.Goals {
+		if !goalNameRe.MatchString(g.Name) {
+			return nil, fmt.Errorf("%w: %s: goal name %q must be letters, digits, '-' or '_'", ErrInvalid, GoalsFileName, g.Name)
+		}
+		if seen[g.Name] {
+			return nil, fmt.Errorf("%w: %s: duplicate goal %q", ErrInvalid, GoalsFileName, g.Name)
+		}
+		seen[g.Name] = true
+	}
+	return f.Goals, nil
+}
+
+// GoalNames returns the names of goals.
+func GoalNames(goals []Goal) []string {
+	names := make([]string, len(goals))
+	for i, g := range goals {
+		names[i] = g.Name
+	}
+	return names
+}
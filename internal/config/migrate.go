@@ -37,15 +37,45 @@ func migrate(cfg *Config) error {
 // migrations maps each version to the function that migrates it to the next version.
 // The migration function must increment cfg.Version after a successful migration.
 var migrations = map[int]func(*Config) error{
-	1: migrateV1ToV2,
-	2: migrateV2ToV3,
-	3: migrateV3ToV4,
-	4: migrateV4ToV5,
-	5: migrateV5ToV6,
-	6: migrateV6ToV7,
-	7: migrateV7ToV8,
-	8: migrateV8ToV9,
-	9: migrateV9ToV10,
+	1:  migrateV1ToV2,
+	2:  migrateV2ToV3,
+	3:  migrateV3ToV4,
+	4:  migrateV4ToV5,
+	5:  migrateV5ToV6,
+	6:  migrateV6ToV7,
+	7:  migrateV7ToV8,
+	8:  migrateV8ToV9,
+	9:  migrateV9ToV10,
+	10: migrateV10ToV11,
+	11: migrateV11ToV12,
+	12: migrateV12ToV13,
+	13: migrateV13ToV14,
+	14: migrateV14ToV15,
+	15: migrateV15ToV16,
+	16: migrateV16ToV17,
+	17: migrateV17ToV18,
+	18: migrateV18ToV19,
+	19: migrateV19ToV20,
+	20: migrateV20ToV21,
+	21: migrateV21ToV22,
+	22: migrateV22ToV23,
+	23: migrateV23ToV24,
+	24: migrateV24ToV25,
+	25: migrateV25ToV26,
+	26: migrateV26ToV27,
+	27: migrateV27ToV28,
+	28: migrateV28ToV29,
+	29: migrateV29ToV30,
+	30: migrateV30ToV31,
+	31: migrateV31ToV32,
+	32: migrateV32ToV33,
+	33: migrateV33ToV34,
+	34: migrateV34ToV35,
+	35: migrateV35ToV36,
+	36: migrateV36ToV37,
+	37: migrateV37ToV38,
+	38: migrateV38ToV39,
+	39: migrateV39ToV40,
 }
 
 // migrateV1ToV2 adds the wip_limits field (defaults to nil/empty = unlimited).
@@ -141,3 +171,183 @@ func migrateV9ToV10(cfg *Config) error { //nolint:unparam // signature must matc
 	cfg.Version = 10
 	return nil
 }
+
+// migrateV10ToV11 adds dependencies.on_unblock (default empty, no automation).
+func migrateV10ToV11(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 11
+	return nil
+}
+
+// migrateV11ToV12 adds estimates.hours_per_day (default 8).
+func migrateV11ToV12(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 12
+	return nil
+}
+
+// migrateV12ToV13 adds display.timezone (default empty, local time).
+func migrateV12ToV13(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 13
+	return nil
+}
+
+// migrateV13ToV14 adds the calendar section (default Saturday/Sunday weekends, no holidays).
+func migrateV13ToV14(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 14
+	return nil
+}
+
+// migrateV14ToV15 adds per-status checklist templates. No data changes needed.
+func migrateV14ToV15(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 15
+	return nil
+}
+
+// migrateV15ToV16 adds the tags section for tag colors and icons. No data changes needed.
+func migrateV15ToV16(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 16
+	return nil
+}
+
+// migrateV16ToV17 adds board.readonly. No data changes needed.
+func migrateV16ToV17(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 17
+	return nil
+}
+
+// migrateV17ToV18 adds the serve section for API tokens. No data changes needed.
+func migrateV17ToV18(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 18
+	return nil
+}
+
+// migrateV18ToV19 adds the security section for private task recipients. No data changes needed.
+func migrateV18ToV19(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 19
+	return nil
+}
+
+// migrateV19ToV20 adds the optional redact section for masking task text in context output.
+func migrateV19ToV20(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 20
+	return nil
+}
+
+// migrateV20ToV21 adds the optional board.id_prefix for rendering task IDs.
+func migrateV20ToV21(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 21
+	return nil
+}
+
+// migrateV21ToV22 adds the optional defaults.auto_status search order for create --status auto.
+func migrateV21ToV22(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 22
+	return nil
+}
+
+// migrateV22ToV23 adds optional health.thresholds for the health command.
+func migrateV22ToV23(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 23
+	return nil
+}
+
+// migrateV23ToV24 adds per-tag create defaults and named body templates. No data changes needed.
+func migrateV23ToV24(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 24
+	return nil
+}
+
+// migrateV24ToV25 adds require_estimate_for. No data changes needed.
+func migrateV24ToV25(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 25
+	return nil
+}
+
+// migrateV25ToV26 adds the per-status require_reviewer flag and the task reviewer field. No data changes needed.
+func migrateV25ToV26(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 26
+	return nil
+}
+
+// migrateV26ToV27 adds log.sinks for mirroring activity log entries. No data changes needed.
+func migrateV26ToV27(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 27
+	return nil
+}
+
+// migrateV27ToV28 adds task subscriptions run by watch mode. No data changes needed.
+func migrateV27ToV28(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 28
+	return nil
+}
+
+// migrateV28ToV29 adds tui.keys for rebinding TUI keys. No data changes needed.
+func migrateV28ToV29(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 29
+	return nil
+}
+
+// migrateV29ToV30 adds tui.collapsed_columns. No data changes needed.
+func migrateV29ToV30(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 30
+	return nil
+}
+
+// migrateV30ToV31 adds automation.assume_yes. No data changes needed.
+func migrateV30ToV31(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 31
+	return nil
+}
+
+// migrateV31ToV32 adds tasks_dirs and workstream_defaults. No data changes needed.
+func migrateV31ToV32(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 32
+	return nil
+}
+
+// migrateV32ToV33 adds owners. No data changes needed.
+func migrateV32ToV33(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 33
+	return nil
+}
+
+// migrateV33ToV34 adds inbox. No data changes needed.
+func migrateV33ToV34(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 34
+	return nil
+}
+
+// migrateV34ToV35 adds timestamps.start_on and timestamps.complete_on. No data changes needed.
+func migrateV34ToV35(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 35
+	return nil
+}
+
+// migrateV35ToV36 adds files.naming and files.slug_length. No data changes needed.
+func migrateV35ToV36(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 36
+	return nil
+}
+
+// migrateV36ToV37 adds lint. No data changes needed.
+func migrateV36ToV37(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 37
+	return nil
+}
+
+// migrateV37ToV38 adds log.ops. No data changes needed.
+func migrateV37ToV38(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 38
+	return nil
+}
+
+// migrateV38ToV39 adds the optional ready section (pull_from, target). Older configs lack it, so readiness keeps pick's rule: any non-terminal status, no target column.
+func migrateV38ToV39(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 39
+	return nil
+}
+
+// migrateV39ToV40 adds git.auto_commit. No data changes needed.
+func migrateV39ToV40(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	cfg.Version = 40
+	return nil
+}
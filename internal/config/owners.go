@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// OwnerRule maps repository paths to the assignee and tags of tasks filed
+// against them, in the manner of a CODEOWNERS line.
+type OwnerRule struct {
+	// Path is a slash-separated pattern relative to the repository root
+	// (the directory holding the kanban directory). A pattern without a
+	// slash matches a file or directory name at any depth ("*.sql",
+	// "migrations"); one with a slash is anchored at the root and matches
+	// that path and everything below it ("src/api/", "cmd/*/main.go").
+	Path     string   `yaml:"path" json:"path"`
+	Assignee string   `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	Tags     []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// OwnerFor returns the owners rule for the repository-relative,
+// slash-separated path p. As in CODEOWNERS, the last matching rule wins.
+func (c *Config) OwnerFor(p string) (OwnerRule, bool) {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	for i := len(c.Owners) - 1; i >= 0; i-- {
+		if matchOwnerPath(c.Owners[i].Path, p) {
+			return c.Owners[i], true
+		}
+	}
+	return OwnerRule{}, false
+}
+
+// matchOwnerPath reports whether the owners pattern matches the clean,
+// slash-separated relative path p or one of its parent directories.
+func matchOwnerPath(pattern, p string) bool {
+	pattern = strings.TrimSuffix(pattern, "/")
+	elems := strings.Split(p, "/")
+	if !strings.Contains(pattern, "/") {
+		for _, e := range elems {
+			if ok, _ := path.Match(pattern, e); ok {
+				return true
+			}
+		}
+		return false
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	for i := range elems {
+		if ok, _ := path.Match(pattern, strings.Join(elems[:i+1], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Config) validateOwners() error {
+	for i, r := range c.Owners {
+		if strings.Trim(r.Path, "/") == "" {
+			return fmt.Errorf("%w: owners[%d]: path is required", ErrInvalid, i)
+		}
+		if _, err := path.Match(r.Path, ""); err != nil {
+			return fmt.Errorf("%w: owners[%d]: bad path pattern %q", ErrInvalid, i, r.Path)
+		}
+		if r.Assignee == "" && len(r.Tags) == 0 {
+			return fmt.Errorf("%w: owners[%d]: %q sets neither assignee nor tags", ErrInvalid, i, r.Path)
+		}
+	}
+	return nil
+}
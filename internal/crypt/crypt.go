@@ -0,0 +1,134 @@
+// Package crypt encrypts the bodies of private tasks with age or GPG.
+//
+// Encryption shells out to the age or gpg binary so that keys stay in the
+// user's existing keyring or identity files. Ciphertext is ASCII-armored and
+// stored as the task body; frontmatter stays in plain text.
+package crypt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+const (
+	ageHeader = "-----BEGIN AGE ENCRYPTED FILE-----"
+	pgpHeader = "-----BEGIN PGP MESSAGE-----"
+
+	// IdentityEnv names the environment variable holding the path of the age
+	// identity file used to decrypt. GPG uses the gpg-agent keyring.
+	IdentityEnv = "KANBAN_AGE_IDENTITY"
+
+	// Redacted replaces the body of a private task that cannot be decrypted.
+	Redacted = "[encrypted]"
+)
+
+// ErrNoRecipients is returned when encrypting without any recipients.
+var ErrNoRecipients = errors.New("no recipients configured (set security.recipients)")
+
+// IsAgeRecipient reports whether r is an age public key.
+func IsAgeRecipient(r string) bool {
+	return strings.HasPrefix(r, "age1")
+}
+
+// IsEncrypted reports whether body is armored age or PGP ciphertext.
+func IsEncrypted(body string) bool {
+	body = strings.TrimSpace(body)
+	return strings.HasPrefix(body, ageHeader) || strings.HasPrefix(body, pgpHeader)
+}
+
+// Encrypt encrypts plaintext to recipients, using age for age1 keys and GPG
+// otherwise, and returns the armored ciphertext.
+func Encrypt(recipients []string, plaintext string) (string, error) {
+	if len(recipients) == 0 {
+		return "", ErrNoRecipients
+	}
+	var args []string
+	name := "gpg"
+	if IsAgeRecipient(recipients[0]) {
+		name = "age"
+		args = []string{"--encrypt", "--armor"}
+	} else {
+		args = []string{"--batch", "--yes", "--quiet", "--trust-model", "always", "--armor", "--encrypt"}
+	}
+	for _, r := range recipients {
+		args = append(args, "--recipient", r)
+	}
+	return run(name, args, plaintext)
+}
+
+// Decrypt decrypts armored age or PGP ciphertext. age needs the identity
+// file named by KANBAN_AGE_IDENTITY; GPG uses the default keyring.
+func Decrypt(ciphertext string) (string, error) {
+	switch body := strings.TrimSpace(ciphertext); {
+	case strings.HasPrefix(body, ageHeader):
+		identity := os.Getenv(IdentityEnv)
+		if identity == "" {
+			return "", fmt.Errorf("no age identity (set %s)", IdentityEnv)
+		}
+		return run("age", []string{"--decrypt", "--identity", identity}, body)
+	case strings.HasPrefix(body, pgpHeader):
+		return run("gpg", []string{"--batch", "--quiet", "--decrypt"}, body)
+	}
+	return "", errors.New("body is not age or PGP ciphertext")
+}
+
+// Seal encrypts the task body to recipients and marks the task private.
+// An already sealed body is left as is.
+func Seal(t *task.Task, recipients []string) error {
+	if t.Private && IsEncrypted(t.Body) {
+		return nil
+	}
+	sealed, err := Encrypt(recipients, t.Body)
+	if err != nil {
+		return err
+	}
+	t.Body = sealed
+	t.Private = true
+	return nil
+}
+
+// Open returns the plaintext body of a task, decrypting private tasks.
+func Open(t *task.Task) (string, error) {
+	if !t.Private || !IsEncrypted(t.Body) {
+		return t.Body, nil
+	}
+	return Decrypt(t.Body)
+}
+
+// Reveal replaces the body of each private task with its plaintext, or with
+// Redacted when no key can decrypt it. Revealed tasks are for display only
+// and must not be written back.
+func Reveal(tasks ...*task.Task) {
+	for _, t := range tasks {
+		if !t.Private {
+			continue
+		}
+		body, err := Open(t)
+		if err != nil {
+			body = Redacted
+		}
+		t.Body = body
+	}
+}
+
+// run executes name with args, feeding input on stdin, and returns stdout.
+func run(name string, args []string, input string) (string, error) {
+	cmd := exec.Command(name, args...) //nolint:gosec // fixed tool names; args are recipients/paths from config
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s: %s", name, msg)
+		}
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,95 @@
+package crypt
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+const testRecipient = "kanban-test@example.com"
+
+// setupGPG creates a throwaway keyring with an unprotected key for
+// testRecipient and points GNUPGHOME at it.
+func setupGPG(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg not installed")
+	}
+	// Short path: gpg-agent sockets must fit in sun_path.
+	home, err := os.MkdirTemp("", "gpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GNUPGHOME", home)
+	t.Cleanup(func() {
+		_ = exec.Command("gpgconf", "--kill", "gpg-agent").Run()
+		_ = os.RemoveAll(home)
+	})
+	out, err := exec.Command("gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		testRecipient, "default", "default", "never").CombinedOutput()
+	if err != nil {
+		t.Skipf("gpg key generation failed: %v: %s", err, out)
+	}
+}
+
+func TestEncryptDecrypt_GPG(t *testing.T) {
+	setupGPG(t)
+
+	sealed, err := Encrypt([]string{testRecipient}, "client: ACME\n")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if !IsEncrypted(sealed) || strings.Contains(sealed, "ACME") {
+		t.Fatalf("ciphertext = %q, want armored PGP without plaintext", sealed)
+	}
+
+	plain, err := Decrypt(sealed)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if plain != "client: ACME\n" {
+		t.Errorf("Decrypt = %q, want original body", plain)
+	}
+}
+
+func TestSealAndReveal(t *testing.T) {
+	setupGPG(t)
+
+	tk := &task.Task{ID: 1, Body: "secret notes"}
+	if err := Seal(tk, []string{testRecipient}); err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if !tk.Private || !IsEncrypted(tk.Body) {
+		t.Fatalf("Seal should mark the task private and encrypt the body, got %+v", tk)
+	}
+
+	Reveal(tk)
+	if tk.Body != "secret notes" {
+		t.Errorf("Reveal body = %q, want plaintext", tk.Body)
+	}
+}
+
+func TestReveal_RedactsWithoutKey(t *testing.T) {
+	t.Setenv(IdentityEnv, "")
+	tk := &task.Task{Private: true, Body: ageHeader + "\nYWJj\n-----END AGE ENCRYPTED FILE-----\n"}
+	public := &task.Task{Body: "visible"}
+
+	Reveal(tk, public)
+
+	if tk.Body != Redacted {
+		t.Errorf("Body = %q, want %q", tk.Body, Redacted)
+	}
+	if public.Body != "visible" {
+		t.Errorf("public Body = %q, want unchanged", public.Body)
+	}
+}
+
+func TestEncrypt_NoRecipients(t *testing.T) {
+	if _, err := Encrypt(nil, "x"); !errors.Is(err, ErrNoRecipients) {
+		t.Errorf("err = %v, want ErrNoRecipients", err)
+	}
+}
@@ -0,0 +1,131 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdays maps English weekday names and abbreviations to time.Weekday.
+// Parsing is deliberately locale-independent.
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// ParseNatural parses a YYYY-MM-DD date or a relative expression such as
+// "tomorrow", "next friday", "+2w", or "eod", relative to today.
+func ParseNatural(s string) (Date, error) {
+	return ParseNaturalAt(s, time.Now())
+}
+
+// ParseNaturalAt is like ParseNatural but resolves relative expressions
+// against the calendar date of now. Supported forms (case-insensitive):
+//
+//	YYYY-MM-DD
+//	today, eod, tomorrow, yesterday
+//	eow (the coming Friday), eom (last day of the month)
+//	monday..sunday, mon..sun, optionally prefixed by "next" or "this":
+//	  the next such day after today
+//	+N or -N with a unit d, w, m, or y (e.g. +3d, -1w, +2m)
+//	in N days|weeks|months|years
+func ParseNaturalAt(s string, now time.Time) (Date, error) {
+	if d, err := Parse(strings.TrimSpace(s)); err == nil {
+		return d, nil
+	}
+	today := New(now.Year(), now.Month(), now.Day())
+	expr := strings.Join(strings.Fields(strings.ToLower(s)), " ")
+
+	switch expr {
+	case "today", "eod":
+		return today, nil
+	case "tomorrow":
+		return Date{today.AddDate(0, 0, 1)}, nil
+	case "yesterday":
+		return Date{today.AddDate(0, 0, -1)}, nil
+	case "eow":
+		return nextWeekday(today, time.Friday, true), nil
+	case "eom":
+		return Date{time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, time.UTC)}, nil
+	}
+
+	day := strings.TrimPrefix(strings.TrimPrefix(expr, "next "), "this ")
+	if wd, ok := weekdays[day]; ok {
+		return nextWeekday(today, wd, false), nil
+	}
+
+	if d, ok := parseOffset(expr, today); ok {
+		return d, nil
+	}
+	return Date{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or a relative date "+
+		"(today, tomorrow, next friday, +2w, eod)", s)
+}
+
+// nextWeekday returns the next wd after d, or d itself when includeToday is
+// set and d already falls on wd.
+func nextWeekday(d Date, wd time.Weekday, includeToday bool) Date {
+	const daysPerWeek = 7
+	delta := (int(wd) - int(d.Weekday()) + daysPerWeek) % daysPerWeek
+	if delta == 0 && !includeToday {
+		delta = daysPerWeek
+	}
+	return Date{d.AddDate(0, 0, delta)}
+}
+
+// parseOffset handles "+3d", "-1w", and "in 2 weeks".
+func parseOffset(expr string, today Date) (Date, bool) {
+	sign := 1
+	var num, unit string
+	switch {
+	case strings.HasPrefix(expr, "in "):
+		fields := strings.Fields(strings.TrimPrefix(expr, "in "))
+		if len(fields) != 2 { //nolint:mnd // "in N unit"
+			return Date{}, false
+		}
+		num, unit = fields[0], strings.TrimSuffix(fields[1], "s")
+	case strings.HasPrefix(expr, "+"), strings.HasPrefix(expr, "-"):
+		if expr[0] == '-' {
+			sign = -1
+		}
+		body := strings.TrimSpace(expr[1:])
+		if body == "" {
+			return Date{}, false
+		}
+		num, unit = body[:len(body)-1], body[len(body)-1:]
+	default:
+		return Date{}, false
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(num))
+	if err != nil || n < 0 {
+		return Date{}, false
+	}
+	n *= sign
+
+	const daysPerWeek = 7
+	switch unit {
+	case "d", "day":
+		return Date{today.AddDate(0, 0, n)}, true
+	case "w", "week":
+		return Date{today.AddDate(0, 0, n*daysPerWeek)}, true
+	case "m", "month":
+		return addMonths(today, n), true
+	case "y", "year":
+		return addMonths(today, n*12), true //nolint:mnd // months per year
+	}
+	return Date{}, false
+}
+
+// addMonths moves d by n calendar months, clamping to the last day of the
+// target month (Jan 31 + 1m = Feb 28).
+func addMonths(d Date, n int) Date {
+	first := time.Date(d.Year(), d.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return New(first.Year(), first.Month(), min(d.Day(), last))
+}
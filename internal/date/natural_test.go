@@ -0,0 +1,65 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNaturalAt(t *testing.T) {
+	// Wednesday, 2026-03-04, late in the evening local time.
+	now := time.Date(2026, time.March, 4, 23, 30, 0, 0, time.FixedZone("X", -5*3600))
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"2026-12-25", "2026-12-25"},
+		{"today", "2026-03-04"},
+		{"EOD", "2026-03-04"},
+		{"tomorrow", "2026-03-05"},
+		{"yesterday", "2026-03-03"},
+		{"eow", "2026-03-06"},
+		{"eom", "2026-03-31"},
+		{"friday", "2026-03-06"},
+		{"next friday", "2026-03-06"},
+		{"Next  Wednesday", "2026-03-11"},
+		{"this mon", "2026-03-09"},
+		{"+3d", "2026-03-07"},
+		{"+2w", "2026-03-18"},
+		{"-1w", "2026-02-25"},
+		{"+1m", "2026-04-04"},
+		{"+1y", "2027-03-04"},
+		{"in 10 days", "2026-03-14"},
+		{"in 1 week", "2026-03-11"},
+	}
+	for _, tt := range tests {
+		d, err := ParseNaturalAt(tt.input, now)
+		if err != nil {
+			t.Errorf("ParseNaturalAt(%q) error: %v", tt.input, err)
+			continue
+		}
+		if d.String() != tt.want {
+			t.Errorf("ParseNaturalAt(%q) = %s, want %s", tt.input, d, tt.want)
+		}
+	}
+}
+
+func TestParseNaturalAtClampsMonthEnd(t *testing.T) {
+	now := time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC)
+	d, err := ParseNaturalAt("+1m", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.String() != "2026-02-28" {
+		t.Errorf("+1m from Jan 31 = %s, want 2026-02-28", d)
+	}
+}
+
+func TestParseNaturalAtInvalid(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 12, 0, 0, 0, time.UTC)
+	for _, in := range []string{"", "soon", "+", "+3x", "+d", "in two weeks", "next", "2026/03/04"} {
+		if _, err := ParseNaturalAt(in, now); err == nil {
+			t.Errorf("ParseNaturalAt(%q) expected error", in)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package export renders a board as a standalone document for people who
+// do not use the CLI. Callers pass tasks that are already safe to share:
+// redacted, and with private bodies left sealed.
+package export
+
+import (
+	"sort"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Board is the layout shared by every export format.
+type Board struct {
+	Name      string
+	Generated time.Time
+	Columns   []Column
+	// Lanes holds one lane per swimlane key, or a single unnamed lane when
+	// the board is not split into swimlanes.
+	Lanes []Lane
+}
+
+// Column is a status column header. Count and WIPLimit cover the whole
+// column across all lanes, since WIP limits are per column.
+type Column struct {
+	Status   string
+	Count    int
+	WIPLimit int
+}
+
+// OverWIP reports whether the column holds more tasks than its WIP limit.
+func (c Column) OverWIP() bool {
+	return c.WIPLimit > 0 && c.Count > c.WIPLimit
+}
+
+// AtWIP reports whether the column is exactly at its WIP limit.
+func (c Column) AtWIP() bool {
+	return c.WIPLimit > 0 && c.Count == c.WIPLimit
+}
+
+// Lane is one swimlane: the tasks of each column, in column order.
+type Lane struct {
+	Key   string
+	Cells [][]*task.Task
+}
+
+// Build lays out tasks into the board's columns, excluding the archived
+// status. With a swimlane field (any board --group-by field except status)
+// the columns are split into one lane per value. Tasks within a cell are
+// ordered by priority, highest first, then by ID.
+func Build(cfg *config.Config, tasks []*task.Task, swimlane string, now time.Time) Board {
+	statuses := cfg.BoardStatuses()
+	b := Board{Name: cfg.Board.Name, Generated: now}
+
+	var shown []*task.Task
+	for _, t := range tasks {
+		if config.IndexOf(statuses, t.Status) >= 0 {
+			shown = append(shown, t)
+		}
+	}
+	board.Sort(shown, "id", false, cfg)
+	sort.SliceStable(shown, func(i, j int) bool {
+		return cfg.PriorityIndex(shown[i].Priority) > cfg.PriorityIndex(shown[j].Priority)
+	})
+
+	for _, s := range statuses {
+		c := Column{Status: s, WIPLimit: cfg.WIPLimit(s)}
+		for _, t := range shown {
+			if t.Status == s {
+				c.Count++
+			}
+		}
+		b.Columns = append(b.Columns, c)
+	}
+
+	if swimlane == "" {
+		b.Lanes = []Lane{newLane("", statuses, shown)}
+		return b
+	}
+	keys, groups := board.GroupTasks(shown, swimlane, cfg, now)
+	for _, k := range keys {
+		b.Lanes = append(b.Lanes, newLane(k, statuses, groups[k]))
+	}
+	return b
+}
+
+func newLane(key string, statuses []string, tasks []*task.Task) Lane {
+	l := Lane{Key: key, Cells: make([][]*task.Task, len(statuses))}
+	for _, t := range tasks {
+		i := config.IndexOf(statuses, t.Status)
+		l.Cells[i] = append(l.Cells[i], t)
+	}
+	return l
+}
@@ -0,0 +1,150 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func exportConfig() *config.Config {
+	cfg := config.NewDefault("Export")
+	cfg.WIPLimits = map[string]int{"in-progress": 1}
+	return cfg
+}
+
+func TestBuildColumnsAndOrder(t *testing.T) {
+	cfg := exportConfig()
+	tasks := []*task.Task{
+		{ID: 1, Title: "Low", Status: "todo", Priority: "low"},
+		{ID: 2, Title: "Critical", Status: "todo", Priority: "critical"},
+		{ID: 3, Title: "Busy", Status: "in-progress", Priority: "medium"},
+		{ID: 4, Title: "Busier", Status: "in-progress", Priority: "medium"},
+		{ID: 5, Title: "Old", Status: config.ArchivedStatus, Priority: "medium"},
+	}
+
+	b := Build(cfg, tasks, "", time.Now())
+	if len(b.Columns) != len(cfg.BoardStatuses()) {
+		t.Fatalf("columns = %d, want %d (archived excluded)", len(b.Columns), len(cfg.BoardStatuses()))
+	}
+	if len(b.Lanes) != 1 || b.Lanes[0].Key != "" {
+		t.Fatalf("lanes = %+v, want one unnamed lane", b.Lanes)
+	}
+
+	todo := b.Lanes[0].Cells[config.IndexOf(cfg.BoardStatuses(), "todo")]
+	if len(todo) != 2 || todo[0].ID != 2 {
+		t.Errorf("todo cell = %v, want critical task first", todo)
+	}
+	wip := b.Columns[config.IndexOf(cfg.BoardStatuses(), "in-progress")]
+	if wip.Count != 2 || !wip.OverWIP() {
+		t.Errorf("in-progress column = %+v, want 2 tasks over a limit of 1", wip)
+	}
+}
+
+func TestBuildSwimlanes(t *testing.T) {
+	cfg := exportConfig()
+	tasks := []*task.Task{
+		{ID: 1, Title: "A", Status: "todo", Priority: "medium", Assignee: "alice"},
+		{ID: 2, Title: "B", Status: "done", Priority: "medium"},
+	}
+
+	b := Build(cfg, tasks, "assignee", time.Now())
+	if len(b.Lanes) != 2 {
+		t.Fatalf("lanes = %d, want 2", len(b.Lanes))
+	}
+	keys := []string{b.Lanes[0].Key, b.Lanes[1].Key}
+	if strings.Join(keys, ",") != "(unassigned),alice" {
+		t.Errorf("lane keys = %v", keys)
+	}
+}
+
+func TestHTMLEscapesAndIsSelfContained(t *testing.T) {
+	cfg := exportConfig()
+	due := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	parent := 1
+	tasks := []*task.Task{
+		{
+			ID: 2, Title: "<script>alert(1)</script>", Status: "todo", Priority: "high",
+			Tags: []string{"ui"}, Parent: &parent, DependsOn: []int{1}, Created: due, Updated: due,
+			Body: "a & b",
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := HTML(&buf, Build(cfg, tasks, "", due)); err != nil {
+		t.Fatalf("HTML: %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "<script>") {
+		t.Error("task title was not escaped")
+	}
+	for _, want := range []string{"&lt;script&gt;", "a &amp; b", "<details", "#1", "<title>Export</title>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML missing %q", want)
+		}
+	}
+	for _, external := range []string{"<link", "src=", "http://", "https://"} {
+		if strings.Contains(out, external) {
+			t.Errorf("HTML references an external resource (%q)", external)
+		}
+	}
+}
+
+func TestSVGLayout(t *testing.T) {
+	cfg := exportConfig()
+	tasks := []*task.Task{
+		{ID: 1, Title: "A & B", Status: "in-progress", Priority: "high"},
+		{ID: 2, Title: strings.Repeat("long ", 20), Status: "in-progress", Priority: "low", Blocked: true},
+	}
+
+	var buf bytes.Buffer
+	if err := SVG(&buf, Build(cfg, tasks, "", time.Now())); err != nil {
+		t.Fatalf("SVG: %v", err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, `<svg xmlns="http://www.w3.org/2000/svg"`) || !strings.HasSuffix(out, "</svg>\n") {
+		t.Errorf("not a complete SVG document:\n%s", out)
+	}
+	for _, want := range []string{"A &amp; B", "…", ">2/1</text>", `fill="#ffebe9"`, "blocked"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("SVG missing %q", want)
+		}
+	}
+}
+
+func TestPNGWithoutRasterizer(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	if _, err := PNG([]byte("<svg/>")); !errors.Is(err, ErrNoRasterizer) {
+		t.Errorf("PNG() error = %v, want ErrNoRasterizer", err)
+	}
+}
+
+func TestMarkdownSections(t *testing.T) {
+	cfg := exportConfig()
+	tasks := []*task.Task{
+		{ID: 1, Title: "Pipe | title", Status: "todo", Priority: "high", Tags: []string{"docs"}},
+		{ID: 2, Title: "Stuck", Status: "in-progress", Priority: "medium", Blocked: true, BlockReason: "vendor"},
+	}
+
+	var buf bytes.Buffer
+	if err := Markdown(&buf, Build(cfg, tasks, "", time.Now())); err != nil {
+		t.Fatalf("Markdown: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# Export\n",
+		"## backlog (0)\n\n_No tasks._\n",
+		"## todo (1)\n",
+		"| #1 | Pipe \\| title | high |  |  | docs |",
+		"## in-progress (1/1)\n",
+		"Stuck **(blocked: vendor)**",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q:\n%s", want, out)
+		}
+	}
+}
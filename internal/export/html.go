@@ -0,0 +1,39 @@
+package export
+
+import (
+	_ "embed" // board.html.tmpl
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+//go:embed board.html.tmpl
+var htmlTemplate string
+
+var htmlTmpl = template.Must(template.New("board").Funcs(template.FuncMap{
+	"id":       func(id int) string { return fmt.Sprintf("#%d", id) },
+	"ids":      formatIDs,
+	"stamp":    func(t time.Time) string { return t.Format("2006-01-02 15:04") },
+	"deref":    func(p *int) int { return *p },
+	"hasLanes": func(b Board) bool { return len(b.Lanes) > 0 && b.Lanes[0].Key != "" },
+	"cell":     func(l Lane, i int) []*task.Task { return l.Cells[i] },
+	"span":     func(b Board) int { return len(b.Columns) },
+}).Parse(htmlTemplate))
+
+// HTML writes b as a self-contained HTML page: no scripts, stylesheets, or
+// fonts are loaded from elsewhere. Clicking a card expands its details.
+func HTML(w io.Writer, b Board) error {
+	return htmlTmpl.Execute(w, b)
+}
+
+func formatIDs(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprintf("#%d", id)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Markdown writes b as one markdown document with a section per status and
+// a table of its tasks' key fields. Swimlanes become subsections. Bodies are
+// left out so the document stays short enough to paste into a wiki page or
+// pull request.
+func Markdown(w io.Writer, b Board) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n", b.Name)
+	fmt.Fprintf(&sb, "_Exported %s_\n", b.Generated.Format("2006-01-02 15:04"))
+
+	for i, c := range b.Columns {
+		count := fmt.Sprint(c.Count)
+		if c.WIPLimit > 0 {
+			count += fmt.Sprintf("/%d", c.WIPLimit)
+		}
+		fmt.Fprintf(&sb, "\n## %s (%s)\n", c.Status, count)
+		if c.Count == 0 {
+			sb.WriteString("\n_No tasks._\n")
+			continue
+		}
+		for _, l := range b.Lanes {
+			tasks := l.Cells[i]
+			if len(tasks) == 0 {
+				continue
+			}
+			if l.Key != "" {
+				fmt.Fprintf(&sb, "\n### %s\n", l.Key)
+			}
+			writeMarkdownTable(&sb, tasks)
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMarkdownTable(sb *strings.Builder, tasks []*task.Task) {
+	sb.WriteString("\n| ID | Title | Priority | Assignee | Due | Tags |\n")
+	sb.WriteString("|----|-------|----------|----------|-----|------|\n")
+	for _, t := range tasks {
+		title := cellText(t.Title)
+		if t.Blocked {
+			title += " **(blocked: " + cellText(t.BlockReason) + ")**"
+		}
+		due := ""
+		if t.Due != nil {
+			due = t.Due.String()
+		}
+		fmt.Fprintf(sb, "| #%d | %s | %s | %s | %s | %s |\n",
+			t.ID, title, t.Priority, cellText(t.Assignee), due, cellText(strings.Join(t.Tags, ", ")))
+	}
+}
+
+// cellText makes s safe inside a markdown table cell.
+func cellText(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ErrNoRasterizer is returned by PNG when no SVG rasterizer is installed.
+var ErrNoRasterizer = errors.New("no SVG rasterizer found; install rsvg-convert (librsvg) or ImageMagick")
+
+// rasterizers are tried in order; each reads SVG on stdin and writes PNG
+// on stdout.
+var rasterizers = [][]string{
+	{"rsvg-convert", "--format", "png"},
+	{"magick", "svg:-", "png:-"},
+	{"convert", "svg:-", "png:-"},
+}
+
+// PNG rasterizes an SVG document with the first rasterizer found on PATH.
+func PNG(svg []byte) ([]byte, error) {
+	for _, r := range rasterizers {
+		if _, err := exec.LookPath(r[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(r[0], r[1:]...) //nolint:gosec // fixed tool names and args
+		cmd.Stdin = bytes.NewReader(svg)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, fmt.Errorf("%s: %s", r[0], msg)
+			}
+			return nil, fmt.Errorf("%s: %w", r[0], err)
+		}
+		return stdout.Bytes(), nil
+	}
+	return nil, ErrNoRasterizer
+}
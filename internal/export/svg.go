@@ -0,0 +1,155 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// SVG layout, in pixels.
+const (
+	svgMargin     = 16
+	svgTitleH     = 40
+	svgColumnW    = 220
+	svgGap        = 12
+	svgHeaderH    = 32
+	svgLaneLabelH = 24
+	svgCardH      = 52
+	svgCardPad    = 8
+	svgStripeW    = 4
+	svgTitleChars = 30 // fits svgColumnW at the card font size
+	svgMetaChars  = 34 // the meta line uses a smaller font
+
+	// Text baselines, relative to the top of their box.
+	svgTitleBase  = 24
+	svgHeaderBase = 18
+	svgLaneBase   = 16
+	svgCardLine1  = 20
+	svgCardLine2  = 40
+)
+
+// priorityColors are the card stripe colors for the default priorities.
+var priorityColors = map[string]string{
+	"critical": "#cf222e",
+	"high":     "#bc4c00",
+	"medium":   "#9a6700",
+}
+
+// SVG writes b as a static SVG image: one column per status with its task
+// count and WIP limit in the header, and one card per task showing its ID,
+// title, and priority. Headers of columns at their WIP limit are amber and
+// over it are red.
+func SVG(w io.Writer, b Board) error {
+	width := 2*svgMargin + len(b.Columns)*svgColumnW + max(len(b.Columns)-1, 0)*svgGap
+	height := svgMargin + svgTitleH + svgHeaderH + svgMargin
+	for _, l := range b.Lanes {
+		height += laneHeight(b, l)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="system-ui, sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+	sb.WriteString(`<rect width="100%" height="100%" fill="#f6f8fa"/>` + "\n")
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" font-size="20" font-weight="600" fill="#1f2328">%s</text>`+"\n",
+		svgMargin, svgMargin+svgTitleBase, esc(b.Name))
+
+	y := svgMargin + svgTitleH
+	for i, c := range b.Columns {
+		x := columnX(i)
+		fill, countColor := "#eaeef2", "#656d76"
+		switch {
+		case c.OverWIP():
+			fill, countColor = "#ffebe9", "#cf222e"
+		case c.AtWIP():
+			countColor = "#9a6700"
+		}
+		count := fmt.Sprint(c.Count)
+		if c.WIPLimit > 0 {
+			count += fmt.Sprintf("/%d", c.WIPLimit)
+		}
+		fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s"/>`+"\n",
+			x, y, svgColumnW, svgHeaderH-svgCardPad/2, fill)
+		fmt.Fprintf(&sb, `<text x="%d" y="%d" font-weight="600" fill="#1f2328">%s</text>`+"\n",
+			x+svgCardPad, y+svgHeaderBase, esc(c.Status))
+		fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="end" fill="%s">%s</text>`+"\n",
+			x+svgColumnW-svgCardPad, y+svgHeaderBase, countColor, count)
+	}
+	y += svgHeaderH
+
+	for _, l := range b.Lanes {
+		top := y
+		if l.Key != "" {
+			fmt.Fprintf(&sb, `<text x="%d" y="%d" font-weight="600" fill="#1f2328">%s</text>`+"\n",
+				svgMargin, y+svgLaneBase, esc(l.Key))
+			top += svgLaneLabelH
+		}
+		for i := range b.Columns {
+			for j, t := range l.Cells[i] {
+				writeSVGCard(&sb, columnX(i), top+j*(svgCardH+svgGap), t)
+			}
+		}
+		y += laneHeight(b, l)
+	}
+
+	sb.WriteString("</svg>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeSVGCard(sb *strings.Builder, x, y int, t *task.Task) {
+	stroke := "#d0d7de"
+	if t.Blocked {
+		stroke = "#cf222e"
+	}
+	stripe, ok := priorityColors[t.Priority]
+	if !ok {
+		stripe = "#8c959f"
+	}
+	fmt.Fprintf(sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="#fff" stroke="%s"/>`+"\n",
+		x, y, svgColumnW, svgCardH, stroke)
+	fmt.Fprintf(sb, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n", x, y, svgStripeW, svgCardH, stripe)
+	fmt.Fprintf(sb, `<text x="%d" y="%d" fill="#1f2328"><tspan fill="#656d76">#%d</tspan> %s</text>`+"\n",
+		x+svgCardPad+svgStripeW, y+svgCardLine1, t.ID, esc(truncate(t.Title, svgTitleChars)))
+	meta := t.Priority
+	if t.Assignee != "" {
+		meta += " · @" + t.Assignee
+	}
+	if t.Blocked {
+		meta += " · blocked"
+	}
+	fmt.Fprintf(sb, `<text x="%d" y="%d" font-size="11" fill="#656d76">%s</text>`+"\n",
+		x+svgCardPad+svgStripeW, y+svgCardLine2, esc(truncate(meta, svgMetaChars)))
+}
+
+// laneHeight is the height of l: its label, if any, plus its tallest cell.
+func laneHeight(b Board, l Lane) int {
+	tallest := 0
+	for i := range b.Columns {
+		tallest = max(tallest, len(l.Cells[i]))
+	}
+	h := tallest*(svgCardH+svgGap) + svgGap
+	if l.Key != "" {
+		h += svgLaneLabelH
+	}
+	return h
+}
+
+func columnX(i int) int {
+	return svgMargin + i*(svgColumnW+svgGap)
+}
+
+// truncate shortens s to n runes, ending in an ellipsis when cut.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
+
+func esc(s string) string {
+	return html.EscapeString(s)
+}
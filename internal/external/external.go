@@ -0,0 +1,103 @@
+// Package external checks whether the items outside the board that tasks
+// are blocked on, such as GitHub issues and pull requests, have closed.
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultGitHubAPI is the GitHub API issue states are read from.
+const DefaultGitHubAPI = "https://api.github.com"
+
+// maxResponse caps the size of an API response.
+const maxResponse = 1 << 20
+
+// ErrUnsupported is returned by Closed for a reference it cannot poll.
+var ErrUnsupported = errors.New("unsupported reference")
+
+// GitHubRef names a GitHub issue or pull request.
+type GitHubRef struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r GitHubRef) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+var (
+	githubURLRe   = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([\w.-]+)/([\w.-]+)/(?:issues|pull)/(\d+)(?:[/?#].*)?$`)
+	githubShortRe = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)#(\d+)$`)
+)
+
+// ParseGitHub parses a GitHub issue or pull request URL, or the short form
+// OWNER/REPO#N.
+func ParseGitHub(ref string) (GitHubRef, bool) {
+	ref = strings.TrimSpace(ref)
+	m := githubURLRe.FindStringSubmatch(ref)
+	if m == nil {
+		m = githubShortRe.FindStringSubmatch(ref)
+	}
+	if m == nil {
+		return GitHubRef{}, false
+	}
+	n, err := strconv.Atoi(m[3])
+	if err != nil || n <= 0 {
+		return GitHubRef{}, false
+	}
+	return GitHubRef{Owner: m[1], Repo: m[2], Number: n}, true
+}
+
+// Client polls external items.
+type Client struct {
+	HTTP *http.Client
+	// API is the GitHub API base URL, DefaultGitHubAPI in production.
+	API string
+	// Token, when set, authenticates API requests for private repositories
+	// and higher rate limits.
+	Token string
+}
+
+// Closed reports whether the item ref names has closed. A pull request
+// counts as closed once it is merged or closed. References other than
+// GitHub issues and pull requests return ErrUnsupported.
+func (c *Client) Closed(ctx context.Context, ref string) (bool, error) {
+	r, ok := ParseGitHub(ref)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrUnsupported, ref)
+	}
+	// The issues endpoint serves pull requests as well.
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d", strings.TrimSuffix(c.API, "/"), r.Owner, r.Repo, r.Number)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("fetching %s: %w", r, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only response body
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("fetching %s: %s", r, resp.Status)
+	}
+	var issue struct {
+		State string `json:"state"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponse)).Decode(&issue); err != nil {
+		return false, fmt.Errorf("parsing %s: %w", r, err)
+	}
+	return issue.State == "closed", nil
+}
@@ -0,0 +1,63 @@
+package external
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseGitHub(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want GitHubRef
+		ok   bool
+	}{
+		{"https://github.com/acme/api/issues/12", GitHubRef{"acme", "api", 12}, true},
+		{"https://github.com/acme/api.go/pull/7/files", GitHubRef{"acme", "api.go", 7}, true},
+		{"http://www.github.com/acme/api/issues/3#issuecomment-1", GitHubRef{"acme", "api", 3}, true},
+		{"acme/api#42", GitHubRef{"acme", "api", 42}, true},
+		{"https://github.com/acme/api", GitHubRef{}, false},
+		{"https://example.atlassian.net/browse/OPS-12", GitHubRef{}, false},
+		{"OPS-12", GitHubRef{}, false},
+		{"acme/api#0", GitHubRef{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseGitHub(tt.ref)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseGitHub(%q) = %+v, %v; want %+v, %v", tt.ref, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestClosed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		switch r.URL.Path {
+		case "/repos/acme/api/issues/1":
+			fmt.Fprint(w, `{"state": "closed"}`)
+		case "/repos/acme/api/issues/2":
+			fmt.Fprint(w, `{"state": "open"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := &Client{HTTP: srv.Client(), API: srv.URL, Token: "tok"}
+
+	if closed, err := c.Closed(t.Context(), "acme/api#1"); err != nil || !closed {
+		t.Errorf("closed issue: %v, %v", closed, err)
+	}
+	if closed, err := c.Closed(t.Context(), "https://github.com/acme/api/pull/2"); err != nil || closed {
+		t.Errorf("open pull request: %v, %v", closed, err)
+	}
+	if _, err := c.Closed(t.Context(), "acme/api#3"); err == nil {
+		t.Error("missing issue: want an error")
+	}
+	if _, err := c.Closed(t.Context(), "OPS-12"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("ticket key: err = %v, want ErrUnsupported", err)
+	}
+}
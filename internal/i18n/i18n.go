@@ -0,0 +1,128 @@
+// Package i18n translates human-readable output. Strings are looked up by
+// their English text, so untranslated strings fall back to English and JSON
+// output, which never goes through T, keeps stable keys.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// EnvVar selects the output language when --lang is not given.
+const EnvVar = "KANBAN_LANG"
+
+// DirName is the directory, inside the kanban directory or the user config
+// directory, that holds translation files named <lang>.yml.
+const DirName = "i18n"
+
+var (
+	mu      sync.RWMutex
+	lang    string
+	catalog map[string]string
+)
+
+// ErrNotFound is returned by Load when no translation file exists for a
+// language.
+var ErrNotFound = errors.New("translation not found")
+
+// Normalize reduces a locale such as "de_DE.UTF-8" to "de-de". An empty,
+// "C" or "POSIX" locale normalizes to "".
+func Normalize(l string) string {
+	l, _, _ = strings.Cut(l, ".")
+	l, _, _ = strings.Cut(l, "@")
+	if l == "C" || l == "POSIX" {
+		return ""
+	}
+	return strings.ToLower(strings.ReplaceAll(l, "_", "-"))
+}
+
+// candidates lists file base names to try for a language, most specific
+// first: "pt-br" tries "pt-br" then "pt".
+func candidates(l string) []string {
+	names := []string{l}
+	if base, _, ok := strings.Cut(l, "-"); ok {
+		names = append(names, base)
+	}
+	return names
+}
+
+// Load activates the catalog for language l, reading the first
+// <dir>/<lang>.yml found in dirs. English (or an empty language) needs no
+// file and resets to the untranslated strings. Each file is a flat YAML map
+// from English text to its translation.
+func Load(l string, dirs ...string) error {
+	l = Normalize(l)
+	if l == "" || l == "en" || strings.HasPrefix(l, "en-") {
+		set(l, nil)
+		return nil
+	}
+	for _, name := range candidates(l) {
+		for _, dir := range dirs {
+			if dir == "" {
+				continue
+			}
+			path := filepath.Join(dir, name+".yml")
+			data, err := os.ReadFile(path) //nolint:gosec // translation file from a known directory
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("reading translation %s: %w", path, err)
+			}
+			m := map[string]string{}
+			if err := yaml.Unmarshal(data, &m); err != nil {
+				return fmt.Errorf("parsing translation %s: %w", path, err)
+			}
+			set(l, m)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w for language %q (looked for %s.yml in %s)",
+		ErrNotFound, l, l, strings.Join(nonEmpty(dirs), ", "))
+}
+
+func nonEmpty(dirs []string) []string {
+	var out []string
+	for _, d := range dirs {
+		if d != "" {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+func set(l string, m map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+	lang, catalog = l, m
+}
+
+// Language returns the active language, or "" for English.
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return lang
+}
+
+// T returns the translation of s, or s itself when the active catalog has
+// none.
+func T(s string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if tr, ok := catalog[s]; ok && tr != "" {
+		return tr
+	}
+	return s
+}
+
+// Sprintf translates format and then formats it with args. Translations
+// must keep the verbs of the original in the same order.
+func Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
@@ -0,0 +1,97 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCatalog(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+".yml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"":            "",
+		"C":           "",
+		"POSIX":       "",
+		"de":          "de",
+		"de_DE.UTF-8": "de-de",
+		"pt-BR":       "pt-br",
+		"sr@latin":    "sr",
+	}
+	for in, want := range tests {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestLoad_TranslatesAndFallsBack(t *testing.T) {
+	t.Cleanup(func() { set("", nil) })
+	dir := t.TempDir()
+	writeCatalog(t, dir, "de", "STATUS: ZUSTAND\n\"Created task #%d: %s\": \"Aufgabe #%d erstellt: %s\"\n")
+
+	if err := Load("de_DE.UTF-8", "", dir); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if Language() != "de-de" {
+		t.Errorf("Language() = %q, want de-de", Language())
+	}
+	if got := T("STATUS"); got != "ZUSTAND" {
+		t.Errorf("T(STATUS) = %q", got)
+	}
+	if got := T("TITLE"); got != "TITLE" {
+		t.Errorf("untranslated T(TITLE) = %q, want English", got)
+	}
+	if got := Sprintf("Created task #%d: %s", 3, "x"); got != "Aufgabe #3 erstellt: x" {
+		t.Errorf("Sprintf = %q", got)
+	}
+}
+
+func TestLoad_FirstDirWins(t *testing.T) {
+	t.Cleanup(func() { set("", nil) })
+	board, user := t.TempDir(), t.TempDir()
+	writeCatalog(t, board, "fr", "TITLE: TITRE\n")
+	writeCatalog(t, user, "fr", "TITLE: NOM\n")
+
+	if err := Load("fr", board, user); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := T("TITLE"); got != "TITRE" {
+		t.Errorf("T(TITLE) = %q, want board catalog to win", got)
+	}
+}
+
+func TestLoad_EnglishResets(t *testing.T) {
+	dir := t.TempDir()
+	writeCatalog(t, dir, "de", "STATUS: ZUSTAND\n")
+	if err := Load("de", dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := Load("en_US.UTF-8", dir); err != nil {
+		t.Fatalf("Load(en): %v", err)
+	}
+	if got := T("STATUS"); got != "STATUS" {
+		t.Errorf("T(STATUS) = %q after switching to English", got)
+	}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	err := Load("xx", t.TempDir())
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load(missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLoad_Malformed(t *testing.T) {
+	dir := t.TempDir()
+	writeCatalog(t, dir, "de", "- not\n- a map\n")
+	if err := Load("de", dir); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Load(malformed) = %v, want parse error", err)
+	}
+}
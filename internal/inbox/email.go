@@ -0,0 +1,89 @@
+package inbox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+)
+
+// maxMIMEDepth bounds how deeply nested multipart messages are searched
+// for a text part.
+const maxMIMEDepth = 4
+
+// parseEmail reads an RFC 5322 message: the subject is the title, and the
+// body is the first text/plain part, headed by the sender.
+func parseEmail(data []byte) (Item, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return Item{}, fmt.Errorf("invalid email: %w", err)
+	}
+	dec := new(mime.WordDecoder)
+	subject, err := dec.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return Item{}, errors.New("email has no subject")
+	}
+	text, err := textPart(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body, 0)
+	if err != nil {
+		return Item{}, err
+	}
+	body := strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if from, err := dec.DecodeHeader(msg.Header.Get("From")); err == nil && from != "" {
+		body = strings.TrimSpace("From: " + from + "\n\n" + body)
+	}
+	return Item{Title: subject, Body: body}, nil
+}
+
+// textPart returns the decoded text of a message body with the given
+// content type and transfer encoding, descending into multipart bodies for
+// their first text/plain part.
+func textPart(contentType, encoding string, r io.Reader, depth int) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if contentType == "" || err != nil {
+		mediaType = "text/plain"
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMIMEDepth {
+			return "", errors.New("email nests multipart bodies too deeply")
+		}
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				return "", nil
+			}
+			if err != nil {
+				return "", fmt.Errorf("reading email part: %w", err)
+			}
+			// multipart.Reader already undoes quoted-printable.
+			text, err := textPart(part.Header.Get("Content-Type"), "", part, depth+1)
+			if err != nil || text != "" {
+				return text, err
+			}
+		}
+	}
+	if mediaType != "text/plain" {
+		return "", nil
+	}
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("reading email body: %w", err)
+	}
+	return string(data), nil
+}
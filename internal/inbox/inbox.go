@@ -0,0 +1,140 @@
+// Package inbox reads new work items from the board's intake sources and
+// remembers which ones have already been filed.
+package inbox
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// Item is one intake item read from a source file.
+type Item struct {
+	Source string   `json:"source"`
+	File   string   `json:"file"`
+	Title  string   `json:"title"`
+	Body   string   `json:"-"`
+	Tags   []string `json:"tags,omitempty"`
+	// Hash identifies the item's content, so the same note delivered twice
+	// (or through two sources) is filed once.
+	Hash string `json:"hash"`
+}
+
+// Skipped is a source file that could not be read as an item.
+type Skipped struct {
+	Source string `json:"source"`
+	File   string `json:"file"`
+	Reason string `json:"reason"`
+}
+
+// sourceExt is the file extension each source type reads; other files in
+// the directory are left alone.
+var sourceExt = map[string]string{
+	config.InboxFolder:  ".md",
+	config.InboxEmail:   ".eml",
+	config.InboxWebhook: ".json",
+}
+
+// Collect reads the items in the source directory dir, in file name order.
+// A missing directory has no items. Files that cannot be parsed are
+// reported as skipped rather than failing the whole source.
+func Collect(src config.InboxSource, dir string) ([]Item, []Skipped, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading inbox source %s: %w", src.Name, err)
+	}
+	var items []Item
+	var skipped []Skipped
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != sourceExt[src.Type] {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path) //nolint:gosec // file in a configured inbox directory
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		item, err := parse(src.Type, e.Name(), data)
+		if err != nil {
+			skipped = append(skipped, Skipped{Source: src.Name, File: path, Reason: err.Error()})
+			continue
+		}
+		item.Source = src.Name
+		item.File = path
+		item.Tags = mergeTags(src.Tags, item.Tags)
+		item.Hash = Hash(item.Title, item.Body)
+		items = append(items, item)
+	}
+	return items, skipped, nil
+}
+
+func parse(typ, name string, data []byte) (Item, error) {
+	switch typ {
+	case config.InboxEmail:
+		return parseEmail(data)
+	case config.InboxWebhook:
+		return parseWebhook(data)
+	default:
+		return parseNote(name, data), nil
+	}
+}
+
+// parseNote reads a Markdown note. A leading "# " heading is the title;
+// otherwise the file name is.
+func parseNote(name string, data []byte) Item {
+	text := strings.TrimSpace(strings.ReplaceAll(string(data), "\r\n", "\n"))
+	first, rest, _ := strings.Cut(text, "\n")
+	if heading, ok := strings.CutPrefix(first, "# "); ok && strings.TrimSpace(heading) != "" {
+		return Item{Title: strings.TrimSpace(heading), Body: strings.TrimSpace(rest)}
+	}
+	title := strings.TrimSuffix(name, filepath.Ext(name))
+	title = strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(title))
+	return Item{Title: title, Body: text}
+}
+
+// webhookItem is the JSON a webhook receiver spools for each request.
+type webhookItem struct {
+	Title string   `json:"title"`
+	Body  string   `json:"body,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func parseWebhook(data []byte) (Item, error) {
+	var w webhookItem
+	if err := json.Unmarshal(data, &w); err != nil {
+		return Item{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if strings.TrimSpace(w.Title) == "" {
+		return Item{}, errors.New("item has no title")
+	}
+	return Item{Title: strings.TrimSpace(w.Title), Body: strings.TrimSpace(w.Body), Tags: w.Tags}, nil
+}
+
+// Hash returns the content hash of an item with the given title and body.
+// Whitespace at either end does not count.
+func Hash(title, body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(title) + "\n\n" + strings.TrimSpace(body)))
+	return hex.EncodeToString(sum[:])
+}
+
+// mergeTags returns base followed by the extra tags it does not have.
+func mergeTags(base, extra []string) []string {
+	tags := slices.Clone(base)
+	for _, t := range extra {
+		if !slices.Contains(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,125 @@
+package inbox
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollectFolder(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.md", "# Flaky test\n\nIt fails on Tuesdays.\n")
+	writeFile(t, dir, "slow-build.md", "The build takes 20 minutes.")
+	writeFile(t, dir, "notes.txt", "ignored")
+	src := config.InboxSource{Name: "drop", Type: config.InboxFolder, Tags: []string{"intake"}}
+
+	items, skipped, err := Collect(src, dir)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if len(items) != 2 || len(skipped) != 0 {
+		t.Fatalf("Collect = %d items, %d skipped; want 2, 0", len(items), len(skipped))
+	}
+	if items[0].Title != "Flaky test" || items[0].Body != "It fails on Tuesdays." {
+		t.Errorf("heading note = %+v", items[0])
+	}
+	if items[1].Title != "slow build" || items[1].Body != "The build takes 20 minutes." {
+		t.Errorf("plain note = %+v", items[1])
+	}
+	if !slices.Equal(items[0].Tags, []string{"intake"}) || items[0].Source != "drop" {
+		t.Errorf("source fields not applied: %+v", items[0])
+	}
+	if items[0].Hash != Hash("Flaky test", "It fails on Tuesdays.\n") {
+		t.Error("hash should ignore surrounding whitespace")
+	}
+}
+
+func TestCollectMissingDir(t *testing.T) {
+	src := config.InboxSource{Name: "drop", Type: config.InboxFolder}
+	items, skipped, err := Collect(src, filepath.Join(t.TempDir(), "none"))
+	if err != nil || items != nil || skipped != nil {
+		t.Errorf("Collect(missing) = %v, %v, %v", items, skipped, err)
+	}
+}
+
+func TestCollectEmail(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "1.eml", "From: Ann <ann@example.com>\r\n"+
+		"Subject: =?UTF-8?Q?Login_broken_=E2=9C=97?=\r\n"+
+		"Content-Type: multipart/alternative; boundary=XX\r\n\r\n"+
+		"--XX\r\nContent-Type: text/html\r\n\r\n<p>hi</p>\r\n"+
+		"--XX\r\nContent-Type: text/plain; charset=utf-8\r\nContent-Transfer-Encoding: quoted-printable\r\n\r\n"+
+		"Cannot log in =E2=80=94 since today.\r\n--XX--\r\n")
+	writeFile(t, dir, "2.eml", "From: bob@example.com\r\n\r\nno subject here\r\n")
+	src := config.InboxSource{Name: "mail", Type: config.InboxEmail}
+
+	items, skipped, err := Collect(src, dir)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if len(items) != 1 || len(skipped) != 1 {
+		t.Fatalf("Collect = %d items, %d skipped; want 1, 1", len(items), len(skipped))
+	}
+	if items[0].Title != "Login broken ✗" {
+		t.Errorf("title = %q", items[0].Title)
+	}
+	want := "From: Ann <ann@example.com>\n\nCannot log in — since today."
+	if items[0].Body != want {
+		t.Errorf("body = %q, want %q", items[0].Body, want)
+	}
+	if !strings.Contains(skipped[0].Reason, "no subject") {
+		t.Errorf("skipped reason = %q", skipped[0].Reason)
+	}
+}
+
+func TestCollectWebhookSpool(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spool")
+	if _, err := Spool(dir, "Page is down", "500 on /checkout", []string{"ops"}); err != nil {
+		t.Fatalf("Spool: %v", err)
+	}
+	writeFile(t, dir, "bad.json", `{"body": "no title"}`)
+	src := config.InboxSource{Name: "hook", Type: config.InboxWebhook, Tags: []string{"ops", "intake"}}
+
+	items, skipped, err := Collect(src, dir)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if len(items) != 1 || len(skipped) != 1 {
+		t.Fatalf("Collect = %d items, %d skipped; want 1, 1", len(items), len(skipped))
+	}
+	if items[0].Title != "Page is down" || !slices.Equal(items[0].Tags, []string{"ops", "intake"}) {
+		t.Errorf("webhook item = %+v", items[0])
+	}
+}
+
+func TestLedger(t *testing.T) {
+	dir := t.TempDir()
+	l, err := LoadLedger(dir)
+	if err != nil || len(l) != 0 {
+		t.Fatalf("LoadLedger(empty) = %v, %v", l, err)
+	}
+	if err := l.Record(dir, "abc", 7); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	l, err = LoadLedger(dir)
+	if err != nil {
+		t.Fatalf("LoadLedger: %v", err)
+	}
+	if l["abc"] != 7 {
+		t.Errorf("ledger = %v, want abc -> 7", l)
+	}
+}
@@ -0,0 +1,64 @@
+package inbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ledgerFileName is the file in the kanban directory recording the content
+// hash of every item filed, with the ID of the task it became.
+const ledgerFileName = ".inbox-seen"
+
+const ledgerFileMode = 0o600
+
+// Ledger maps the content hashes of filed items to their task IDs.
+type Ledger map[string]int
+
+// LoadLedger reads the board's ledger. A board that has never filed an
+// item has an empty ledger.
+func LoadLedger(kanbanDir string) (Ledger, error) {
+	l := Ledger{}
+	f, err := os.Open(filepath.Join(kanbanDir, ledgerFileName)) //nolint:gosec // fixed name in the kanban directory
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading inbox ledger: %w", err)
+	}
+	defer f.Close() //nolint:errcheck // read-only file
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		hash, id, ok := strings.Cut(strings.TrimSpace(sc.Text()), " ")
+		if !ok {
+			continue
+		}
+		if n, err := strconv.Atoi(id); err == nil {
+			l[hash] = n
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("reading inbox ledger: %w", err)
+	}
+	return l, nil
+}
+
+// Record adds hash, filed as task id, to the ledger and its file.
+func (l Ledger) Record(kanbanDir, hash string, id int) error {
+	f, err := os.OpenFile(filepath.Join(kanbanDir, ledgerFileName),
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY, ledgerFileMode) //nolint:gosec // fixed name in the kanban directory
+	if err != nil {
+		return fmt.Errorf("updating inbox ledger: %w", err)
+	}
+	if _, err := fmt.Fprintf(f, "%s %d\n", hash, id); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("updating inbox ledger: %w", err)
+	}
+	l[hash] = id
+	return f.Close()
+}
@@ -0,0 +1,40 @@
+package inbox
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const spoolDirMode = 0o750
+
+// Spool writes an item to dir for a webhook source and returns the file
+// name. The file appears under its final name only once complete, so a
+// concurrent inbox run never reads half of it.
+func Spool(dir, title, body string, tags []string) (string, error) {
+	data, err := json.Marshal(webhookItem{Title: title, Body: body, Tags: tags})
+	if err != nil {
+		return "", err
+	}
+	var suffix [4]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return "", fmt.Errorf("naming spool file: %w", err)
+	}
+	name := fmt.Sprintf("%d-%s.json", time.Now().UnixNano(), hex.EncodeToString(suffix[:]))
+	if err := os.MkdirAll(dir, spoolDirMode); err != nil {
+		return "", fmt.Errorf("creating spool directory: %w", err)
+	}
+	tmp := filepath.Join(dir, name+".tmp")
+	if err := os.WriteFile(tmp, data, ledgerFileMode); err != nil {
+		return "", fmt.Errorf("spooling item: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("spooling item: %w", err)
+	}
+	return name, nil
+}
@@ -0,0 +1,137 @@
+// Package jsonschema derives JSON Schema documents from the Go types the CLI
+// encodes as JSON output, so the schemas cannot drift from the output.
+package jsonschema
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/date"
+)
+
+// Draft is the JSON Schema dialect of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document or subschema.
+type Schema = map[string]any
+
+var (
+	timeType = reflect.TypeFor[time.Time]()
+	dateType = reflect.TypeFor[date.Date]()
+)
+
+// For returns the schema of the JSON encoding/json produces for values of
+// v's type. Properties follow the json struct tags: fields tagged omitempty
+// are optional, the rest are required, and embedded structs are flattened.
+// Objects allow additional properties, so adding output fields is not a
+// breaking change.
+func For(v any) Schema {
+	return forType(reflect.TypeOf(v))
+}
+
+func forType(t reflect.Type) Schema {
+	switch t {
+	case timeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case dateType:
+		return Schema{"type": "string", "format": "date"}
+	}
+
+	switch t.Kind() { //nolint:exhaustive // the remaining kinds are not encoded by the CLI
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		// encoding/json writes nil pointers, slices, and maps as null.
+		return nullable(forNonNil(t))
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Array:
+		return Schema{"type": "array", "items": forType(t.Elem())}
+	case reflect.Struct:
+		return forStruct(t)
+	default:
+		return Schema{} // any value
+	}
+}
+
+// forNonNil is the schema of a non-nil pointer, slice, or map.
+func forNonNil(t reflect.Type) Schema {
+	switch t.Kind() { //nolint:exhaustive // only called for these kinds
+	case reflect.Pointer:
+		return forType(t.Elem())
+	case reflect.Slice:
+		return Schema{"type": "array", "items": forType(t.Elem())}
+	default:
+		s := Schema{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			s["additionalProperties"] = forType(t.Elem())
+		}
+		return s
+	}
+}
+
+func forStruct(t reflect.Type) Schema {
+	props := Schema{}
+	var required []string
+	addFields(t, props, &required)
+	s := Schema{"type": "object", "properties": props}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// addFields adds the JSON properties of struct t, flattening embedded
+// structs the way encoding/json does.
+func addFields(t reflect.Type, props Schema, required *[]string) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addFields(ft, props, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+		if k := ft.Kind(); omitEmpty && (k == reflect.Pointer || k == reflect.Slice || k == reflect.Map) {
+			props[name] = forNonNil(ft) // absent rather than null when nil
+		} else {
+			props[name] = forType(ft)
+		}
+		if !omitEmpty {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// nullable widens s to also accept null.
+func nullable(s Schema) Schema {
+	switch typ := s["type"].(type) {
+	case string:
+		s["type"] = []string{typ, "null"}
+	default:
+		return Schema{"anyOf": []Schema{s, {"type": "null"}}}
+	}
+	return s
+}
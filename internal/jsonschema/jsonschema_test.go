@@ -0,0 +1,54 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/date"
+)
+
+type inner struct {
+	Note string `json:"note"`
+}
+
+type sample struct {
+	*inner
+	ID      int               `json:"id"`
+	Tags    []string          `json:"tags,omitempty"`
+	Items   []int             `json:"items"`
+	Due     *date.Date        `json:"due,omitempty"`
+	At      time.Time         `json:"at"`
+	Ratio   *float64          `json:"ratio"`
+	Details map[string]any    `json:"details,omitempty"`
+	Counts  map[string]int    `json:"counts,omitempty"`
+	Skipped string            `json:"-"`
+	hidden  string            //nolint:unused // unexported fields are not encoded
+	Extra   map[string]string `json:"extra,omitempty"`
+}
+
+func TestFor(t *testing.T) {
+	got := For(sample{})
+	want := Schema{
+		"type": "object",
+		"properties": Schema{
+			"note":    Schema{"type": "string"},
+			"id":      Schema{"type": "integer"},
+			"tags":    Schema{"type": "array", "items": Schema{"type": "string"}},
+			"items":   Schema{"type": []string{"array", "null"}, "items": Schema{"type": "integer"}},
+			"due":     Schema{"type": "string", "format": "date"},
+			"at":      Schema{"type": "string", "format": "date-time"},
+			"ratio":   Schema{"type": []string{"number", "null"}},
+			"details": Schema{"type": "object"},
+			"counts":  Schema{"type": "object", "additionalProperties": Schema{"type": "integer"}},
+			"extra":   Schema{"type": "object", "additionalProperties": Schema{"type": "string"}},
+		},
+		"required": []string{"note", "id", "items", "at", "ratio"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		g, _ := json.MarshalIndent(got, "", "  ")
+		w, _ := json.MarshalIndent(want, "", "  ")
+		t.Errorf("For(sample) =\n%s\nwant\n%s", g, w)
+	}
+}
@@ -0,0 +1,53 @@
+// Package notify shows desktop notifications, such as the end of a focus
+// timer.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoNotifier is returned by Desktop when no notification tool is
+// installed for this system.
+var ErrNoNotifier = errors.New("no desktop notifier found; install notify-send (libnotify) on Linux")
+
+// Desktop shows a desktop notification with notify-send, or osascript on
+// macOS.
+func Desktop(title, message string) error {
+	args := command(runtime.GOOS, title, message)
+	if args == nil {
+		return ErrNoNotifier
+	}
+	if _, err := exec.LookPath(args[0]); err != nil {
+		return ErrNoNotifier
+	}
+	out, err := exec.Command(args[0], args[1:]...).CombinedOutput() //nolint:gosec // fixed tool names
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%s: %s", args[0], msg)
+		}
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+	return nil
+}
+
+// command returns the command line that shows a notification on goos, or
+// nil when there is no tool for it.
+func command(goos, title, message string) []string {
+	switch goos {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		return []string{"osascript", "-e", script}
+	case "windows", "plan9", "js", "wasip1":
+		return nil
+	}
+	return []string{"notify-send", title, message}
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,20 @@
+package notify
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	if got := command("linux", "Focus done", "#12 Fix login"); !slices.Equal(got, []string{"notify-send", "Focus done", "#12 Fix login"}) {
+		t.Errorf("linux: %q", got)
+	}
+	got := command("darwin", "Focus done", `Fix "login" \ signup`)
+	want := `display notification "Fix \"login\" \\ signup" with title "Focus done"`
+	if len(got) != 3 || got[0] != "osascript" || got[2] != want {
+		t.Errorf("darwin: %q, want script %s", got, want)
+	}
+	if got := command("windows", "Focus done", "x"); got != nil {
+		t.Errorf("windows: %q, want no command", got)
+	}
+}
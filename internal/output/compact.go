@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -26,10 +27,22 @@ func TaskCompact(w io.Writer, tasks []*task.Task) {
 
 // TaskDetailCompact renders a single task with detail in compact format.
 func TaskDetailCompact(w io.Writer, t *task.Task) {
+	TaskDetailCompactWithProgress(w, t, nil)
+}
+
+// TaskDetailCompactWithProgress renders a task in compact format, adding a
+// children line when progress is non-nil.
+func TaskDetailCompactWithProgress(w io.Writer, t *task.Task, progress *board.ChildProgress) {
 	line := formatTaskLine(t)
 	if t.Estimate != "" {
 		line += " est:" + t.Estimate
 	}
+	if t.Reviewer != "" {
+		line += " reviewer:" + t.Reviewer
+	}
+	if t.Goal != "" {
+		line += " goal:" + t.Goal
+	}
 	if t.Branch != "" {
 		line += " branch:" + t.Branch
 	}
@@ -39,15 +52,24 @@ func TaskDetailCompact(w io.Writer, t *task.Task) {
 	fmt.Fprintln(w, line)
 
 	// Timestamps line.
-	ts := "  created:" + t.Created.Format("2006-01-02") +
-		" updated:" + t.Updated.Format("2006-01-02")
+	ts := "  created:" + formatTime(t.Created, "2006-01-02") +
+		" updated:" + formatTime(t.Updated, "2006-01-02")
 	if t.Started != nil {
-		ts += " started:" + t.Started.Format("2006-01-02")
+		ts += " started:" + formatTime(*t.Started, "2006-01-02")
 	}
 	if t.Completed != nil {
-		ts += " completed:" + t.Completed.Format("2006-01-02")
+		ts += " completed:" + formatTime(*t.Completed, "2006-01-02")
+	}
+	if t.Reopened > 0 {
+		ts += " reopened:" + strconv.Itoa(t.Reopened)
+	}
+	if len(t.Worklog) > 0 {
+		ts += " worked:" + strings.ReplaceAll(FormatDuration(task.Worked(t)), " ", "")
 	}
 	fmt.Fprintln(w, ts)
+	if progress != nil {
+		fmt.Fprintln(w, "  children: "+progress.String())
+	}
 
 	if t.Body != "" {
 		for _, bodyLine := range strings.Split(t.Body, "\n") {
@@ -85,6 +107,28 @@ func OverviewCompact(w io.Writer, s board.Overview) {
 		}
 		fmt.Fprintln(w, "Priority: "+strings.Join(parts, " "))
 	}
+
+	if len(s.Goals) > 0 {
+		parts := make([]string, 0, len(s.Goals))
+		for _, g := range s.Goals {
+			parts = append(parts, g.Goal+"="+strconv.Itoa(g.Done)+"/"+strconv.Itoa(g.Total))
+		}
+		fmt.Fprintln(w, "Goals: "+strings.Join(parts, " "))
+	}
+}
+
+// MatrixCompact renders a two-field group matrix, one row per line,
+// omitting empty cells.
+func MatrixCompact(w io.Writer, m board.GroupMatrix) {
+	for _, r := range m.Rows {
+		parts := make([]string, 0, len(r.Counts))
+		for i, n := range r.Counts {
+			if n > 0 {
+				parts = append(parts, m.Columns[i]+"="+strconv.Itoa(n))
+			}
+		}
+		fmt.Fprintf(w, "%s (%d): %s\n", r.Key, r.Total, strings.Join(parts, " "))
+	}
 }
 
 // MetricsCompact renders flow metrics in compact format.
@@ -94,8 +138,17 @@ func MetricsCompact(w io.Writer, m board.Metrics) {
 		"Lead: " + compactDuration(m.AvgLeadTimeHours),
 		"Cycle: " + compactDuration(m.AvgCycleTimeHours),
 		"Efficiency: " + formatOptionalPercent(m.FlowEfficiency),
+		"Reopened: " + strconv.Itoa(m.Reopened) + " (" + formatOptionalPercent(m.ReopenRate) + ")",
+	}
+	if m.BusinessDays {
+		parts = append(parts, "business days")
 	}
 	fmt.Fprintln(w, strings.Join(parts, " | "))
+	if a := m.Abandonment; a != nil {
+		fmt.Fprintf(w, "Expired claims: %d/%d, avg %s claimed%s%s\n", a.Expired, a.Claims,
+			compactDuration(a.AvgClaimedHours), compactAbandonGroups(" | agents:", a.ByAgent),
+			compactAbandonGroups(" | tags:", a.ByTag))
+	}
 
 	for _, a := range m.AgingItems {
 		title := a.Title
@@ -103,8 +156,46 @@ func MetricsCompact(w io.Writer, m board.Metrics) {
 		if len(title) > maxTitle {
 			title = title[:maxTitle-3] + "..."
 		}
-		fmt.Fprintf(w, "Aging: #%d [%s] %s (%s)\n",
-			a.ID, a.Status, title, FormatDuration(time.Duration(a.AgeHours*float64(time.Hour))))
+		fmt.Fprintf(w, "Aging: %s [%s] %s (%s)\n",
+			FormatID(a.ID), a.Status, title, FormatDuration(time.Duration(a.AgeHours*float64(time.Hour))))
+	}
+}
+
+// compactAbandonGroups lists the groups with expired claims after label as
+// "name expired/claims", or returns "" when there are none.
+func compactAbandonGroups(label string, groups []board.AbandonGroup) string {
+	var parts []string
+	for _, g := range groups {
+		if g.Expired > 0 {
+			parts = append(parts, " "+g.Name+" "+strconv.Itoa(g.Expired)+"/"+strconv.Itoa(g.Claims))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return label + strings.Join(parts, ",")
+}
+
+// TriageCompact renders triage times, one line per triage event.
+func TriageCompact(w io.Writer, m board.TriageMetrics) {
+	for _, row := range []struct {
+		label string
+		s     board.TriageStat
+	}{
+		{"First status change", m.FirstStatusChange},
+		{"First assignee", m.FirstAssignee},
+	} {
+		fmt.Fprintf(w, "%s: %d tasks, %d waiting | avg %s | p50 %s | p90 %s\n", row.label, row.s.Count, row.s.Waiting,
+			compactDuration(row.s.AvgHours), compactDuration(row.s.P50Hours), compactDuration(row.s.P90Hours))
+	}
+}
+
+// TagTrendsCompact renders per-tag created and completed counts, one line
+// per tag.
+func TagTrendsCompact(w io.Writer, r board.TagTrends) {
+	for _, tr := range r.Tags {
+		fmt.Fprintf(w, "%s: created %d, completed %d (%.1f/week)\n",
+			tr.Tag, tr.Created, tr.Completed, tr.ThroughputPerWeek)
 	}
 }
 
@@ -116,15 +207,85 @@ func ActivityLogCompact(w io.Writer, entries []board.LogEntry) {
 	}
 
 	for _, e := range entries {
-		fmt.Fprintf(w, "%s %s #%d %s\n",
-			e.Timestamp.Format("2006-01-02 15:04:05"),
-			e.Action, e.TaskID, e.Detail)
+		line := fmt.Sprintf("%s %s %s %s",
+			formatTime(e.Timestamp, "2006-01-02 15:04:05"),
+			e.Action, FormatID(e.TaskID), e.Detail)
+		if e.Actor != "" {
+			line += " by:" + e.Actor
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+// WorkContextCompact renders a picked task's work context one line per
+// related task or log entry.
+func WorkContextCompact(w io.Writer, wc board.WorkContext) {
+	related := func(kind string, r board.RelatedTask) {
+		if r.Missing {
+			fmt.Fprintf(w, "%s: %s (missing)\n", kind, FormatID(r.ID))
+			return
+		}
+		line := fmt.Sprintf("%s: %s [%s] %s", kind, FormatID(r.ID), r.Status, r.Title)
+		if r.ClaimedBy != "" {
+			line += " @" + r.ClaimedBy
+		}
+		fmt.Fprintln(w, line)
+	}
+	for _, d := range wc.Dependencies {
+		related("Dep", d)
+	}
+	if wc.Parent != nil {
+		related("Parent", *wc.Parent)
+	}
+	for _, e := range wc.RecentLog {
+		line := fmt.Sprintf("Log: %s %s %s %s", formatTime(e.Timestamp, "2006-01-02 15:04"), e.Action, FormatID(e.TaskID), e.Detail)
+		if e.Actor != "" {
+			line += " by:" + e.Actor
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+// SubscriptionsCompact renders task subscriptions one per line.
+func SubscriptionsCompact(w io.Writer, subs []config.Subscription) {
+	if len(subs) == 0 {
+		fmt.Fprintln(os.Stderr, "No subscriptions found.")
+		return
+	}
+	for _, s := range subs {
+		fmt.Fprintf(w, "%s %s\n", FormatID(s.Task), s.Exec)
+	}
+}
+
+// LogSummaryCompact renders an activity summary in compact format.
+func LogSummaryCompact(w io.Writer, s board.LogSummary) {
+	fmt.Fprintf(w, "%s..%s %s\n", formatTime(s.From, "2006-01-02"), formatTime(s.To, "2006-01-02"),
+		logSummaryNarrative(s))
+	for _, sec := range []struct {
+		name  string
+		tasks []board.LogSummaryTask
+	}{
+		{"completed", s.Completed},
+		{"created", s.Created},
+		{"blocked", s.Blocked},
+		{"deleted", s.Deleted},
+	} {
+		for _, t := range sec.tasks {
+			line := "  " + sec.name + " " + FormatID(t.ID) + " " + t.Title
+			if t.Detail != "" {
+				line += " (" + t.Detail + ")"
+			}
+			if t.StillBlocked {
+				line += " still-blocked"
+			}
+			fmt.Fprintln(w, line)
+		}
 	}
 }
 
 // formatTaskLine builds the one-line representation of a task.
 func formatTaskLine(t *task.Task) string {
-	line := "#" + strconv.Itoa(t.ID) + " [" + t.Status + "/" + t.Priority + "] " + t.Title
+	line := FormatID(t.ID) + " [" + t.Status + "/" + t.Priority + "] " + markedTitle(t)
 
 	if t.ClaimedBy != "" {
 		line += " @" + t.ClaimedBy
@@ -138,6 +299,9 @@ func formatTaskLine(t *task.Task) string {
 	if t.Due != nil {
 		line += " due:" + t.Due.String()
 	}
+	if t.Blocked && t.BlockedUntil != nil {
+		line += " blocked-until:" + t.BlockedUntil.String()
+	}
 
 	return line
 }
@@ -149,3 +313,129 @@ func compactDuration(h *float64) string {
 	}
 	return FormatDuration(time.Duration(*h * float64(time.Hour)))
 }
+
+// CriticalPathCompact renders the critical path as a single chain line
+// followed by the total estimate.
+func CriticalPathCompact(w io.Writer, cp board.CriticalPath) {
+	if len(cp.Tasks) == 0 {
+		fmt.Fprintln(os.Stderr, "No remaining work found.")
+		return
+	}
+
+	for _, t := range cp.Tasks {
+		line := FormatID(t.ID) + " [" + t.Status + "] " + t.Title
+		if t.Estimate != "" {
+			line += " est:" + t.Estimate
+		}
+		fmt.Fprintln(w, line)
+	}
+	line := "Critical path: " + strconv.Itoa(len(cp.Tasks)) + " tasks, " + FormatHours(cp.TotalHours)
+	if len(cp.Unestimated) > 0 {
+		line += " (unestimated: " + FormatIDList(cp.Unestimated) + ")"
+	}
+	fmt.Fprintln(w, line)
+}
+
+// EstimateReportCompact renders one line per estimate group plus a total.
+func EstimateReportCompact(w io.Writer, r board.EstimateReport) {
+	if r.Tasks == 0 {
+		fmt.Fprintln(os.Stderr, "No remaining work found.")
+		return
+	}
+
+	for _, g := range r.Groups {
+		line := g.Key + ": " + FormatHours(g.Hours) + " (" + strconv.Itoa(g.Tasks) + " tasks"
+		if g.Unestimated > 0 {
+			line += ", " + strconv.Itoa(g.Unestimated) + " unestimated"
+		}
+		fmt.Fprintln(w, line+")")
+	}
+	line := "Total: " + FormatHours(r.TotalHours) + " across " + strconv.Itoa(r.Tasks) + " tasks"
+	if len(r.Unestimated) > 0 {
+		line += " (unestimated: " + FormatIDList(r.Unestimated) + ")"
+	}
+	fmt.Fprintln(w, line)
+}
+
+// PlanCompact renders a capacity plan as one line per person and task.
+func PlanCompact(w io.Writer, p board.Plan) {
+	fmt.Fprintf(w, "Plan %s..%s (%d working days)\n", p.From, p.Until, p.WorkingDays)
+	for _, person := range p.People {
+		line := "  " + person.Name + ": " + FormatHours(person.Planned) + "/" + FormatHours(person.Capacity) +
+			" planned, " + FormatHours(person.Assigned) + " assigned"
+		if person.Overloaded {
+			line += " OVERLOADED"
+		}
+		fmt.Fprintln(w, line)
+	}
+	for _, group := range []struct {
+		label string
+		items []board.PlanItem
+	}{{"fits", p.Fits}, {"slips", p.Slips}} {
+		for _, it := range group.items {
+			line := group.label + " " + FormatID(it.ID) + " [" + it.Priority + "] " + it.Title + " " + FormatHours(it.Hours)
+			if it.Assignee != "" {
+				line += " @" + it.Assignee
+				if it.Suggested {
+					line += "?"
+				}
+			}
+			if it.Reason != "" {
+				line += " (" + it.Reason + ")"
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+	if len(p.Unestimated) > 0 {
+		fmt.Fprintln(w, "unestimated: "+FormatIDList(p.Unestimated))
+	}
+}
+
+// TreeCompact renders a task hierarchy as indented one-line entries.
+func TreeCompact(w io.Writer, nodes []*board.TreeNode) {
+	if len(nodes) == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found.")
+		return
+	}
+	var walk func(n *board.TreeNode, depth int)
+	walk = func(n *board.TreeNode, depth int) {
+		fmt.Fprintf(w, "%s%s [%s/%s] %s\n", strings.Repeat("  ", depth), FormatID(n.ID), n.Status, n.Priority, n.Title)
+		for _, c := range n.Children {
+			walk(c, depth+1)
+		}
+	}
+	for _, n := range nodes {
+		walk(n, 0)
+	}
+}
+
+// SimulationCompact renders a WIP limit simulation as one line per column
+// and one for the board.
+func SimulationCompact(w io.Writer, s board.Simulation) {
+	for _, c := range s.Columns {
+		fmt.Fprintf(w, "%s: limit %s->%s | WIP %.1f->%.1f | in column %s->%s | wait %s\n",
+			c.Status, simLimit(c.CurrentLimit), simLimit(c.Limit), c.ActualAvgWIP, c.PredictedAvgWIP,
+			formatHours(c.ActualAvgHours), formatHours(c.PredictedAvgHours), formatHours(c.PredictedAvgWaitHours))
+	}
+	fmt.Fprintf(w, "Cycle: %s->%s | Throughput: %.1f->%.1f/week (%d completed, %s)\n",
+		compactDuration(s.Actual.AvgCycleTimeHours), compactDuration(s.Predicted.AvgCycleTimeHours),
+		s.Actual.ThroughputPerWeek, s.Predicted.ThroughputPerWeek, s.Completed, s.Strategy)
+}
+
+// DiffCompact renders the changes to each column, one line per column
+// that changed and one per task that entered or left it.
+func DiffCompact(w io.Writer, d board.Diff) {
+	fmt.Fprintf(w, "since %s: %d changed\n", formatTime(d.Since, "2006-01-02 15:04"), d.Changed)
+	for _, c := range d.Columns {
+		if len(c.Added)+len(c.Removed) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s %d->%d\n", c.Status, c.Before, c.After)
+		for _, t := range c.Added {
+			fmt.Fprintf(w, "  + %s %s (%s)\n", FormatID(t.ID), t.Title, diffTaskOrigin(t))
+		}
+		for _, t := range c.Removed {
+			fmt.Fprintf(w, "  - %s %s (%s)\n", FormatID(t.ID), t.Title, diffTaskDestination(t))
+		}
+	}
+}
@@ -327,6 +327,8 @@ func TestActivityLogCompact(t *testing.T) {
 		},
 	}
 
+	setLocationForTest(t, time.UTC)
+
 	var buf strings.Builder
 	ActivityLogCompact(&buf, entries)
 	out := buf.String()
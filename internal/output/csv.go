@@ -0,0 +1,23 @@
+package output
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+)
+
+// TagTrendsCSV writes per-tag weekly counts as CSV, one row per tag and
+// week, for spreadsheets.
+func TagTrendsCSV(w io.Writer, r board.TagTrends) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"tag", "week_start", "created", "completed"})
+	for _, tr := range r.Tags {
+		for _, b := range tr.Weekly {
+			_ = cw.Write([]string{tr.Tag, b.Start.String(), strconv.Itoa(b.Created), strconv.Itoa(b.Completed)})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -21,20 +21,36 @@ type ErrorResponse struct {
 	Error   string         `json:"error"`
 	Code    string         `json:"code"`
 	Details map[string]any `json:"details,omitempty"`
+	// Retryable is true when the same command may succeed later, and
+	// RetryAfter the seconds to wait first, when known.
+	Retryable  bool `json:"retryable"`
+	RetryAfter int  `json:"retry_after,omitempty"`
 }
 
 // JSONError writes a structured error to the given writer as JSON.
 func JSONError(w io.Writer, code, msg string, details map[string]any) {
-	resp := ErrorResponse{Error: msg, Code: code, Details: details}
+	WriteError(w, ErrorResponse{Error: msg, Code: code, Details: details})
+}
+
+// WriteError writes resp to the given writer as JSON.
+func WriteError(w io.Writer, resp ErrorResponse) {
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
 	_ = enc.Encode(resp) // best-effort; if writer fails, nothing we can do
 }
 
 // BatchResult represents the outcome of a single operation within a batch.
+// Deferred marks an operation skipped on purpose, such as a move --fill
+// that found no WIP room; it is not a failure.
 type BatchResult struct {
-	ID    int    `json:"id"`
-	OK    bool   `json:"ok"`
-	Error string `json:"error,omitempty"`
-	Code  string `json:"code,omitempty"`
+	ID       int    `json:"id"`
+	OK       bool   `json:"ok"`
+	Deferred bool   `json:"deferred,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Code     string `json:"code,omitempty"`
+	// Retryable is true when the failed operation may succeed later.
+	Retryable bool `json:"retryable,omitempty"`
+	// Warnings lists soft problems the operation reported, such as moving
+	// a blocked task.
+	Warnings []string `json:"warnings,omitempty"`
 }
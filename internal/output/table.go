@@ -3,6 +3,7 @@ package output
 import (
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/i18n"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -48,12 +51,94 @@ func DisableColor() {
 	priorityStyles = map[string]lipgloss.Style{}
 	tagStyle = lipgloss.NewStyle()
 	claimStyle = lipgloss.NewStyle()
+	colorDisabled = true
+}
+
+// colorDisabled is set by DisableColor so configured tag colors are skipped.
+var colorDisabled bool
+
+// idPrefix is the board's task ID prefix (board.id_prefix).
+var idPrefix string
+
+// SetIDPrefix sets the prefix shown before task IDs.
+func SetIDPrefix(prefix string) {
+	idPrefix = prefix
+}
+
+// FormatID renders a task ID as "API-12" with an ID prefix set, or "#12".
+func FormatID(id int) string {
+	if idPrefix != "" {
+		return idPrefix + strconv.Itoa(id)
+	}
+	return "#" + strconv.Itoa(id)
+}
+
+// columnID renders a task ID for an ID table column: the bare number, with
+// the ID prefix if one is set.
+func columnID(id int) string {
+	return idPrefix + strconv.Itoa(id)
+}
+
+// tagStyles holds the board's per-tag colors and icons.
+var tagStyles map[string]config.TagStyle
+
+// SetTagStyles sets the per-tag colors and icons used in table output.
+func SetTagStyles(styles map[string]config.TagStyle) {
+	tagStyles = styles
+}
+
+// tagLabel returns the tag prefixed with its configured icon, if any.
+func tagLabel(tag string) string {
+	if s := tagStyles[tag]; s.Icon != "" {
+		return s.Icon + " " + tag
+	}
+	return tag
+}
+
+// renderTags joins tags with sep, each with its configured icon and color.
+// Tags without a configured color use the default tag style.
+func renderTags(tags []string, sep string) string {
+	parts := make([]string, len(tags))
+	for i, tag := range tags {
+		style := tagStyle
+		if s := tagStyles[tag]; s.Color != "" && !colorDisabled {
+			style = lipgloss.NewStyle().Foreground(lipgloss.Color(s.Color))
+		}
+		parts[i] = style.Render(tagLabel(tag))
+	}
+	return strings.Join(parts, tagStyle.Render(sep))
+}
+
+// tagsWidth returns the display width of tags joined with sep.
+func tagsWidth(tags []string, sep string) int {
+	w := 0
+	for i, tag := range tags {
+		if i > 0 {
+			w += len(sep)
+		}
+		w += lipgloss.Width(tagLabel(tag))
+	}
+	return w
+}
+
+// displayLocation is the time zone timestamps are rendered in.
+var displayLocation = time.Local
+
+// SetLocation sets the time zone used to render timestamps in table and
+// compact output. JSON output is unaffected.
+func SetLocation(loc *time.Location) {
+	displayLocation = loc
+}
+
+// formatTime renders ts in the display time zone.
+func formatTime(ts time.Time, layout string) string {
+	return ts.In(displayLocation).Format(layout)
 }
 
 // TaskTable renders a list of tasks as a formatted table.
 func TaskTable(w io.Writer, tasks []*task.Task) {
 	if len(tasks) == 0 {
-		fmt.Fprintln(os.Stderr, "No tasks found.")
+		fmt.Fprintln(os.Stderr, i18n.T("No tasks found."))
 		return
 	}
 
@@ -61,18 +146,26 @@ func TaskTable(w io.Writer, tasks []*task.Task) {
 	const pad = 2
 	idW, statusW, prioW, titleW, claimW, tagsW, dueW := 4, 8, 10, 5, 9, 6, 12
 	for _, t := range tasks {
-		idW = max(idW, len(strconv.Itoa(t.ID))+pad)
+		idW = max(idW, len(columnID(t.ID))+pad)
 		statusW = max(statusW, len(t.Status)+pad)
 		prioW = max(prioW, len(t.Priority)+pad)
-		titleW = max(titleW, min(len(t.Title)+pad, 50)) //nolint:mnd // max title column width
+		titleW = max(titleW, min(lipgloss.Width(markedTitle(t))+pad, 50)) //nolint:mnd // max title column width
 		claimW = max(claimW, len(claimDisplay(t))+pad)
-		tagsW = max(tagsW, min(len(strings.Join(t.Tags, ","))+pad, 30)) //nolint:mnd // max tags column width
+		tagsW = max(tagsW, min(tagsWidth(t.Tags, ",")+pad, 30)) //nolint:mnd // max tags column width
 	}
+	// Translated headers may be wider than the English defaults.
+	idW = max(idW, lipgloss.Width(i18n.T("ID"))+1)
+	statusW = max(statusW, lipgloss.Width(i18n.T("STATUS"))+1)
+	prioW = max(prioW, lipgloss.Width(i18n.T("PRIORITY"))+1)
+	titleW = max(titleW, lipgloss.Width(i18n.T("TITLE"))+1)
+	claimW = max(claimW, lipgloss.Width(i18n.T("CLAIMED"))+1)
+	tagsW = max(tagsW, lipgloss.Width(i18n.T("TAGS"))+1)
+	dueW = max(dueW, lipgloss.Width(i18n.T("DUE"))+1)
 
 	// Print header.
 	header := fmt.Sprintf("%-*s %-*s %-*s %-*s %-*s %-*s %-*s",
-		idW, "ID", statusW, "STATUS", prioW, "PRIORITY",
-		titleW, "TITLE", claimW, "CLAIMED", tagsW, "TAGS", dueW, "DUE")
+		idW, i18n.T("ID"), statusW, i18n.T("STATUS"), prioW, i18n.T("PRIORITY"),
+		titleW, i18n.T("TITLE"), claimW, i18n.T("CLAIMED"), tagsW, i18n.T("TAGS"), dueW, i18n.T("DUE"))
 	fmt.Fprintln(w, headerStyle.Render(strings.TrimRight(header, " ")))
 
 	// Print rows.
@@ -82,17 +175,18 @@ func TaskTable(w io.Writer, tasks []*task.Task) {
 		if len(title) > maxTitle {
 			title = title[:maxTitle-3] + "..."
 		}
+		if t.BlockedByDependency {
+			title = depBlockedMarker + " " + title
+		}
 		claim := claimDisplay(t)
 		if claim == "" {
 			claim = dimStyle.Render("--")
 		} else {
 			claim = claimStyle.Render(claim)
 		}
-		tags := strings.Join(t.Tags, ",")
-		if tags == "" {
-			tags = dimStyle.Render("--")
-		} else {
-			tags = tagStyle.Render(tags)
+		tags := dimStyle.Render("--")
+		if len(t.Tags) > 0 {
+			tags = renderTags(t.Tags, ",")
 		}
 		due := "--"
 		if t.Due != nil {
@@ -101,8 +195,8 @@ func TaskTable(w io.Writer, tasks []*task.Task) {
 			due = dimStyle.Render(due)
 		}
 
-		row := fmt.Sprintf("%-*d %s %s %s %s %s %s",
-			idW, t.ID,
+		row := fmt.Sprintf("%-*s %s %s %s %s %s %s",
+			idW, columnID(t.ID),
 			padRight(styledValue(t.Status, statusStyles), statusW),
 			padRight(styledValue(t.Priority, priorityStyles), prioW),
 			padRight(title, titleW),
@@ -115,18 +209,27 @@ func TaskTable(w io.Writer, tasks []*task.Task) {
 
 // TaskDetail renders a single task with full detail.
 func TaskDetail(w io.Writer, t *task.Task) {
-	titleLine := fmt.Sprintf("Task #%d: %s", t.ID, t.Title)
+	TaskDetailWithProgress(w, t, nil)
+}
+
+// TaskDetailWithProgress renders a task with full detail, including the
+// rollup of its children when progress is non-nil.
+func TaskDetailWithProgress(w io.Writer, t *task.Task, progress *board.ChildProgress) {
+	titleLine := fmt.Sprintf("Task %s: %s", FormatID(t.ID), t.Title)
 	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(titleLine))
 	fmt.Fprintln(w, strings.Repeat("─", len(titleLine)))
 
 	printField(w, "Status", styledValue(t.Status, statusStyles))
 	printField(w, "Priority", styledValue(t.Priority, priorityStyles))
-	if t.Class != "" {
-		printField(w, "Class", t.Class)
-	}
+	printOptionalField(w, "Class", t.Class)
+	printOptionalField(w, "Workstream", t.Workstream)
+	printOptionalField(w, "Goal", t.Goal)
 	printField(w, "Assignee", stringOrDash(t.Assignee))
+	if t.Reviewer != "" {
+		printField(w, "Reviewer", t.Reviewer)
+	}
 	if len(t.Tags) > 0 {
-		printField(w, "Tags", tagStyle.Render(strings.Join(t.Tags, ", ")))
+		printField(w, "Tags", renderTags(t.Tags, ", "))
 	} else {
 		printField(w, "Tags", dimStyle.Render("--"))
 	}
@@ -135,34 +238,58 @@ func TaskDetail(w io.Writer, t *task.Task) {
 	} else {
 		printField(w, "Due", dimStyle.Render("--"))
 	}
+	if t.Blocked {
+		blocked := t.BlockReason
+		if t.BlockedUntil != nil {
+			blocked += " (until " + t.BlockedUntil.String() + ")"
+		}
+		printField(w, "Blocked", blocked)
+		printOptionalField(w, "Blocked on", t.BlockedOn)
+	}
 	printField(w, "Estimate", stringOrDash(t.Estimate))
-	printField(w, "Created", t.Created.Format("2006-01-02 15:04"))
-	printField(w, "Updated", t.Updated.Format("2006-01-02 15:04"))
+	if progress != nil {
+		printField(w, "Children", progress.String())
+	}
+	printField(w, "Created", formatTime(t.Created, "2006-01-02 15:04"))
+	printField(w, "Updated", formatTime(t.Updated, "2006-01-02 15:04"))
 	if t.Started != nil {
-		printField(w, "Started", t.Started.Format("2006-01-02 15:04"))
+		printField(w, "Started", formatTime(*t.Started, "2006-01-02 15:04"))
 	}
 	if t.Completed != nil {
-		printField(w, "Completed", t.Completed.Format("2006-01-02 15:04"))
+		printField(w, "Completed", formatTime(*t.Completed, "2006-01-02 15:04"))
 		printField(w, "Lead time", FormatDuration(t.Completed.Sub(t.Created)))
 		if t.Started != nil {
 			printField(w, "Cycle time", FormatDuration(t.Completed.Sub(*t.Started)))
 		}
 	}
+	if t.Reopened > 0 {
+		printField(w, "Reopened", strconv.Itoa(t.Reopened)+"x")
+	}
+	if len(t.Worklog) > 0 {
+		sessions := "sessions"
+		if len(t.Worklog) == 1 {
+			sessions = "session"
+		}
+		printField(w, "Worked", fmt.Sprintf("%s (%d %s)", FormatDuration(task.Worked(t)), len(t.Worklog), sessions))
+	}
+	if task.Focusing(t, time.Now()) {
+		printField(w, "Focus", "until "+formatTime(*t.FocusUntil, "15:04"))
+	}
 
 	if t.ClaimedBy != "" {
 		claimStr := claimStyle.Render(t.ClaimedBy)
 		if t.ClaimedAt != nil {
-			claimStr += " (since " + t.ClaimedAt.Format("2006-01-02 15:04") + ")"
+			claimStr += " (since " + formatTime(*t.ClaimedAt, "2006-01-02 15:04") + ")"
 		}
 		printField(w, "Claimed by", claimStr)
 	}
 
-	if t.Branch != "" {
-		printField(w, "Branch", t.Branch)
-	}
-	if t.Worktree != "" {
-		printField(w, "Worktree", t.Worktree)
+	printOptionalField(w, "Branch", t.Branch)
+	printOptionalField(w, "Worktree", t.Worktree)
+	if t.UID != "" {
+		printField(w, "UID", dimStyle.Render(t.UID))
 	}
+	printStatusHistory(w, t, time.Now())
 
 	if t.Body != "" {
 		fmt.Fprintln(w)
@@ -170,12 +297,30 @@ func TaskDetail(w io.Writer, t *task.Task) {
 	}
 }
 
+// printStatusHistory lists the statuses a task entered, when, and how long
+// it stayed in each.
+func printStatusHistory(w io.Writer, t *task.Task, now time.Time) {
+	if len(t.StatusHistory) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "  %s\n", i18n.T("History")+":")
+	const statusW = 14
+	for i, e := range t.StatusHistory {
+		end, suffix := now, " (now)"
+		if i+1 < len(t.StatusHistory) {
+			end, suffix = t.StatusHistory[i+1].EnteredAt, ""
+		}
+		fmt.Fprintf(w, "    %s %s  %s\n", padRight(styledValue(e.Status, statusStyles), statusW),
+			formatTime(e.EnteredAt, "2006-01-02 15:04"), dimStyle.Render(FormatDuration(end.Sub(e.EnteredAt))+suffix))
+	}
+}
+
 // OverviewTable renders a board summary as a formatted dashboard.
 func OverviewTable(w io.Writer, s board.Overview) {
 	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(s.BoardName))
-	fmt.Fprintf(w, "Total: %d tasks\n\n", s.TotalTasks)
+	fmt.Fprint(w, i18n.Sprintf("Total: %d tasks", s.TotalTasks)+"\n\n")
 
-	header := fmt.Sprintf("%-16s %6s %8s %8s %8s", "STATUS", "COUNT", "WIP", "BLOCKED", "OVERDUE")
+	header := fmt.Sprintf("%-16s %6s %8s %8s %8s", i18n.T("STATUS"), i18n.T("COUNT"), i18n.T("WIP"), i18n.T("BLOCKED"), i18n.T("OVERDUE"))
 	fmt.Fprintln(w, headerStyle.Render(header))
 
 	for _, ss := range s.Statuses {
@@ -190,7 +335,7 @@ func OverviewTable(w io.Writer, s board.Overview) {
 	}
 
 	fmt.Fprintln(w)
-	prioHeader := fmt.Sprintf("%-16s %6s", "PRIORITY", "COUNT")
+	prioHeader := fmt.Sprintf("%-16s %6s", i18n.T("PRIORITY"), i18n.T("COUNT"))
 	fmt.Fprintln(w, headerStyle.Render(prioHeader))
 
 	for _, pc := range s.Priorities {
@@ -201,17 +346,32 @@ func OverviewTable(w io.Writer, s board.Overview) {
 
 	if len(s.Classes) > 0 {
 		fmt.Fprintln(w)
-		classHeader := fmt.Sprintf("%-16s %6s", "CLASS", "COUNT")
+		classHeader := fmt.Sprintf("%-16s %6s", i18n.T("CLASS"), i18n.T("COUNT"))
 		fmt.Fprintln(w, headerStyle.Render(classHeader))
 		for _, cc := range s.Classes {
 			fmt.Fprintf(w, "%-16s %6d\n", cc.Class, cc.Count)
 		}
 	}
+
+	if len(s.Goals) > 0 {
+		fmt.Fprintln(w)
+		goalHeader := fmt.Sprintf("%-16s %8s %8s %8s  %s", i18n.T("GOAL"), i18n.T("DONE"), i18n.T("PROGRESS"), i18n.T("BLOCKED"), i18n.T("TITLE"))
+		fmt.Fprintln(w, headerStyle.Render(goalHeader))
+		for _, g := range s.Goals {
+			line := fmt.Sprintf("%-16s %8s %7d%% %8d  %s", g.Goal,
+				strconv.Itoa(g.Done)+"/"+strconv.Itoa(g.Total), g.Percent(), g.Blocked, g.Title)
+			fmt.Fprintln(w, strings.TrimRight(line, " "))
+		}
+	}
 }
 
 // MetricsTable renders flow metrics as a formatted dashboard.
 func MetricsTable(w io.Writer, m board.Metrics) {
-	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render("Flow Metrics"))
+	heading := "Flow Metrics"
+	if m.BusinessDays {
+		heading += " (business days)"
+	}
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(heading))
 	fmt.Fprintln(w)
 
 	printField(w, "Throughput 7d", strconv.Itoa(m.Throughput7d)+" tasks")
@@ -219,10 +379,20 @@ func MetricsTable(w io.Writer, m board.Metrics) {
 	printField(w, "Avg lead time", formatOptionalHours(m.AvgLeadTimeHours))
 	printField(w, "Avg cycle time", formatOptionalHours(m.AvgCycleTimeHours))
 	printField(w, "Flow efficiency", formatOptionalPercent(m.FlowEfficiency))
+	printField(w, "Reopened", fmt.Sprintf("%d tasks, %d reopens (%s)",
+		m.Reopened, m.Reopens, formatOptionalPercent(m.ReopenRate)))
+	if a := m.Abandonment; a != nil {
+		printField(w, "Expired claims", fmt.Sprintf("%d of %d, avg %s claimed",
+			a.Expired, a.Claims, formatOptionalHours(a.AvgClaimedHours)))
+	}
+
+	if a := m.Abandonment; a != nil && a.Expired > 0 {
+		abandonmentTable(w, a)
+	}
 
 	if len(m.AgingItems) > 0 {
 		fmt.Fprintln(w)
-		agingHeader := fmt.Sprintf("%-6s %-16s %-40s %10s", "ID", "STATUS", "TITLE", "AGE")
+		agingHeader := fmt.Sprintf("%-6s %-16s %-40s %10s", i18n.T("ID"), i18n.T("STATUS"), i18n.T("TITLE"), i18n.T("AGE"))
 		fmt.Fprintln(w, headerStyle.Render(agingHeader))
 		for _, a := range m.AgingItems {
 			title := a.Title
@@ -231,13 +401,37 @@ func MetricsTable(w io.Writer, m board.Metrics) {
 				title = title[:maxTitle-3] + "..."
 			}
 			const agingStatusW = 16
-			fmt.Fprintf(w, "%-6d %s %-40s %10s\n",
-				a.ID, padRight(styledValue(a.Status, statusStyles), agingStatusW),
+			fmt.Fprintf(w, "%-6s %s %-40s %10s\n",
+				columnID(a.ID), padRight(styledValue(a.Status, statusStyles), agingStatusW),
 				title, FormatDuration(time.Duration(a.AgeHours*float64(time.Hour))))
 		}
 	}
 }
 
+// abandonmentTable lists the agents and tags with expired claims.
+func abandonmentTable(w io.Writer, a *board.Abandonment) {
+	const nameW, avgW = 24, 12
+	for _, section := range []struct {
+		heading string
+		groups  []board.AbandonGroup
+	}{
+		{i18n.T("AGENT"), a.ByAgent},
+		{i18n.T("TAG"), a.ByTag},
+	} {
+		fmt.Fprintln(w)
+		header := fmt.Sprintf("%-*s %8s %7s %*s", nameW, section.heading,
+			i18n.T("EXPIRED"), i18n.T("CLAIMS"), avgW, i18n.T("AVG CLAIMED"))
+		fmt.Fprintln(w, headerStyle.Render(header))
+		for _, g := range section.groups {
+			if g.Expired == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "%-*s %8d %7d %s\n", nameW, g.Name, g.Expired, g.Claims,
+				padLeft(formatOptionalHours(g.AvgClaimedHours), avgW))
+		}
+	}
+}
+
 func formatOptionalHours(h *float64) string {
 	if h == nil {
 		return dimStyle.Render("--")
@@ -253,27 +447,204 @@ func formatOptionalPercent(f *float64) string {
 	return fmt.Sprintf("%.1f%%", *f*percentMultiplier)
 }
 
+// TriageTable renders triage times: how long new tasks wait for their first
+// status change and their first assignee.
+func TriageTable(w io.Writer, m board.TriageMetrics) {
+	heading := "Triage Times"
+	if m.BusinessDays {
+		heading += " (business days)"
+	}
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(heading))
+	fmt.Fprintln(w)
+
+	const labelW, durW = 22, 10
+	header := fmt.Sprintf("%-*s %6s %8s %10s %10s %10s", labelW, i18n.T("FROM CREATION TO"),
+		i18n.T("COUNT"), i18n.T("WAITING"), i18n.T("AVG"), i18n.T("P50"), i18n.T("P90"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, row := range []struct {
+		label string
+		s     board.TriageStat
+	}{
+		{"First status change", m.FirstStatusChange},
+		{"First assignee", m.FirstAssignee},
+	} {
+		fmt.Fprintf(w, "%-*s %6d %8d %s %s %s\n", labelW, i18n.T(row.label), row.s.Count, row.s.Waiting,
+			padLeft(formatOptionalHours(row.s.AvgHours), durW),
+			padLeft(formatOptionalHours(row.s.P50Hours), durW),
+			padLeft(formatOptionalHours(row.s.P90Hours), durW))
+	}
+}
+
+// TagTrendsTable renders per-tag created and completed counts with a
+// sparkline of weekly completions.
+func TagTrendsTable(w io.Writer, r board.TagTrends) {
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(
+		i18n.Sprintf("Tag trends since %s", formatTime(r.Since, "2006-01-02"))))
+	fmt.Fprintln(w)
+	if len(r.Tags) == 0 {
+		fmt.Fprintln(w, dimStyle.Render(i18n.T("No tasks created or completed in this period.")))
+		return
+	}
+
+	const tagW = 20
+	header := fmt.Sprintf("%-*s %8s %10s %8s  %s", tagW, i18n.T("TAG"), i18n.T("CREATED"),
+		i18n.T("COMPLETED"), i18n.T("/WEEK"), i18n.T("WEEKLY COMPLETED"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, tr := range r.Tags {
+		completed := make([]int, len(tr.Weekly))
+		for i, b := range tr.Weekly {
+			completed[i] = b.Completed
+		}
+		fmt.Fprintf(w, "%-*s %8d %10d %8.1f  %s\n", tagW, tr.Tag,
+			tr.Created, tr.Completed, tr.ThroughputPerWeek, sparkline(completed))
+	}
+}
+
+// sparkline draws values as a row of block characters scaled to the largest.
+func sparkline(values []int) string {
+	const blocks = "▁▂▃▄▅▆▇█"
+	levels := []rune(blocks)
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	var b strings.Builder
+	for _, v := range values {
+		level := 0
+		if peak > 0 {
+			level = v * (len(levels) - 1) / peak
+		}
+		b.WriteRune(levels[level])
+	}
+	return b.String()
+}
+
 // ActivityLogTable renders activity log entries as a formatted table.
 func ActivityLogTable(w io.Writer, entries []board.LogEntry) {
 	if len(entries) == 0 {
-		fmt.Fprintln(os.Stderr, "No activity log entries found.")
+		fmt.Fprintln(os.Stderr, i18n.T("No activity log entries found."))
 		return
 	}
 
-	header := fmt.Sprintf("%-20s %-10s %6s  %s", "TIMESTAMP", "ACTION", "TASK", "DETAIL")
+	header := fmt.Sprintf("%-20s %-10s %6s  %-16s %s", i18n.T("TIMESTAMP"), i18n.T("ACTION"), i18n.T("TASK"), i18n.T("ACTOR"), i18n.T("DETAIL"))
 	fmt.Fprintln(w, headerStyle.Render(header))
 
 	for _, e := range entries {
-		fmt.Fprintf(w, "%-20s %-10s %6d  %s\n",
-			e.Timestamp.Format("2006-01-02 15:04:05"),
-			e.Action, e.TaskID, e.Detail)
+		fmt.Fprintf(w, "%-20s %-10s %6s  %-16s %s\n",
+			formatTime(e.Timestamp, "2006-01-02 15:04:05"),
+			e.Action, columnID(e.TaskID), stringOrDash(e.Actor), e.Detail)
+	}
+}
+
+// WorkContextTable renders a picked task's dependencies, parent, and recent
+// related activity.
+func WorkContextTable(w io.Writer, wc board.WorkContext) {
+	if len(wc.Dependencies) > 0 {
+		fmt.Fprintf(w, "\n%s\n", headerStyle.Render(i18n.T("Dependencies")))
+		for _, d := range wc.Dependencies {
+			fmt.Fprintln(w, "  "+relatedTaskLine(d))
+		}
+	}
+	if wc.Parent != nil {
+		fmt.Fprintf(w, "\n%s\n", headerStyle.Render(i18n.T("Parent")))
+		fmt.Fprintln(w, "  "+relatedTaskLine(*wc.Parent))
+	}
+	if len(wc.RecentLog) > 0 {
+		fmt.Fprintf(w, "\n%s\n", headerStyle.Render(i18n.T("Recent activity")))
+		for _, e := range wc.RecentLog {
+			line := fmt.Sprintf("  %s %s %s %s", formatTime(e.Timestamp, "2006-01-02 15:04"), e.Action, FormatID(e.TaskID), e.Detail)
+			if e.Actor != "" {
+				line += dimStyle.Render(" by " + e.Actor)
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// relatedTaskLine is one line about a related task: its ID, status, and
+// title, or that it no longer exists.
+func relatedTaskLine(r board.RelatedTask) string {
+	if r.Missing {
+		return FormatID(r.ID) + dimStyle.Render(" (missing)")
+	}
+	line := fmt.Sprintf("%s [%s] %s", FormatID(r.ID), styledValue(r.Status, statusStyles), r.Title)
+	if r.ClaimedBy != "" {
+		line += " " + claimStyle.Render("@"+r.ClaimedBy)
+	}
+	return line
+}
+
+// SubscriptionsTable renders task subscriptions as a table.
+func SubscriptionsTable(w io.Writer, subs []config.Subscription) {
+	if len(subs) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("No subscriptions found."))
+		return
+	}
+
+	fmt.Fprintln(w, headerStyle.Render(fmt.Sprintf("%6s  %s", i18n.T("TASK"), i18n.T("EXEC"))))
+	for _, s := range subs {
+		fmt.Fprintf(w, "%6s  %s\n", columnID(s.Task), s.Exec)
+	}
+}
+
+// LogSummaryTable renders an activity summary as a short narrative followed
+// by the tasks behind each count.
+func LogSummaryTable(w io.Writer, s board.LogSummary) {
+	title := fmt.Sprintf("Board activity %s to %s", formatTime(s.From, "2006-01-02"), formatTime(s.To, "2006-01-02"))
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(title))
+	fmt.Fprintln(w, strings.Repeat("─", len(title)))
+	fmt.Fprintln(w, logSummaryNarrative(s))
+
+	sections := []struct {
+		name  string
+		tasks []board.LogSummaryTask
+	}{
+		{"Completed", s.Completed},
+		{"Created", s.Created},
+		{"Blocked", s.Blocked},
+		{"Deleted", s.Deleted},
+	}
+	for _, sec := range sections {
+		if len(sec.tasks) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s\n", headerStyle.Render(fmt.Sprintf("%s (%d)", sec.name, len(sec.tasks))))
+		for _, t := range sec.tasks {
+			line := fmt.Sprintf("  %s %s", FormatID(t.ID), t.Title)
+			if t.Detail != "" {
+				line += dimStyle.Render(" — " + t.Detail)
+			}
+			if t.StillBlocked {
+				line += " (still blocked)"
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// logSummaryNarrative sums up a LogSummary in a sentence or two.
+func logSummaryNarrative(s board.LogSummary) string {
+	if s.Entries == 0 {
+		return "No activity."
+	}
+	text := fmt.Sprintf("Created %d, completed %d, deleted %d. %d moves, %d edits.",
+		len(s.Created), len(s.Completed), len(s.Deleted), s.Moves, s.Edits)
+	still := 0
+	for _, t := range s.Blocked {
+		if t.StillBlocked {
+			still++
+		}
+	}
+	if len(s.Blocked) > 0 {
+		text += fmt.Sprintf(" %d blocked, %d still blocked.", len(s.Blocked), still)
 	}
+	return text
 }
 
 // GroupedTable renders a grouped board view with per-group status breakdowns.
 func GroupedTable(w io.Writer, gs board.GroupedSummary) {
 	if len(gs.Groups) == 0 {
-		fmt.Fprintln(os.Stderr, "No groups found.")
+		fmt.Fprintln(os.Stderr, i18n.T("No groups found."))
 		return
 	}
 
@@ -295,13 +666,63 @@ func GroupedTable(w io.Writer, gs board.GroupedSummary) {
 	}
 }
 
+// MatrixTable renders a two-field group matrix with row and column totals.
+func MatrixTable(w io.Writer, m board.GroupMatrix) {
+	if len(m.Rows) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("No groups found."))
+		return
+	}
+
+	const totalLabel = "total"
+	keyW := lipgloss.Width(m.RowField + " / " + m.ColumnField)
+	for _, r := range m.Rows {
+		keyW = max(keyW, lipgloss.Width(r.Key))
+	}
+	widths := make([]int, len(m.Columns))
+	for i, c := range m.Columns {
+		widths[i] = max(lipgloss.Width(c), len(strconv.Itoa(m.ColumnTotals[i])))
+	}
+	totalW := max(len(totalLabel), len(strconv.Itoa(m.Total)))
+
+	header := padRight(m.RowField+" / "+m.ColumnField, keyW)
+	for i, c := range m.Columns {
+		header += "  " + padRight(c, widths[i])
+	}
+	header += "  " + totalLabel
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(header))
+
+	writeRow := func(key string, counts []int, total int) {
+		line := padRight(key, keyW)
+		for i, n := range counts {
+			cell := "-"
+			if n > 0 {
+				cell = strconv.Itoa(n)
+			}
+			line += "  " + padLeft(cell, widths[i])
+		}
+		line += "  " + padLeft(strconv.Itoa(total), totalW)
+		fmt.Fprintln(w, line)
+	}
+	for _, r := range m.Rows {
+		writeRow(r.Key, r.Counts, r.Total)
+	}
+	writeRow(totalLabel, m.ColumnTotals, m.Total)
+}
+
 // Messagef prints a simple formatted message line.
 func Messagef(w io.Writer, format string, args ...interface{}) {
-	fmt.Fprintf(w, format+"\n", args...)
+	fmt.Fprintln(w, i18n.Sprintf(format, args...))
 }
 
 func printField(w io.Writer, label, value string) {
-	fmt.Fprintf(w, "  %-12s %s\n", label+":", value)
+	fmt.Fprintf(w, "  %-12s %s\n", i18n.T(label)+":", value)
+}
+
+// printOptionalField prints a field only when it has a value.
+func printOptionalField(w io.Writer, label, value string) {
+	if value != "" {
+		printField(w, label, value)
+	}
 }
 
 // FormatDuration renders a duration as human-readable "Xd Yh" or "Xh Ym".
@@ -326,6 +747,15 @@ func padRight(s string, width int) string {
 	return s + strings.Repeat(" ", width-visible)
 }
 
+// padLeft right-aligns s within the given visible width.
+func padLeft(s string, width int) string {
+	visible := lipgloss.Width(s)
+	if visible >= width {
+		return s
+	}
+	return strings.Repeat(" ", width-visible) + s
+}
+
 func stringOrDash(s string) string {
 	if s == "" {
 		return dimStyle.Render("--")
@@ -333,6 +763,18 @@ func stringOrDash(s string) string {
 	return s
 }
 
+// depBlockedMarker flags tasks waiting on incomplete dependencies.
+const depBlockedMarker = "⛓"
+
+// markedTitle returns the task title prefixed with depBlockedMarker when the
+// task is waiting on dependencies.
+func markedTitle(t *task.Task) string {
+	if t.BlockedByDependency {
+		return depBlockedMarker + " " + t.Title
+	}
+	return t.Title
+}
+
 // claimDisplay returns "@agent" if the task is claimed, or "" otherwise.
 func claimDisplay(t *task.Task) string {
 	if t.ClaimedBy != "" {
@@ -343,8 +785,253 @@ func claimDisplay(t *task.Task) string {
 
 // styledValue renders s using a matching style from the map, or returns s unchanged.
 func styledValue(s string, styles map[string]lipgloss.Style) string {
-	if st, ok := styles[s]; ok {
+	return styledAs(s, s, styles)
+}
+
+// styledAs renders s using the style registered under key, or returns s unchanged.
+func styledAs(s, key string, styles map[string]lipgloss.Style) string {
+	if st, ok := styles[key]; ok {
 		return st.Render(s)
 	}
 	return s
 }
+
+// CriticalPathTable renders the critical path as a table in work order.
+func CriticalPathTable(w io.Writer, cp board.CriticalPath) {
+	if len(cp.Tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("No remaining work found."))
+		return
+	}
+
+	header := fmt.Sprintf("%-6s %-16s %-40s %10s", i18n.T("ID"), i18n.T("STATUS"), i18n.T("TITLE"), i18n.T("ESTIMATE"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, t := range cp.Tasks {
+		title := t.Title
+		const maxTitle = 38
+		if len(title) > maxTitle {
+			title = title[:maxTitle-3] + "..."
+		}
+		const cpStatusW = 16
+		fmt.Fprintf(w, "%-6s %s %-40s %10s\n",
+			columnID(t.ID), padRight(styledValue(t.Status, statusStyles), cpStatusW),
+			title, stringOrDash(t.Estimate))
+	}
+
+	fmt.Fprintln(w)
+	printField(w, "Tasks", strconv.Itoa(len(cp.Tasks)))
+	printField(w, "Total", FormatHours(cp.TotalHours))
+	if len(cp.Unestimated) > 0 {
+		printField(w, "Unestimated", FormatIDList(cp.Unestimated))
+	}
+}
+
+// EstimateReportTable renders remaining estimates per group, in hours and
+// working days.
+func EstimateReportTable(w io.Writer, r board.EstimateReport) {
+	if r.Tasks == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("No remaining work found."))
+		return
+	}
+
+	header := fmt.Sprintf("%-30s %6s %10s %8s %12s", strings.ToUpper(r.GroupBy), i18n.T("TASKS"), i18n.T("HOURS"), i18n.T("DAYS"), i18n.T("UNESTIMATED"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, g := range r.Groups {
+		key := g.Key
+		const maxKey = 30
+		if len(key) > maxKey {
+			key = key[:maxKey-3] + "..."
+		}
+		unest := "-"
+		if g.Unestimated > 0 {
+			unest = strconv.Itoa(g.Unestimated)
+		}
+		fmt.Fprintf(w, "%-30s %6d %10s %8s %12s\n",
+			key, g.Tasks, FormatHours(g.Hours), formatDays(g.Hours, r.HoursPerDay), unest)
+	}
+
+	fmt.Fprintln(w)
+	printField(w, "Tasks", strconv.Itoa(r.Tasks))
+	printField(w, "Total", FormatHours(r.TotalHours)+" ("+formatDays(r.TotalHours, r.HoursPerDay)+" at "+
+		FormatHours(r.HoursPerDay)+"/day)")
+	if len(r.Unestimated) > 0 {
+		printField(w, "Unestimated", FormatIDList(r.Unestimated))
+	}
+}
+
+// PlanTable renders a capacity plan: per-person load, then the tasks that
+// fit and the tasks that slip.
+func PlanTable(w io.Writer, p board.Plan) {
+	printField(w, "Window", p.From.String()+" to "+p.Until.String()+" ("+strconv.Itoa(p.WorkingDays)+" working days)")
+	fmt.Fprintln(w)
+
+	header := fmt.Sprintf("%-16s %10s %10s %10s  %s", i18n.T("PERSON"), i18n.T("CAPACITY"), i18n.T("PLANNED"), i18n.T("ASSIGNED"), "")
+	fmt.Fprintln(w, headerStyle.Render(strings.TrimRight(header, " ")))
+	for _, person := range p.People {
+		note := ""
+		if person.Overloaded {
+			note = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render("overloaded")
+		}
+		fmt.Fprintf(w, "%-16s %10s %10s %10s  %s\n", person.Name, FormatHours(person.Capacity),
+			FormatHours(person.Planned), FormatHours(person.Assigned), note)
+	}
+
+	writePlanItems(w, "Fits", p.Fits)
+	writePlanItems(w, "Slips", p.Slips)
+	if len(p.Unestimated) > 0 {
+		fmt.Fprintln(w)
+		printField(w, "Unestimated", FormatIDList(p.Unestimated))
+	}
+}
+
+func writePlanItems(w io.Writer, label string, items []board.PlanItem) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, headerStyle.Render(label+" ("+strconv.Itoa(len(items))+")"))
+	for _, it := range items {
+		who := stringOrDash(it.Assignee)
+		if it.Suggested {
+			who += "?"
+		}
+		line := fmt.Sprintf("  %-6s %-10s %-16s %8s  %s", FormatID(it.ID), it.Priority, who, FormatHours(it.Hours), it.Title)
+		if it.Reason != "" {
+			line += dimStyle.Render("  (" + it.Reason + ")")
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+// formatDays renders hours as working days, rounded to one decimal.
+func formatDays(hours, hoursPerDay float64) string {
+	return strconv.FormatFloat(math.Round(hours/hoursPerDay*10)/10, 'f', -1, 64) + "d" //nolint:mnd // one decimal
+}
+
+// FormatHours renders an hour count as "12h" or "1.5h".
+func FormatHours(h float64) string {
+	return strconv.FormatFloat(h, 'f', -1, 64) + "h"
+}
+
+// FormatIDList renders task IDs as a comma-separated list.
+func FormatIDList(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = FormatID(id)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// TreeTable renders a task hierarchy with box-drawing connectors.
+func TreeTable(w io.Writer, nodes []*board.TreeNode) {
+	if len(nodes) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("No tasks found."))
+		return
+	}
+	for _, n := range nodes {
+		fmt.Fprintln(w, treeNodeLine(n))
+		writeTreeChildren(w, n.Children, "")
+	}
+}
+
+func writeTreeChildren(w io.Writer, children []*board.TreeNode, prefix string) {
+	for i, c := range children {
+		connector, indent := "├── ", "│   "
+		if i == len(children)-1 {
+			connector, indent = "└── ", "    "
+		}
+		fmt.Fprintln(w, dimStyle.Render(prefix+connector)+treeNodeLine(c))
+		writeTreeChildren(w, c.Children, prefix+indent)
+	}
+}
+
+func treeNodeLine(n *board.TreeNode) string {
+	return styledAs(n.StatusGlyph, n.Status, statusStyles) + " " +
+		styledAs(n.PriorityGlyph, n.Priority, priorityStyles) + " " +
+		FormatID(n.ID) + " " + n.Title + " " +
+		dimStyle.Render("("+n.Status+", "+n.Priority+")")
+}
+
+// SimulationTable renders a WIP limit simulation as actual -> predicted
+// figures per column and for the board.
+func SimulationTable(w io.Writer, s board.Simulation) {
+	printField(w, "Period", formatTime(s.From, "2006-01-02")+" to "+formatTime(s.To, "2006-01-02")+
+		" ("+strconv.Itoa(s.Completed)+" completed, "+s.Strategy+" queue)")
+	fmt.Fprintln(w)
+
+	header := fmt.Sprintf("%-16s %-12s %-14s %-20s %s", i18n.T("STATUS"), i18n.T("LIMIT"), i18n.T("AVG WIP"),
+		i18n.T("TIME IN COLUMN"), i18n.T("QUEUE WAIT"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, c := range s.Columns {
+		const statusW = 16
+		fmt.Fprintf(w, "%s %-12s %-14s %-20s %s\n",
+			padRight(styledValue(c.Status, statusStyles), statusW),
+			simLimit(c.CurrentLimit)+" -> "+simLimit(c.Limit),
+			strconv.FormatFloat(c.ActualAvgWIP, 'f', 1, 64)+" -> "+strconv.FormatFloat(c.PredictedAvgWIP, 'f', 1, 64),
+			formatHours(c.ActualAvgHours)+" -> "+formatHours(c.PredictedAvgHours),
+			formatHours(c.PredictedAvgWaitHours))
+	}
+
+	fmt.Fprintln(w)
+	printField(w, "Cycle time", formatOptionalHours(s.Actual.AvgCycleTimeHours)+" -> "+
+		formatOptionalHours(s.Predicted.AvgCycleTimeHours))
+	printField(w, "Throughput", strconv.FormatFloat(s.Actual.ThroughputPerWeek, 'f', 1, 64)+" -> "+
+		strconv.FormatFloat(s.Predicted.ThroughputPerWeek, 'f', 1, 64)+" tasks/week")
+}
+
+func simLimit(n int) string {
+	if n == 0 {
+		return "none"
+	}
+	return strconv.Itoa(n)
+}
+
+func formatHours(h float64) string {
+	return FormatDuration(time.Duration(h * float64(time.Hour)))
+}
+
+// DiffTable renders the changes to each column since a point in time.
+func DiffTable(w io.Writer, d board.Diff) {
+	title := fmt.Sprintf("Board changes since %s", formatTime(d.Since, "2006-01-02 15:04"))
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(title))
+	fmt.Fprintln(w, strings.Repeat("─", len(title)))
+	if d.Changed == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	const statusW = 16
+	header := fmt.Sprintf("%-16s %6s %6s", i18n.T("STATUS"), i18n.T("BEFORE"), i18n.T("AFTER"))
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, c := range d.Columns {
+		fmt.Fprintf(w, "%s %6d %6d\n", padRight(styledValue(c.Status, statusStyles), statusW), c.Before, c.After)
+		for _, t := range c.Added {
+			fmt.Fprintf(w, "  + %s %s%s\n", FormatID(t.ID), t.Title, dimStyle.Render(" ("+diffTaskOrigin(t)+")"))
+		}
+		for _, t := range c.Removed {
+			fmt.Fprintf(w, "  - %s %s%s\n", FormatID(t.ID), t.Title, dimStyle.Render(" ("+diffTaskDestination(t)+")"))
+		}
+	}
+}
+
+// diffTaskOrigin says where a task added to a column came from.
+func diffTaskOrigin(t board.DiffTask) string {
+	switch {
+	case t.Created && t.Deleted:
+		return "created, deleted"
+	case t.Created || t.From == "":
+		return "created"
+	case t.Deleted:
+		return "deleted from " + t.From
+	default:
+		return "from " + t.From
+	}
+}
+
+// diffTaskDestination says where a task removed from a column went.
+func diffTaskDestination(t board.DiffTask) string {
+	switch {
+	case t.Deleted:
+		return "deleted"
+	case t.To == "":
+		return "gone"
+	default:
+		return "to " + t.To
+	}
+}
@@ -9,10 +9,19 @@ import (
 	"github.com/muesli/termenv"
 
 	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
+// setLocationForTest sets the display time zone for the duration of a test.
+func setLocationForTest(t *testing.T, loc *time.Location) {
+	t.Helper()
+	prev := displayLocation
+	SetLocation(loc)
+	t.Cleanup(func() { SetLocation(prev) })
+}
+
 // disableColorForTest calls DisableColor and registers a cleanup that
 // restores all package-level styles to their default values.
 func disableColorForTest(t *testing.T) {
@@ -37,6 +46,7 @@ func disableColorForTest(t *testing.T) {
 		}
 		tagStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("110"))
 		claimStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("44")).Bold(true)
+		colorDisabled = false
 	})
 }
 
@@ -447,6 +457,34 @@ func TestMetricsTable(t *testing.T) {
 	}
 }
 
+func TestTagTrendsTable(t *testing.T) {
+	disableColorForTest(t)
+
+	r := board.TagTrends{
+		Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tags: []board.TagTrend{{
+			Tag: "bug", Created: 5, Completed: 4, ThroughputPerWeek: 1.3,
+			Weekly: []board.TrendBucket{{Completed: 0}, {Completed: 1}, {Completed: 3}},
+		}},
+	}
+	var buf strings.Builder
+	TagTrendsTable(&buf, r)
+	out := buf.String()
+	for _, want := range []string{"Tag trends since 2026-01-01", "bug", "1.3", "▁▃█"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("TagTrendsTable missing %q in output:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	if err := TagTrendsCSV(&buf, r); err != nil {
+		t.Fatalf("TagTrendsCSV: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(buf.String()), "\n"); len(lines) != 4 || lines[3] != "bug,0001-01-01,0,3" {
+		t.Errorf("TagTrendsCSV = %q, want a header and a row per week", buf.String())
+	}
+}
+
 func TestMetricsTableNoAging(t *testing.T) {
 	disableColorForTest(t)
 
@@ -583,6 +621,32 @@ func TestGroupedTableSingleGroup(t *testing.T) {
 	}
 }
 
+func TestMatrixTable(t *testing.T) {
+	disableColorForTest(t)
+
+	m := board.GroupMatrix{
+		RowField:     "assignee",
+		ColumnField:  "status",
+		Columns:      []string{"todo", "done"},
+		Rows:         []board.MatrixRow{{Key: "alice", Counts: []int{2, 0}, Total: 2}},
+		ColumnTotals: []int{2, 0},
+		Total:        2,
+	}
+
+	var buf strings.Builder
+	MatrixTable(&buf, m)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want header, row, and total:\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], "assignee / status  todo  done  total") {
+		t.Errorf("header = %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "alice 2 - 2" {
+		t.Errorf("row = %q, want alice 2 - 2", lines[1])
+	}
+}
+
 func TestGroupedTableEmpty(t *testing.T) {
 	var buf strings.Builder
 	GroupedTable(&buf, board.GroupedSummary{})
@@ -591,3 +655,69 @@ func TestGroupedTableEmpty(t *testing.T) {
 		t.Errorf("GroupedTable empty output to writer = %q, want empty", buf.String())
 	}
 }
+
+func TestTaskDetail_DisplayLocation(t *testing.T) {
+	disableColorForTest(t)
+	setLocationForTest(t, time.FixedZone("UTC+9", 9*3600))
+
+	created := time.Date(2026, 2, 8, 14, 0, 0, 0, time.UTC)
+	tk := &task.Task{ID: 1, Title: "Zoned", Status: "todo", Priority: "medium", Created: created, Updated: created}
+
+	var buf strings.Builder
+	TaskDetail(&buf, tk)
+	if !strings.Contains(buf.String(), "2026-02-08 23:00") {
+		t.Errorf("TaskDetail should render timestamps in the display zone:\n%s", buf.String())
+	}
+}
+
+func TestTaskTable_TagStyles(t *testing.T) {
+	disableColorForTest(t)
+	SetTagStyles(map[string]config.TagStyle{"bug": {Color: "196", Icon: "🐛"}})
+	t.Cleanup(func() { SetTagStyles(nil) })
+
+	var buf strings.Builder
+	TaskTable(&buf, []*task.Task{
+		{ID: 1, Title: "Crash on start", Status: "todo", Priority: "high", Tags: []string{"bug", "ui"}},
+	})
+
+	out := buf.String()
+	if !strings.Contains(out, "🐛 bug,ui") {
+		t.Errorf("table should prefix styled tag with its icon, got:\n%s", out)
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Errorf("tag color should be skipped when color is disabled, got %q", out)
+	}
+}
+
+func TestRenderTags_Color(t *testing.T) {
+	SetTagStyles(map[string]config.TagStyle{"bug": {Color: "196"}})
+	t.Cleanup(func() { SetTagStyles(nil) })
+
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	got := renderTags([]string{"bug"}, ",")
+	if !strings.Contains(got, "38;5;196") {
+		t.Errorf("renderTags = %q, want foreground color 196", got)
+	}
+}
+
+func TestFormatID_Prefix(t *testing.T) {
+	if got := FormatID(12); got != "#12" {
+		t.Errorf("FormatID(12) = %q, want #12", got)
+	}
+
+	disableColorForTest(t)
+	SetIDPrefix("API-")
+	t.Cleanup(func() { SetIDPrefix("") })
+
+	if got := FormatID(12); got != "API-12" {
+		t.Errorf("FormatID(12) = %q, want API-12", got)
+	}
+	var buf strings.Builder
+	TaskTable(&buf, []*task.Task{{ID: 12, Title: "Prefixed", Status: "todo", Priority: "high"}})
+	if !strings.Contains(buf.String(), "API-12") {
+		t.Errorf("table should show the prefixed ID, got:\n%s", buf.String())
+	}
+}
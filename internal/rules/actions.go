@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Apply performs action on t in memory and reports whether it changed the
+// task. counts holds the board's tasks per status, for WIP limits, and is
+// kept current when a task moves. notify changes nothing; the caller
+// records it in the activity log.
+func Apply(cfg *config.Config, t *task.Task, action string, counts map[string]int) (bool, error) {
+	verb, arg := SplitAction(action)
+	switch verb {
+	case ActionTag:
+		if slices.Contains(t.Tags, arg) {
+			return false, nil
+		}
+		t.Tags = append(t.Tags, arg)
+	case ActionUntag:
+		i := slices.Index(t.Tags, arg)
+		if i < 0 {
+			return false, nil
+		}
+		t.Tags = slices.Delete(t.Tags, i, i+1)
+	case ActionMoveTo:
+		return moveTo(cfg, t, arg, counts)
+	case ActionPriority:
+		if t.Priority == arg {
+			return false, nil
+		}
+		t.Priority = arg
+	case ActionEscalatePriority:
+		i := cfg.PriorityIndex(t.Priority)
+		if i < 0 || i == len(cfg.Priorities)-1 {
+			return false, nil
+		}
+		t.Priority = cfg.Priorities[i+1]
+	case ActionAssign:
+		if t.Assignee == arg {
+			return false, nil
+		}
+		t.Assignee = arg
+		task.RecordAssigned(t, time.Now())
+	case ActionNotify:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown action %q", action)
+	}
+	return true, nil
+}
+
+func moveTo(cfg *config.Config, t *task.Task, status string, counts map[string]int) (bool, error) {
+	if t.Status == status {
+		return false, nil
+	}
+	if err := board.CheckWIPLimit(cfg, counts, status, t.Status); err != nil {
+		return false, err
+	}
+	old := t.Status
+	t.Status = status
+	task.UpdateTimestamps(t, old, status, cfg)
+	task.ApplyChecklist(t, cfg)
+	counts[old]--
+	counts[status]++
+	return true, nil
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"slices"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// Firing is a rule whose trigger has held long enough on a task.
+type Firing struct {
+	Rule string `json:"rule"`
+	ID   int    `json:"id"`
+	// Title is the task title, for display.
+	Title string `json:"title"`
+	// Since is when the trigger state began. A rule fires once for each
+	// distinct Since on a task.
+	Since   time.Time `json:"since"`
+	Actions []string  `json:"actions"`
+}
+
+// Evaluate returns the firings due now: every rule whose trigger has held
+// for its For duration on a task that meets its conditions, and that has
+// not fired for this occurrence according to state. log is the activity
+// log, used to date status changes and blocks.
+func Evaluate(cfg *config.Config, f *File, tasks []*task.Task, log []board.LogEntry, state State, now time.Time) []Firing {
+	events := indexLog(log)
+	var firings []Firing
+	for _, r := range f.Rules {
+		wait, _ := r.When.Duration()
+		for _, t := range tasks {
+			since, ok := triggerSince(cfg, r.When, t, events[t.ID])
+			if !ok || now.Sub(since) < wait || !r.If.matches(t) {
+				continue
+			}
+			if state.Fired(r.Name, t.ID, since) {
+				continue
+			}
+			firings = append(firings, Firing{
+				Rule: r.Name, ID: t.ID, Title: t.Title, Since: since, Actions: slices.Clone(r.Then),
+			})
+		}
+	}
+	return firings
+}
+
+// taskEvents holds the latest status change and block of a task.
+type taskEvents struct {
+	entered map[string]time.Time
+	blocked time.Time
+}
+
+func indexLog(log []board.LogEntry) map[int]*taskEvents {
+	events := make(map[int]*taskEvents)
+	for _, e := range log {
+		ev := events[e.TaskID]
+		if ev == nil {
+			ev = &taskEvents{entered: map[string]time.Time{}}
+			events[e.TaskID] = ev
+		}
+		if _, to, ok := e.StatusChange(); ok && e.Timestamp.After(ev.entered[to]) {
+			ev.entered[to] = e.Timestamp
+		}
+		if e.Action == "block" && e.Timestamp.After(ev.blocked) {
+			ev.blocked = e.Timestamp
+		}
+	}
+	return events
+}
+
+// triggerSince reports whether tr holds for t and, if so, since when.
+// Without a log entry to date a status change or block, the task's last
+// update stands in.
+func triggerSince(cfg *config.Config, tr Trigger, t *task.Task, ev *taskEvents) (time.Time, bool) {
+	switch {
+	case tr.Enters != "":
+		if t.Status != tr.Enters {
+			return time.Time{}, false
+		}
+		return enteredSince(cfg, t, ev), true
+	case tr.Blocked:
+		if !t.Blocked {
+			return time.Time{}, false
+		}
+		if ev != nil && !ev.blocked.IsZero() {
+			return ev.blocked, true
+		}
+		return t.Updated, true
+	case tr.Overdue:
+		if t.Due == nil || cfg.IsTerminalStatus(t.Status) {
+			return time.Time{}, false
+		}
+		// A task becomes overdue at the start of the day after it is due.
+		return t.Due.AddDate(0, 0, 1), true
+	case tr.Idle:
+		if cfg.IsTerminalStatus(t.Status) {
+			return time.Time{}, false
+		}
+		return t.Updated, true
+	}
+	return time.Time{}, false
+}
+
+// enteredSince returns when t entered its current status: from its status
+// history, else the log, else its creation for the default status and its
+// last update otherwise.
+func enteredSince(cfg *config.Config, t *task.Task, ev *taskEvents) time.Time {
+	if at, ok := task.EnteredStatus(t); ok {
+		return at
+	}
+	if ev != nil && !ev.entered[t.Status].IsZero() {
+		return ev.entered[t.Status]
+	}
+	if t.Status == cfg.Defaults.Status {
+		return t.Created
+	}
+	return t.Updated
+}
+
+func (c Condition) matches(t *task.Task) bool {
+	for _, tag := range c.Tags {
+		if !slices.Contains(t.Tags, tag) {
+			return false
+		}
+	}
+	for _, tag := range c.NotTags {
+		if slices.Contains(t.Tags, tag) {
+			return false
+		}
+	}
+	switch {
+	case len(c.Status) > 0 && !slices.Contains(c.Status, t.Status),
+		len(c.Priority) > 0 && !slices.Contains(c.Priority, t.Priority),
+		c.Class != "" && t.Class != c.Class,
+		c.Assignee != "" && t.Assignee != c.Assignee:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,239 @@
+// Package rules evaluates the board's automation rules (rules.yml): each
+// rule names a trigger, optional conditions, and the actions to take on
+// the tasks that match.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// FileName is the rules file in the kanban directory.
+const FileName = "rules.yml"
+
+// ErrInvalid is wrapped by every rules file validation error.
+var ErrInvalid = errors.New("invalid rules")
+
+// Action verbs.
+const (
+	ActionTag              = "tag"
+	ActionUntag            = "untag"
+	ActionMoveTo           = "move_to"
+	ActionPriority         = "priority"
+	ActionEscalatePriority = "escalate_priority"
+	ActionAssign           = "assign"
+	ActionNotify           = "notify"
+)
+
+// actionArgs says whether each action verb takes an argument.
+var actionArgs = map[string]bool{
+	ActionTag:              true,
+	ActionUntag:            true,
+	ActionMoveTo:           true,
+	ActionPriority:         true,
+	ActionEscalatePriority: false,
+	ActionAssign:           true,
+	ActionNotify:           false,
+}
+
+// File is the layout of rules.yml.
+type File struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule fires its actions on every task its trigger holds for, once per
+// occurrence of the trigger.
+type Rule struct {
+	Name string    `yaml:"name" json:"name"`
+	When Trigger   `yaml:"when" json:"when"`
+	If   Condition `yaml:"if,omitempty" json:"if,omitzero"`
+	// Then lists actions as "verb [argument]", e.g. "tag release" or
+	// "escalate_priority".
+	Then []string `yaml:"then" json:"then"`
+}
+
+// Trigger is the task state a rule reacts to. Exactly one of Enters,
+// Blocked, Overdue, and Idle is set.
+type Trigger struct {
+	// Enters fires when a task is in this status.
+	Enters string `yaml:"enters,omitempty" json:"enters,omitempty"`
+	// Blocked fires when a task is marked blocked.
+	Blocked bool `yaml:"blocked,omitempty" json:"blocked,omitempty"`
+	// Overdue fires when an open task is past its due date.
+	Overdue bool `yaml:"overdue,omitempty" json:"overdue,omitempty"`
+	// Idle fires when an open task has not been updated.
+	Idle bool `yaml:"idle,omitempty" json:"idle,omitempty"`
+	// For is how long the state must have held, e.g. "7d", "2w", "36h".
+	// Empty means at once.
+	For string `yaml:"for,omitempty" json:"for,omitempty"`
+}
+
+// Condition narrows the tasks a rule applies to. Empty fields match all.
+type Condition struct {
+	Tags     []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	NotTags  []string `yaml:"not_tags,omitempty" json:"not_tags,omitempty"`
+	Status   []string `yaml:"status,omitempty" json:"status,omitempty"`
+	Priority []string `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Class    string   `yaml:"class,omitempty" json:"class,omitempty"`
+	Assignee string   `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+}
+
+// Path returns the rules file of the board in kanbanDir.
+func Path(kanbanDir string) string {
+	return filepath.Join(kanbanDir, FileName)
+}
+
+// Load reads and validates the rules file at path against the board
+// config.
+func Load(path string, cfg *config.Config) (*File, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // rules file in the kanban directory
+	if err != nil {
+		return nil, err
+	}
+	var f File
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%w: parsing %s: %w", ErrInvalid, FileName, err)
+	}
+	if err := f.Validate(cfg); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// ruleNameRe matches rule names, which key the firing state.
+var ruleNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Validate checks every rule against the board's statuses and priorities.
+func (f *File) Validate(cfg *config.Config) error {
+	seen := make(map[string]bool, len(f.Rules))
+	for _, r := range f.Rules {
+		if !ruleNameRe.MatchString(r.Name) {
+			return fmt.Errorf("%w: rule name %q must be letters, digits, '-' or '_'", ErrInvalid, r.Name)
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("%w: duplicate rule %q", ErrInvalid, r.Name)
+		}
+		seen[r.Name] = true
+		if err := r.validate(cfg); err != nil {
+			return fmt.Errorf("%w: rule %s: %w", ErrInvalid, r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r Rule) validate(cfg *config.Config) error {
+	if err := r.When.validate(cfg); err != nil {
+		return err
+	}
+	for _, s := range r.If.Status {
+		if !slices.Contains(cfg.StatusNames(), s) {
+			return fmt.Errorf("if.status: unknown status %q", s)
+		}
+	}
+	for _, p := range r.If.Priority {
+		if !slices.Contains(cfg.Priorities, p) {
+			return fmt.Errorf("if.priority: unknown priority %q", p)
+		}
+	}
+	if len(r.Then) == 0 {
+		return errors.New("then has no actions")
+	}
+	for _, a := range r.Then {
+		if err := validateAction(cfg, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tr Trigger) validate(cfg *config.Config) error {
+	n := 0
+	for _, set := range []bool{tr.Enters != "", tr.Blocked, tr.Overdue, tr.Idle} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("when must set exactly one of enters, blocked, overdue, idle")
+	}
+	if tr.Enters != "" && !slices.Contains(cfg.StatusNames(), tr.Enters) {
+		return fmt.Errorf("when.enters: unknown status %q", tr.Enters)
+	}
+	if tr.Idle && tr.For == "" {
+		return errors.New("when.idle needs a for duration")
+	}
+	if _, err := tr.Duration(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Duration returns the parsed For duration, zero when empty.
+func (tr Trigger) Duration() (time.Duration, error) {
+	if tr.For == "" {
+		return 0, nil
+	}
+	return ParseDuration(tr.For)
+}
+
+// ParseDuration parses a duration in days ("7d"), weeks ("2w"), or any
+// unit time.ParseDuration accepts ("36h").
+func ParseDuration(s string) (time.Duration, error) {
+	const day = 24 * time.Hour
+	units := map[string]time.Duration{"d": day, "w": 7 * day} //nolint:mnd // days per week
+	var d time.Duration
+	if mult, ok := units[s[max(len(s)-1, 0):]]; ok {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q (use e.g. 7d, 2w, 36h)", s)
+		}
+		d = time.Duration(n) * mult
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, fmt.Errorf("invalid duration %q (use e.g. 7d, 2w, 36h)", s)
+		}
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid duration %q: must not be negative", s)
+	}
+	return d, nil
+}
+
+// SplitAction splits an action into its verb and argument.
+func SplitAction(a string) (verb, arg string) {
+	fields := strings.Fields(a)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	return fields[0], strings.Join(fields[1:], " ")
+}
+
+func validateAction(cfg *config.Config, a string) error {
+	verb, arg := SplitAction(a)
+	needsArg, ok := actionArgs[verb]
+	switch {
+	case !ok:
+		return fmt.Errorf("unknown action %q", a)
+	case needsArg && arg == "":
+		return fmt.Errorf("action %q needs an argument", verb)
+	case !needsArg && arg != "":
+		return fmt.Errorf("action %q takes no argument", verb)
+	case verb == ActionMoveTo && !slices.Contains(cfg.StatusNames(), arg):
+		return fmt.Errorf("action %q: unknown status %q", a, arg)
+	case verb == ActionPriority && !slices.Contains(cfg.Priorities, arg):
+		return fmt.Errorf("action %q: unknown priority %q", a, arg)
+	}
+	return nil
+}
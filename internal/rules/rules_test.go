@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestValidate(t *testing.T) {
+	cfg := config.NewDefault("test")
+	ok := Rule{Name: "r", When: Trigger{Blocked: true, For: "7d"}, Then: []string{"escalate_priority"}}
+	tests := []struct {
+		name    string
+		modify  func(r *Rule)
+		wantErr bool
+	}{
+		{"valid", func(*Rule) {}, false},
+		{"bad name", func(r *Rule) { r.Name = "a b" }, true},
+		{"no trigger", func(r *Rule) { r.When = Trigger{} }, true},
+		{"two triggers", func(r *Rule) { r.When.Overdue = true }, true},
+		{"unknown enters", func(r *Rule) { r.When = Trigger{Enters: "nope"} }, true},
+		{"idle without for", func(r *Rule) { r.When = Trigger{Idle: true} }, true},
+		{"bad for", func(r *Rule) { r.When.For = "soon" }, true},
+		{"unknown if status", func(r *Rule) { r.If.Status = []string{"nope"} }, true},
+		{"unknown if priority", func(r *Rule) { r.If.Priority = []string{"urgent"} }, true},
+		{"no actions", func(r *Rule) { r.Then = nil }, true},
+		{"unknown action", func(r *Rule) { r.Then = []string{"archive"} }, true},
+		{"missing argument", func(r *Rule) { r.Then = []string{"tag"} }, true},
+		{"extra argument", func(r *Rule) { r.Then = []string{"notify ops"} }, true},
+		{"unknown move status", func(r *Rule) { r.Then = []string{"move_to nope"} }, true},
+		{"unknown priority", func(r *Rule) { r.Then = []string{"priority urgent"} }, true},
+		{"all actions", func(r *Rule) {
+			r.Then = []string{"tag x", "untag y", "move_to done", "priority high", "assign bob", "notify"}
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ok
+			tt.modify(&r)
+			err := (&File{Rules: []Rule{r}}).Validate(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalid) {
+				t.Errorf("error %v does not wrap ErrInvalid", err)
+			}
+		})
+	}
+
+	dup := &File{Rules: []Rule{ok, ok}}
+	if err := dup.Validate(cfg); err == nil {
+		t.Error("duplicate rule names should be rejected")
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		err  bool
+	}{
+		{"7d", 7 * 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"36h", 36 * time.Hour, false},
+		{"0d", 0, false},
+		{"xd", 0, true},
+		{"-1d", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, %v; want %v, err %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	cfg := config.NewDefault("test")
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	days := func(n int) time.Time { return now.Add(-time.Duration(n) * 24 * time.Hour) }
+	due := date.New(2026, 3, 18)
+
+	blocked := &task.Task{ID: 1, Title: "Blocked", Status: "todo", Blocked: true, Priority: "medium", Updated: days(1)}
+	released := &task.Task{ID: 2, Title: "Released", Status: "done", Tags: []string{"release"}, Updated: days(1)}
+	late := &task.Task{ID: 3, Title: "Late", Status: "todo", Due: &due, Updated: days(1)}
+	plain := &task.Task{ID: 4, Title: "Plain", Status: "done", Updated: days(1)}
+	tasks := []*task.Task{blocked, released, late, plain}
+	log := []board.LogEntry{
+		{Timestamp: days(8), Action: "block", TaskID: 1},
+		{Timestamp: days(5), Action: "move", TaskID: 2, Detail: "todo -> done"},
+		{Timestamp: days(4), Action: "move", TaskID: 2, Detail: "done -> review"},
+		{Timestamp: days(3), Action: "auto-move", TaskID: 2, Detail: "review -> done (dependencies done)"},
+	}
+	f := &File{Rules: []Rule{
+		{Name: "escalate", When: Trigger{Blocked: true, For: "7d"}, Then: []string{"escalate_priority"}},
+		{Name: "milestone", When: Trigger{Enters: "done"}, If: Condition{Tags: []string{"release"}}, Then: []string{"tag m1"}},
+		{Name: "late", When: Trigger{Overdue: true}, Then: []string{"notify"}},
+	}}
+
+	firings := Evaluate(cfg, f, tasks, log, State{}, now)
+	var got []string
+	for _, fr := range firings {
+		got = append(got, fr.Rule+"/"+strconv.Itoa(fr.ID))
+	}
+	want := []string{"escalate/1", "milestone/2", "late/3"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("firings = %v, want %v", got, want)
+	}
+	if !firings[0].Since.Equal(days(8)) {
+		t.Errorf("blocked since = %v, want the block log entry %v", firings[0].Since, days(8))
+	}
+	if !firings[1].Since.Equal(days(3)) {
+		t.Errorf("enters since = %v, want the last move to done %v", firings[1].Since, days(3))
+	}
+
+	// Once recorded, an occurrence does not fire again.
+	state := State{}
+	for _, fr := range firings {
+		state.Record(fr)
+	}
+	if again := Evaluate(cfg, f, tasks, log, state, now); len(again) != 0 {
+		t.Errorf("recorded firings fired again: %v", again)
+	}
+
+	// A new block is a new occurrence, but must hold for 7d first.
+	log = append(log, board.LogEntry{Timestamp: days(2), Action: "block", TaskID: 1})
+	if again := Evaluate(cfg, f, tasks, log, state, now); len(again) != 0 {
+		t.Errorf("fresh block fired before 7d: %v", again)
+	}
+	if again := Evaluate(cfg, f, tasks, log, state, now.Add(6*24*time.Hour)); len(again) != 1 {
+		t.Errorf("re-block after 7d fired %d times, want 1", len(again))
+	}
+}
+
+func TestApply(t *testing.T) {
+	cfg := config.NewDefault("test")
+	tk := &task.Task{ID: 1, Status: "todo", Priority: "high", Tags: []string{"a"}}
+	counts := map[string]int{"todo": 1}
+
+	steps := []struct {
+		action  string
+		changed bool
+	}{
+		{"tag b", true},
+		{"tag b", false},
+		{"untag a", true},
+		{"escalate_priority", true},
+		{"escalate_priority", false},
+		{"assign bob", true},
+		{"notify", false},
+		{"move_to in-progress", true},
+	}
+	for _, s := range steps {
+		changed, err := Apply(cfg, tk, s.action, counts)
+		if err != nil {
+			t.Fatalf("Apply(%q): %v", s.action, err)
+		}
+		if changed != s.changed {
+			t.Errorf("Apply(%q) changed = %v, want %v", s.action, changed, s.changed)
+		}
+	}
+	if !slices.Equal(tk.Tags, []string{"b"}) || tk.Priority != "critical" || tk.Assignee != "bob" || tk.Status != "in-progress" {
+		t.Errorf("task = %+v", tk)
+	}
+	if counts["todo"] != 0 || counts["in-progress"] != 1 {
+		t.Errorf("counts = %v", counts)
+	}
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// stateFileName is the file in the kanban directory recording, for each
+// rule and task, the trigger occurrence the rule last fired for.
+const stateFileName = ".rules-state"
+
+const stateFileMode = 0o600
+
+// State maps "rule/taskID" to the Since of the occurrence last fired for.
+type State map[string]time.Time
+
+func stateKey(rule string, id int) string {
+	return rule + "/" + strconv.Itoa(id)
+}
+
+// LoadState reads the board's rule state. A board whose rules have never
+// fired has an empty state.
+func LoadState(kanbanDir string) (State, error) {
+	s := State{}
+	data, err := os.ReadFile(filepath.Join(kanbanDir, stateFileName)) //nolint:gosec // fixed name in the kanban directory
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading rules state: %w", err)
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("reading rules state: %w", err)
+	}
+	return s, nil
+}
+
+// Fired reports whether rule has fired on task id for the occurrence that
+// began at since.
+func (s State) Fired(rule string, id int, since time.Time) bool {
+	last, ok := s[stateKey(rule, id)]
+	return ok && last.Equal(since)
+}
+
+// Record marks f as fired.
+func (s State) Record(f Firing) {
+	s[stateKey(f.Rule, f.ID)] = f.Since
+}
+
+// Save writes the state to the board's state file.
+func (s State) Save(kanbanDir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("writing rules state: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(kanbanDir, stateFileName), append(data, '\n'), stateFileMode); err != nil {
+		return fmt.Errorf("writing rules state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// binaryMode is the mode of an installed binary.
+const binaryMode = 0o755
+
+// ErrChecksumMismatch is returned by VerifyChecksum when an archive does
+// not match its published checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ArchiveName returns the name of the release archive for a platform, as
+// the release build names it.
+func ArchiveName(version, goos, goarch string) string {
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("kanban-md_%s_%s_%s%s", version, goos, goarch, ext)
+}
+
+// BinaryName returns the executable's file name inside a release archive.
+func BinaryName(goos string) string {
+	if goos == "windows" {
+		return "kanban-md.exe"
+	}
+	return "kanban-md"
+}
+
+// VerifyChecksum checks data against the SHA-256 listed for name in a
+// checksums file ("<hex>  <name>" per line).
+func VerifyChecksum(data, checksums []byte, name string) error {
+	sc := bufio.NewScanner(bytes.NewReader(checksums))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+		sum := sha256.Sum256(data)
+		if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+			return fmt.Errorf("%w for %s", ErrChecksumMismatch, name)
+		}
+		return nil
+	}
+	return fmt.Errorf("no checksum listed for %s", name)
+}
+
+// ExtractBinary returns the file named binary from a .tar.gz or, when
+// zipped, a .zip release archive.
+func ExtractBinary(archive []byte, zipped bool, binary string) ([]byte, error) {
+	if zipped {
+		return extractZip(archive, binary)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("archive has no %s", binary)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == binary {
+			return io.ReadAll(io.LimitReader(tr, maxDownload))
+		}
+	}
+}
+
+func extractZip(archive []byte, binary string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || path.Base(f.Name) != binary {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		defer rc.Close() //nolint:errcheck // read-only archive entry
+		return io.ReadAll(io.LimitReader(rc, maxDownload))
+	}
+	return nil, fmt.Errorf("archive has no %s", binary)
+}
+
+// Replace installs data as the executable at exe. The new binary is
+// written beside it and renamed into place, so exe is never left half
+// written. Where a running executable cannot be overwritten (Windows), the
+// old one is first moved aside to exe+".old".
+func Replace(exe string, data []byte, goos string) error {
+	tmp := exe + ".new"
+	if err := os.WriteFile(tmp, data, binaryMode); err != nil {
+		return fmt.Errorf("writing new binary: %w", err)
+	}
+	if err := os.Chmod(tmp, binaryMode); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("writing new binary: %w", err)
+	}
+	if goos == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			_ = os.Remove(tmp)
+			return fmt.Errorf("moving old binary aside: %w", err)
+		}
+	}
+	if err := os.Rename(tmp, exe); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("installing new binary in %s: %w", filepath.Dir(exe), err)
+	}
+	return nil
+}
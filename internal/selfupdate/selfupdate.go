@@ -0,0 +1,148 @@
+// Package selfupdate finds, verifies, and installs kanban-md releases
+// published on GitHub.
+package selfupdate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultAPI is the GitHub API the releases are read from.
+const DefaultAPI = "https://api.github.com"
+
+// Repo is the repository kanban-md is released from.
+const Repo = "antopolskiy/kanban-md"
+
+// Release channels.
+const (
+	ChannelStable     = "stable"
+	ChannelPrerelease = "prerelease"
+)
+
+// Channels lists the valid release channels.
+var Channels = []string{ChannelStable, ChannelPrerelease}
+
+// maxDownload caps the size of any file fetched while updating.
+const maxDownload = 256 << 20
+
+// releasesPerPage is how many recent releases are considered.
+const releasesPerPage = 30
+
+// ErrNoRelease is returned by Latest when the channel has no release.
+var ErrNoRelease = errors.New("no release found")
+
+// Asset is a file attached to a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Release is a published GitHub release.
+type Release struct {
+	Tag        string  `json:"tag_name"`
+	Prerelease bool    `json:"prerelease"`
+	Draft      bool    `json:"draft"`
+	Assets     []Asset `json:"assets"`
+}
+
+// Version returns the release version without the leading "v".
+func (r *Release) Version() string {
+	return strings.TrimPrefix(r.Tag, "v")
+}
+
+// Asset returns the asset with the given name.
+func (r *Release) Asset(name string) (Asset, bool) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Asset{}, false
+}
+
+// ChecksumsAsset returns the release's SHA-256 checksums file.
+func (r *Release) ChecksumsAsset() (Asset, bool) {
+	for _, a := range r.Assets {
+		if strings.HasSuffix(a.Name, "checksums.txt") {
+			return a, true
+		}
+	}
+	return Asset{}, false
+}
+
+// Client reads releases from a GitHub API.
+type Client struct {
+	HTTP *http.Client
+	// API is the GitHub API base URL, DefaultAPI in production.
+	API  string
+	Repo string
+	// Token, when set, authenticates API requests to raise rate limits.
+	Token string
+}
+
+// Latest returns the newest release on the channel: stable releases only,
+// or prereleases as well. Drafts are never considered.
+func (c *Client) Latest(ctx context.Context, channel string) (*Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases?per_page=%d", strings.TrimSuffix(c.API, "/"), c.Repo, releasesPerPage)
+	body, err := c.get(ctx, url, true)
+	if err != nil {
+		return nil, err
+	}
+	var releases []Release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("parsing releases: %w", err)
+	}
+	var latest *Release
+	for i := range releases {
+		r := &releases[i]
+		if r.Draft || (r.Prerelease && channel != ChannelPrerelease) {
+			continue
+		}
+		if latest == nil || Compare(r.Version(), latest.Version()) > 0 {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%w on the %s channel", ErrNoRelease, channel)
+	}
+	return latest, nil
+}
+
+// Download fetches a release asset.
+func (c *Client) Download(ctx context.Context, a Asset) ([]byte, error) {
+	return c.get(ctx, a.URL, false)
+}
+
+func (c *Client) get(ctx context.Context, url string, api bool) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if api {
+		req.Header.Set("Accept", "application/vnd.github+json")
+		if c.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Token)
+		}
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only response body
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDownload+1))
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", url, err)
+	}
+	if len(data) > maxDownload {
+		return nil, fmt.Errorf("fetching %s: larger than %d bytes", url, maxDownload)
+	}
+	return data, nil
+}
@@ -0,0 +1,186 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "v1.2.3", 0},
+		{"1.10.0", "1.9.9", 1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0-rc.2", "1.0.0-rc.10", -1},
+		{"1.0.0-alpha", "1.0.0-1", 1},
+		{"1.0.0-rc", "1.0.0-rc.1", -1},
+		{"dev", "0.0.1", -1},
+		{"0.0.1", "dev", 1},
+		{"1.2", "1.2.0", -1},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if IsRelease("dev") || !IsRelease("v0.30.1") {
+		t.Error("IsRelease misclassifies dev or v0.30.1")
+	}
+}
+
+func TestLatest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/o/r/releases" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		fmt.Fprint(w, `[
+			{"tag_name": "v2.0.0", "draft": true},
+			{"tag_name": "v1.3.0-rc.1", "prerelease": true},
+			{"tag_name": "v1.2.0"},
+			{"tag_name": "v1.10.0-beta", "prerelease": true},
+			{"tag_name": "v1.1.0"}
+		]`)
+	}))
+	defer srv.Close()
+	c := &Client{HTTP: srv.Client(), API: srv.URL, Repo: "o/r", Token: "tok"}
+
+	stable, err := c.Latest(t.Context(), ChannelStable)
+	if err != nil || stable.Tag != "v1.2.0" {
+		t.Errorf("Latest(stable) = %v, %v; want v1.2.0", stable, err)
+	}
+	pre, err := c.Latest(t.Context(), ChannelPrerelease)
+	if err != nil || pre.Tag != "v1.10.0-beta" {
+		t.Errorf("Latest(prerelease) = %v, %v; want v1.10.0-beta", pre, err)
+	}
+
+	c.Repo = "o/missing"
+	if _, err := c.Latest(t.Context(), ChannelStable); err == nil {
+		t.Error("Latest on a 404 succeeded")
+	}
+}
+
+func TestLatestNoRelease(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"tag_name": "v1.0.0-rc.1", "prerelease": true}]`)
+	}))
+	defer srv.Close()
+	c := &Client{HTTP: srv.Client(), API: srv.URL, Repo: "o/r"}
+	if _, err := c.Latest(t.Context(), ChannelStable); !errors.Is(err, ErrNoRelease) {
+		t.Errorf("Latest = %v, want ErrNoRelease", err)
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("archive")
+	sum := sha256.Sum256(data)
+	sums := []byte(hex.EncodeToString(sum[:]) + "  kanban-md_1.0.0_linux_amd64.tar.gz\n" +
+		"00  kanban-md_1.0.0_darwin_arm64.tar.gz\n")
+
+	if err := VerifyChecksum(data, sums, "kanban-md_1.0.0_linux_amd64.tar.gz"); err != nil {
+		t.Errorf("VerifyChecksum(match) = %v", err)
+	}
+	if err := VerifyChecksum(data, sums, "kanban-md_1.0.0_darwin_arm64.tar.gz"); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("VerifyChecksum(mismatch) = %v, want ErrChecksumMismatch", err)
+	}
+	if err := VerifyChecksum(data, sums, "kanban-md_1.0.0_windows_amd64.zip"); err == nil {
+		t.Error("VerifyChecksum(unlisted) succeeded")
+	}
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractBinary(t *testing.T) {
+	archive := tarGz(t, map[string]string{"README.md": "docs", "kanban-md": "new binary"})
+	bin, err := ExtractBinary(archive, false, "kanban-md")
+	if err != nil || string(bin) != "new binary" {
+		t.Errorf("ExtractBinary(tar.gz) = %q, %v", bin, err)
+	}
+	if _, err := ExtractBinary(archive, false, "kanban-md.exe"); err == nil {
+		t.Error("ExtractBinary found a missing binary")
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("kanban-md.exe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("windows binary")); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	bin, err = ExtractBinary(buf.Bytes(), true, "kanban-md.exe")
+	if err != nil || string(bin) != "windows binary" {
+		t.Errorf("ExtractBinary(zip) = %q, %v", bin, err)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	for _, goos := range []string{"linux", "windows"} {
+		exe := filepath.Join(t.TempDir(), "kanban-md")
+		if err := os.WriteFile(exe, []byte("old"), 0o755); err != nil { //nolint:gosec // test executable
+			t.Fatal(err)
+		}
+		if err := Replace(exe, []byte("new"), goos); err != nil {
+			t.Fatalf("Replace(%s): %v", goos, err)
+		}
+		data, err := os.ReadFile(exe)
+		if err != nil || string(data) != "new" {
+			t.Errorf("Replace(%s) left %q, %v", goos, data, err)
+		}
+		if info, err := os.Stat(exe); err != nil || info.Mode().Perm()&0o100 == 0 {
+			t.Errorf("Replace(%s) binary is not executable: %v", goos, err)
+		}
+		if _, err := os.Stat(exe + ".new"); !os.IsNotExist(err) {
+			t.Errorf("Replace(%s) left the temporary file: %v", goos, err)
+		}
+	}
+}
+
+func TestArchiveName(t *testing.T) {
+	if got := ArchiveName("1.2.0", "linux", "arm64"); got != "kanban-md_1.2.0_linux_arm64.tar.gz" {
+		t.Errorf("ArchiveName(linux) = %q", got)
+	}
+	if got := ArchiveName("1.2.0", "windows", "amd64"); got != "kanban-md_1.2.0_windows_amd64.zip" {
+		t.Errorf("ArchiveName(windows) = %q", got)
+	}
+}
@@ -0,0 +1,96 @@
+package selfupdate
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+)
+
+// Compare compares two semantic versions, with or without a leading "v",
+// returning -1, 0, or +1. A release sorts after its prereleases. A version
+// that does not parse (such as "dev") sorts before every one that does.
+func Compare(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+	for i := range va.core {
+		if c := cmp.Compare(va.core[i], vb.core[i]); c != 0 {
+			return c
+		}
+	}
+	return comparePre(va.pre, vb.pre)
+}
+
+// IsRelease reports whether v is a released version rather than a
+// development build.
+func IsRelease(v string) bool {
+	_, ok := parseVersion(v)
+	return ok
+}
+
+type semver struct {
+	core [3]int
+	pre  []string
+}
+
+func parseVersion(v string) (semver, bool) {
+	v = strings.TrimPrefix(v, "v")
+	v, _, _ = strings.Cut(v, "+")
+	core, pre, hasPre := strings.Cut(v, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) != len(semver{}.core) {
+		return semver{}, false
+	}
+	var s semver
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return semver{}, false
+		}
+		s.core[i] = n
+	}
+	if hasPre {
+		s.pre = strings.Split(pre, ".")
+	}
+	return s, true
+}
+
+// comparePre orders prerelease identifiers: none sorts last, numeric
+// identifiers numerically and before alphanumeric ones, and a shorter list
+// before a longer one it prefixes.
+func comparePre(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, errA := strconv.Atoi(a[i])
+		nb, errB := strconv.Atoi(b[i])
+		var c int
+		switch {
+		case errA == nil && errB == nil:
+			c = cmp.Compare(na, nb)
+		case errA == nil:
+			c = -1
+		case errB == nil:
+			c = 1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
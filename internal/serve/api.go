@@ -0,0 +1,41 @@
+package serve
+
+import (
+	"net/http"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// WarningHeader carries each warning an API request produced, such as
+// moving a blocked task, the way the CLI prints them to stderr.
+const WarningHeader = "X-Kanban-Warning"
+
+// RevisionHeader carries a counter of the changes the server has seen to
+// the board's files, from the API or elsewhere. Clients can poll a cheap
+// route and refetch only when it moves.
+const RevisionHeader = "X-Kanban-Revision"
+
+// WriteJSON writes v as the JSON body of a response with the given status.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = output.JSON(w, v)
+}
+
+// StatusFor returns the HTTP status of a request that failed with e,
+// following the class of its CLI exit code.
+func StatusFor(e *clierr.Error) int {
+	if e.Code == CodeForbidden {
+		return http.StatusForbidden
+	}
+	switch e.ExitCode() {
+	case clierr.ExitValidation:
+		return http.StatusBadRequest
+	case clierr.ExitNotFound:
+		return http.StatusNotFound
+	case clierr.ExitConflict, clierr.ExitWIP:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
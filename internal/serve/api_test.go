@@ -0,0 +1,22 @@
+package serve
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+)
+
+func TestStatusFor(t *testing.T) {
+	for code, want := range map[string]int{
+		clierr.InvalidPriority:  http.StatusBadRequest,
+		clierr.TaskNotFound:     http.StatusNotFound,
+		clierr.TaskClaimed:      http.StatusConflict,
+		clierr.WIPLimitExceeded: http.StatusConflict,
+		clierr.InternalError:    http.StatusInternalServerError,
+	} {
+		if got := StatusFor(clierr.New(code, "x")); got != want {
+			t.Errorf("StatusFor(%s) = %d, want %d", code, got, want)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Package serve implements the kanban-md HTTP API.
+package serve
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// Error codes returned by the API when a request is not authorized.
+const (
+	CodeUnauthorized = "UNAUTHORIZED"
+	CodeForbidden    = "FORBIDDEN"
+)
+
+const hashPrefix = "sha256:"
+
+// Principal is the identity behind an authenticated request.
+type Principal struct {
+	Name  string `json:"name"`
+	Scope string `json:"scope"`
+}
+
+// Authorizer checks bearer tokens against the tokens configured in
+// serve.tokens and serve.tokens_file.
+type Authorizer struct {
+	tokens []config.ServeToken
+}
+
+// tokensFile is the layout of serve.tokens_file.
+type tokensFile struct {
+	Tokens []config.ServeToken `yaml:"tokens"`
+}
+
+// NewAuthorizer collects the tokens from the board config and its tokens
+// file. A configured tokens file that cannot be read is an error, so a typo
+// never leaves the API open.
+func NewAuthorizer(cfg *config.Config) (*Authorizer, error) {
+	tokens := append([]config.ServeToken{}, cfg.Serve.Tokens...)
+	if path := cfg.ServeTokensPath(); path != "" {
+		data, err := os.ReadFile(path) //nolint:gosec // path comes from board config
+		if err != nil {
+			return nil, fmt.Errorf("reading serve.tokens_file: %w", err)
+		}
+		var f tokensFile
+		if err := yaml.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("parsing serve.tokens_file: %w", err)
+		}
+		tokens = append(tokens, f.Tokens...)
+	}
+	if err := config.ValidateServeTokens(tokens); err != nil {
+		return nil, err
+	}
+	return &Authorizer{tokens: tokens}, nil
+}
+
+// Enabled reports whether any tokens are configured. Without tokens every
+// request is treated as admin.
+func (a *Authorizer) Enabled() bool {
+	return len(a.tokens) > 0
+}
+
+var errNoToken = errors.New("missing bearer token")
+
+// Authenticate returns the principal for the request's bearer token.
+func (a *Authorizer) Authenticate(r *http.Request) (Principal, error) {
+	if !a.Enabled() {
+		return Principal{Name: "anonymous", Scope: config.ScopeAdmin}, nil
+	}
+	secret, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || secret == "" {
+		return Principal{}, errNoToken
+	}
+	for _, t := range a.tokens {
+		if tokenMatches(t.Token, secret) {
+			return Principal{Name: t.Name, Scope: t.Scope}, nil
+		}
+	}
+	return Principal{}, errors.New("invalid token")
+}
+
+// Require wraps next so it only runs for requests whose token grants at
+// least scope. Unauthenticated requests get 401, insufficient scope 403.
+// next finds the principal with PrincipalFrom.
+func (a *Authorizer) Require(scope string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := a.Authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="kanban-md"`)
+			writeError(w, http.StatusUnauthorized, CodeUnauthorized, err.Error())
+			return
+		}
+		if err := p.need(scope); err != nil {
+			writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, p)))
+	})
+}
+
+// principalKey keys the request's Principal in its context.
+type principalKey struct{}
+
+// PrincipalFrom returns the principal Require authenticated for the request.
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// CheckScope returns a CodeForbidden error unless the principal of r has at
+// least scope. Handlers use it for actions that need more than their route.
+func CheckScope(r *http.Request, scope string) error {
+	p, ok := PrincipalFrom(r.Context())
+	if !ok {
+		return clierr.New(CodeForbidden, "request is not authenticated")
+	}
+	if err := p.need(scope); err != nil {
+		return clierr.New(CodeForbidden, err.Error())
+	}
+	return nil
+}
+
+// need returns an error unless p has at least scope.
+func (p Principal) need(scope string) error {
+	if !Allows(p.Scope, scope) {
+		return fmt.Errorf("token %q has scope %s; %s required", p.Name, p.Scope, scope)
+	}
+	return nil
+}
+
+// Allows reports whether a token with scope have may perform an action that
+// needs scope need.
+func Allows(have, need string) bool {
+	h, n := config.IndexOf(config.ServeScopes, have), config.IndexOf(config.ServeScopes, need)
+	return h >= 0 && n >= 0 && h >= n
+}
+
+// ScopeForMethod returns the scope an HTTP method needs: read for GET, HEAD,
+// and OPTIONS, write for everything else.
+func ScopeForMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return config.ScopeRead
+	}
+	return config.ScopeWrite
+}
+
+// tokenMatches compares a presented secret with a configured token in
+// constant time. Configured tokens may be stored as "sha256:<hex>".
+func tokenMatches(configured, secret string) bool {
+	if want, ok := strings.CutPrefix(configured, hashPrefix); ok {
+		sum := sha256.Sum256([]byte(secret))
+		return subtle.ConstantTimeCompare([]byte(strings.ToLower(want)), []byte(hex.EncodeToString(sum[:]))) == 1
+	}
+	return subtle.ConstantTimeCompare([]byte(configured), []byte(secret)) == 1
+}
+
+// writeError writes a JSON error body in the CLI's --json error format.
+func writeError(w http.ResponseWriter, status int, code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	output.JSONError(w, code, msg, nil)
+}
@@ -0,0 +1,148 @@
+package serve
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func testAuthorizer(t *testing.T) *Authorizer {
+	t.Helper()
+	sum := sha256.Sum256([]byte("admin-secret"))
+	cfg := config.NewDefault("Test")
+	cfg.SetDir(t.TempDir())
+	cfg.Serve.Tokens = []config.ServeToken{
+		{Name: "dashboard", Token: "read-secret", Scope: config.ScopeRead},
+		{Name: "ops", Token: "sha256:" + hex.EncodeToString(sum[:]), Scope: config.ScopeAdmin},
+	}
+	a, err := NewAuthorizer(cfg)
+	if err != nil {
+		t.Fatalf("NewAuthorizer: %v", err)
+	}
+	return a
+}
+
+func serveWith(a *Authorizer, scope, token string) int {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	a.Require(scope, ok).ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRequire(t *testing.T) {
+	a := testAuthorizer(t)
+	tests := []struct {
+		name  string
+		scope string
+		token string
+		want  int
+	}{
+		{"no token", config.ScopeRead, "", http.StatusUnauthorized},
+		{"unknown token", config.ScopeRead, "guess", http.StatusUnauthorized},
+		{"read token reads", config.ScopeRead, "read-secret", http.StatusNoContent},
+		{"read token cannot write", config.ScopeWrite, "read-secret", http.StatusForbidden},
+		{"hashed admin token writes", config.ScopeWrite, "admin-secret", http.StatusNoContent},
+		{"hash itself is not a token", config.ScopeRead, "sha256:", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := serveWith(a, tt.scope, tt.token); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequire_NoTokensIsOpen(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	a, err := NewAuthorizer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Enabled() {
+		t.Error("Enabled() = true with no tokens")
+	}
+	if got := serveWith(a, config.ScopeAdmin, ""); got != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", got, http.StatusNoContent)
+	}
+}
+
+func TestNewAuthorizer_TokensFile(t *testing.T) {
+	dir := t.TempDir()
+	content := "tokens:\n  - name: agent\n    token: agent-secret\n    scope: write\n"
+	if err := os.WriteFile(filepath.Join(dir, "tokens.yml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.NewDefault("Test")
+	cfg.SetDir(dir)
+	cfg.Serve.TokensFile = "tokens.yml"
+
+	a, err := NewAuthorizer(cfg)
+	if err != nil {
+		t.Fatalf("NewAuthorizer: %v", err)
+	}
+	if got := serveWith(a, config.ScopeWrite, "agent-secret"); got != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", got, http.StatusNoContent)
+	}
+
+	cfg.Serve.TokensFile = "missing.yml"
+	if _, err := NewAuthorizer(cfg); err == nil {
+		t.Error("expected error for missing tokens file")
+	}
+}
+
+func TestScopeForMethod(t *testing.T) {
+	if got := ScopeForMethod(http.MethodGet); got != config.ScopeRead {
+		t.Errorf("GET = %q, want read", got)
+	}
+	if got := ScopeForMethod(http.MethodPatch); got != config.ScopeWrite {
+		t.Errorf("PATCH = %q, want write", got)
+	}
+}
+
+func TestAllows(t *testing.T) {
+	if !Allows(config.ScopeAdmin, config.ScopeWrite) {
+		t.Error("admin should allow write")
+	}
+	if Allows(config.ScopeWrite, config.ScopeAdmin) {
+		t.Error("write should not allow admin")
+	}
+	if Allows("bogus", config.ScopeRead) {
+		t.Error("unknown scope should allow nothing")
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	a := testAuthorizer(t)
+	check := func(token string) error {
+		var err error
+		h := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { err = CheckScope(r, config.ScopeAdmin) })
+		req := httptest.NewRequest(http.MethodPost, "/tasks", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		a.Require(config.ScopeRead, h).ServeHTTP(httptest.NewRecorder(), req)
+		return err
+	}
+	if err := check("admin-secret"); err != nil {
+		t.Errorf("admin token: %v", err)
+	}
+	err := check("read-secret")
+	var cliErr *clierr.Error
+	if !errors.As(err, &cliErr) || cliErr.Code != CodeForbidden || StatusFor(cliErr) != http.StatusForbidden {
+		t.Errorf("read token: %v, want a %s error", err, CodeForbidden)
+	}
+	if err := CheckScope(httptest.NewRequest(http.MethodPost, "/tasks", nil), config.ScopeRead); err == nil {
+		t.Error("request without a principal passed CheckScope")
+	}
+}
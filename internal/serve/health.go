@@ -0,0 +1,93 @@
+package serve
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// Readiness check names, in report order.
+const (
+	CheckBoard   = "board"
+	CheckStorage = "storage"
+	CheckWatcher = "watcher"
+)
+
+// Check is the outcome of one readiness check.
+type Check struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// readiness is the body of a /readyz response.
+type readiness struct {
+	Ready  bool    `json:"ready"`
+	Checks []Check `json:"checks"`
+}
+
+// Healthz returns a liveness handler: it replies 200 whenever the process
+// can serve HTTP at all, without touching the board.
+func Healthz() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = output.JSON(w, map[string]string{"status": "ok"})
+	})
+}
+
+// Readyz returns a readiness handler for the board in dir. It checks that
+// the config loads, that a file can be written to the tasks directory, and,
+// when watcherAlive is not nil, that the file watcher is running. It replies
+// 200 when every check passes and 503 otherwise, listing the checks either
+// way.
+func Readyz(dir string, watcherAlive func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r := readiness{Ready: true}
+		add := func(name string, err error) {
+			c := Check{Name: name, OK: err == nil}
+			if err != nil {
+				c.Error = err.Error()
+				r.Ready = false
+			}
+			r.Checks = append(r.Checks, c)
+		}
+
+		cfg, err := config.Load(dir)
+		add(CheckBoard, err)
+		if err == nil {
+			add(CheckStorage, probeWritable(cfg.TasksPath()))
+		} else {
+			add(CheckStorage, errors.New("board not loaded"))
+		}
+		if watcherAlive != nil {
+			if watcherAlive() {
+				add(CheckWatcher, nil)
+			} else {
+				add(CheckWatcher, errors.New("not running"))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !r.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = output.JSON(w, r)
+	})
+}
+
+// probeWritable creates and removes a file in dir.
+func probeWritable(dir string) error {
+	f, err := os.CreateTemp(dir, ".readyz-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	if err := f.Close(); err != nil {
+		_ = os.Remove(name)
+		return err
+	}
+	return os.Remove(name)
+}
@@ -0,0 +1,73 @@
+package serve
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func TestHealthz(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Healthz().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET /healthz = %d, want 200", rec.Code)
+	}
+}
+
+func readyzChecks(t *testing.T, h http.Handler) (int, map[string]Check) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var r readiness
+	if err := json.Unmarshal(rec.Body.Bytes(), &r); err != nil {
+		t.Fatalf("parsing /readyz body %q: %v", rec.Body, err)
+	}
+	checks := make(map[string]Check, len(r.Checks))
+	for _, c := range r.Checks {
+		checks[c.Name] = c
+	}
+	return rec.Code, checks
+}
+
+func TestReadyz(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewDefault("Test")
+	cfg.SetDir(dir)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(cfg.TasksPath(), 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	alive := true
+	h := Readyz(dir, func() bool { return alive })
+	code, checks := readyzChecks(t, h)
+	if code != http.StatusOK || len(checks) != 3 || !checks[CheckBoard].OK || !checks[CheckStorage].OK || !checks[CheckWatcher].OK {
+		t.Errorf("ready board = %d %+v, want 200 with three passing checks", code, checks)
+	}
+	if entries, _ := os.ReadDir(cfg.TasksPath()); len(entries) != 0 {
+		t.Errorf("storage probe left files behind: %v", entries)
+	}
+
+	alive = false
+	if code, checks = readyzChecks(t, h); code != http.StatusServiceUnavailable || checks[CheckWatcher].OK {
+		t.Errorf("stopped watcher = %d %+v, want 503 with a failing watcher check", code, checks)
+	}
+
+	// Without a watcher only the board and storage are checked.
+	if code, checks = readyzChecks(t, Readyz(dir, nil)); code != http.StatusOK || len(checks) != 2 {
+		t.Errorf("no watcher = %d %+v, want 200 with two checks", code, checks)
+	}
+
+	missing := filepath.Join(dir, "missing")
+	if code, checks = readyzChecks(t, Readyz(missing, nil)); code != http.StatusServiceUnavailable ||
+		checks[CheckBoard].OK || checks[CheckStorage].OK {
+		t.Errorf("missing board = %d %+v, want 503 with failing board and storage checks", code, checks)
+	}
+}
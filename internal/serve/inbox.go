@@ -0,0 +1,52 @@
+package serve
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/inbox"
+	"github.com/antopolskiy/kanban-md/internal/output"
+)
+
+// maxInboxBody caps the size of an inbox webhook request body.
+const maxInboxBody = 1 << 20
+
+// inboxRequest is the body of an inbox webhook request.
+type inboxRequest struct {
+	Title string   `json:"title"`
+	Body  string   `json:"body,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// InboxWebhook returns a handler that spools each POSTed item into dir, the
+// directory of a "webhook" inbox source, for the inbox command to file. It
+// replies 202 Accepted with the spooled file name.
+func InboxWebhook(dir string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, clierr.InvalidInput, "use POST")
+			return
+		}
+		var req inboxRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxInboxBody)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, clierr.InvalidInput, "invalid JSON: "+err.Error())
+			return
+		}
+		if strings.TrimSpace(req.Title) == "" {
+			writeError(w, http.StatusBadRequest, clierr.InvalidInput, "title is required")
+			return
+		}
+		name, err := inbox.Spool(dir, req.Title, req.Body, req.Tags)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, clierr.InternalError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = output.JSON(w, map[string]string{"file": name})
+	})
+}
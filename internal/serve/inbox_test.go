@@ -0,0 +1,40 @@
+package serve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestInboxWebhook(t *testing.T) {
+	dir := t.TempDir()
+	h := InboxWebhook(dir)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/inbox/hook", strings.NewReader(`{"title":"Disk full"}`)))
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("POST = %d, want 202: %s", rec.Code, rec.Body)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || !strings.HasSuffix(entries[0].Name(), ".json") {
+		t.Errorf("spool dir = %v, %v; want one .json file", entries, err)
+	}
+
+	tests := []struct {
+		method, body string
+		want         int
+	}{
+		{http.MethodGet, "", http.StatusMethodNotAllowed},
+		{http.MethodPost, `{"body":"no title"}`, http.StatusBadRequest},
+		{http.MethodPost, `not json`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(tt.method, "/inbox/hook", strings.NewReader(tt.body)))
+		if rec.Code != tt.want {
+			t.Errorf("%s %q = %d, want %d", tt.method, tt.body, rec.Code, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package serve
+
+import (
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Error codes returned for requests that may come from another site.
+const (
+	CodeForbiddenOrigin  = "FORBIDDEN_ORIGIN"
+	CodeUnsupportedMedia = "UNSUPPORTED_MEDIA_TYPE"
+)
+
+// SameOrigin wraps next so browsers cannot be used to reach the API from
+// another site. A request with an Origin header must come from the host it
+// was sent to. When the server listens only on loopback, the Host header
+// must also name a loopback address, which defeats DNS rebinding: a page
+// on evil.example that resolves its own name to 127.0.0.1 still sends
+// Host: evil.example.
+func SameOrigin(loopback bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if loopback && !isLoopbackHost(r.Host) {
+			writeError(w, http.StatusForbidden, CodeForbiddenOrigin, "host "+r.Host+" is not a loopback address")
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" {
+			u, err := url.Parse(origin)
+			if err != nil || !strings.EqualFold(u.Host, r.Host) {
+				writeError(w, http.StatusForbidden, CodeForbiddenOrigin, "cross-origin request from "+origin)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireJSON wraps next so POST, PUT, and PATCH requests must send a JSON
+// body. HTML forms cannot send one, so another site cannot submit a change
+// without a CORS preflight, which the server never grants.
+func RequireJSON(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+			if mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mt != "application/json" {
+				writeError(w, http.StatusUnsupportedMediaType, CodeUnsupportedMedia, "request body must be application/json")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackHost reports whether the host of a Host header is localhost or
+// a loopback IP.
+func isLoopbackHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,73 @@
+package serve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSameOrigin(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	tests := []struct {
+		name     string
+		loopback bool
+		host     string
+		origin   string
+		want     int
+	}{
+		{"loopback host", true, "127.0.0.1:8080", "", http.StatusNoContent},
+		{"localhost", true, "localhost:8080", "", http.StatusNoContent},
+		{"ipv6 loopback", true, "[::1]:8080", "", http.StatusNoContent},
+		{"same origin", true, "127.0.0.1:8080", "http://127.0.0.1:8080", http.StatusNoContent},
+		{"rebound name", true, "evil.example:8080", "", http.StatusForbidden},
+		{"other site", true, "127.0.0.1:8080", "http://evil.example", http.StatusForbidden},
+		{"other port", true, "127.0.0.1:8080", "http://127.0.0.1:9000", http.StatusForbidden},
+		{"public name", false, "board.example", "", http.StatusNoContent},
+		{"public other site", false, "board.example", "https://evil.example", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", nil)
+			req.Host = tt.host
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			SameOrigin(tt.loopback, ok).ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireJSON(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		want        int
+	}{
+		{"json", http.MethodPost, "application/json", http.StatusNoContent},
+		{"json with charset", http.MethodPatch, "application/json; charset=utf-8", http.StatusNoContent},
+		{"form", http.MethodPost, "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{"text", http.MethodPost, "text/plain", http.StatusUnsupportedMediaType},
+		{"missing", http.MethodPatch, "", http.StatusUnsupportedMediaType},
+		{"delete without body", http.MethodDelete, "", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/v1/tasks", strings.NewReader("{}"))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			rec := httptest.NewRecorder()
+			RequireJSON(ok).ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
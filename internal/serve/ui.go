@@ -0,0 +1,31 @@
+package serve
+
+import (
+	_ "embed" // ui/index.html
+	"net/http"
+)
+
+//go:embed ui/index.html
+var uiPage []byte
+
+// uiPolicy keeps the web board to its own inline script and styles and the
+// API of the server that served it.
+const uiPolicy = "default-src 'none'; script-src 'unsafe-inline'; style-src 'unsafe-inline'; " +
+	"connect-src 'self'; frame-ancestors 'none'"
+
+// UI returns a handler serving the web board at /: a single page, embedded
+// in the binary, that reads and changes the board through the API. The page
+// holds no board data, so it needs no token; its API requests do.
+func UI() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", uiPolicy)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "no-cache")
+		_, _ = w.Write(uiPage)
+	})
+}
@@ -0,0 +1,25 @@
+package serve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUI(t *testing.T) {
+	rec := httptest.NewRecorder()
+	UI().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"/api/v1"`) {
+		t.Errorf("GET / = %d, want the web board page", rec.Code)
+	}
+	if csp := rec.Header().Get("Content-Security-Policy"); !strings.Contains(csp, "connect-src 'self'") {
+		t.Errorf("Content-Security-Policy = %q", csp)
+	}
+
+	rec = httptest.NewRecorder()
+	UI().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/index.html", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GET /index.html = %d, want 404", rec.Code)
+	}
+}
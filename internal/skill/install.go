@@ -109,3 +109,17 @@ func FindInstalledSkills(baseDir string) map[string]string {
 	}
 	return result
 }
+
+// JSONSchemasReference is the path, relative to the kanban-md skill
+// directory, of the JSON output reference that skill generate rewrites.
+const JSONSchemasReference = "references/json-schemas.md"
+
+// WriteReference writes a reference file of an installed skill. The target
+// directory is the agent's skill base directory, as for Install.
+func WriteReference(skillName, targetDir, ref string, data []byte) error {
+	destPath := filepath.Join(targetDir, skillName, filepath.FromSlash(ref))
+	if err := os.MkdirAll(filepath.Dir(destPath), dirMode); err != nil {
+		return fmt.Errorf("creating reference directory: %w", err)
+	}
+	return os.WriteFile(destPath, data, fileMode)
+}
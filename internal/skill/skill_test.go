@@ -261,6 +261,17 @@ func TestInstallOverwrite(t *testing.T) {
 	}
 }
 
+func TestWriteReference(t *testing.T) {
+	tmp := t.TempDir()
+	if err := WriteReference("kanban-md", tmp, JSONSchemasReference, []byte("generated\n")); err != nil {
+		t.Fatalf("WriteReference: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmp, "kanban-md", "references", "json-schemas.md")) //nolint:gosec // test reads from known temp directory
+	if err != nil || string(data) != "generated\n" {
+		t.Errorf("reference = %q, %v; want the written data", data, err)
+	}
+}
+
 func TestInjectVersionCommentWithFrontmatter(t *testing.T) {
 	input := "---\nname: test\ndescription: a test\n---\n# Title\nBody\n"
 	result := string(injectVersionComment([]byte(input), "1.0.0"))
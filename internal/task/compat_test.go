@@ -2,6 +2,7 @@ package task
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -292,3 +293,68 @@ func TestCompatV1TaskWithoutBranchAndWorktree(t *testing.T) {
 		t.Errorf("Worktree = %q, want empty", tk.Worktree)
 	}
 }
+
+func TestCompatV1TaskPrivate(t *testing.T) {
+	path := filepath.Join(v1FixtureDir, "008-private-task.md")
+	tk, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read() v1 private task: %v", err)
+	}
+
+	if !tk.Private {
+		t.Error("Private = false, want true")
+	}
+	if !strings.HasPrefix(tk.Body, "-----BEGIN PGP MESSAGE-----") {
+		t.Errorf("Body = %q, want the armored ciphertext unchanged", tk.Body)
+	}
+
+	other, err := Read(filepath.Join(v1FixtureDir, "002-design-api.md"))
+	if err != nil {
+		t.Fatalf("Read() v1 task: %v", err)
+	}
+	if other.Private {
+		t.Error("tasks without the private field should not be private")
+	}
+}
+
+func TestCompatV1TaskUID(t *testing.T) {
+	path := filepath.Join(v1FixtureDir, "009-task-with-uid.md")
+	tk, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read() v1 task with uid: %v", err)
+	}
+	if tk.UID != "3f6c2a1e-8b4d-4c7a-9e2f-5d1b0a7c6e48" {
+		t.Errorf("UID = %q, want the stored UID", tk.UID)
+	}
+
+	id, err := FindByUID(v1FixtureDir, "3F6C2A1E-8B4D-4C7A-9E2F-5D1B0A7C6E48")
+	if err != nil || id != 9 {
+		t.Errorf("FindByUID = %d, %v; want 9", id, err)
+	}
+
+	other, err := Read(filepath.Join(v1FixtureDir, "002-design-api.md"))
+	if err != nil {
+		t.Fatalf("Read() v1 task: %v", err)
+	}
+	if other.UID != "" {
+		t.Errorf("tasks without the uid field should have no UID, got %q", other.UID)
+	}
+}
+
+func TestCompatV1TaskReviewer(t *testing.T) {
+	tk, err := Read(filepath.Join(v1FixtureDir, "010-task-with-reviewer.md"))
+	if err != nil {
+		t.Fatalf("Read() v1 task with reviewer: %v", err)
+	}
+	if tk.Reviewer != "bob" || tk.Assignee != "alice" {
+		t.Errorf("Reviewer, Assignee = %q, %q; want bob, alice", tk.Reviewer, tk.Assignee)
+	}
+
+	other, err := Read(filepath.Join(v1FixtureDir, "002-design-api.md"))
+	if err != nil {
+		t.Fatalf("Read() v1 task: %v", err)
+	}
+	if other.Reviewer != "" {
+		t.Errorf("tasks without the reviewer field should have no reviewer, got %q", other.Reviewer)
+	}
+}
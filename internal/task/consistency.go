@@ -2,9 +2,11 @@ package task
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
@@ -19,7 +21,7 @@ type ConsistencyReport struct {
 // EnsureConsistency checks tasks for ID/filename inconsistencies and repairs
 // them in place. It also advances next_id to avoid future collisions.
 func EnsureConsistency(cfg *config.Config) (ConsistencyReport, error) {
-	tasks, warnings, err := ReadAllLenient(cfg.TasksPath())
+	tasks, warnings, err := ReadAllLenient(cfg.TasksPaths()...)
 	if err != nil {
 		return ConsistencyReport{}, err
 	}
@@ -35,7 +37,7 @@ func EnsureConsistency(cfg *config.Config) (ConsistencyReport, error) {
 	nextID, duplicateRepairs := repairDuplicateIDs(tasks, nextID, usedIDs)
 	report.Repairs = append(report.Repairs, duplicateRepairs...)
 
-	renameRepairs, err := repairFilenameMismatches(tasks, cfg.TasksPath())
+	renameRepairs, err := repairFilenameMismatches(cfg, tasks)
 	if err != nil {
 		return ConsistencyReport{}, err
 	}
@@ -94,10 +96,17 @@ func repairDuplicateIDs(tasks []*Task, startNextID int, usedIDs map[int]bool) (i
 	return nextID, repairs
 }
 
-func repairFilenameMismatches(tasks []*Task, tasksDir string) ([]string, error) {
-	occupied, err := occupiedTaskPaths(tasksDir)
-	if err != nil {
-		return nil, err
+// repairFilenameMismatches renames task files whose name does not match
+// their ID, using the board's naming scheme. Files stay in their own tasks
+// directory.
+func repairFilenameMismatches(cfg *config.Config, tasks []*Task) ([]string, error) {
+	occupied := map[string]bool{}
+	for _, dir := range cfg.TasksPaths() {
+		dirOccupied, err := occupiedTaskPaths(dir)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(occupied, dirOccupied)
 	}
 
 	sortTasksByFile(tasks)
@@ -109,7 +118,7 @@ func repairFilenameMismatches(tasks []*Task, tasksDir string) ([]string, error)
 
 		oldPath := t.File
 		oldName := filepath.Base(oldPath)
-		targetPath := chooseTaskPath(tasksDir, t, oldPath, occupied)
+		targetPath := chooseTaskPath(cfg, filepath.Dir(oldPath), t, oldPath, occupied)
 		t.File = targetPath
 		t.Updated = time.Now()
 
@@ -221,7 +230,7 @@ func nextAvailableID(start int, used map[int]bool) int {
 }
 
 func occupiedTaskPaths(tasksDir string) (map[string]bool, error) {
-	entries, err := os.ReadDir(tasksDir)
+	entries, err := readDir(tasksDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return map[string]bool{}, nil
@@ -239,18 +248,19 @@ func occupiedTaskPaths(tasksDir string) (map[string]bool, error) {
 	return occupied, nil
 }
 
-func chooseTaskPath(tasksDir string, t *Task, currentPath string, occupied map[string]bool) string {
-	slug := GenerateSlug(t.Title)
+func chooseTaskPath(cfg *config.Config, tasksDir string, t *Task, currentPath string, occupied map[string]bool) string {
+	slug := slugOf(t.Title, cfg.SlugLength())
 	if slug == "" {
 		slug = "task"
 	}
-	base := GenerateFilename(t.ID, slug)
+	base := FilenameWithSlug(cfg, t, slug)
 	candidate := filepath.Join(tasksDir, base)
 	if candidate == currentPath || !occupied[candidate] {
 		return candidate
 	}
+	stem := strings.TrimSuffix(base, taskFileExt)
 	for i := 1; ; i++ {
-		candidate = filepath.Join(tasksDir, fmt.Sprintf("%03d-%s-%d%s", t.ID, slug, i, taskFileExt))
+		candidate = filepath.Join(tasksDir, fmt.Sprintf("%s-%d%s", stem, i, taskFileExt))
 		if candidate == currentPath || !occupied[candidate] {
 			return candidate
 		}
@@ -0,0 +1,81 @@
+package task
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Working-time units used when converting estimates to hours. A day of
+// estimated work is a working day, not a calendar day.
+const (
+	EstimateHoursPerDay  = 8
+	EstimateDaysPerWeek  = 5
+	estimateMinutesPerHr = 60
+)
+
+var errBadEstimate = errors.New("expected a number followed by m, h, d, or w (e.g. 30m, 4h, 2d, 1w)")
+
+// ParseEstimate converts an estimate string such as "4h", "2d", "1.5h", or
+// "1d4h" into hours. Days count as EstimateHoursPerDay working hours and
+// weeks as EstimateDaysPerWeek working days.
+func ParseEstimate(s string) (float64, error) {
+	return ParseEstimateWith(s, EstimateHoursPerDay)
+}
+
+// ParseEstimateWith is like ParseEstimate but counts a day as hoursPerDay
+// working hours.
+func ParseEstimateWith(s string, hoursPerDay float64) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, errBadEstimate
+	}
+
+	var total float64
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] == '.' || (s[i] >= '0' && s[i] <= '9')) {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, errBadEstimate
+		}
+		n, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return 0, errBadEstimate
+		}
+		switch s[i] {
+		case 'm':
+			total += n / estimateMinutesPerHr
+		case 'h':
+			total += n
+		case 'd':
+			total += n * hoursPerDay
+		case 'w':
+			total += n * hoursPerDay * EstimateDaysPerWeek
+		default:
+			return 0, errBadEstimate
+		}
+		s = strings.TrimSpace(s[i+1:])
+	}
+	return total, nil
+}
+
+// EstimateHours returns the task's estimate in hours and whether it could be
+// parsed. Tasks without an estimate, or with a free-form one, report false.
+func (t *Task) EstimateHours() (float64, bool) {
+	return t.EstimateHoursWith(EstimateHoursPerDay)
+}
+
+// EstimateHoursWith is like EstimateHours but counts a day as hoursPerDay
+// working hours.
+func (t *Task) EstimateHoursWith(hoursPerDay float64) (float64, bool) {
+	if t.Estimate == "" {
+		return 0, false
+	}
+	h, err := ParseEstimateWith(t.Estimate, hoursPerDay)
+	if err != nil {
+		return 0, false
+	}
+	return h, true
+}
@@ -0,0 +1,57 @@
+package task
+
+import "testing"
+
+func TestParseEstimate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{"4h", 4},
+		{"30m", 0.5},
+		{"2d", 16},
+		{"1w", 40},
+		{"1.5h", 1.5},
+		{"1d4h", 12},
+		{" 2H ", 2},
+	}
+	for _, tt := range tests {
+		got, err := ParseEstimate(tt.in)
+		if err != nil {
+			t.Errorf("ParseEstimate(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseEstimate(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseEstimateInvalid(t *testing.T) {
+	for _, in := range []string{"", "h", "4", "4x", "a few days", "1..2h"} {
+		if _, err := ParseEstimate(in); err == nil {
+			t.Errorf("ParseEstimate(%q) expected error", in)
+		}
+	}
+}
+
+func TestEstimateHours(t *testing.T) {
+	tk := &Task{Estimate: "2d"}
+	if h, ok := tk.EstimateHours(); !ok || h != 16 {
+		t.Errorf("EstimateHours() = %v, %v; want 16, true", h, ok)
+	}
+	tk.Estimate = "soon"
+	if _, ok := tk.EstimateHours(); ok {
+		t.Error("EstimateHours() ok = true for free-form estimate")
+	}
+}
+
+func TestParseEstimateWith(t *testing.T) {
+	got, err := ParseEstimateWith("1w1d", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 36 {
+		t.Errorf("ParseEstimateWith(1w1d, 6) = %v, want 36", got)
+	}
+}
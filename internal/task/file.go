@@ -6,19 +6,29 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"go.yaml.in/yaml/v3"
+
+	"github.com/antopolskiy/kanban-md/internal/timing"
 )
 
 const fileMode = 0o600
 
 // Read parses a task file and returns the Task with body populated.
 func Read(path string) (*Task, error) {
+	defer timing.Track(timing.Parse)()
+	timing.Count(timing.Parse, 1)
 	data, err := os.ReadFile(path) //nolint:gosec // task path from trusted source
 	if err != nil {
 		return nil, fmt.Errorf("reading task file: %w", err)
 	}
+	return Parse(path, data)
+}
 
+// Parse parses the contents of the task file at path, such as a version
+// staged in git, and returns the Task with body populated.
+func Parse(path string, data []byte) (*Task, error) {
 	fm, body, err := splitFrontmatter(data)
 	if err != nil {
 		return nil, fmt.Errorf("parsing %s: %w", path, err)
@@ -39,8 +49,11 @@ func Read(path string) (*Task, error) {
 }
 
 // Write serializes a task to a markdown file with YAML frontmatter.
+// Timestamps are always written in UTC, whatever zone they carry in memory.
 func Write(path string, t *Task) error {
-	fm, err := yaml.Marshal(t)
+	defer timing.Track(timing.Write)()
+	timing.Count(timing.Write, 1)
+	fm, err := yaml.Marshal(utcCopy(t))
 	if err != nil {
 		return fmt.Errorf("marshaling frontmatter: %w", err)
 	}
@@ -60,6 +73,33 @@ func Write(path string, t *Task) error {
 	return os.WriteFile(path, buf.Bytes(), fileMode)
 }
 
+// utcCopy returns a shallow copy of t with every timestamp converted to UTC.
+func utcCopy(t *Task) *Task {
+	c := *t
+	c.Created = c.Created.UTC()
+	c.Updated = c.Updated.UTC()
+	for _, ts := range []**time.Time{&c.Started, &c.Completed, &c.ClaimedAt, &c.Assigned, &c.FocusUntil} {
+		if *ts != nil {
+			u := (**ts).UTC()
+			*ts = &u
+		}
+	}
+	if c.StatusHistory != nil {
+		c.StatusHistory = append([]StatusEntry(nil), c.StatusHistory...)
+		for i := range c.StatusHistory {
+			c.StatusHistory[i].EnteredAt = c.StatusHistory[i].EnteredAt.UTC()
+		}
+	}
+	if c.Worklog != nil {
+		c.Worklog = append([]WorkEntry(nil), c.Worklog...)
+		for i := range c.Worklog {
+			c.Worklog[i].Start = c.Worklog[i].Start.UTC()
+			c.Worklog[i].End = c.Worklog[i].End.UTC()
+		}
+	}
+	return &c
+}
+
 // splitFrontmatter splits a markdown file into YAML frontmatter and body.
 // The file must start with "---\n". Returns frontmatter bytes and body string.
 func splitFrontmatter(data []byte) ([]byte, string, error) {
@@ -121,3 +121,89 @@ updated: 2026-02-24T12:00:00Z
 		t.Fatalf("error = %v, want missing required field message", err)
 	}
 }
+
+func TestWriteStoresUTC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "003-zoned.md")
+	zone := time.FixedZone("UTC+9", 9*3600)
+	started := time.Date(2026, 2, 8, 9, 0, 0, 0, zone)
+	tk := &Task{
+		ID: 3, Title: "Zoned", Status: "todo", Priority: "medium",
+		Created: started, Updated: started, Started: &started,
+		Assignee: "alice", Assigned: &started,
+	}
+
+	if err := Write(path, tk); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // test file path
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "started: 2026-02-08T00:00:00Z") {
+		t.Errorf("frontmatter should store UTC timestamps:\n%s", data)
+	}
+	if !strings.Contains(string(data), "assigned: 2026-02-08T00:00:00Z") {
+		t.Errorf("frontmatter should store the assigned timestamp in UTC:\n%s", data)
+	}
+	if tk.Started.Location() != zone {
+		t.Error("Write() should not modify the task's in-memory timestamps")
+	}
+}
+
+func TestWriteStoresStatusHistoryUTC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "004-history.md")
+	zone := time.FixedZone("UTC+9", 9*3600)
+	at := time.Date(2026, 2, 8, 9, 0, 0, 0, zone)
+	tk := &Task{
+		ID: 4, Title: "History", Status: "todo", Priority: "medium",
+		Created: at, Updated: at,
+	}
+	RecordStatus(tk, "todo", at)
+
+	if err := Write(path, tk); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // test file path
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "entered_at: 2026-02-08T00:00:00Z") {
+		t.Errorf("status_history should store UTC timestamps:\n%s", data)
+	}
+	if tk.StatusHistory[0].EnteredAt.Location() != zone {
+		t.Error("Write() should not modify the task's status history")
+	}
+}
+
+func TestWriteStoresFocusUTC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "005-focus.md")
+	zone := time.FixedZone("UTC+9", 9*3600)
+	start := time.Date(2026, 2, 8, 9, 0, 0, 0, zone)
+	until := start.Add(25 * time.Minute)
+	tk := &Task{
+		ID: 5, Title: "Focus", Status: "todo", Priority: "medium",
+		Created: start, Updated: start,
+	}
+	RecordWork(tk, start, until, "alice")
+	tk.FocusUntil = &until
+
+	if err := Write(path, tk); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // test file path
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"focus_until: 2026-02-08T00:25:00Z",
+		"start: 2026-02-08T00:00:00Z",
+		"end: 2026-02-08T00:25:00Z",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("frontmatter missing %q:\n%s", want, data)
+		}
+	}
+	if tk.Worklog[0].Start.Location() != zone {
+		t.Error("Write() should not modify the task's worklog")
+	}
+}
@@ -1,31 +1,37 @@
 package task
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/antopolskiy/kanban-md/internal/clierr"
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/timing"
 )
 
-// idPrefixRe matches the numeric ID prefix of a task filename.
-var idPrefixRe = regexp.MustCompile(`^(\d+)-`)
-
 const taskFileExt = ".md"
 
+// readDir lists the tasks directory, recording the scan for --timing.
+func readDir(tasksDir string) ([]os.DirEntry, error) {
+	defer timing.Track(timing.Scan)()
+	entries, err := os.ReadDir(tasksDir)
+	timing.Count(timing.Scan, len(entries))
+	return entries, err
+}
+
 // FindByID scans the tasks directory for a file matching the given ID.
 // Returns the full path to the task file.
 func FindByID(tasksDir string, id int) (string, error) {
-	entries, err := os.ReadDir(tasksDir)
+	entries, err := readDir(tasksDir)
 	if err != nil {
 		return "", fmt.Errorf("reading tasks directory: %w", err)
 	}
 
-	idStr := strconv.Itoa(id)
-	path, prefixFallback := findByFilenamePrefix(entries, tasksDir, idStr, id)
+	path, prefixFallback := findByFilenamePrefix(entries, tasksDir, id)
 	if path != "" {
 		return path, nil
 	}
@@ -42,20 +48,38 @@ func FindByID(tasksDir string, id int) (string, error) {
 		WithDetails(map[string]any{"id": id})
 }
 
-func findByFilenamePrefix(entries []os.DirEntry, tasksDir, idStr string, id int) (string, string) {
+// FindByIDIn looks for the task with the given ID in each tasks directory
+// in turn (see ReadAll). Workstream directories that do not exist yet are
+// skipped.
+func FindByIDIn(tasksDirs []string, id int) (string, error) {
+	for i, dir := range tasksDirs {
+		if i > 0 && !dirExists(dir) {
+			continue
+		}
+		path, err := FindByID(dir, id)
+		var cliErr *clierr.Error
+		if !errors.As(err, &cliErr) || cliErr.Code != clierr.TaskNotFound {
+			return path, err
+		}
+	}
+	return "", clierr.Newf(clierr.TaskNotFound, "task not found: #%d", id).
+		WithDetails(map[string]any{"id": id})
+}
+
+func dirExists(dir string) bool {
+	info, err := os.Stat(dir)
+	return err == nil && info.IsDir()
+}
+
+func findByFilenamePrefix(entries []os.DirEntry, tasksDir string, id int) (string, string) {
 	var prefixFallback string
 	for _, entry := range entries {
 		name := entry.Name()
 		if !isTaskMarkdown(entry) {
 			continue
 		}
-		// Strip leading zeros and check if the numeric prefix matches the ID.
-		dash := strings.IndexByte(name, '-')
-		if dash < 1 {
-			continue
-		}
-		prefix := strings.TrimLeft(name[:dash], "0")
-		if prefix != idStr {
+		// Check if the ID in the file name matches.
+		if fileID, err := ExtractIDFromFilename(name); err != nil || fileID != id {
 			continue
 		}
 
@@ -95,9 +119,35 @@ func isTaskMarkdown(entry os.DirEntry) bool {
 	return !entry.IsDir() && strings.HasSuffix(entry.Name(), taskFileExt)
 }
 
-// ReadAll reads all task files from the given directory.
-func ReadAll(tasksDir string) ([]*Task, error) {
-	entries, err := os.ReadDir(tasksDir)
+// ReadAll reads all task files from the given directories. The first is the
+// board's tasks_dir; tasks read from the others, its workstream
+// directories, get Workstream set.
+func ReadAll(tasksDirs ...string) ([]*Task, error) {
+	var tasks []*Task
+	for i, dir := range tasksDirs {
+		dirTasks, err := readAllIn(dir)
+		if err != nil {
+			return nil, err
+		}
+		setWorkstream(dirTasks, i, dir)
+		tasks = append(tasks, dirTasks...)
+	}
+	return tasks, nil
+}
+
+// setWorkstream marks tasks read from the i-th tasks directory dir.
+func setWorkstream(tasks []*Task, i int, dir string) {
+	if i == 0 {
+		return
+	}
+	name := config.WorkstreamName(dir)
+	for _, t := range tasks {
+		t.Workstream = name
+	}
+}
+
+func readAllIn(tasksDir string) ([]*Task, error) {
+	entries, err := readDir(tasksDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -130,8 +180,24 @@ type ReadWarning struct {
 
 // ReadAllLenient reads all task files, skipping malformed files instead of aborting.
 // Successfully parsed tasks are returned along with warnings for files that failed.
-func ReadAllLenient(tasksDir string) ([]*Task, []ReadWarning, error) {
-	entries, err := os.ReadDir(tasksDir)
+// Directories are handled as by ReadAll.
+func ReadAllLenient(tasksDirs ...string) ([]*Task, []ReadWarning, error) {
+	var tasks []*Task
+	var warnings []ReadWarning
+	for i, dir := range tasksDirs {
+		dirTasks, dirWarnings, err := readAllLenientIn(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		setWorkstream(dirTasks, i, dir)
+		tasks = append(tasks, dirTasks...)
+		warnings = append(warnings, dirWarnings...)
+	}
+	return tasks, warnings, nil
+}
+
+func readAllLenientIn(tasksDir string) ([]*Task, []ReadWarning, error) {
+	entries, err := readDir(tasksDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil, nil
@@ -158,9 +224,11 @@ func ReadAllLenient(tasksDir string) ([]*Task, []ReadWarning, error) {
 	return tasks, warnings, nil
 }
 
-// ExtractIDFromFilename extracts the numeric ID from a task filename.
+// ExtractIDFromFilename extracts the numeric ID from a task filename under
+// any naming scheme: "012-fix-login.md", "012.md", or
+// "2026-03-02-012-fix-login.md".
 func ExtractIDFromFilename(filename string) (int, error) {
-	matches := idPrefixRe.FindStringSubmatch(filename)
+	matches := filenameRe.FindStringSubmatch(filename)
 	if len(matches) < 2 { //nolint:mnd // regex capture group
 		return 0, fmt.Errorf("cannot extract ID from filename %q", filename)
 	}
@@ -171,9 +239,22 @@ func ExtractIDFromFilename(filename string) (int, error) {
 // highest task ID found. Returns 0 if no task files exist or the directory is
 // empty. This is used as defense-in-depth to prevent duplicate IDs when the
 // NextID counter in config.yml is stale (e.g. after a crash, manual edit, or
-// concurrent access from a process that bypassed the lock).
-func MaxIDFromFiles(tasksDir string) (int, error) {
-	entries, err := os.ReadDir(tasksDir)
+// concurrent access from a process that bypassed the lock). Every given
+// directory is scanned.
+func MaxIDFromFiles(tasksDirs ...string) (int, error) {
+	maxID := 0
+	for _, dir := range tasksDirs {
+		id, err := maxIDIn(dir)
+		if err != nil {
+			return 0, err
+		}
+		maxID = max(maxID, id)
+	}
+	return maxID, nil
+}
+
+func maxIDIn(tasksDir string) (int, error) {
+	entries, err := readDir(tasksDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return 0, nil
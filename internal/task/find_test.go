@@ -197,6 +197,8 @@ func TestExtractIDFromFilename(t *testing.T) {
 		{"001-setup-database.md", 1, false},
 		{"042-fix-bug.md", 42, false},
 		{"1000-big-project.md", 1000, false},
+		{"007.md", 7, false},
+		{"2026-03-02-012-fix-login.md", 12, false},
 		{"no-id.md", 0, true},
 		{"", 0, true},
 	}
@@ -211,3 +213,36 @@ func TestExtractIDFromFilename(t *testing.T) {
 		}
 	}
 }
+
+func TestReadAllWorkstreams(t *testing.T) {
+	main, infra := t.TempDir(), filepath.Join(t.TempDir(), "infra")
+	if err := os.MkdirAll(infra, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	createTestTask(t, main, 1, "Main", "backlog")
+	createTestTask(t, infra, 2, "Infra", "todo")
+	missing := filepath.Join(t.TempDir(), "product")
+
+	tasks, err := ReadAll(main, infra, missing)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].Workstream != "" || tasks[1].Workstream != "infra" {
+		t.Fatalf("ReadAll() = %+v, want Main outside any workstream and Infra in infra", tasks)
+	}
+
+	path, err := FindByIDIn([]string{main, missing, infra}, 2)
+	if err != nil || filepath.Dir(path) != infra {
+		t.Errorf("FindByIDIn(2) = %q, %v; want a file in %s", path, err, infra)
+	}
+	_, err = FindByIDIn([]string{main, infra}, 99)
+	var cliErr *clierr.Error
+	if !errors.As(err, &cliErr) || cliErr.Code != clierr.TaskNotFound {
+		t.Errorf("FindByIDIn(99) error = %v, want TASK_NOT_FOUND", err)
+	}
+
+	maxID, err := MaxIDFromFiles(main, infra)
+	if err != nil || maxID != 2 {
+		t.Errorf("MaxIDFromFiles() = %d, %v; want 2", maxID, err)
+	}
+}
@@ -1,33 +1,172 @@
 package task
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 )
 
 // UpdateTimestamps sets Started and Completed based on the status transition.
-//   - Sets Started on first move out of initial status (never overwrites).
-//   - Sets Completed on move to terminal status; also sets Started if nil.
-//   - Clears Completed when moving away from terminal status (reopening).
+//   - Sets Started on a move the config says starts a task (never overwrites).
+//   - Sets Completed on a move to a status that completes a task; also sets
+//     Started if nil.
+//   - Clears Completed when moving away from such a status (reopening) and
+//     counts the reopen, unless the task is restored from the archive.
+//   - Records the new status in StatusHistory.
+//
+// Without timestamps.start_on and complete_on, the first move out of the
+// initial status starts a task and the terminal status completes it.
 func UpdateTimestamps(t *Task, oldStatus, newStatus string, cfg *config.Config) {
 	now := time.Now()
-	initialStatus := cfg.StatusNames()[0]
+	RecordStatus(t, newStatus, now)
 
-	// Set Started on first move out of initial status (never overwrite).
-	if t.Started == nil && oldStatus == initialStatus && newStatus != initialStatus {
+	// Set Started when the move starts the task (never overwrite).
+	if t.Started == nil && cfg.StartsTask(oldStatus, newStatus) {
 		t.Started = &now
 	}
 
-	// Set/clear Completed based on terminal status.
-	if cfg.IsTerminalStatus(newStatus) {
+	// Set/clear Completed based on the completing statuses.
+	if cfg.CompletesTask(newStatus) {
 		t.Completed = &now
-		// Direct move to terminal: also set Started if nil.
+		// Direct move to completion: also set Started if nil.
 		if t.Started == nil {
 			t.Started = &now
 		}
-	} else if cfg.IsTerminalStatus(oldStatus) {
+	} else if cfg.CompletesTask(oldStatus) {
 		// Reopening: clear Completed, preserve Started.
 		t.Completed = nil
+		if !cfg.IsArchivedStatus(oldStatus) {
+			t.Reopened++
+		}
+	}
+}
+
+// RecordStatus appends status to t's status history as entered at at,
+// unless the history already ends in it.
+func RecordStatus(t *Task, status string, at time.Time) {
+	if n := len(t.StatusHistory); n > 0 && t.StatusHistory[n-1].Status == status {
+		return
+	}
+	t.StatusHistory = append(t.StatusHistory, StatusEntry{Status: status, EnteredAt: at})
+}
+
+// RecordAssigned records at as when t first got an assignee, unless it has
+// none or one was recorded before.
+func RecordAssigned(t *Task, at time.Time) {
+	if t.Assignee != "" && t.Assigned == nil {
+		t.Assigned = &at
+	}
+}
+
+// Focusing reports whether a focus timer is running on t at now.
+func Focusing(t *Task, now time.Time) bool {
+	return t.FocusUntil != nil && t.FocusUntil.After(now)
+}
+
+// RecordWork appends a focus session from start to end to t's worklog and
+// stops its focus timer.
+func RecordWork(t *Task, start, end time.Time, by string) {
+	t.Worklog = append(t.Worklog, WorkEntry{Start: start, End: end, By: by})
+	t.FocusUntil = nil
+}
+
+// Worked returns the total time of t's worklog.
+func Worked(t *Task) time.Duration {
+	var total time.Duration
+	for _, w := range t.Worklog {
+		total += w.End.Sub(w.Start)
+	}
+	return total
+}
+
+// EnteredStatus returns when t entered its current status, according to
+// its status history.
+func EnteredStatus(t *Task) (time.Time, bool) {
+	n := len(t.StatusHistory)
+	if n == 0 || t.StatusHistory[n-1].Status != t.Status {
+		return time.Time{}, false
+	}
+	return t.StatusHistory[n-1].EnteredAt, true
+}
+
+// TimeInStatus sums the time t spent in each status of its history, up to
+// now for the status it is in. Time before the history starts is unknown
+// and not counted.
+func TimeInStatus(t *Task, now time.Time) map[string]time.Duration {
+	spent := make(map[string]time.Duration)
+	for i, e := range t.StatusHistory {
+		end := now
+		if i+1 < len(t.StatusHistory) {
+			end = t.StatusHistory[i+1].EnteredAt
+		}
+		if end.After(e.EnteredAt) {
+			spent[e.Status] += end.Sub(e.EnteredAt)
+		}
+	}
+	return spent
+}
+
+// Block marks t blocked for reason, expected to be unblocked by until
+// (nil when unknown). Any external item it was blocked on is cleared.
+func Block(t *Task, reason string, until *date.Date) {
+	t.Blocked = true
+	t.BlockReason = reason
+	t.BlockedUntil = until
+	t.BlockedOn = ""
+}
+
+// Unblock clears t's block.
+func Unblock(t *Task) {
+	t.Blocked = false
+	t.BlockReason = ""
+	t.BlockedUntil = nil
+	t.BlockedOn = ""
+}
+
+// BlockReviewDue reports whether t is still blocked after the date it was
+// expected to be unblocked, so the block should be reviewed.
+func BlockReviewDue(t *Task, today date.Date) bool {
+	return t.Blocked && t.BlockedUntil != nil && t.BlockedUntil.Before(today.Time)
+}
+
+// checklistItemRe matches a markdown task-list line and captures its text.
+var checklistItemRe = regexp.MustCompile(`^\s*[-*+]\s+\[[ xX]\]\s+(.*?)\s*$`)
+
+// ApplyChecklist appends the checklist template of the task's current status
+// to its body as unchecked "- [ ] item" lines. Items already in the body,
+// checked or not, are left alone. Private tasks, whose body is encrypted,
+// are skipped. Reports whether the body changed.
+func ApplyChecklist(t *Task, cfg *config.Config) bool {
+	items := cfg.StatusChecklist(t.Status)
+	if len(items) == 0 || t.Private {
+		return false
+	}
+
+	present := make(map[string]bool)
+	for _, line := range strings.Split(t.Body, "\n") {
+		if m := checklistItemRe.FindStringSubmatch(line); m != nil {
+			present[strings.ToLower(m[1])] = true
+		}
+	}
+
+	var missing []string
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if !present[strings.ToLower(item)] {
+			missing = append(missing, "- [ ] "+item)
+		}
+	}
+	if len(missing) == 0 {
+		return false
+	}
+
+	body := strings.TrimRight(t.Body, "\n")
+	if body != "" {
+		body += "\n\n"
 	}
+	t.Body = body + strings.Join(missing, "\n") + "\n"
+	return true
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -26,6 +27,23 @@ func TestUpdateTimestamps_FirstMoveFromInitial(t *testing.T) {
 	}
 }
 
+func TestRecordAssigned(t *testing.T) {
+	first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tk := &task.Task{}
+	task.RecordAssigned(tk, first)
+	if tk.Assigned != nil {
+		t.Errorf("Assigned = %v without an assignee, want nil", tk.Assigned)
+	}
+
+	tk.Assignee = "alice"
+	task.RecordAssigned(tk, first)
+	tk.Assignee = "bob"
+	task.RecordAssigned(tk, first.Add(time.Hour))
+	if tk.Assigned == nil || !tk.Assigned.Equal(first) {
+		t.Errorf("Assigned = %v, want the first assignment %v", tk.Assigned, first)
+	}
+}
+
 func TestUpdateTimestamps_SubsequentMovePreservesStarted(t *testing.T) {
 	cfg := testConfig()
 	originalStarted := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
@@ -81,6 +99,24 @@ func TestUpdateTimestamps_ReopenClearsCompleted(t *testing.T) {
 	if tk.Started == nil {
 		t.Error("Started should be preserved when reopening")
 	}
+	if tk.Reopened != 1 {
+		t.Errorf("Reopened = %d, want 1", tk.Reopened)
+	}
+}
+
+func TestUpdateTimestamps_RestoreIsNotReopen(t *testing.T) {
+	cfg := testConfig()
+	completed := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	tk := &task.Task{Status: "backlog", Completed: &completed}
+
+	task.UpdateTimestamps(tk, config.ArchivedStatus, "backlog", cfg)
+
+	if tk.Completed != nil {
+		t.Error("Completed should be cleared when restoring from the archive")
+	}
+	if tk.Reopened != 0 {
+		t.Errorf("Reopened = %d, want 0 for a restore", tk.Reopened)
+	}
 }
 
 func TestUpdateTimestamps_MiddleMoveNoChange(t *testing.T) {
@@ -97,3 +133,172 @@ func TestUpdateTimestamps_MiddleMoveNoChange(t *testing.T) {
 		t.Error("Completed should remain nil on middle status move")
 	}
 }
+
+func TestUpdateTimestamps_ConfiguredTriggers(t *testing.T) {
+	cfg := testConfig()
+	cfg.Timestamps = config.TimestampsConfig{StartOn: []string{"in-progress"}, CompleteOn: []string{"review"}}
+	tk := &task.Task{Status: "todo"}
+
+	task.UpdateTimestamps(tk, "backlog", "todo", cfg)
+	if tk.Started != nil {
+		t.Error("Started should wait for a start_on status")
+	}
+	task.UpdateTimestamps(tk, "todo", "in-progress", cfg)
+	if tk.Started == nil {
+		t.Fatal("Started should be set on entering a start_on status")
+	}
+	task.UpdateTimestamps(tk, "in-progress", "review", cfg)
+	if tk.Completed == nil {
+		t.Fatal("Completed should be set on entering a complete_on status")
+	}
+	task.UpdateTimestamps(tk, "review", "done", cfg)
+	if tk.Completed != nil {
+		t.Error("Completed should be cleared on leaving complete_on statuses")
+	}
+}
+
+func checklistConfig() *config.Config {
+	cfg := testConfig()
+	for i := range cfg.Statuses {
+		if cfg.Statuses[i].Name == "review" {
+			cfg.Statuses[i].Checklist = []string{"code reviewed", "tests pass"}
+		}
+	}
+	return cfg
+}
+
+func TestUpdateTimestamps_RecordsStatusHistory(t *testing.T) {
+	cfg := testConfig()
+	tk := &task.Task{Status: "backlog"}
+
+	tk.Status = "todo"
+	task.UpdateTimestamps(tk, "backlog", "todo", cfg)
+	tk.Status = "in-progress"
+	task.UpdateTimestamps(tk, "todo", "in-progress", cfg)
+
+	if len(tk.StatusHistory) != 2 || tk.StatusHistory[0].Status != "todo" || tk.StatusHistory[1].Status != "in-progress" {
+		t.Fatalf("StatusHistory = %+v", tk.StatusHistory)
+	}
+	at, ok := task.EnteredStatus(tk)
+	if !ok || !at.Equal(tk.StatusHistory[1].EnteredAt) {
+		t.Errorf("EnteredStatus = %v, %v", at, ok)
+	}
+}
+
+func TestTimeInStatus(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	tk := &task.Task{Status: "todo"}
+	task.RecordStatus(tk, "todo", start)
+	task.RecordStatus(tk, "todo", start.Add(time.Hour)) // already there
+	task.RecordStatus(tk, "in-progress", start.Add(2*time.Hour))
+	task.RecordStatus(tk, "todo", start.Add(5*time.Hour))
+
+	if len(tk.StatusHistory) != 3 {
+		t.Fatalf("StatusHistory = %+v, want 3 entries", tk.StatusHistory)
+	}
+	spent := task.TimeInStatus(tk, start.Add(6*time.Hour))
+	if spent["todo"] != 3*time.Hour || spent["in-progress"] != 3*time.Hour {
+		t.Errorf("TimeInStatus = %v, want 3h each", spent)
+	}
+
+	// The history no longer matches a status changed without it.
+	tk.Status = "done"
+	if _, ok := task.EnteredStatus(tk); ok {
+		t.Error("EnteredStatus should not guess when the history is stale")
+	}
+}
+
+func TestApplyChecklist_AppendsToBody(t *testing.T) {
+	tk := &task.Task{Status: "review", Body: "Implements the parser.\n"}
+
+	if !task.ApplyChecklist(tk, checklistConfig()) {
+		t.Fatal("ApplyChecklist should report a change")
+	}
+	want := "Implements the parser.\n\n- [ ] code reviewed\n- [ ] tests pass\n"
+	if tk.Body != want {
+		t.Errorf("Body = %q, want %q", tk.Body, want)
+	}
+}
+
+func TestApplyChecklist_EmptyBody(t *testing.T) {
+	tk := &task.Task{Status: "review"}
+
+	task.ApplyChecklist(tk, checklistConfig())
+
+	want := "- [ ] code reviewed\n- [ ] tests pass\n"
+	if tk.Body != want {
+		t.Errorf("Body = %q, want %q", tk.Body, want)
+	}
+}
+
+func TestApplyChecklist_SkipsPresentItems(t *testing.T) {
+	tk := &task.Task{Status: "review", Body: "- [x] Code reviewed\n"}
+
+	task.ApplyChecklist(tk, checklistConfig())
+
+	want := "- [x] Code reviewed\n\n- [ ] tests pass\n"
+	if tk.Body != want {
+		t.Errorf("Body = %q, want %q", tk.Body, want)
+	}
+	if task.ApplyChecklist(tk, checklistConfig()) {
+		t.Error("second ApplyChecklist should not change the body")
+	}
+}
+
+func TestApplyChecklist_NoTemplate(t *testing.T) {
+	tk := &task.Task{Status: "todo", Body: "body"}
+
+	if task.ApplyChecklist(tk, checklistConfig()) {
+		t.Error("status without checklist should not change the body")
+	}
+	if tk.Body != "body" {
+		t.Errorf("Body = %q, want unchanged", tk.Body)
+	}
+}
+
+func TestBlockAndUnblock(t *testing.T) {
+	tk := &task.Task{}
+	until := date.New(2026, 3, 10)
+	task.Block(tk, "waiting on vendor", &until)
+	if !tk.Blocked || tk.BlockReason != "waiting on vendor" || tk.BlockedUntil == nil {
+		t.Fatalf("after Block: %+v", tk)
+	}
+
+	if task.BlockReviewDue(tk, date.New(2026, 3, 10)) {
+		t.Error("review should not be due on the expected date itself")
+	}
+	if !task.BlockReviewDue(tk, date.New(2026, 3, 11)) {
+		t.Error("review should be due the day after the expected date")
+	}
+
+	tk.BlockedOn = "acme/api#7"
+	task.Unblock(tk)
+	if tk.Blocked || tk.BlockReason != "" || tk.BlockedUntil != nil || tk.BlockedOn != "" {
+		t.Errorf("after Unblock: %+v", tk)
+	}
+	if task.BlockReviewDue(tk, date.New(2026, 3, 11)) {
+		t.Error("an unblocked task needs no review")
+	}
+}
+
+func TestRecordWork(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	until := start.Add(25 * time.Minute)
+	tk := &task.Task{FocusUntil: &until}
+
+	if !task.Focusing(tk, start) || task.Focusing(tk, until) {
+		t.Error("Focusing should hold until the timer ends")
+	}
+	task.RecordWork(tk, start, start.Add(20*time.Minute), "alice")
+	task.RecordWork(tk, start.Add(time.Hour), start.Add(90*time.Minute), "")
+
+	if tk.FocusUntil != nil || task.Focusing(tk, start) {
+		t.Error("RecordWork should stop the focus timer")
+	}
+	if len(tk.Worklog) != 2 || tk.Worklog[0].By != "alice" {
+		t.Errorf("Worklog = %+v, want 2 entries, the first by alice", tk.Worklog)
+	}
+	if got := task.Worked(tk); got != 50*time.Minute {
+		t.Errorf("Worked = %v, want 50m", got)
+	}
+}
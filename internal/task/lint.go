@@ -0,0 +1,90 @@
+package task
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+// LintIssue is a lint rule a task file breaks.
+type LintIssue struct {
+	File     string `json:"file"`
+	ID       int    `json:"id,omitempty"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Lint checks a task against the lint rules in cfg and returns the issues
+// of rules that are not turned off. Archived tasks are only checked for
+// unknown field values.
+func Lint(cfg *config.Config, t *Task) []LintIssue {
+	l := linter{cfg: cfg, file: t.File, id: t.ID}
+	l.checkFields(t)
+	if t.Status == config.ArchivedStatus {
+		return l.issues
+	}
+
+	if n := utf8.RuneCountInString(t.Title); n > cfg.LintTitleMaxLength() {
+		l.add(config.LintRuleTitleLength, "title is %d characters, more than %d", n, cfg.LintTitleMaxLength())
+	}
+	if req := cfg.Lint.RequiredTags; len(req) > 0 && !slices.ContainsFunc(req, func(tag string) bool {
+		return slices.Contains(t.Tags, tag)
+	}) {
+		l.add(config.LintRuleRequiredTags, "needs one of the tags: %s", strings.Join(req, ", "))
+	}
+	from := cfg.PriorityIndex(cfg.LintBodyRequiredFrom())
+	if p := cfg.PriorityIndex(t.Priority); from >= 0 && p >= from && strings.TrimSpace(t.Body) == "" {
+		l.add(config.LintRuleEmptyBody, "%s priority task has an empty body", t.Priority)
+	}
+	for _, word := range cfg.Lint.ForbiddenWords {
+		re := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(word) + `\b`)
+		// The body of a private task is ciphertext.
+		if re.MatchString(t.Title) || (!t.Private && re.MatchString(t.Body)) {
+			l.add(config.LintRuleForbiddenWords, "contains forbidden word %q", word)
+		}
+	}
+	return l.issues
+}
+
+// LintReadError returns the issue for a task file that cannot be read or
+// parsed, or nil when the frontmatter rule is off.
+func LintReadError(cfg *config.Config, file string, err error) []LintIssue {
+	l := linter{cfg: cfg, file: file}
+	l.add(config.LintRuleFrontmatter, "%v", err)
+	return l.issues
+}
+
+// linter collects the issues of one task file.
+type linter struct {
+	cfg    *config.Config
+	file   string
+	id     int
+	issues []LintIssue
+}
+
+func (l *linter) add(rule, format string, args ...any) {
+	severity := l.cfg.LintSeverity(rule)
+	if severity == config.LintOff {
+		return
+	}
+	l.issues = append(l.issues, LintIssue{
+		File: l.file, ID: l.id, Rule: rule, Severity: severity, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) checkFields(t *Task) {
+	if !slices.Contains(l.cfg.StatusNames(), t.Status) {
+		l.add(config.LintRuleFields, "unknown status %q", t.Status)
+	}
+	if l.cfg.PriorityIndex(t.Priority) < 0 {
+		l.add(config.LintRuleFields, "unknown priority %q", t.Priority)
+	}
+	if t.Class != "" && len(l.cfg.Classes) > 0 && l.cfg.ClassByName(t.Class) == nil {
+		l.add(config.LintRuleFields, "unknown class %q", t.Class)
+	}
+}
@@ -0,0 +1,66 @@
+package task
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func TestLint(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Lint = config.LintConfig{
+		TitleMaxLength: 20,
+		RequiredTags:   []string{"bug", "feature"},
+		ForbiddenWords: []string{"wip"},
+		Severity:       map[string]string{config.LintRuleEmptyBody: config.LintError},
+	}
+
+	tests := []struct {
+		name string
+		task Task
+		want []string // rule:severity
+	}{
+		{"clean", Task{Title: "Fix login", Status: "todo", Priority: "high", Tags: []string{"bug"}, Body: "Steps"}, nil},
+		{"long title", Task{Title: strings.Repeat("x", 21), Status: "todo", Priority: "low", Tags: []string{"bug"}},
+			[]string{"title-length:warning"}},
+		{"missing tag", Task{Title: "Fix", Status: "todo", Priority: "low", Tags: []string{"docs"}},
+			[]string{"required-tags:error"}},
+		{"empty body", Task{Title: "Fix", Status: "todo", Priority: "critical", Tags: []string{"bug"}, Body: " \n"},
+			[]string{"empty-body:error"}},
+		{"forbidden word", Task{Title: "Fix", Status: "todo", Priority: "low", Tags: []string{"bug"}, Body: "still WIP."},
+			[]string{"forbidden-words:error"}},
+		{"word inside another word", Task{Title: "Wipe cache", Status: "todo", Priority: "low", Tags: []string{"bug"}}, nil},
+		{"unknown fields", Task{Title: "Fix", Status: "doing", Priority: "urgent", Tags: []string{"bug"}},
+			[]string{"fields:error", "fields:error"}},
+		{"archived", Task{Title: strings.Repeat("x", 21), Status: config.ArchivedStatus, Priority: "low"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range Lint(cfg, &tt.task) {
+				got = append(got, issue.Rule+":"+issue.Severity)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Lint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintSeverityOff(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	cfg.Lint.Severity = map[string]string{
+		config.LintRuleTitleLength: config.LintOff,
+		config.LintRuleFrontmatter: config.LintOff,
+	}
+
+	tk := &Task{Title: strings.Repeat("x", 200), Status: "todo", Priority: "low"}
+	if issues := Lint(cfg, tk); len(issues) != 0 {
+		t.Errorf("Lint() = %v, want no issues", issues)
+	}
+	if issues := LintReadError(cfg, "001-x.md", errors.New("bad yaml")); len(issues) != 0 {
+		t.Errorf("LintReadError() = %v, want no issues", issues)
+	}
+}
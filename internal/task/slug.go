@@ -5,23 +5,30 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
 )
 
-const maxSlugLength = 50
+const maxSlugLength = config.DefaultSlugLength
 
 var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
 
 // GenerateSlug converts a title to a URL-friendly slug.
 func GenerateSlug(title string) string {
+	return slugOf(title, maxSlugLength)
+}
+
+// slugOf converts title to a slug of at most maxLen bytes.
+func slugOf(title string, maxLen int) string {
 	slug := strings.ToLower(title)
 	slug = nonAlphanumeric.ReplaceAllString(slug, "-")
 	slug = strings.Trim(slug, "-")
 
-	if len(slug) > maxSlugLength {
+	if len(slug) > maxLen {
 		// Truncate at word boundary.
-		truncated := slug[:maxSlugLength]
+		truncated := slug[:maxLen]
 		// Only trim to last hyphen if we cut mid-word.
-		if slug[maxSlugLength] != '-' {
+		if slug[maxLen] != '-' {
 			if idx := strings.LastIndex(truncated, "-"); idx > 0 {
 				truncated = truncated[:idx]
 			}
@@ -34,10 +41,51 @@ func GenerateSlug(title string) string {
 
 // GenerateFilename creates a task filename from an ID and slug.
 func GenerateFilename(id int, slug string) string {
+	return padID(id) + "-" + slug + taskFileExt
+}
+
+// padID zero-pads id to at least three digits.
+func padID(id int) string {
 	padWidth := 3
 	idStr := strconv.Itoa(id)
 	if len(idStr) > padWidth {
 		padWidth = len(idStr)
 	}
-	return fmt.Sprintf("%0*d-%s.md", padWidth, id, slug)
+	return fmt.Sprintf("%0*d", padWidth, id)
+}
+
+// Filename returns the file name of t under the board's files.naming
+// scheme, with a slug of its title cut to files.slug_length.
+func Filename(cfg *config.Config, t *Task) string {
+	return FilenameWithSlug(cfg, t, slugOf(t.Title, cfg.SlugLength()))
+}
+
+// FilenameWithSlug is Filename with the given slug. The {id} scheme has no
+// slug, and {date} is the day t was created.
+func FilenameWithSlug(cfg *config.Config, t *Task, slug string) string {
+	switch cfg.FileNaming() {
+	case config.NamingID:
+		return padID(t.ID) + taskFileExt
+	case config.NamingDateIDSlug:
+		return t.Created.Format(filenameDateLayout) + "-" + GenerateFilename(t.ID, slug)
+	default:
+		return GenerateFilename(t.ID, slug)
+	}
+}
+
+// filenameDateLayout is the {date} part of a task file name.
+const filenameDateLayout = "2006-01-02"
+
+// filenameRe splits a task file name under any naming scheme into its ID
+// and slug.
+var filenameRe = regexp.MustCompile(`^(?:\d{4}-\d{2}-\d{2}-)?(\d+)(?:-(.*))?\.md$`)
+
+// SlugFromFilename returns the slug part of a task file name under any
+// naming scheme ("012-fix-login.md" -> "fix-login"), or "" when it has none.
+func SlugFromFilename(name string) string {
+	m := filenameRe.FindStringSubmatch(name)
+	if m == nil {
+		return ""
+	}
+	return m[2]
 }
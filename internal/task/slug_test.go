@@ -1,6 +1,11 @@
 package task
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
 
 func TestGenerateSlug(t *testing.T) {
 	tests := []struct {
@@ -44,3 +49,38 @@ func TestGenerateFilename(t *testing.T) {
 		}
 	}
 }
+
+func TestFilenameSchemes(t *testing.T) {
+	tk := &Task{ID: 12, Title: "Fix the login page", Created: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		naming     string
+		slugLength int
+		want       string
+	}{
+		{"", 0, "012-fix-the-login-page.md"},
+		{config.NamingID, 0, "012.md"},
+		{config.NamingDateIDSlug, 0, "2026-03-02-012-fix-the-login-page.md"},
+		{config.NamingIDSlug, 10, "012-fix-the.md"},
+	}
+	for _, tt := range tests {
+		cfg := config.NewDefault("Test")
+		cfg.Files = config.FilesConfig{Naming: tt.naming, SlugLength: tt.slugLength}
+		got := Filename(cfg, tk)
+		if got != tt.want {
+			t.Errorf("Filename(%q, %d) = %q, want %q", tt.naming, tt.slugLength, got, tt.want)
+		}
+		if id, err := ExtractIDFromFilename(got); err != nil || id != tk.ID {
+			t.Errorf("ExtractIDFromFilename(%q) = %d, %v", got, id, err)
+		}
+	}
+
+	for name, want := range map[string]string{
+		"012-fix-login.md":            "fix-login",
+		"012.md":                      "",
+		"2026-03-02-012-fix-login.md": "fix-login",
+	} {
+		if got := SlugFromFilename(name); got != want {
+			t.Errorf("SlugFromFilename(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
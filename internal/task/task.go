@@ -10,6 +10,7 @@ import (
 // Task represents a kanban task parsed from a markdown file.
 type Task struct {
 	ID          int        `yaml:"id" json:"id"`
+	UID         string     `yaml:"uid,omitempty" json:"uid,omitempty"`
 	Title       string     `yaml:"title" json:"title"`
 	Status      string     `yaml:"status" json:"status"`
 	Priority    string     `yaml:"priority" json:"priority"`
@@ -18,6 +19,8 @@ type Task struct {
 	Started     *time.Time `yaml:"started,omitempty" json:"started,omitempty"`
 	Completed   *time.Time `yaml:"completed,omitempty" json:"completed,omitempty"`
 	Assignee    string     `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	Assigned    *time.Time `yaml:"assigned,omitempty" json:"assigned,omitempty"`
+	Reviewer    string     `yaml:"reviewer,omitempty" json:"reviewer,omitempty"`
 	Tags        []string   `yaml:"tags,omitempty" json:"tags,omitempty"`
 	Due         *date.Date `yaml:"due,omitempty" json:"due,omitempty"`
 	Estimate    string     `yaml:"estimate,omitempty" json:"estimate,omitempty"`
@@ -29,12 +32,62 @@ type Task struct {
 	ClaimedAt   *time.Time `yaml:"claimed_at,omitempty" json:"claimed_at,omitempty"`
 	Class       string     `yaml:"class,omitempty" json:"class,omitempty"`
 
+	// Goal names the goals.yml objective the task contributes to.
+	Goal string `yaml:"goal,omitempty" json:"goal,omitempty"`
+
+	// BlockedUntil is when a blocked task is expected to be unblocked.
+	BlockedUntil *date.Date `yaml:"blocked_until,omitempty" json:"blocked_until,omitempty"`
+	// BlockedOn is the external item (a URL or ticket key) a blocked task
+	// waits for, as opposed to the board tasks in DependsOn.
+	BlockedOn string `yaml:"blocked_on,omitempty" json:"blocked_on,omitempty"`
+	// Reopened counts the times the task was reopened: moved out of a
+	// completing status back into the flow.
+	Reopened int `yaml:"reopened,omitempty" json:"reopened,omitempty"`
+	// FocusUntil is when the focus timer running on the task ends; see
+	// Worklog for the time it recorded.
+	FocusUntil *time.Time `yaml:"focus_until,omitempty" json:"focus_until,omitempty"`
+	// Worklog lists the focus sessions spent on the task.
+	Worklog []WorkEntry `yaml:"worklog,omitempty" json:"worklog,omitempty"`
+
 	Branch   string `yaml:"branch,omitempty" json:"branch,omitempty"`
 	Worktree string `yaml:"worktree,omitempty" json:"worktree,omitempty"`
 
+	// Private marks a task whose body is stored encrypted (see security.recipients).
+	Private bool `yaml:"private,omitempty" json:"private,omitempty"`
+
+	// StatusHistory records each status the task entered and when, so time
+	// in status survives the activity log being pruned.
+	StatusHistory []StatusEntry `yaml:"status_history,omitempty" json:"status_history,omitempty"`
+
+	// BlockedByDependency is computed when listing (not in YAML): true when
+	// some dependency has not reached a terminal status. Unlike Blocked, it
+	// is never set by hand.
+	BlockedByDependency bool `yaml:"-" json:"blocked_by_dependency,omitempty"`
+
+	// Ready is computed when listing (not in YAML): true when the task can
+	// be started now, by the rule pick uses (see board.MarkReady).
+	Ready bool `yaml:"-" json:"ready,omitempty"`
+
+	// Workstream is computed when reading (not in YAML): the workstream
+	// whose tasks_dirs entry holds the file, empty for tasks_dir.
+	Workstream string `yaml:"-" json:"workstream,omitempty"`
+
 	// Body is the markdown content below the frontmatter (not in YAML).
 	Body string `yaml:"-" json:"body,omitempty"`
 
 	// File is the path to the task file (not in YAML).
 	File string `yaml:"-" json:"file,omitempty"`
 }
+
+// WorkEntry is one focus session on a task, in its worklog.
+type WorkEntry struct {
+	Start time.Time `yaml:"start" json:"start"`
+	End   time.Time `yaml:"end" json:"end"`
+	By    string    `yaml:"by,omitempty" json:"by,omitempty"`
+}
+
+// StatusEntry is a status a task entered, in its status history.
+type StatusEntry struct {
+	Status    string    `yaml:"status" json:"status"`
+	EnteredAt time.Time `yaml:"entered_at" json:"entered_at"`
+}
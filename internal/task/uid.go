@@ -0,0 +1,71 @@
+package task
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/antopolskiy/kanban-md/internal/clierr"
+)
+
+// uidRe matches a UUID in its canonical 8-4-4-4-12 hex form.
+var uidRe = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// NewUID returns a random (version 4) UUID for a new task.
+func NewUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])  // crypto/rand.Read never returns an error
+	b[6] = b[6]&0x0f | 0x40 //nolint:mnd // UUID version 4
+	b[8] = b[8]&0x3f | 0x80 //nolint:mnd // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// IsUID reports whether s has the form of a task UID.
+func IsUID(s string) bool {
+	return uidRe.MatchString(strings.TrimSpace(s))
+}
+
+// FindByUIDIn looks for the task with the given UID in each tasks
+// directory in turn, as FindByIDIn does.
+func FindByUIDIn(tasksDirs []string, uid string) (int, error) {
+	for i, dir := range tasksDirs {
+		if i > 0 && !dirExists(dir) {
+			continue
+		}
+		id, err := FindByUID(dir, uid)
+		var cliErr *clierr.Error
+		if !errors.As(err, &cliErr) || cliErr.Code != clierr.TaskNotFound {
+			return id, err
+		}
+	}
+	uid = strings.TrimSpace(uid)
+	return 0, clierr.Newf(clierr.TaskNotFound, "task not found: %s", uid).
+		WithDetails(map[string]any{"uid": uid})
+}
+
+// FindByUID scans the tasks directory for the task with the given UID and
+// returns its numeric ID.
+func FindByUID(tasksDir, uid string) (int, error) {
+	entries, err := readDir(tasksDir)
+	if err != nil {
+		return 0, fmt.Errorf("reading tasks directory: %w", err)
+	}
+	uid = strings.TrimSpace(uid)
+	for _, entry := range entries {
+		if !isTaskMarkdown(entry) {
+			continue
+		}
+		t, readErr := Read(filepath.Join(tasksDir, entry.Name()))
+		if readErr != nil {
+			continue
+		}
+		if strings.EqualFold(t.UID, uid) {
+			return t.ID, nil
+		}
+	}
+	return 0, clierr.Newf(clierr.TaskNotFound, "task not found: %s", uid).
+		WithDetails(map[string]any{"uid": uid})
+}
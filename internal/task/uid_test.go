@@ -0,0 +1,24 @@
+package task
+
+import "testing"
+
+func TestNewUID(t *testing.T) {
+	a, b := NewUID(), NewUID()
+	if !IsUID(a) || !IsUID(b) {
+		t.Fatalf("NewUID() = %q, %q; want UUIDs", a, b)
+	}
+	if a == b {
+		t.Error("NewUID() returned the same UID twice")
+	}
+	if a[14] != '4' {
+		t.Errorf("NewUID() = %q, want version 4", a)
+	}
+}
+
+func TestIsUID(t *testing.T) {
+	for _, s := range []string{"12", "API-12", "3f6c2a1e-8b4d-4c7a-9e2f", ""} {
+		if IsUID(s) {
+			t.Errorf("IsUID(%q) = true, want false", s)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package task
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/antopolskiy/kanban-md/internal/clierr"
@@ -49,6 +53,20 @@ func ValidateTaskID(input string) *clierr.Error {
 		WithDetails(map[string]any{"input": input})
 }
 
+// ParseID parses a task ID given as a number ("12") or with the board's ID
+// prefix ("API-12", case-insensitive).
+func ParseID(input, prefix string) (int, error) {
+	s := strings.TrimSpace(input)
+	if prefix != "" && len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		s = s[len(prefix):]
+	}
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, ValidateTaskID(input)
+	}
+	return id, nil
+}
+
 // ValidateSelfReference returns a CLIError for self-referencing dependency.
 func ValidateSelfReference(id int) *clierr.Error {
 	return clierr.Newf(clierr.SelfReference, "task cannot depend on itself (ID %d)", id).
@@ -106,6 +124,34 @@ func ValidateClaimRequired(status string) *clierr.Error {
 		})
 }
 
+// ValidateEstimateRequired returns a CLIError when a task without an
+// estimate moves into a status listed in require_estimate_for.
+func ValidateEstimateRequired(t *Task, status string) *clierr.Error {
+	return clierr.Newf(clierr.EstimateRequired,
+		"status %q requires an estimate; set one with 'edit %d --estimate' or pass --force", status, t.ID).
+		WithDetails(map[string]any{
+			"id":     t.ID,
+			"status": status,
+		})
+}
+
+// ValidateReviewerRequired returns a CLIError when a task moves into a
+// require_reviewer status without a reviewer, or with its assignee as the
+// reviewer.
+func ValidateReviewerRequired(t *Task, status string) *clierr.Error {
+	err := clierr.Newf(clierr.ReviewerRequired, "status %q requires a reviewer; set one with --reviewer NAME", status)
+	if t.Reviewer != "" {
+		err = clierr.Newf(clierr.ReviewerRequired, "status %q requires a reviewer other than the assignee %q", status, t.Assignee)
+	}
+	return err.
+		WithDetails(map[string]any{
+			"id":       t.ID,
+			"status":   status,
+			"assignee": t.Assignee,
+			"reviewer": t.Reviewer,
+		})
+}
+
 // ValidateTaskClaimed returns a CLIError when a task is claimed by another agent.
 func ValidateTaskClaimed(id int, claimedBy, remaining string) *clierr.Error {
 	return clierr.Newf(clierr.TaskClaimed,
@@ -118,6 +164,22 @@ func ValidateTaskClaimed(id int, claimedBy, remaining string) *clierr.Error {
 		})
 }
 
+// ValidateGoal checks that a goal is one of the board's goals.
+func ValidateGoal(goal string, allowed []string) error {
+	if slices.Contains(allowed, goal) {
+		return nil
+	}
+	msg := fmt.Sprintf("unknown goal %q", goal)
+	if len(allowed) == 0 {
+		msg += " (the board has no goals.yml)"
+	}
+	return clierr.New(clierr.InvalidInput, msg).
+		WithDetails(map[string]any{
+			"goal":    goal,
+			"allowed": allowed,
+		})
+}
+
 // ValidateClassWIPExceeded returns a CLIError for class-level WIP limit violations.
 func ValidateClassWIPExceeded(class string, limit, current int) *clierr.Error {
 	return clierr.Newf(clierr.ClassWIPExceeded,
@@ -129,6 +191,47 @@ func ValidateClassWIPExceeded(class string, limit, current int) *clierr.Error {
 		})
 }
 
+// ValidateChildrenIncomplete returns a CLIError when a parent is moved to a
+// terminal status while some of its children are still open.
+func ValidateChildrenIncomplete(id int, status string, incomplete []int) *clierr.Error {
+	return clierr.Newf(clierr.ChildrenIncomplete,
+		"task #%d has %d incomplete child task(s); finish them first or use --force to move to %s",
+		id, len(incomplete), status).
+		WithDetails(map[string]any{
+			"id":         id,
+			"status":     status,
+			"incomplete": incomplete,
+		})
+}
+
+// ValidateHasChildren returns a CLIError when a task is deleted while it
+// still has children.
+func ValidateHasChildren(id int, children []int) *clierr.Error {
+	return clierr.Newf(clierr.HasChildren,
+		"task #%d has %d child task(s); use --cascade to delete them too or --orphan to detach them",
+		id, len(children)).
+		WithDetails(map[string]any{
+			"id":       id,
+			"children": children,
+		})
+}
+
+// ValidateParentCycle returns a CLIError when assigning a parent would make a
+// task its own ancestor. chain runs from the proposed parent up to the task.
+func ValidateParentCycle(id, parent int, chain []int) *clierr.Error {
+	parts := make([]string, len(chain))
+	for i, c := range chain {
+		parts[i] = "#" + strconv.Itoa(c)
+	}
+	return clierr.Newf(clierr.ParentCycle,
+		"cannot set parent of #%d to #%d: parent chain would loop (%s)", id, parent, strings.Join(parts, " -> ")).
+		WithDetails(map[string]any{
+			"id":     id,
+			"parent": parent,
+			"chain":  chain,
+		})
+}
+
 // CheckClaim verifies that a mutating operation is allowed on a claimed task.
 // If the task is unclaimed, claimed by the same agent, or expired, the operation
 // proceeds. Otherwise, returns a TaskClaimed error.
@@ -144,20 +247,26 @@ func CheckClaim(t *Task, claimant string, timeout time.Duration) error {
 		t.ClaimedAt = nil
 		return nil
 	}
-	remaining := "unknown"
 	if timeout > 0 && t.ClaimedAt != nil {
-		remaining = (timeout - time.Since(*t.ClaimedAt)).Truncate(time.Minute).String()
+		left := timeout - time.Since(*t.ClaimedAt)
+		return ValidateTaskClaimed(t.ID, t.ClaimedBy, left.Truncate(time.Minute).String()).WithRetryAfter(left)
 	}
-	return ValidateTaskClaimed(t.ID, t.ClaimedBy, remaining)
+	return ValidateTaskClaimed(t.ID, t.ClaimedBy, "unknown")
 }
 
 // ValidateDependencyIDs checks that all dependency IDs exist and none are self-referencing.
 func ValidateDependencyIDs(tasksDir string, selfID int, ids []int) error {
+	return ValidateDependencyIDsIn([]string{tasksDir}, selfID, ids)
+}
+
+// ValidateDependencyIDsIn is ValidateDependencyIDs looking in several tasks
+// directories (see FindByIDIn).
+func ValidateDependencyIDsIn(tasksDirs []string, selfID int, ids []int) error {
 	for _, depID := range ids {
 		if depID == selfID {
 			return ValidateSelfReference(depID)
 		}
-		if _, err := FindByID(tasksDir, depID); err != nil {
+		if _, err := FindByIDIn(tasksDirs, depID); err != nil {
 			return ValidateDependencyNotFound(depID)
 		}
 	}
@@ -80,6 +80,34 @@ func TestValidateTaskID(t *testing.T) {
 	}
 }
 
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		input, prefix string
+		want          int
+		wantErr       bool
+	}{
+		{"12", "", 12, false},
+		{"12", "API-", 12, false},
+		{"API-12", "API-", 12, false},
+		{"api-12", "API-", 12, false},
+		{" API-3 ", "API-", 3, false},
+		{"API-12", "", 0, true},
+		{"WEB-12", "API-", 0, true},
+		{"API-", "API-", 0, true},
+		{"abc", "API-", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseID(tt.input, tt.prefix)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseID(%q, %q) error = %v, wantErr %v", tt.input, tt.prefix, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseID(%q, %q) = %d, want %d", tt.input, tt.prefix, got, tt.want)
+		}
+	}
+}
+
 func TestValidateSelfReference(t *testing.T) {
 	err := ValidateSelfReference(42)
 	if err.Code != clierr.SelfReference {
@@ -288,6 +316,9 @@ func TestCheckClaimErrorDetails(t *testing.T) {
 	if remaining == "" || remaining == remainingUnknown {
 		t.Errorf("details[remaining] = %q, want a non-empty duration string", remaining)
 	}
+	if !cliErr.Retryable() || cliErr.RetryAfter <= 49*time.Minute || cliErr.RetryAfter > 50*time.Minute {
+		t.Errorf("retryable = %v, retry after %v; want retryable after about 50m", cliErr.Retryable(), cliErr.RetryAfter)
+	}
 }
 
 func TestCheckClaimRemainingUnknown(t *testing.T) {
@@ -309,6 +340,9 @@ func TestCheckClaimRemainingUnknown(t *testing.T) {
 	if cliErr.Details["remaining"] != remainingUnknown {
 		t.Errorf("details[remaining] = %v, want %q", cliErr.Details["remaining"], remainingUnknown)
 	}
+	if cliErr.RetryAfter != 0 {
+		t.Errorf("RetryAfter = %v, want 0 (unknown)", cliErr.RetryAfter)
+	}
 }
 
 func TestValidateTaskClaimedMessageSuggestsReclaimFlag(t *testing.T) {
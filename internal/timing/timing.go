@@ -0,0 +1,108 @@
+// Package timing collects per-phase durations and counters so slow
+// commands on large boards can be diagnosed with --timing.
+package timing
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Phases reported by --timing, in report order.
+const (
+	Scan   = "scan"   // listing the tasks directory
+	Parse  = "parse"  // reading and parsing task files
+	Filter = "filter" // filtering and sorting tasks
+	Write  = "write"  // writing task files
+)
+
+var phaseOrder = []string{Scan, Parse, Filter, Write}
+
+// Phase accumulates the time spent in one phase.
+type Phase struct {
+	Duration time.Duration
+	Calls    int
+	Items    int
+}
+
+var (
+	mu      sync.Mutex
+	enabled bool
+	started time.Time
+	phases  = map[string]*Phase{}
+)
+
+// Enable starts collecting timings. Until it is called, Track and Count do
+// nothing.
+func Enable() {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = true
+	started = time.Now()
+	phases = map[string]*Phase{}
+}
+
+// Enabled reports whether timings are being collected.
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return enabled
+}
+
+// Track starts timing one call of phase and returns a function that stops
+// it. Typical use: defer timing.Track(timing.Parse)().
+func Track(phase string) func() {
+	if !Enabled() {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+		mu.Lock()
+		defer mu.Unlock()
+		p := get(phase)
+		p.Duration += elapsed
+		p.Calls++
+	}
+}
+
+// Count adds n items (files, directory entries, tasks) to phase.
+func Count(phase string, n int) {
+	mu.Lock()
+	defer mu.Unlock()
+	if enabled {
+		get(phase).Items += n
+	}
+}
+
+func get(phase string) *Phase {
+	p, ok := phases[phase]
+	if !ok {
+		p = &Phase{}
+		phases[phase] = p
+	}
+	return p
+}
+
+// Report writes the collected timings and the total elapsed time since
+// Enable. Nothing is written when timings are disabled.
+func Report(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	fmt.Fprintf(w, "timing: total %s\n", round(time.Since(started)))
+	for _, name := range phaseOrder {
+		p, ok := phases[name]
+		if !ok {
+			p = &Phase{}
+		}
+		fmt.Fprintf(w, "  %-7s %10s  %4d calls  %6d items\n", name, round(p.Duration), p.Calls, p.Items)
+	}
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
@@ -0,0 +1,47 @@
+package timing
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDisabledRecordsNothing(t *testing.T) {
+	mu.Lock()
+	enabled = false
+	mu.Unlock()
+
+	Track(Parse)()
+	Count(Parse, 3)
+
+	var buf strings.Builder
+	Report(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("Report while disabled = %q, want empty", buf.String())
+	}
+}
+
+func TestReport(t *testing.T) {
+	Enable()
+	t.Cleanup(func() {
+		mu.Lock()
+		enabled = false
+		mu.Unlock()
+	})
+
+	Track(Parse)()
+	Track(Parse)()
+	Count(Parse, 2)
+	Count(Scan, 5)
+
+	var buf strings.Builder
+	Report(&buf)
+	out := buf.String()
+	if !strings.HasPrefix(out, "timing: total ") {
+		t.Errorf("missing total line:\n%s", out)
+	}
+	for _, want := range []string{"parse", "2 calls", "5 items", "write"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -2,10 +2,12 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +21,9 @@ import (
 
 	"github.com/antopolskiy/kanban-md/internal/board"
 	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/crypt"
+	"github.com/antopolskiy/kanban-md/internal/filelock"
+	"github.com/antopolskiy/kanban-md/internal/i18n"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -33,6 +38,10 @@ const (
 	viewHelp
 	viewCreate
 	viewDebug
+	viewQuickAdd
+	viewJump
+	viewDeps
+	viewConflict
 )
 
 // Key and layout constants.
@@ -76,10 +85,25 @@ type Board struct {
 	width     int
 	height    int
 	err       error
+	notice    string // shown in the status bar until the next key
 	// hideEmptyColumns controls whether status columns with zero visible tasks
 	// are removed from the board view.
 	hideEmptyColumns bool
-	now              func() time.Time // clock for duration display; defaults to time.Now
+	now              func() time.Time    // clock for duration display; defaults to time.Now
+	loc              *time.Location      // time zone for rendering timestamps
+	readOnly         bool                // reject keys that modify the board
+	keys             map[string][]string // action -> keys, from cfg.TUIKeys
+	actions          map[string]string   // key -> action
+	collapsed        map[string]bool     // statuses whose columns are collapsed
+	copyText         func(string) error  // clipboard writer; defaults to copyToClipboard
+	emptyBoard       bool                // no tasks at all, archived included; shows the onboarding screen
+
+	// Render caches, so a keystroke on a huge board only renders what
+	// changed. See cache.go.
+	taskHashes     map[*task.Task]uint64
+	loadedHash     uint64 // boardHash of the tasks the columns were built from
+	cardCache      map[cardKey]string
+	cardLinesCache map[cardKey][]string
 
 	// Detail view.
 	detailTask      *task.Task
@@ -103,6 +127,20 @@ type Board struct {
 	createTitleInput  textinput.Model
 	createBodyInput   textarea.Model
 	createTagsInput   textinput.Model
+	editBase          *task.Task // the edited task as it was when editing began
+	editTheirs        *task.Task // the file's version in a conflict; nil if deleted
+	conflictDiff      bool       // whether the conflict prompt shows the diff
+
+	// Quick add reuses createStatus and createTitleInput.
+	quickErr error
+
+	// Jump to task: the ID typed so far.
+	jumpDigits string
+
+	// Dependency dialog, opened from the detail view.
+	depsInput  textinput.Model
+	depsCursor int
+	depsErr    error
 }
 
 // column groups tasks belonging to a single status.
@@ -117,7 +155,22 @@ func NewBoard(cfg *config.Config) *Board {
 	b := &Board{
 		cfg:              cfg,
 		now:              time.Now,
+		loc:              cfg.DisplayLocation(),
+		readOnly:         cfg.Board.ReadOnly,
 		hideEmptyColumns: cfg.TUI.HideEmptyColumns,
+		keys:             cfg.TUIKeys(),
+		actions:          make(map[string]string),
+		collapsed:        make(map[string]bool),
+		copyText:         copyToClipboard,
+	}
+	b.resetCardCache()
+	for _, s := range cfg.TUI.CollapsedColumns {
+		b.collapsed[s] = true
+	}
+	for action, keys := range b.keys {
+		for _, k := range keys {
+			b.actions[k] = action
+		}
 	}
 	b.loadTasks()
 	return b
@@ -128,15 +181,31 @@ func (b *Board) SetNow(fn func() time.Time) {
 	b.now = fn
 }
 
+// SetLocation sets the time zone used to render timestamps.
+func (b *Board) SetLocation(loc *time.Location) {
+	b.loc = loc
+}
+
+// SetClipboard overrides how yanked text reaches the clipboard (for testing).
+func (b *Board) SetClipboard(fn func(string) error) {
+	b.copyText = fn
+}
+
+// SetReadOnly controls whether keys that modify the board are rejected.
+func (b *Board) SetReadOnly(v bool) {
+	b.readOnly = v
+}
+
 // SetHideEmptyColumns controls whether empty status columns are shown.
 func (b *Board) SetHideEmptyColumns(v bool) {
 	b.hideEmptyColumns = v
+	b.loadedHash = 0
 	b.loadTasks()
 }
 
 // Init implements tea.Model.
 func (b *Board) Init() tea.Cmd {
-	return tickCmd()
+	return tickCmd(b.focusTask() != nil)
 }
 
 // Update implements tea.Model.
@@ -147,14 +216,16 @@ func (b *Board) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		b.width = msg.Width
 		b.height = msg.Height
+		b.resetCardCache()
 		b.applyCreateInputLayout()
 		return b, nil
 	case ReloadMsg:
 		b.loadTasks()
 		b.refreshDetailTask()
+		b.checkEditConflict()
 		return b, nil
 	case TickMsg:
-		return b, tickCmd()
+		return b, tickCmd(b.focusTask() != nil)
 	case errMsg:
 		b.err = msg.err
 		return b, nil
@@ -181,6 +252,14 @@ func (b *Board) View() string {
 		return b.viewCreateDialog()
 	case viewDebug:
 		return b.viewDebugScreen()
+	case viewQuickAdd:
+		return b.viewQuickAddDialog()
+	case viewJump:
+		return b.viewBoard()
+	case viewDeps:
+		return b.viewDepsDialog()
+	case viewConflict:
+		return b.viewConflictDialog()
 	default:
 		return b.viewBoard()
 	}
@@ -191,6 +270,7 @@ func (b *Board) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if key.Matches(msg, key.NewBinding(key.WithKeys("ctrl+c"))) {
 		return b, tea.Quit
 	}
+	b.notice = ""
 
 	switch b.view {
 	case viewBoard:
@@ -207,64 +287,110 @@ func (b *Board) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return b.handleCreateKey(msg)
 	case viewDebug:
 		return b.handleDebugKey(msg)
+	case viewQuickAdd:
+		return b.handleQuickAddKey(msg)
+	case viewJump:
+		return b.handleJumpKey(msg)
+	case viewDeps:
+		return b.handleDepsKey(msg)
+	case viewConflict:
+		return b.handleConflictKey(msg)
 	}
 
 	return b, nil
 }
 
 func (b *Board) handleBoardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "q", keyEsc:
+	action := b.actions[msg.String()]
+	if b.readOnly && isMutatingAction(action) {
+		b.err = errReadOnly
+		return b, nil
+	}
+
+	switch action {
+	case "quit":
 		return b, tea.Quit
-	case "?":
+	case "help":
 		b.view = viewHelp
-	case "h", keyLeft, "l", keyRight, "j", keyDown, "k", keyUp:
-		b.handleNavigation(msg.String())
-	case keyEnter:
+	case "left", "right", "down", "up":
+		b.handleNavigation(action)
+	case "open":
 		b.handleEnter()
-	case "m":
+	case "move":
 		b.handleMoveStart()
-	case "n":
+	case "next_status":
 		return b.moveNext()
-	case "p":
+	case "prev_status":
 		return b.movePrev()
-	case "+", "=":
+	case "raise_priority":
 		return b.raisePriority()
-	case "-", "_":
+	case "lower_priority":
 		return b.lowerPriority()
-	case "c":
+	case "create":
 		b.handleCreateStart()
-	case "e":
+	case "quick_add":
+		b.handleQuickAddStart()
+	case "edit":
 		b.handleEditStart()
-	case "d":
+	case "delete":
 		b.handleDeleteStart()
-	case "r":
+	case "jump":
+		b.handleJumpStart()
+	case "collapse":
+		b.toggleCollapse()
+	case "yank":
+		b.yank(false)
+	case "yank_path":
+		b.yank(true)
+	case "refresh":
 		b.loadTasks()
-	case "ctrl+d":
+	case "sample_tasks":
+		b.addSampleTasks()
+	case "debug":
 		b.view = viewDebug
 	}
 	return b, nil
 }
 
-func (b *Board) handleNavigation(k string) {
-	switch k {
-	case "h", keyLeft:
+// bindsKey reports whether k triggers an action on the board view, so a
+// surrounding workspace leaves it to the board.
+func (b *Board) bindsKey(k string) bool {
+	_, ok := b.actions[k]
+	return ok
+}
+
+// errReadOnly is shown when a modifying key is pressed on a read-only board.
+var errReadOnly = errors.New("board is read-only") //nolint:gochecknoglobals // sentinel error
+
+// isMutatingAction reports whether a board-view action modifies tasks.
+func isMutatingAction(action string) bool {
+	switch action {
+	case "move", "next_status", "prev_status", "raise_priority", "lower_priority",
+		"create", "quick_add", "edit", "delete", "sample_tasks":
+		return true
+	}
+	return false
+}
+
+func (b *Board) handleNavigation(action string) {
+	switch action {
+	case "left":
 		if b.activeCol > 0 {
 			b.activeCol--
 			b.clampRow()
 		}
-	case "l", keyRight:
+	case "right":
 		if b.activeCol < len(b.columns)-1 {
 			b.activeCol++
 			b.clampRow()
 		}
-	case "j", keyDown:
+	case "down":
 		col := b.currentColumn()
-		if col != nil && b.activeRow < len(col.tasks)-1 {
+		if col != nil && !b.collapsed[col.status] && b.activeRow < len(col.tasks)-1 {
 			b.activeRow++
 			b.ensureVisible()
 		}
-	case "k", keyUp:
+	case "up":
 		if b.activeRow > 0 {
 			b.activeRow--
 			b.ensureVisible()
@@ -274,7 +400,7 @@ func (b *Board) handleNavigation(k string) {
 
 func (b *Board) handleEnter() {
 	if t := b.selectedTask(); t != nil {
-		b.detailTask = t
+		b.detailTask = revealed(t)
 		b.detailScrollOff = 0
 		b.view = viewDetail
 	}
@@ -293,6 +419,13 @@ func (b *Board) handleMoveStart() {
 
 func (b *Board) handleDeleteStart() {
 	if t := b.selectedTask(); t != nil {
+		// Deleting a parent needs the CLI's --cascade or --orphan.
+		all, _, _ := task.ReadAllLenient(b.cfg.TasksPaths()...)
+		if kids := board.Children(all, t.ID); len(kids) > 0 {
+			b.err = fmt.Errorf("task %s has %d child task(s); delete it with kanban-md delete --cascade or --orphan",
+				b.cfg.FormatID(t.ID), len(kids))
+			return
+		}
 		b.deleteID = t.ID
 		b.deleteTitle = t.Title
 		b.view = viewConfirmDelete
@@ -322,17 +455,23 @@ func (b *Board) handleEditStart() {
 	if t == nil {
 		return
 	}
+	body, err := crypt.Open(t)
+	if err != nil {
+		b.err = fmt.Errorf("task %s is private: %w", b.cfg.FormatID(t.ID), err)
+		return
+	}
 
 	b.initCreateInputs()
 	b.createIsEdit = true
 	b.createEditID = t.ID
+	b.editBase = t
 	b.createStatus = t.Status
 	b.createStep = stepTitle
 	b.createPriority = b.cfg.PriorityIndex(t.Priority)
 	if b.createPriority < 0 {
 		b.createPriority = b.defaultPriorityIndex()
 	}
-	bodyText := strings.TrimSuffix(t.Body, "\n")
+	bodyText := strings.TrimSuffix(body, "\n")
 	tagText := strings.Join(t.Tags, ",")
 	b.createTitleInput.SetValue(t.Title)
 	b.createBodyInput.SetValue(bodyText)
@@ -356,6 +495,8 @@ func (b *Board) defaultPriorityIndex() int {
 func (b *Board) resetCreateState() {
 	b.createIsEdit = false
 	b.createEditID = 0
+	b.editBase = nil
+	b.editTheirs = nil
 	b.createTitleInput.SetValue("")
 	b.createBodyInput.SetValue("")
 	b.createTagsInput.SetValue("")
@@ -520,48 +661,64 @@ func (b *Board) executeCreate() (tea.Model, tea.Cmd) {
 		return b, nil
 	}
 
-	body := strings.TrimSpace(b.createBodyInput.Value())
+	b.createTask(&task.Task{
+		Title:    title,
+		Status:   b.createStatus,
+		Priority: b.selectedCreatePriority(),
+		Tags:     parseTagsCSV(b.createTagsInput.Value()),
+		Body:     strings.TrimSpace(b.createBodyInput.Value()),
+	})
+	return b, nil
+}
 
-	priority := b.selectedCreatePriority()
-	tags := parseTagsCSV(b.createTagsInput.Value())
+// createTask fills in t's ID, UID, class, timestamps, and checklist, writes
+// it, closes the create dialog, and selects the new task.
+func (b *Board) createTask(t *task.Task) {
+	// Other writers may have created tasks since the config was loaded.
+	unlock, err := filelock.Lock(filepath.Join(b.cfg.Dir(), ".lock"))
+	if err != nil {
+		b.resetCreateState()
+		b.view = viewBoard
+		b.err = fmt.Errorf("acquiring lock: %w", err)
+		return
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock
+	if err := board.SyncNextID(b.cfg); err != nil {
+		b.resetCreateState()
+		b.view = viewBoard
+		b.err = fmt.Errorf("creating task: %w", err)
+		return
+	}
 
 	now := b.now()
 	id := b.cfg.NextID
-	t := &task.Task{
-		ID:       id,
-		Title:    title,
-		Status:   b.createStatus,
-		Priority: priority,
-		Class:    b.cfg.Defaults.Class,
-		Tags:     tags,
-		Body:     body,
-		Created:  now,
-		Updated:  now,
-	}
+	t.ID = id
+	t.UID = task.NewUID()
+	t.Class = b.cfg.Defaults.Class
+	t.Created = now
+	t.Updated = now
+	task.RecordStatus(t, t.Status, now)
+	task.RecordAssigned(t, now)
+	task.ApplyChecklist(t, b.cfg)
 
-	slug := task.GenerateSlug(title)
-	filename := task.GenerateFilename(id, slug)
-	path := filepath.Join(b.cfg.TasksPath(), filename)
+	path := filepath.Join(b.cfg.TasksPath(), task.Filename(b.cfg, t))
 
+	b.resetCreateState()
+	b.view = viewBoard
 	if err := task.Write(path, t); err != nil {
 		b.err = fmt.Errorf("creating task: %w", err)
-		b.resetCreateState()
-		b.view = viewBoard
-		return b, nil
+		return
 	}
 
 	b.cfg.NextID++
 	if err := b.cfg.Save(); err != nil {
 		b.err = fmt.Errorf("saving config after create: %w", err)
 	} else {
-		board.LogMutation(b.cfg.Dir(), "create", id, title)
+		board.LogMutation(b.cfg.Dir(), "create", id, t.Title)
 	}
 
-	b.resetCreateState()
-	b.view = viewBoard
 	b.loadTasks()
 	b.selectTaskByID(id)
-	return b, nil
 }
 
 func (b *Board) executeEdit() (tea.Model, tea.Cmd) {
@@ -572,9 +729,13 @@ func (b *Board) executeEdit() (tea.Model, tea.Cmd) {
 		return b, nil
 	}
 
-	path, err := task.FindByID(b.cfg.TasksPath(), b.createEditID)
+	if b.checkEditConflict() {
+		return b, nil
+	}
+
+	path, err := task.FindByIDIn(b.cfg.TasksPaths(), b.createEditID)
 	if err != nil {
-		b.err = fmt.Errorf("finding task #%d: %w", b.createEditID, err)
+		b.err = fmt.Errorf("finding task %s: %w", b.cfg.FormatID(b.createEditID), err)
 		b.resetCreateState()
 		b.view = viewBoard
 		return b, nil
@@ -582,7 +743,7 @@ func (b *Board) executeEdit() (tea.Model, tea.Cmd) {
 
 	tk, err := task.Read(path)
 	if err != nil {
-		b.err = fmt.Errorf("reading task #%d: %w", b.createEditID, err)
+		b.err = fmt.Errorf("reading task %s: %w", b.cfg.FormatID(b.createEditID), err)
 		b.resetCreateState()
 		b.view = viewBoard
 		return b, nil
@@ -594,9 +755,18 @@ func (b *Board) executeEdit() (tea.Model, tea.Cmd) {
 	tk.Priority = b.selectedCreatePriority()
 	tk.Tags = parseTagsCSV(b.createTagsInput.Value())
 	tk.Updated = b.now()
+	if tk.Private {
+		tk.Private = false
+		if err := crypt.Seal(tk, b.cfg.Security.Recipients); err != nil {
+			b.err = fmt.Errorf("encrypting task %s: %w", b.cfg.FormatID(b.createEditID), err)
+			b.resetCreateState()
+			b.view = viewBoard
+			return b, nil
+		}
+	}
 
-	if _, err := writeTaskAndRename(path, tk, oldTitle); err != nil {
-		b.err = fmt.Errorf("editing task #%d: %w", b.createEditID, err)
+	if _, err := writeTaskAndRename(b.cfg, path, tk, oldTitle); err != nil {
+		b.err = fmt.Errorf("editing task %s: %w", b.cfg.FormatID(b.createEditID), err)
 	} else {
 		board.LogMutation(b.cfg.Dir(), "edit", tk.ID, tk.Title)
 	}
@@ -628,12 +798,10 @@ func parseTagsCSV(raw string) []string {
 	return tags
 }
 
-func writeTaskAndRename(path string, t *task.Task, oldTitle string) (string, error) {
+func writeTaskAndRename(cfg *config.Config, path string, t *task.Task, oldTitle string) (string, error) {
 	newPath := path
 	if t.Title != oldTitle {
-		slug := task.GenerateSlug(t.Title)
-		filename := task.GenerateFilename(t.ID, slug)
-		newPath = filepath.Join(filepath.Dir(path), filename)
+		newPath = filepath.Join(filepath.Dir(path), task.Filename(cfg, t))
 	}
 
 	if err := task.Write(newPath, t); err != nil {
@@ -675,6 +843,8 @@ func (b *Board) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if b.detailScrollOff > 0 {
 			b.detailScrollOff--
 		}
+	case "D":
+		b.handleDepsStart()
 	case "g":
 		b.detailScrollOff = 0
 	case "G":
@@ -727,12 +897,13 @@ func (b *Board) handleDebugKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // loadTasks reads all tasks and organizes them into columns.
 func (b *Board) loadTasks() {
-	tasks, _, err := task.ReadAllLenient(b.cfg.TasksPath())
+	tasks, _, err := task.ReadAllLenient(b.cfg.TasksPaths()...)
 	if err != nil {
 		b.err = err
 		return
 	}
 	b.err = nil
+	b.emptyBoard = len(tasks) == 0
 
 	// Filter out archived tasks from TUI display.
 	var visibleTasks []*task.Task
@@ -741,7 +912,24 @@ func (b *Board) loadTasks() {
 			visibleTasks = append(visibleTasks, t)
 		}
 	}
+	board.MarkDependencyBlocked(b.cfg, visibleTasks, tasks)
+
+	// A reload that read the same tasks keeps the sorted columns.
+	hashes := make(map[*task.Task]uint64, len(visibleTasks))
+	ordered := make([]uint64, len(visibleTasks))
+	for i, t := range visibleTasks {
+		ordered[i] = taskHash(t)
+		hashes[t] = ordered[i]
+	}
+	h := boardHash(ordered)
+	if h == b.loadedHash && b.columns != nil {
+		b.clampRow()
+		return
+	}
+	b.loadedHash = h
 	b.tasks = visibleTasks
+	b.taskHashes = hashes
+	b.pruneCardCache()
 
 	// Sort tasks by priority (higher priority first).
 	board.Sort(visibleTasks, "priority", true, b.cfg)
@@ -768,23 +956,44 @@ func (b *Board) loadTasks() {
 		}
 	}
 
+	// Columns keep their scroll position across reloads.
+	scrollOffs := make(map[string]int, len(b.columns))
+	for _, col := range b.columns {
+		scrollOffs[col.status] = col.scrollOff
+	}
 	b.columns = make([]column, len(displayStatuses))
+	index := make(map[string]int, len(displayStatuses))
 	for i, status := range displayStatuses {
-		b.columns[i] = column{status: status}
+		b.columns[i] = column{status: status, scrollOff: scrollOffs[status]}
+		index[status] = i
 	}
 
 	for _, t := range visibleTasks {
-		for i := range b.columns {
-			if b.columns[i].status == t.Status {
-				b.columns[i].tasks = append(b.columns[i].tasks, t)
-				break
-			}
+		if i, ok := index[t.Status]; ok {
+			b.columns[i].tasks = append(b.columns[i].tasks, t)
 		}
 	}
+	for i := range b.columns {
+		b.columns[i].scrollOff = min(b.columns[i].scrollOff, max(len(b.columns[i].tasks)-1, 0))
+	}
+	if b.emptyBoard {
+		b.selectDefaultColumn()
+	}
 
 	b.clampRow()
 }
 
+// revealed returns a copy of t for display, with a private body decrypted or
+// redacted. The copy must never be written back.
+func revealed(t *task.Task) *task.Task {
+	if !t.Private {
+		return t
+	}
+	c := *t
+	crypt.Reveal(&c)
+	return &c
+}
+
 // refreshDetailTask updates the detail view task pointer after a reload.
 // If the task was deleted or moved to an archived status, it closes the detail view.
 func (b *Board) refreshDetailTask() {
@@ -794,7 +1003,7 @@ func (b *Board) refreshDetailTask() {
 	id := b.detailTask.ID
 	for _, t := range b.tasks {
 		if t.ID == id {
-			b.detailTask = t
+			b.detailTask = revealed(t)
 			return
 		}
 	}
@@ -813,7 +1022,7 @@ func (b *Board) currentColumn() *column {
 
 func (b *Board) selectedTask() *task.Task {
 	col := b.currentColumn()
-	if col == nil || len(col.tasks) == 0 {
+	if col == nil || len(col.tasks) == 0 || b.collapsed[col.status] {
 		return nil
 	}
 	if b.activeRow >= 0 && b.activeRow < len(col.tasks) {
@@ -836,7 +1045,7 @@ func (b *Board) clampRow() {
 	}
 
 	col := b.currentColumn()
-	if col == nil || len(col.tasks) == 0 {
+	if col == nil || len(col.tasks) == 0 || b.collapsed[col.status] {
 		b.activeRow = 0
 		return
 	}
@@ -957,7 +1166,7 @@ func (b *Board) moveNext() (tea.Model, tea.Cmd) {
 	boardStatuses := b.cfg.BoardStatuses()
 	idx := indexOf(boardStatuses, t.Status)
 	if idx < 0 || idx >= len(boardStatuses)-1 {
-		b.err = fmt.Errorf("task #%d is already at the last status", t.ID)
+		b.err = fmt.Errorf("task %s is already at the last status", b.cfg.FormatID(t.ID))
 		return b, nil
 	}
 
@@ -974,7 +1183,7 @@ func (b *Board) movePrev() (tea.Model, tea.Cmd) {
 	boardStatuses := b.cfg.BoardStatuses()
 	idx := indexOf(boardStatuses, t.Status)
 	if idx <= 0 {
-		b.err = fmt.Errorf("task #%d is already at the first status", t.ID)
+		b.err = fmt.Errorf("task %s is already at the first status", b.cfg.FormatID(t.ID))
 		return b, nil
 	}
 
@@ -990,7 +1199,7 @@ func (b *Board) raisePriority() (tea.Model, tea.Cmd) {
 
 	idx := b.cfg.PriorityIndex(t.Priority)
 	if idx < 0 || idx >= len(b.cfg.Priorities)-1 {
-		b.err = fmt.Errorf("task #%d is already at the highest priority", t.ID)
+		b.err = fmt.Errorf("task %s is already at the highest priority", b.cfg.FormatID(t.ID))
 		return b, nil
 	}
 
@@ -1006,7 +1215,7 @@ func (b *Board) lowerPriority() (tea.Model, tea.Cmd) {
 
 	idx := b.cfg.PriorityIndex(t.Priority)
 	if idx <= 0 {
-		b.err = fmt.Errorf("task #%d is already at the lowest priority", t.ID)
+		b.err = fmt.Errorf("task %s is already at the lowest priority", b.cfg.FormatID(t.ID))
 		return b, nil
 	}
 
@@ -1020,7 +1229,7 @@ func (b *Board) executePriorityChange(t *task.Task, newPriority string) (tea.Mod
 	t.Updated = time.Now()
 
 	if err := task.Write(t.File, t); err != nil {
-		b.err = fmt.Errorf("updating priority for task #%d: %w", taskID, err)
+		b.err = fmt.Errorf("updating priority for task %s: %w", b.cfg.FormatID(taskID), err)
 		t.Priority = oldPriority // revert
 		return b, nil
 	}
@@ -1063,16 +1272,28 @@ func (b *Board) executeMove(targetStatus string) (tea.Model, tea.Cmd) {
 		b.view = viewBoard
 		return b, nil
 	}
+	if incomplete := b.incompleteChildren(t.ID, targetStatus); len(incomplete) > 0 {
+		b.err = fmt.Errorf("task %s has %d incomplete child task(s); finish them first",
+			b.cfg.FormatID(t.ID), len(incomplete))
+		b.view = viewBoard
+		return b, nil
+	}
 
 	oldStatus := t.Status
 	t.Status = targetStatus
 	task.UpdateTimestamps(t, oldStatus, targetStatus, b.cfg)
+	task.ApplyChecklist(t, b.cfg)
 
 	if err := task.Write(t.File, t); err != nil {
-		b.err = fmt.Errorf("moving task #%d: %w", t.ID, err)
+		b.err = fmt.Errorf("moving task %s: %w", b.cfg.FormatID(t.ID), err)
 		t.Status = oldStatus // revert
 	} else {
 		board.LogMutation(b.cfg.Dir(), "move", t.ID, oldStatus+" -> "+targetStatus)
+		if b.cfg.IsTerminalStatus(targetStatus) {
+			if _, err := board.ApplyOnUnblock(b.cfg, t.ID, b.now()); err != nil {
+				b.err = fmt.Errorf("dependency automation: %w", err)
+			}
+		}
 	}
 
 	b.view = viewBoard
@@ -1080,17 +1301,34 @@ func (b *Board) executeMove(targetStatus string) (tea.Model, tea.Cmd) {
 	return b, nil
 }
 
+// incompleteChildren returns the open children of task id that keep it
+// from moving to status, as move does without --force: a parent cannot be
+// completed before its children, though it can be archived.
+func (b *Board) incompleteChildren(id int, status string) []int {
+	if !b.cfg.IsTerminalStatus(status) || b.cfg.IsArchivedStatus(status) {
+		return nil
+	}
+	tasks, _, err := task.ReadAllLenient(b.cfg.TasksPaths()...)
+	if err != nil {
+		return nil
+	}
+	if p := board.ComputeChildProgress(b.cfg, tasks, id); p != nil {
+		return p.Incomplete
+	}
+	return nil
+}
+
 func (b *Board) executeDelete() (tea.Model, tea.Cmd) {
-	path, err := task.FindByID(b.cfg.TasksPath(), b.deleteID)
+	path, err := task.FindByIDIn(b.cfg.TasksPaths(), b.deleteID)
 	if err != nil {
-		b.err = fmt.Errorf("finding task #%d: %w", b.deleteID, err)
+		b.err = fmt.Errorf("finding task %s: %w", b.cfg.FormatID(b.deleteID), err)
 		b.view = viewBoard
 		return b, nil
 	}
 
 	t, err := task.Read(path)
 	if err != nil {
-		b.err = fmt.Errorf("reading task #%d: %w", b.deleteID, err)
+		b.err = fmt.Errorf("reading task %s: %w", b.cfg.FormatID(b.deleteID), err)
 		b.view = viewBoard
 		return b, nil
 	}
@@ -1103,7 +1341,7 @@ func (b *Board) executeDelete() (tea.Model, tea.Cmd) {
 	}
 
 	if err := task.Write(path, t); err != nil {
-		b.err = fmt.Errorf("archiving task #%d: %w", b.deleteID, err)
+		b.err = fmt.Errorf("archiving task %s: %w", b.cfg.FormatID(b.deleteID), err)
 	} else {
 		board.LogMutation(b.cfg.Dir(), "delete", b.deleteID, b.deleteTitle)
 	}
@@ -1115,8 +1353,8 @@ func (b *Board) executeDelete() (tea.Model, tea.Cmd) {
 
 // WatchPaths returns the paths that should be watched for file changes.
 func (b *Board) WatchPaths() []string {
-	paths := []string{b.cfg.TasksPath()}
-	if b.cfg.Dir() != b.cfg.TasksPath() {
+	paths := b.cfg.ExistingTasksPaths()
+	if !slices.Contains(paths, b.cfg.Dir()) {
 		paths = append(paths, b.cfg.Dir())
 	}
 	return paths
@@ -1132,8 +1370,14 @@ type errMsg struct{ err error }
 // TickMsg is sent periodically to refresh duration displays.
 type TickMsg struct{}
 
-func tickCmd() tea.Cmd {
-	return tea.Tick(tickInterval, func(time.Time) tea.Msg { return TickMsg{} })
+// tickCmd schedules the next TickMsg: every second while a focus timer
+// counts down in the status bar, every tickInterval otherwise.
+func tickCmd(focusing bool) tea.Cmd {
+	d := tickInterval
+	if focusing {
+		d = time.Second
+	}
+	return tea.Tick(d, func(time.Time) tea.Msg { return TickMsg{} })
 }
 
 // --- Styles ---
@@ -1198,18 +1442,68 @@ var (
 			Padding(dialogPadY, dialogPadX)
 )
 
+// renderCardTags renders a card's tags within maxLen cells. Tags with a
+// style in tags.styles get their icon and color; the rest are dimmed.
+func (b *Board) renderCardTags(tags []string, maxLen int) string {
+	styles := b.cfg.Tags.Styles
+	if len(styles) == 0 {
+		tagStr := strings.Join(tags, ",")
+		if len(tagStr) > maxLen {
+			tagStr = tagStr[:maxLen-3] + "..."
+		}
+		return dimStyle.Render(tagStr)
+	}
+
+	var out strings.Builder
+	used := 0
+	for i, tag := range tags {
+		label, style := tag, dimStyle
+		if s, ok := styles[tag]; ok {
+			if s.Icon != "" {
+				label = s.Icon + " " + tag
+			}
+			if s.Color != "" {
+				style = lipgloss.NewStyle().Foreground(lipgloss.Color(s.Color))
+			}
+		}
+		sep := ""
+		if i > 0 {
+			sep = ","
+		}
+		w := lipgloss.Width(sep + label)
+		if used+w > maxLen {
+			if i == 0 {
+				return style.Render(truncate(label, maxLen))
+			}
+			out.WriteString(dimStyle.Render("..."))
+			break
+		}
+		out.WriteString(dimStyle.Render(sep) + style.Render(label))
+		used += w
+	}
+	return out.String()
+}
+
 // ageStyle returns a lipgloss style for the duration label based on the
 // configured age thresholds. Thresholds are walked in reverse order (longest
 // first) so the first match wins.
 func (b *Board) ageStyle(d time.Duration) lipgloss.Style {
+	if c, ok := b.ageColor(d); ok {
+		return lipgloss.NewStyle().Foreground(c)
+	}
+	return dimStyle
+}
+
+// ageColor returns the color of the highest age threshold d reaches.
+func (b *Board) ageColor(d time.Duration) (lipgloss.Color, bool) {
 	thresholds := b.cfg.AgeThresholdsDuration()
 	// Walk backwards: pick the highest threshold that the duration exceeds.
 	for i := len(thresholds) - 1; i >= 0; i-- {
 		if d >= thresholds[i].After {
-			return lipgloss.NewStyle().Foreground(lipgloss.Color(thresholds[i].Color))
+			return lipgloss.Color(thresholds[i].Color), true
 		}
 	}
-	return dimStyle
+	return "", false
 }
 
 // --- View rendering ---
@@ -1219,21 +1513,17 @@ func (b *Board) viewBoard() string {
 		return "No statuses configured."
 	}
 
-	// Calculate column width.
-	colWidth := b.columnWidth()
-
-	// Render columns.
-	renderedCols := make([]string, len(b.columns))
-	for i, col := range b.columns {
-		renderedCols[i] = b.renderColumn(i, col, colWidth)
+	targetHeight := b.height - b.chromeHeight()
+	var boardView string
+	if b.emptyBoard {
+		boardView = b.viewEmptyState(targetHeight)
+	} else {
+		boardView = b.renderColumns()
 	}
 
-	boardView := lipgloss.JoinHorizontal(lipgloss.Top, renderedCols...)
-
 	// Ensure the board view fits within the available height. At very small
 	// terminal sizes, a single card can exceed the budget. Clamp from the
 	// bottom (keeping headers at the top) and pad if needed.
-	targetHeight := b.height - b.chromeHeight()
 	if targetHeight > 0 {
 		actual := strings.Count(boardView, "\n") + 1
 		if actual > targetHeight {
@@ -1249,12 +1539,38 @@ func (b *Board) viewBoard() string {
 	return lipgloss.JoinVertical(lipgloss.Left, boardView, "", statusBar)
 }
 
+// renderColumns renders the status columns side by side.
+func (b *Board) renderColumns() string {
+	colWidth := b.columnWidth()
+	renderedCols := make([]string, len(b.columns))
+	for i, col := range b.columns {
+		if b.collapsed[col.status] {
+			renderedCols[i] = b.renderCollapsedColumn(i, col)
+		} else {
+			renderedCols[i] = b.renderColumn(i, col, colWidth)
+		}
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, renderedCols...)
+}
+
 func (b *Board) columnWidth() int {
 	if b.width == 0 || len(b.columns) == 0 {
 		return 30 //nolint:mnd // default column width
 	}
-	// Total rendered width = w * numColumns (JoinHorizontal adds no gaps).
-	w := b.width / len(b.columns)
+	// Total rendered width = collapsed widths + w * expanded columns
+	// (JoinHorizontal adds no gaps).
+	avail, expanded := b.width, 0
+	for _, col := range b.columns {
+		if b.collapsed[col.status] {
+			avail -= b.collapsedWidth(col)
+		} else {
+			expanded++
+		}
+	}
+	if expanded == 0 {
+		return 30 //nolint:mnd // default column width
+	}
+	w := max(avail/expanded, 1)
 	const maxColWidth = 50
 	if w > maxColWidth {
 		w = maxColWidth
@@ -1262,16 +1578,18 @@ func (b *Board) columnWidth() int {
 	return w
 }
 
-func (b *Board) renderColumn(colIdx int, col column, width int) string {
-	// Header.
-	headerText := fmt.Sprintf("%s (%d)", col.status, len(col.tasks))
-	wip := b.cfg.WIPLimit(col.status)
-	if wip > 0 {
-		headerText = fmt.Sprintf("%s (%d/%d)", col.status, len(col.tasks), wip)
+// columnHeaderText is a column's status with its task count and WIP limit.
+func (b *Board) columnHeaderText(col column) string {
+	if wip := b.cfg.WIPLimit(col.status); wip > 0 {
+		return fmt.Sprintf("%s (%d/%d)", col.status, len(col.tasks), wip)
 	}
-	// Truncate to fit within padding (1 left + 1 right).
+	return fmt.Sprintf("%s (%d)", col.status, len(col.tasks))
+}
+
+func (b *Board) renderColumn(colIdx int, col column, width int) string {
+	// Header, truncated to fit within padding (1 left + 1 right).
 	const headerPad = 2
-	headerText = truncate(headerText, width-headerPad)
+	headerText := truncate(b.columnHeaderText(col), width-headerPad)
 
 	var header string
 	if colIdx == b.activeCol {
@@ -1321,26 +1639,51 @@ func (b *Board) renderColumn(colIdx int, col column, width int) string {
 }
 
 func (b *Board) renderCard(t *task.Task, active bool, width int) string {
-	contentLines := b.cardContentLines(t, width)
+	key := b.cardCacheKey(t, active, width)
+	if card, ok := b.cardCache[key]; ok {
+		return card
+	}
+	contentLines := b.cachedCardLines(t, width)
 	content := strings.Join(contentLines, "\n")
+	card := b.cardStyle(t, active).Width(width - 2).Render(content) //nolint:mnd // border width
+	b.cardCache[key] = card
+	return card
+}
 
-	// Pick style.
-	style := cardStyle
-	if t.Blocked {
-		style = blockedCardStyle
+// cardStyle picks a card's style. The active and blocked styles win; other
+// cards in statuses that show durations get a border in their age color so
+// stale cards stand out.
+func (b *Board) cardStyle(t *task.Task, active bool) lipgloss.Style {
+	switch {
+	case active:
+		return activeCardStyle
+	case t.Blocked, t.BlockedByDependency:
+		return blockedCardStyle
 	}
-	if active {
-		style = activeCardStyle
+	if b.cfg.StatusShowDuration(t.Status) {
+		if c, ok := b.ageColor(b.now().Sub(t.Updated)); ok {
+			return cardStyle.BorderForeground(c)
+		}
 	}
-
-	return style.Width(width - 2).Render(content) //nolint:mnd // border width
+	return cardStyle
 }
 
 func (b *Board) cardHeight(t *task.Task, width int) int {
-	contentLines := b.cardContentLines(t, width)
+	contentLines := b.cachedCardLines(t, width)
 	return len(contentLines) + 2 //nolint:mnd // top and bottom borders
 }
 
+// cachedCardLines is cardContentLines through the card cache.
+func (b *Board) cachedCardLines(t *task.Task, width int) []string {
+	key := b.cardCacheKey(t, false, width)
+	if lines, ok := b.cardLinesCache[key]; ok {
+		return lines
+	}
+	lines := b.cardContentLines(t, width)
+	b.cardLinesCache[key] = lines
+	return lines
+}
+
 func (b *Board) cardContentLines(t *task.Task, width int) []string {
 	// Card content.
 	const cardChrome = 4 // border (2) + padding (2)
@@ -1350,8 +1693,9 @@ func (b *Board) cardContentLines(t *task.Task, width int) []string {
 	}
 
 	titleLines := b.cfg.TitleLines()
-	idStr := dimStyle.Render("#" + strconv.Itoa(t.ID))
-	idLen := len(strconv.Itoa(t.ID)) + 1    // "#" + digits
+	id := b.cfg.FormatID(t.ID)
+	idStr := dimStyle.Render(id)
+	idLen := len(id)
 	firstLineWidth := cardWidth - idLen - 1 // space after id
 	if firstLineWidth < 1 {
 		firstLineWidth = 1
@@ -1378,12 +1722,7 @@ func (b *Board) cardContentLines(t *task.Task, width int) []string {
 	details = append(details, pStyle.Render(t.Priority))
 
 	if len(t.Tags) > 0 {
-		tagStr := strings.Join(t.Tags, ",")
-		tagMaxLen := cardWidth / tagMaxFraction
-		if len(tagStr) > tagMaxLen {
-			tagStr = tagStr[:tagMaxLen-3] + "..."
-		}
-		details = append(details, dimStyle.Render(tagStr))
+		details = append(details, b.renderCardTags(t.Tags, cardWidth/tagMaxFraction))
 	}
 
 	if t.Due != nil {
@@ -1518,8 +1857,26 @@ func wrapLinesCap(maxLines int) int {
 
 func (b *Board) renderStatusBar() string {
 	total := len(b.tasks)
-	status := fmt.Sprintf(" %s | %d tasks | ←↓↑→:nav c:create e:edit m:move n/p:status +/-:priority d:del ?:help q:quit",
-		b.cfg.Board.Name, total)
+	name := b.cfg.Board.Name
+	if focus := b.focusLabel(); focus != "" {
+		name += " | " + focus
+	}
+	hints := []keyHint{{[]string{"quick_add"}, "add"}, {[]string{"create"}, "create"}, {[]string{"edit"}, "edit"},
+		{[]string{"move"}, "move"}, {[]string{"next_status", "prev_status"}, "status"},
+		{[]string{"raise_priority", "lower_priority"}, "priority"}, {[]string{"delete"}, "del"},
+		{[]string{"help"}, "help"}, {[]string{"quit"}, "quit"}}
+	status := fmt.Sprintf(" %s | %s | %s", name, i18n.Sprintf("%d tasks", total), b.renderKeyHints(hints))
+	if b.readOnly {
+		hints = []keyHint{{[]string{"open"}, "details"}, {[]string{"help"}, "help"}, {[]string{"quit"}, "quit"}}
+		status = fmt.Sprintf(" %s | %s | %s | %s", name, i18n.Sprintf("%d tasks", total),
+			i18n.T("read-only"), b.renderKeyHints(hints))
+	}
+	if b.notice != "" {
+		status = " " + b.notice
+	}
+	if b.view == viewJump {
+		status = b.jumpPrompt()
+	}
 	status = truncate(status, b.width)
 
 	if b.err != nil {
@@ -1530,13 +1887,61 @@ func (b *Board) renderStatusBar() string {
 	return statusBarStyle.Render(status)
 }
 
+// keyHint is a status bar entry: the first key of each action, then label.
+type keyHint struct {
+	actions []string
+	label   string
+}
+
+// renderKeyHints renders the navigation keys and hints as "key:label"
+// pairs, leaving out disabled actions.
+func (b *Board) renderKeyHints(hints []keyHint) string {
+	var nav string
+	for _, action := range []string{"left", "down", "up", "right"} {
+		if keys := b.keys[action]; len(keys) > 0 {
+			nav += keyLabel(keys[0])
+		}
+	}
+	var parts []string
+	if nav != "" {
+		parts = append(parts, nav+":"+i18n.T("nav"))
+	}
+	for _, h := range hints {
+		var keys []string
+		for _, action := range h.actions {
+			if k := b.keys[action]; len(k) > 0 {
+				keys = append(keys, keyLabel(k[0]))
+			}
+		}
+		if len(keys) > 0 {
+			parts = append(parts, strings.Join(keys, "/")+":"+i18n.T(h.label))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// keyLabel is how a key is shown in hints and help.
+func keyLabel(k string) string {
+	switch k {
+	case keyLeft:
+		return "←"
+	case keyRight:
+		return "→"
+	case keyDown:
+		return "↓"
+	case keyUp:
+		return "↑"
+	}
+	return k
+}
+
 func (b *Board) viewDetail() string {
 	t := b.detailTask
 	if t == nil {
-		return "No task selected."
+		return i18n.T("No task selected.")
 	}
 
-	lines := detailLines(t, b.width)
+	lines := detailLines(b.cfg, t, board.ComputeChildProgress(b.cfg, b.tasks, t.ID), b.loc, b.width)
 
 	// Reserve space for the blank separator line and the fixed status hint.
 	viewHeight := b.height - 2 //nolint:mnd // 2 = blank line + hint line
@@ -1546,6 +1951,9 @@ func (b *Board) viewDetail() string {
 
 	// Build the status hint (always visible at bottom).
 	hint := "q/esc:back"
+	if !b.readOnly {
+		hint += "  D:dependencies"
+	}
 	if len(lines) > viewHeight {
 		hint += "  j/k:scroll  g/G:top/bottom"
 	}
@@ -1570,9 +1978,9 @@ func (b *Board) viewDetail() string {
 	return strings.Join(lines[off:end], "\n") + "\n\n" + dimStyle.Render(hint)
 }
 
-func detailLines(t *task.Task, width int) []string {
+func detailLines(cfg *config.Config, t *task.Task, progress *board.ChildProgress, loc *time.Location, width int) []string {
 	var lines []string
-	header := fmt.Sprintf("Task #%d: %s", t.ID, t.Title)
+	header := fmt.Sprintf("Task %s: %s", cfg.FormatID(t.ID), t.Title)
 	// Word-wrap the header so long titles fit within the available terminal width.
 	boldStyle := lipgloss.NewStyle().Bold(true)
 	for _, l := range wrapTitle(header, width, noLineLimit) {
@@ -1585,13 +1993,23 @@ func detailLines(t *task.Task, width int) []string {
 	}
 	lines = append(lines, strings.Repeat("─", sepWidth))
 	lines = append(lines, "")
-	lines = append(lines, detailLabelStyle.Render("Status:")+"  "+t.Status)
-	lines = append(lines, detailLabelStyle.Render("Priority:")+"  "+t.Priority)
-	lines = append(lines, detailMetadataLines(t)...)
-	lines = append(lines, detailTimestampLines(t)...)
+	lines = append(lines, detailLabelStyle.Render(i18n.T("Status")+":")+"  "+t.Status)
+	lines = append(lines, detailLabelStyle.Render(i18n.T("Priority")+":")+"  "+t.Priority)
+	lines = append(lines, detailMetadataLines(cfg, t)...)
+	if progress != nil {
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Children")+":")+"  "+progress.String())
+	}
+	lines = append(lines, detailTimestampLines(t, loc)...)
 	if t.Blocked {
 		lines = append(lines, "")
-		lines = append(lines, errorStyle.Render("BLOCKED: "+t.BlockReason))
+		blocked := "BLOCKED: " + t.BlockReason
+		if t.BlockedUntil != nil {
+			blocked += " (until " + t.BlockedUntil.String() + ")"
+		}
+		if t.BlockedOn != "" {
+			blocked += " [on " + t.BlockedOn + "]"
+		}
+		lines = append(lines, errorStyle.Render(blocked))
 	}
 	if t.Body != "" {
 		lines = append(lines, "")
@@ -1651,57 +2069,64 @@ func unescapeBody(s string) string {
 }
 
 // detailMetadataLines renders optional metadata fields (class, assignee, tags, relations, etc.).
-func detailMetadataLines(t *task.Task) []string {
+func detailMetadataLines(cfg *config.Config, t *task.Task) []string {
 	var lines []string
 	if t.Class != "" {
-		lines = append(lines, detailLabelStyle.Render("Class:")+"  "+t.Class)
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Class")+":")+"  "+t.Class)
 	}
 	if t.Assignee != "" {
-		lines = append(lines, detailLabelStyle.Render("Assignee:")+"  "+t.Assignee)
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Assignee")+":")+"  "+t.Assignee)
+	}
+	if t.Reviewer != "" {
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Reviewer")+":")+"  "+t.Reviewer)
 	}
 	if len(t.Tags) > 0 {
-		lines = append(lines, detailLabelStyle.Render("Tags:")+"  "+strings.Join(t.Tags, ", "))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Tags")+":")+"  "+strings.Join(t.Tags, ", "))
 	}
 	if t.Parent != nil {
-		lines = append(lines, detailLabelStyle.Render("Parent:")+"  #"+strconv.Itoa(*t.Parent))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Parent")+":")+"  "+cfg.FormatID(*t.Parent))
 	}
 	if len(t.DependsOn) > 0 {
 		deps := make([]string, len(t.DependsOn))
 		for i, d := range t.DependsOn {
-			deps[i] = "#" + strconv.Itoa(d)
+			deps[i] = cfg.FormatID(d)
+		}
+		line := detailLabelStyle.Render(i18n.T("Depends on")+":") + "  " + strings.Join(deps, ", ")
+		if t.BlockedByDependency {
+			line += "  " + errorStyle.Render("(waiting)")
 		}
-		lines = append(lines, detailLabelStyle.Render("Depends on:")+"  "+strings.Join(deps, ", "))
+		lines = append(lines, line)
 	}
 	if t.Due != nil {
-		lines = append(lines, detailLabelStyle.Render("Due:")+"  "+t.Due.String())
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Due")+":")+"  "+t.Due.String())
 	}
 	if t.Estimate != "" {
-		lines = append(lines, detailLabelStyle.Render("Estimate:")+"  "+t.Estimate)
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Estimate")+":")+"  "+t.Estimate)
 	}
 	return lines
 }
 
 // detailTimestampLines renders timestamps and claim info.
-func detailTimestampLines(t *task.Task) []string {
+func detailTimestampLines(t *task.Task, loc *time.Location) []string {
 	const timeFmt = "2006-01-02 15:04"
 	lines := []string{
-		detailLabelStyle.Render("Created:") + "  " + t.Created.Format(timeFmt),
-		detailLabelStyle.Render("Updated:") + "  " + t.Updated.Format(timeFmt),
+		detailLabelStyle.Render(i18n.T("Created")+":") + "  " + t.Created.In(loc).Format(timeFmt),
+		detailLabelStyle.Render(i18n.T("Updated")+":") + "  " + t.Updated.In(loc).Format(timeFmt),
 	}
 	if t.ClaimedBy != "" {
-		lines = append(lines, detailLabelStyle.Render("Claimed:")+"  "+claimStyle.Render(t.ClaimedBy))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Claimed")+":")+"  "+claimStyle.Render(t.ClaimedBy))
 	}
 	if t.ClaimedAt != nil {
-		lines = append(lines, detailLabelStyle.Render("Claimed at:")+"  "+t.ClaimedAt.Format(timeFmt))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Claimed at")+":")+"  "+t.ClaimedAt.In(loc).Format(timeFmt))
 	}
 	if t.Started != nil {
-		lines = append(lines, detailLabelStyle.Render("Started:")+"  "+t.Started.Format(timeFmt))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Started")+":")+"  "+t.Started.In(loc).Format(timeFmt))
 	}
 	if t.Completed != nil {
-		lines = append(lines, detailLabelStyle.Render("Completed:")+"  "+t.Completed.Format(timeFmt))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Completed")+":")+"  "+t.Completed.In(loc).Format(timeFmt))
 	}
 	if t.Started != nil && t.Completed != nil {
-		lines = append(lines, detailLabelStyle.Render("Duration:")+"  "+humanDuration(t.Completed.Sub(*t.Started)))
+		lines = append(lines, detailLabelStyle.Render(i18n.T("Duration")+":")+"  "+humanDuration(t.Completed.Sub(*t.Started)))
 	}
 	return lines
 }
@@ -1710,7 +2135,7 @@ func (b *Board) viewMoveDialog() string {
 	t := b.selectedTask()
 	title := "Move task"
 	if t != nil {
-		title = fmt.Sprintf("Move #%d to:", t.ID)
+		title = fmt.Sprintf("Move %s to:", b.cfg.FormatID(t.ID))
 	}
 
 	var items []string
@@ -1735,7 +2160,7 @@ func (b *Board) viewMoveDialog() string {
 
 func (b *Board) viewDeleteConfirm() string {
 	content := errorStyle.Render("Delete task?") + "\n\n" +
-		fmt.Sprintf("  #%d: %s", b.deleteID, b.deleteTitle) + "\n\n" +
+		fmt.Sprintf("  %s: %s", b.cfg.FormatID(b.deleteID), b.deleteTitle) + "\n\n" +
 		dimStyle.Render("y:yes  n:no")
 
 	return dialogStyle.Render(content)
@@ -1744,7 +2169,7 @@ func (b *Board) viewDeleteConfirm() string {
 func (b *Board) viewCreateDialog() string {
 	headerText := "Create task in " + b.createStatus
 	if b.createIsEdit {
-		headerText = fmt.Sprintf("Edit task #%d in %s", b.createEditID, b.createStatus)
+		headerText = fmt.Sprintf("Edit task %s in %s", b.cfg.FormatID(b.createEditID), b.createStatus)
 	}
 	header := lipgloss.NewStyle().Bold(true).Render(headerText)
 	stepLabel := dimStyle.Render(fmt.Sprintf("  Step %d/%d: %s",
@@ -1864,29 +2289,49 @@ func (b *Board) renderLabeledCreateInput(label, value string) string {
 	return strings.Join(lines, "\n")
 }
 
+// actionHelp describes each board-view action in the help screen.
+var actionHelp = map[string]string{ //nolint:gochecknoglobals // static lookup table
+	"left":           "Move to left column",
+	"right":          "Move to right column",
+	"down":           "Move cursor down",
+	"up":             "Move cursor up",
+	"open":           "Show task detail",
+	"quick_add":      "Quick add (!prio #tag @user due:fri)",
+	"create":         "Create new task in column",
+	"edit":           "Edit selected task (same flow as create)",
+	"move":           "Move task (status picker)",
+	"next_status":    "Move task to next status",
+	"prev_status":    "Move task to previous status",
+	"raise_priority": "Raise task priority",
+	"lower_priority": "Lower task priority",
+	"delete":         "Delete task",
+	"jump":           "Jump to task by ID (type digits, enter)",
+	"collapse":       "Collapse/expand column",
+	"yank":           "Copy task reference (#12 Title)",
+	"yank_path":      "Copy task file path",
+	"refresh":        "Refresh board",
+	"sample_tasks":   "Add sample tasks (empty board)",
+	"help":           "Show this help",
+	"quit":           "Quit",
+}
+
 func (b *Board) viewHelp() string {
-	help := []struct{ key, desc string }{
-		{"←/h", "Move to left column"},
-		{"→/l", "Move to right column"},
-		{"↓/j", "Move cursor down"},
-		{"↑/k", "Move cursor up"},
-		{"enter", "Show task detail"},
-		{"c", "Create new task in column"},
-		{"e", "Edit selected task (same flow as create)"},
-		{"m", "Move task (status picker)"},
-		{"n", "Move task to next status"},
-		{"p", "Move task to previous status"},
-		{"+/=", "Raise task priority"},
-		{"-/_", "Lower task priority"},
-		{"d", "Delete task"},
-		{"r", "Refresh board"},
-		{"?", "Show this help"},
-		{"esc/q", "Quit"},
-		{"ctrl+c", "Force quit"},
+	var help []struct{ key, desc string }
+	for _, action := range config.TUIActions {
+		desc, ok := actionHelp[action]
+		if !ok || len(b.keys[action]) == 0 {
+			continue
+		}
+		labels := make([]string, len(b.keys[action]))
+		for i, k := range b.keys[action] {
+			labels[i] = keyLabel(k)
+		}
+		help = append(help, struct{ key, desc string }{strings.Join(labels, "/"), i18n.T(desc)})
 	}
+	help = append(help, struct{ key, desc string }{config.TUIForceQuitKey, i18n.T("Force quit")})
 
 	var lines []string
-	lines = append(lines, lipgloss.NewStyle().Bold(true).Render("Keyboard Shortcuts"))
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(i18n.T("Keyboard Shortcuts")))
 	lines = append(lines, "")
 
 	for _, h := range help {
@@ -1895,7 +2340,7 @@ func (b *Board) viewHelp() string {
 	}
 
 	lines = append(lines, "")
-	lines = append(lines, dimStyle.Render("Press any key to close"))
+	lines = append(lines, dimStyle.Render(i18n.T("Press any key to close")))
 
 	return dialogStyle.Render(strings.Join(lines, "\n"))
 }
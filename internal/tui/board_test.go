@@ -1,6 +1,7 @@
 package tui_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/antopolskiy/kanban-md/internal/config"
 	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/i18n"
 	"github.com/antopolskiy/kanban-md/internal/task"
 	"github.com/antopolskiy/kanban-md/internal/tui"
 )
@@ -168,6 +170,46 @@ func TestBoard_EnterDetail(t *testing.T) {
 	}
 }
 
+func TestBoard_MoveParentWithOpenChildrenToDone(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	// Make Task B (backlog) a child of Task A, which is selected.
+	path, err := task.FindByID(cfg.TasksPath(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := 1
+	child.Parent = &parent
+	if err := task.Write(path, child); err != nil {
+		t.Fatal(err)
+	}
+
+	// Move Task A from backlog to done.
+	b = sendKey(b, "m")
+	for range 4 {
+		b = sendKey(b, "j")
+	}
+	b = sendKey(b, "enter")
+
+	if !containsStr(b.View(), "incomplete child") {
+		t.Error("expected incomplete children error in view")
+	}
+	path, err = task.FindByID(cfg.TasksPath(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.Status != "backlog" {
+		t.Errorf("parent status = %q, want backlog while its child is open", tk.Status)
+	}
+}
+
 func TestBoard_MoveDialog(t *testing.T) {
 	b, _ := setupTestBoard(t)
 
@@ -303,6 +345,34 @@ func TestBoard_DeleteTask(t *testing.T) {
 	_ = b.View()
 }
 
+func TestBoard_DeleteParentRefused(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	// Make task 2 a child of task 1.
+	path, err := task.FindByID(cfg.TasksPath(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := 1
+	child.Parent = &parent
+	if err := task.Write(path, child); err != nil {
+		t.Fatal(err)
+	}
+
+	b = sendKey(b, "d")
+	v := b.View()
+	if containsStr(v, "Delete task?") {
+		t.Error("expected no delete confirmation for a parent task")
+	}
+	if !containsStr(v, "child task") {
+		t.Error("expected an error about the task's children")
+	}
+}
+
 func TestBoard_DeleteCancel(t *testing.T) {
 	b, cfg := setupTestBoard(t)
 
@@ -383,6 +453,27 @@ func TestBoard_HelpView(t *testing.T) {
 	}
 }
 
+func TestBoard_HelpViewTranslated(t *testing.T) {
+	dir := t.TempDir()
+	catalog := "Keyboard Shortcuts: Tastenkürzel\npriority: Priorität\n"
+	if err := os.WriteFile(filepath.Join(dir, "de.yml"), []byte(catalog), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := i18n.Load("de", dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = i18n.Load("") })
+
+	b, _ := setupTestBoard(t)
+	if v := b.View(); !containsStr(v, "+/-:Priorität") {
+		t.Error("expected translated status bar hint")
+	}
+	b = sendKey(b, "?")
+	if v := b.View(); !containsStr(v, "Tastenkürzel") {
+		t.Error("expected translated help title")
+	}
+}
+
 func TestBoard_Refresh(t *testing.T) {
 	b, cfg := setupTestBoard(t)
 
@@ -426,11 +517,86 @@ func TestBoard_EmptyBoard(t *testing.T) {
 	b.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
 
 	v := b.View()
-	if !containsStr(v, "(empty)") {
-		t.Error("expected empty column indicator")
+	if !containsStr(v, "Welcome to Empty Board") || !containsStr(v, "kanban-md skill install") {
+		t.Errorf("expected the onboarding screen, got:\n%s", v)
+	}
+	if containsStr(v, "(empty)") {
+		t.Error("expected the onboarding screen in place of empty columns")
 	}
 }
 
+func TestBoard_EmptyBoardSampleTasks(t *testing.T) {
+	b, cfg := setupEmptyBoard(t)
+	b = sendKey(b, "S")
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 4 {
+		t.Fatalf("sample tasks = %d, want 4", len(tasks))
+	}
+	for _, tk := range tasks {
+		if tk.Status != cfg.Defaults.Status || len(tk.Tags) != 1 || tk.Tags[0] != "sample" {
+			t.Errorf("sample task %+v, want status %s and tag sample", tk, cfg.Defaults.Status)
+		}
+	}
+	v := b.View()
+	if containsStr(v, "Welcome to") || !containsStr(v, cfg.Defaults.Status+" (4)") {
+		t.Errorf("expected the board with the samples, got:\n%s", v)
+	}
+
+	// Samples only go on an empty board.
+	b = sendKey(b, "S")
+	if tasks, _ = task.ReadAll(cfg.TasksPath()); len(tasks) != 4 {
+		t.Errorf("tasks after a second S = %d, want 4", len(tasks))
+	}
+}
+
+func TestBoard_EmptyBoardReadOnly(t *testing.T) {
+	b, cfg := setupEmptyBoard(t)
+	b.SetReadOnly(true)
+	b = sendKey(b, "S")
+
+	if tasks, _ := task.ReadAll(cfg.TasksPath()); len(tasks) != 0 {
+		t.Errorf("read-only board got %d sample tasks", len(tasks))
+	}
+	v := b.View()
+	if !containsStr(v, "read-only") || containsStr(v, "Add sample tasks") {
+		t.Errorf("expected the read-only onboarding screen, got:\n%s", v)
+	}
+}
+
+func TestBoard_ArchivedOnlyBoardShowsColumns(t *testing.T) {
+	b, cfg := setupEmptyBoard(t)
+	now := time.Now()
+	tk := &task.Task{ID: 1, Title: "Old work", Status: config.ArchivedStatus, Priority: "low", Created: now, Updated: now}
+	if err := task.Write(filepath.Join(cfg.TasksPath(), task.GenerateFilename(1, tk.Title)), tk); err != nil {
+		t.Fatal(err)
+	}
+	b = sendKey(b, "r")
+
+	if v := b.View(); containsStr(v, "Welcome to") || !containsStr(v, "(empty)") {
+		t.Errorf("expected empty columns on a board with only archived tasks, got:\n%s", v)
+	}
+}
+
+func setupEmptyBoard(t *testing.T) (*tui.Board, *config.Config) {
+	t.Helper()
+	kanbanDir := filepath.Join(t.TempDir(), "kanban")
+	if err := os.MkdirAll(filepath.Join(kanbanDir, "tasks"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.NewDefault("Empty Board")
+	cfg.SetDir(kanbanDir)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b := tui.NewBoard(cfg)
+	b.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return b, cfg
+}
+
 func TestBoard_HideEmptyColumns_ConfigEnabled(t *testing.T) {
 	_, cfg := setupTestBoard(t)
 	cfg.TUI.HideEmptyColumns = true
@@ -474,8 +640,8 @@ func TestBoard_HideEmptyColumns_AllEmptyFallback(t *testing.T) {
 	if containsStr(v, "No statuses configured.") {
 		t.Error("expected fallback columns on empty board, got no-statuses message")
 	}
-	if !containsStr(v, "(empty)") {
-		t.Error("expected empty column indicator even with hide_empty_columns enabled")
+	if !containsStr(v, "Welcome to Empty Board") {
+		t.Error("expected the onboarding screen even with hide_empty_columns enabled")
 	}
 }
 
@@ -2292,3 +2458,263 @@ func TestBoard_DebugScreenCtrlDCloses(t *testing.T) {
 		t.Error("expected board view after second Ctrl+D")
 	}
 }
+
+func TestBoard_CardShowsTagStyleIcon(t *testing.T) {
+	_, cfg := setupTestBoard(t)
+	cfg.Tags.Styles = map[string]config.TagStyle{"bug": {Color: "196", Icon: "🐛"}}
+
+	tk := &task.Task{ID: 5, Title: "Crash", Status: "todo", Priority: "high", Tags: []string{"bug"}, Updated: testRefTime}
+	path := filepath.Join(cfg.TasksPath(), task.GenerateFilename(5, "Crash"))
+	if err := task.Write(path, tk); err != nil {
+		t.Fatalf("writing task: %v", err)
+	}
+
+	b := tui.NewBoard(cfg)
+	b.SetNow(testNow)
+	b.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	if v := b.View(); !strings.Contains(v, "🐛 bug") {
+		t.Errorf("card should show the tag icon, got:\n%s", v)
+	}
+}
+
+func TestBoard_ReadOnlyRejectsMoves(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	b.SetReadOnly(true)
+
+	b = sendKey(b, "n") // would move Task A to todo
+
+	v := b.View()
+	if !strings.Contains(v, "board is read-only") {
+		t.Errorf("expected read-only error, got:\n%s", v)
+	}
+	path, err := task.FindByID(cfg.TasksPath(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.Status != "backlog" {
+		t.Errorf("status = %q, want backlog (unchanged)", tk.Status)
+	}
+}
+
+func TestBoard_IDPrefix(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	cfg.Board.IDPrefix = "API-"
+
+	if v := b.View(); !strings.Contains(v, "API-1") {
+		t.Errorf("expected prefixed ID on cards, got:\n%s", v)
+	}
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if v := b.View(); !strings.Contains(v, "Task API-1: Task A") {
+		t.Errorf("expected prefixed ID in detail header, got:\n%s", v)
+	}
+}
+
+func TestBoard_CustomKeys(t *testing.T) {
+	_, cfg := setupTestBoard(t)
+	cfg.TUI.Keys = map[string][]string{"create": {"x"}, "delete": {}}
+	b := tui.NewBoard(cfg)
+	b.SetNow(testNow)
+	b.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+
+	v := stripANSI(b.View())
+	if !containsStr(v, "x:create") || containsStr(v, "d:del") {
+		t.Errorf("status bar should show x:create and no delete hint:\n%s", v)
+	}
+
+	b = sendKey(b, "c")
+	if containsStr(b.View(), "Create task in") {
+		t.Error("c should no longer open the create dialog")
+	}
+	b = sendKey(b, "d")
+	if containsStr(b.View(), "Delete task?") {
+		t.Error("d should be disabled")
+	}
+	b = sendKey(b, "x")
+	if !containsStr(b.View(), "Create task in") {
+		t.Error("x should open the create dialog")
+	}
+}
+
+func TestBoard_JumpToTaskByID(t *testing.T) {
+	b, _ := setupTestBoard(t)
+
+	b = sendKey(b, "#")
+	b = sendKey(b, "4")
+	if v := b.View(); !containsStr(v, "Jump to #4_") {
+		t.Fatalf("expected jump prompt in status bar, got:\n%s", v)
+	}
+	b = sendSpecialKey(b, tea.KeyEnter)
+	b = sendSpecialKey(b, tea.KeyEnter) // open the selected task
+	if v := b.View(); !containsStr(v, "Task D") || !containsStr(v, "done") {
+		t.Errorf("expected detail of task #4, got:\n%s", v)
+	}
+}
+
+func TestBoard_JumpToMissingTask(t *testing.T) {
+	b, _ := setupTestBoard(t)
+
+	b = sendKey(b, "#")
+	b = sendKey(b, "9")
+	b = sendKey(b, "x") // ignored
+	b = sendKey(b, "9")
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if v := b.View(); !containsStr(v, "task #99 is not on the board") {
+		t.Errorf("expected not-found error, got:\n%s", v)
+	}
+}
+
+func TestBoard_JumpEscCancels(t *testing.T) {
+	b, _ := setupTestBoard(t)
+
+	b = sendKey(b, "#")
+	b = sendKey(b, "3")
+	b = sendSpecialKey(b, tea.KeyEscape)
+	if v := b.View(); containsStr(v, "Jump to") {
+		t.Error("expected esc to close the jump prompt")
+	}
+}
+
+func TestBoard_CollapseColumnPersists(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	b = sendKey(b, "z") // collapse backlog
+	v := b.View()
+	if !containsStr(v, "▸ backlog (2)") || containsStr(v, "Task A") {
+		t.Errorf("expected collapsed backlog with its cards hidden, got:\n%s", v)
+	}
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if containsStr(b.View(), "Task A") {
+		t.Error("enter on a collapsed column should not open a task")
+	}
+
+	reloaded, err := config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.TUI.CollapsedColumns) != 1 || reloaded.TUI.CollapsedColumns[0] != "backlog" {
+		t.Fatalf("collapsed_columns = %v, want [backlog]", reloaded.TUI.CollapsedColumns)
+	}
+
+	// A new session starts with the column collapsed; z expands it again.
+	b = tui.NewBoard(reloaded)
+	b.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if !containsStr(b.View(), "▸ backlog (2)") {
+		t.Error("expected backlog to start collapsed")
+	}
+	b = sendKey(b, "z")
+	if !containsStr(b.View(), "Task A") {
+		t.Error("expected z to expand backlog")
+	}
+	reloaded, err = config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.TUI.CollapsedColumns) != 0 {
+		t.Errorf("collapsed_columns = %v, want none", reloaded.TUI.CollapsedColumns)
+	}
+}
+
+func TestBoard_CollapseReadOnlyDoesNotSave(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	b.SetReadOnly(true)
+
+	b = sendKey(b, "z")
+	if !containsStr(b.View(), "▸ backlog (2)") {
+		t.Error("expected backlog to collapse on a read-only board")
+	}
+	reloaded, err := config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.TUI.CollapsedColumns) != 0 {
+		t.Errorf("collapsed_columns = %v, want none saved", reloaded.TUI.CollapsedColumns)
+	}
+}
+
+func TestBoard_JumpExpandsCollapsedColumn(t *testing.T) {
+	b, _ := setupTestBoard(t)
+
+	b = sendKey(b, "z") // collapse backlog
+	b = sendKey(b, "l")
+	b = sendKey(b, "#")
+	b = sendKey(b, "2")
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if !containsStr(b.View(), "Task B") {
+		t.Error("expected jumping to #2 to expand backlog")
+	}
+}
+
+func TestBoard_YankReferenceAndPath(t *testing.T) {
+	b, _ := setupTestBoard(t)
+	var copied string
+	b.SetClipboard(func(s string) error {
+		copied = s
+		return nil
+	})
+
+	b = sendKey(b, "y")
+	if copied != "#1 Task A" {
+		t.Errorf("copied %q, want %q", copied, "#1 Task A")
+	}
+	if !containsStr(b.View(), "Copied #1 Task A") {
+		t.Error("expected a copied notice in the status bar")
+	}
+
+	b = sendKey(b, "Y")
+	if filepath.Base(copied) != "001-Task A.md" {
+		t.Errorf("copied %q, want the task file path", copied)
+	}
+
+	b = sendKey(b, "j")
+	if containsStr(b.View(), "Copied") {
+		t.Error("expected the notice to clear on the next key")
+	}
+}
+
+func TestBoard_YankError(t *testing.T) {
+	b, _ := setupTestBoard(t)
+	b.SetClipboard(func(string) error { return errors.New("no clipboard") })
+
+	b = sendKey(b, "y")
+	if !containsStr(b.View(), "no clipboard") {
+		t.Error("expected the clipboard error in the status bar")
+	}
+}
+
+func TestBoard_FocusCountdown(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	if containsStr(b.View(), "left") {
+		t.Fatal("status bar should show no countdown before a focus starts")
+	}
+
+	path, err := task.FindByIDIn(cfg.TasksPaths(), 1)
+	if err != nil {
+		t.Fatalf("finding task: %v", err)
+	}
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatalf("reading task: %v", err)
+	}
+	until := testNow().Add(10 * time.Minute)
+	tk.FocusUntil = &until
+	if err := task.Write(path, tk); err != nil {
+		t.Fatalf("writing task: %v", err)
+	}
+
+	b = sendKey(b, "r")
+	if v := b.View(); !containsStr(v, "focus #1 10:00 left") {
+		t.Errorf("expected a focus countdown in the status bar, got:\n%s", v)
+	}
+
+	// A timer that has run out is not shown.
+	b.SetNow(func() time.Time { return until })
+	if containsStr(b.View(), "left") {
+		t.Error("status bar should drop the countdown once the timer ends")
+	}
+}
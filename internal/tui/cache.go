@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"encoding/json"
+	"hash/fnv"
+
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// cardKey identifies a rendered card. The hash covers every task field, so
+// a task edited on disk misses the cache while its unchanged neighbours
+// keep hitting it. age is the age text and border color a card shows,
+// which change with the clock rather than the file.
+type cardKey struct {
+	hash   uint64
+	width  int
+	active bool
+	age    string
+}
+
+// taskHash fingerprints a task as loaded, including its body, file, and
+// computed dependency flag.
+func taskHash(t *task.Task) uint64 {
+	h := fnv.New64a()
+	data, _ := json.Marshal(t) // a Task always marshals
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
+
+// boardHash combines task hashes in order, so a reload that read the same
+// tasks can keep the board's columns instead of re-sorting them.
+func boardHash(hashes []uint64) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range hashes {
+		for i := range buf {
+			buf[i] = byte(v >> (8 * i)) //nolint:mnd // little-endian bytes
+		}
+		_, _ = h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// cardCacheKey returns the cache key of t's card at width.
+func (b *Board) cardCacheKey(t *task.Task, active bool, width int) cardKey {
+	h, ok := b.taskHashes[t]
+	if !ok {
+		h = taskHash(t) // a task not loaded from the board, as in tests
+	}
+	k := cardKey{hash: h, width: width, active: active}
+	if b.cfg.StatusShowDuration(t.Status) {
+		d := b.now().Sub(t.Updated)
+		c, _ := b.ageColor(d)
+		k.age = humanDuration(d) + "/" + string(c)
+	}
+	return k
+}
+
+// pruneCardCache drops cached cards of tasks no longer on the board.
+func (b *Board) pruneCardCache() {
+	live := make(map[uint64]bool, len(b.taskHashes))
+	for _, h := range b.taskHashes {
+		live[h] = true
+	}
+	for k := range b.cardCache {
+		if !live[k.hash] {
+			delete(b.cardCache, k)
+		}
+	}
+	for k := range b.cardLinesCache {
+		if !live[k.hash] {
+			delete(b.cardLinesCache, k)
+		}
+	}
+}
+
+// resetCardCache forgets every cached card, as after a resize.
+func (b *Board) resetCardCache() {
+	b.cardCache = make(map[cardKey]string)
+	b.cardLinesCache = make(map[cardKey][]string)
+}
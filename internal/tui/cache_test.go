@@ -0,0 +1,87 @@
+package tui_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/tui"
+)
+
+// setupLargeBoard creates a board with n backlog tasks.
+func setupLargeBoard(tb testing.TB, n int) (*tui.Board, *config.Config) {
+	tb.Helper()
+	kanbanDir := filepath.Join(tb.TempDir(), "kanban")
+	tasksDir := filepath.Join(kanbanDir, "tasks")
+	if err := os.MkdirAll(tasksDir, 0o750); err != nil {
+		tb.Fatal(err)
+	}
+	cfg := config.NewDefault("Large Board")
+	cfg.SetDir(kanbanDir)
+	if err := cfg.Save(); err != nil {
+		tb.Fatal(err)
+	}
+	for id := 1; id <= n; id++ {
+		title := fmt.Sprintf("Task %d", id)
+		tk := &task.Task{ID: id, Title: title, Status: "backlog", Priority: "medium", Updated: testRefTime}
+		if err := task.Write(filepath.Join(tasksDir, task.GenerateFilename(id, title)), tk); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	b := tui.NewBoard(cfg)
+	b.SetNow(testNow)
+	b.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return b, cfg
+}
+
+func TestCache_ReloadShowsTaskChangedOnDisk(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	if !containsStr(b.View(), "Task A") {
+		t.Fatal("expected Task A on the board")
+	}
+
+	editTitleOnDisk(t, cfg, "Renamed elsewhere")
+	m, _ := b.Update(tui.ReloadMsg{})
+	b = m.(*tui.Board)
+	v := b.View()
+	if !containsStr(v, "Renamed elsewhere") || containsStr(v, "Task A") {
+		t.Errorf("expected the reloaded title instead of the cached card, got:\n%s", v)
+	}
+}
+
+func TestCache_ReloadKeepsScrollPosition(t *testing.T) {
+	b, _ := setupLargeBoard(t, 50)
+	for range 30 {
+		b = sendKey(b, "j")
+	}
+	before := stripANSI(b.View())
+	if !strings.Contains(before, "↑") {
+		t.Fatalf("expected the column to be scrolled, got:\n%s", before)
+	}
+
+	m, _ := b.Update(tui.ReloadMsg{})
+	b = m.(*tui.Board)
+	if after := stripANSI(b.View()); after != before {
+		t.Errorf("reload changed the view:\nbefore:\n%s\nafter:\n%s", before, after)
+	}
+}
+
+func BenchmarkBoard_Keystroke(b *testing.B) {
+	board, _ := setupLargeBoard(b, 3000)
+	down := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}
+	up := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}
+	for i := 0; b.Loop(); i++ {
+		msg := down
+		if i%200 >= 100 {
+			msg = up
+		}
+		board.Update(msg)
+		_ = board.View()
+	}
+}
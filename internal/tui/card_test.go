@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+func TestCardStyleAgeBorder(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	b := &Board{cfg: config.NewDefault("Test"), now: func() time.Time { return now }}
+	stale := now.Add(-100 * time.Hour) // past the 72h (orange) threshold
+
+	tests := []struct {
+		name   string
+		task   *task.Task
+		active bool
+		want   lipgloss.TerminalColor
+	}{
+		{"fresh", &task.Task{Status: "in-progress", Updated: now.Add(-2 * time.Hour)}, false, lipgloss.Color("34")},
+		{"stale", &task.Task{Status: "in-progress", Updated: stale}, false, lipgloss.Color("208")},
+		{"no duration status", &task.Task{Status: "backlog", Updated: stale}, false, cardStyle.GetBorderTopForeground()},
+		{"blocked", &task.Task{Status: "in-progress", Updated: stale, Blocked: true}, false,
+			blockedCardStyle.GetBorderTopForeground()},
+		{"active", &task.Task{Status: "in-progress", Updated: stale}, true, activeCardStyle.GetBorderTopForeground()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := b.cardStyle(tt.task, tt.active).GetBorderTopForeground()
+			if got != tt.want {
+				t.Errorf("border color = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
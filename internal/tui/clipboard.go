@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+)
+
+// copyToClipboard puts s on the system clipboard. Over SSH, and when no
+// clipboard tool is available, it falls back to an OSC 52 escape sequence,
+// which asks the terminal itself to set its clipboard.
+func copyToClipboard(s string) error {
+	if os.Getenv("SSH_TTY") == "" && os.Getenv("SSH_CONNECTION") == "" {
+		if err := clipboard.WriteAll(s); err == nil {
+			return nil
+		}
+	}
+	seq := osc52.New(s)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+	_, err := seq.WriteTo(os.Stderr)
+	return err
+}
+
+// yank copies the selected task's reference ("#12 Fix login") or, with
+// path set, its file path.
+func (b *Board) yank(path bool) {
+	t := b.selectedTask()
+	if t == nil {
+		return
+	}
+	text := b.cfg.FormatID(t.ID) + " " + t.Title
+	if path {
+		text = t.File
+	}
+	if err := b.copyText(text); err != nil {
+		b.err = err
+		return
+	}
+	b.notice = "Copied " + text
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// collapsedColumnPad is the header padding plus the "▸ " marker.
+	collapsedColumnPad = 4
+	// collapsedColumnMax caps the width of a collapsed column.
+	collapsedColumnMax = 20
+)
+
+// toggleCollapse collapses or expands the focused column and saves the
+// collapsed columns to tui.collapsed_columns. A read-only board only
+// changes the view.
+func (b *Board) toggleCollapse() {
+	col := b.currentColumn()
+	if col == nil {
+		return
+	}
+	if b.collapsed[col.status] {
+		delete(b.collapsed, col.status)
+	} else {
+		b.collapsed[col.status] = true
+	}
+	b.clampRow()
+	if b.readOnly {
+		return
+	}
+
+	var statuses []string
+	for _, s := range b.cfg.BoardStatuses() {
+		if b.collapsed[s] {
+			statuses = append(statuses, s)
+		}
+	}
+	b.cfg.TUI.CollapsedColumns = statuses
+	if err := b.cfg.Save(); err != nil {
+		b.err = fmt.Errorf("saving collapsed columns: %w", err)
+	}
+}
+
+// collapsedWidth is the width of a collapsed column: its header, capped.
+func (b *Board) collapsedWidth(col column) int {
+	return min(lipgloss.Width(b.columnHeaderText(col))+collapsedColumnPad, collapsedColumnMax)
+}
+
+// renderCollapsedColumn renders only the column's header and task count.
+func (b *Board) renderCollapsedColumn(colIdx int, col column) string {
+	const headerPad = 2
+	width := b.collapsedWidth(col)
+	text := truncate("▸ "+b.columnHeaderText(col), width-headerPad)
+	if colIdx == b.activeCol {
+		return activeColumnHeaderStyle.Width(width).Render(text)
+	}
+	return columnHeaderStyle.Width(width).Render(text)
+}
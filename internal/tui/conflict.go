@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/antopolskiy/kanban-md/internal/crypt"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// maxConflictDiffLines caps the body diff shown in the conflict prompt.
+const maxConflictDiffLines = 15
+
+// sameEditFields reports whether a and b agree on the fields the edit
+// wizard writes.
+func sameEditFields(a, b *task.Task) bool {
+	return a.Title == b.Title && a.Body == b.Body && a.Priority == b.Priority &&
+		strings.Join(a.Tags, ",") == strings.Join(b.Tags, ",")
+}
+
+// checkEditConflict compares the task being edited with its file. When
+// another writer changed or deleted it since editing began, it switches to
+// the conflict prompt and reports true.
+func (b *Board) checkEditConflict() bool {
+	if !b.createIsEdit || b.editBase == nil || (b.view != viewCreate && b.view != viewConflict) {
+		return false
+	}
+	var theirs *task.Task
+	if path, err := task.FindByIDIn(b.cfg.TasksPaths(), b.createEditID); err == nil {
+		if theirs, err = task.Read(path); err != nil {
+			return false // a half-written file; the next reload looks again
+		}
+		if sameEditFields(b.editBase, theirs) {
+			return false
+		}
+	}
+	b.editTheirs = theirs
+	b.conflictDiff = false
+	b.view = viewConflict
+	return true
+}
+
+func (b *Board) handleConflictKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "m":
+		// Keep mine: their version becomes the base, so saving overwrites it.
+		if b.editTheirs != nil {
+			b.editBase = b.editTheirs
+			b.view = viewCreate
+			b.focusCreateField()
+		}
+	case "t":
+		id := b.createEditID
+		b.resetCreateState()
+		b.view = viewBoard
+		b.loadTasks()
+		b.selectTaskByID(id)
+	case "d":
+		b.conflictDiff = !b.conflictDiff
+	case keyEsc:
+		b.view = viewCreate
+		b.focusCreateField()
+	}
+	return b, nil
+}
+
+func (b *Board) viewConflictDialog() string {
+	id := b.cfg.FormatID(b.createEditID)
+	var lines []string
+	if b.editTheirs == nil {
+		lines = append(lines,
+			errorStyle.Render(fmt.Sprintf("Task %s was deleted while you were editing it.", id)),
+			"", dimStyle.Render("t:discard my changes  esc:back to editing"))
+		return dialogStyle.Render(strings.Join(lines, "\n"))
+	}
+
+	lines = append(lines, errorStyle.Render(fmt.Sprintf("Task %s changed on disk while you were editing it.", id)))
+	if b.conflictDiff {
+		lines = append(lines, "")
+		lines = append(lines, b.conflictDiffLines()...)
+	}
+	lines = append(lines, "",
+		dimStyle.Render("m:keep mine (overwrite)  t:take theirs (discard mine)  d:diff  esc:back to editing"))
+	return dialogStyle.Render(strings.Join(lines, "\n"))
+}
+
+// conflictDiffLines compares the wizard's values ("mine") with the file
+// ("theirs"), field by field.
+func (b *Board) conflictDiffLines() []string {
+	theirs := b.editTheirs
+	theirBody, err := crypt.Open(theirs)
+	if err != nil {
+		theirBody = "(private body: " + err.Error() + ")"
+	}
+	label := lipgloss.NewStyle().Bold(true)
+	field := func(name, mine, their string) []string {
+		if mine == their {
+			return nil
+		}
+		return []string{label.Render(name + ":"), "- " + mine, "+ " + their}
+	}
+
+	var lines []string
+	lines = append(lines, field("Title", strings.TrimSpace(b.createTitleInput.Value()), theirs.Title)...)
+	lines = append(lines, field("Priority", b.selectedCreatePriority(), theirs.Priority)...)
+	lines = append(lines, field("Tags", strings.Join(parseTagsCSV(b.createTagsInput.Value()), ","),
+		strings.Join(theirs.Tags, ","))...)
+
+	mine := strings.TrimSpace(b.createBodyInput.Value())
+	if their := strings.TrimSpace(theirBody); mine != their {
+		diff := lineDiff(strings.Split(mine, "\n"), strings.Split(their, "\n"))
+		if len(diff) > maxConflictDiffLines {
+			diff = append(diff[:maxConflictDiffLines], fmt.Sprintf("… %d more lines", len(diff)-maxConflictDiffLines))
+		}
+		lines = append(lines, label.Render("Body:"))
+		lines = append(lines, diff...)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, dimStyle.Render("Your edits already match the file."))
+	}
+	lines = append(lines, "", dimStyle.Render("- mine  + theirs"))
+	return lines
+}
+
+// lineDiff returns the lines of a and b in order, prefixed "- " when only
+// in a, "+ " when only in b, and "  " when in both (by longest common
+// subsequence).
+func lineDiff(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+	return out
+}
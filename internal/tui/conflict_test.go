@@ -0,0 +1,104 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/tui"
+)
+
+// editTitleOnDisk rewrites task 1's title as another writer would.
+func editTitleOnDisk(t *testing.T, cfg *config.Config, title string) {
+	t.Helper()
+	path, err := task.FindByID(cfg.TasksPath(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := readTestTask(t, cfg, 1)
+	tk.Title = title
+	if err := task.Write(path, tk); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// startConflict opens the edit wizard on task 1, types into the title, and
+// changes the task on disk before the watcher's reload arrives.
+func startConflict(t *testing.T) (*tui.Board, *config.Config) {
+	t.Helper()
+	b, cfg := setupTestBoard(t)
+	b = sendKey(b, "e")
+	b = typeText(b, " mine")
+	editTitleOnDisk(t, cfg, "Theirs")
+	m, _ := b.Update(tui.ReloadMsg{})
+	b = m.(*tui.Board)
+	if !containsStr(b.View(), "changed on disk") {
+		t.Fatalf("expected conflict prompt, got:\n%s", b.View())
+	}
+	return b, cfg
+}
+
+func TestConflict_KeepMineOverwrites(t *testing.T) {
+	b, cfg := startConflict(t)
+
+	b = sendKey(b, "m")
+	if !containsStr(b.View(), "Edit task #1") {
+		t.Fatalf("expected edit wizard after keep mine, got:\n%s", b.View())
+	}
+	sendSpecialKey(b, tea.KeyEnter)
+	if got := readTestTask(t, cfg, 1).Title; got != "Task A mine" {
+		t.Errorf("title = %q, want %q", got, "Task A mine")
+	}
+}
+
+func TestConflict_TakeTheirsDiscardsEdits(t *testing.T) {
+	b, cfg := startConflict(t)
+
+	b = sendKey(b, "t")
+	if containsStr(b.View(), "Edit task #1") {
+		t.Fatal("expected edit wizard to close after take theirs")
+	}
+	if got := readTestTask(t, cfg, 1).Title; got != "Theirs" {
+		t.Errorf("title = %q, want %q", got, "Theirs")
+	}
+}
+
+func TestConflict_DiffShowsBothSides(t *testing.T) {
+	b, _ := startConflict(t)
+
+	b = sendKey(b, "d")
+	v := b.View()
+	if !containsStr(v, "- Task A mine") || !containsStr(v, "+ Theirs") {
+		t.Errorf("expected title diff, got:\n%s", v)
+	}
+}
+
+func TestConflict_SaveChecksBeforeWriting(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	b = sendKey(b, "e")
+	b = typeText(b, " mine")
+	editTitleOnDisk(t, cfg, "Theirs")
+
+	// No reload arrived yet; saving must still notice the change.
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if !containsStr(b.View(), "changed on disk") {
+		t.Fatalf("expected conflict prompt on save, got:\n%s", b.View())
+	}
+	if got := readTestTask(t, cfg, 1).Title; got != "Theirs" {
+		t.Errorf("title = %q, want the other writer's %q", got, "Theirs")
+	}
+}
+
+func TestConflict_UnrelatedChangeIsNoConflict(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	b = sendKey(b, "e")
+	editTitleOnDisk(t, cfg, "Task A") // rewrite with the same fields
+
+	m, _ := b.Update(tui.ReloadMsg{})
+	b = m.(*tui.Board)
+	if containsStr(b.View(), "changed on disk") {
+		t.Error("expected no conflict when edited fields are unchanged")
+	}
+}
@@ -258,6 +258,28 @@ func TestCreate_NextIDIncrements(t *testing.T) {
 	}
 }
 
+func TestCreate_SeesIDsAllocatedElsewhere(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+	// Another writer, such as the CLI or serve, created tasks 5-9 after the
+	// TUI loaded its config.
+	onDisk, err := config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	onDisk.NextID = 10
+	if err := onDisk.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	b = sendKey(b, "c")
+	b = typeText(b, "After the others")
+	_ = sendSpecialKey(b, tea.KeyEnter)
+
+	if _, err := task.FindByIDIn(cfg.TasksPaths(), 10); err != nil {
+		t.Errorf("created task not given ID 10: %v", err)
+	}
+}
+
 func TestCreate_SpaceInTitle(t *testing.T) {
 	b, _ := setupTestBoard(t)
 
@@ -822,3 +844,71 @@ func TestCreate_AltEnterOnTitleStepSubmits(t *testing.T) {
 		t.Error("expected task to be created via alt+enter on title step")
 	}
 }
+
+func TestQuickAdd_CreatesTaskWithTokens(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	b = sendKey(b, "l") // todo column
+	b = sendKey(b, "a")
+	if v := b.View(); !containsStr(v, "Quick add to todo") {
+		t.Fatal("expected quick-add dialog, got:", v[:min(len(v), 200)])
+	}
+	b = typeText(b, "fix login !high #backend @alice due:fri")
+	b = sendSpecialKey(b, tea.KeyEnter)
+
+	if v := b.View(); !containsStr(v, "fix login") {
+		t.Error("expected new task in board view")
+	}
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tk *task.Task
+	for _, c := range tasks {
+		if c.Title == "fix login" {
+			tk = c
+		}
+	}
+	if tk == nil {
+		t.Fatal("task \"fix login\" not found")
+	}
+	if tk.Title != "fix login" || tk.Status != "todo" || tk.Priority != "high" ||
+		tk.Assignee != "alice" || len(tk.Tags) != 1 || tk.Tags[0] != "backend" {
+		t.Errorf("task = %+v", tk)
+	}
+	// testNow is Thursday 2026-01-15.
+	if tk.Due == nil || tk.Due.String() != "2026-01-16" {
+		t.Errorf("due = %v, want 2026-01-16", tk.Due)
+	}
+}
+
+func TestQuickAdd_BadTokenKeepsDialogOpen(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	b = sendKey(b, "a")
+	b = typeText(b, "task !urgentest")
+	b = sendSpecialKey(b, tea.KeyEnter)
+
+	v := b.View()
+	if !containsStr(v, "Quick add to") || !containsStr(v, "unknown priority") {
+		t.Error("expected the dialog to stay open with the error, got:", v[:min(len(v), 300)])
+	}
+	if n := countTaskFiles(t, cfg.TasksPath()); n != 4 {
+		t.Errorf("task files = %d, want 4", n)
+	}
+
+	b = sendSpecialKey(b, tea.KeyEscape)
+	if containsStr(b.View(), "Quick add to") {
+		t.Error("expected esc to close the dialog")
+	}
+}
+
+func TestQuickAdd_ReadOnly(t *testing.T) {
+	b, _ := setupTestBoard(t)
+	b.SetReadOnly(true)
+
+	b = sendKey(b, "a")
+	if containsStr(b.View(), "Quick add to") {
+		t.Error("quick add should be rejected on a read-only board")
+	}
+}
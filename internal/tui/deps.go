@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/antopolskiy/kanban-md/internal/board"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// depsListRows is how many matching tasks the dependency dialog lists.
+const depsListRows = 10
+
+// handleDepsStart opens the dependency dialog for the task in the detail
+// view.
+func (b *Board) handleDepsStart() {
+	if b.readOnly {
+		b.err = errReadOnly
+		return
+	}
+	if b.detailTask == nil {
+		return
+	}
+	b.depsInput = textinput.New()
+	b.depsInput.Prompt = ""
+	b.depsInput.Placeholder = "title or ID"
+	b.depsInput.Width = b.createInputWidth("Search: ")
+	b.depsInput.Focus()
+	b.depsCursor = 0
+	b.depsErr = nil
+	b.view = viewDeps
+}
+
+// depsMatches returns the tasks other than the detail task whose title
+// contains the search text or whose ID it names, ordered by ID.
+func (b *Board) depsMatches() []*task.Task {
+	q := strings.ToLower(strings.TrimSpace(b.depsInput.Value()))
+	idQuery := strings.TrimPrefix(strings.TrimPrefix(q, strings.ToLower(b.cfg.Board.IDPrefix)), "#")
+	var matches []*task.Task
+	for _, t := range b.tasks {
+		if t.ID == b.detailTask.ID {
+			continue
+		}
+		if q == "" || strings.Contains(strings.ToLower(t.Title), q) || strconv.Itoa(t.ID) == idQuery {
+			matches = append(matches, t)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches
+}
+
+func (b *Board) handleDepsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := b.depsMatches()
+	switch msg.String() {
+	case keyEsc:
+		b.view = viewDetail
+		return b, nil
+	case keyUp:
+		if b.depsCursor > 0 {
+			b.depsCursor--
+		}
+		return b, nil
+	case keyDown:
+		if b.depsCursor < len(matches)-1 {
+			b.depsCursor++
+		}
+		return b, nil
+	case keyEnter:
+		if b.depsCursor < len(matches) {
+			b.toggleDependency(matches[b.depsCursor].ID)
+		}
+		return b, nil
+	case "ctrl+p":
+		if b.depsCursor < len(matches) {
+			b.toggleParent(matches[b.depsCursor].ID)
+		}
+		return b, nil
+	}
+	m, cmd := b.depsInput.Update(msg)
+	b.depsInput = m
+	b.depsCursor = 0
+	return b, cmd
+}
+
+// toggleDependency adds id to the detail task's dependencies, or removes
+// it if already there.
+func (b *Board) toggleDependency(id int) {
+	b.updateDetailTask(func(t *task.Task) (string, error) {
+		for i, d := range t.DependsOn {
+			if d == id {
+				t.DependsOn = append(t.DependsOn[:i], t.DependsOn[i+1:]...)
+				return "removed dependency " + b.cfg.FormatID(id), nil
+			}
+		}
+		t.DependsOn = append(t.DependsOn, id)
+		return "added dependency " + b.cfg.FormatID(id), nil
+	})
+}
+
+// toggleParent makes id the detail task's parent, or clears the parent if
+// it already is. A parent that would make the task its own ancestor is
+// rejected.
+func (b *Board) toggleParent(id int) {
+	b.updateDetailTask(func(t *task.Task) (string, error) {
+		if t.Parent != nil && *t.Parent == id {
+			t.Parent = nil
+			return "cleared parent", nil
+		}
+		if chain := board.ParentCycle(b.tasks, t.ID, id); chain != nil {
+			return "", task.ValidateParentCycle(t.ID, id, chain)
+		}
+		t.Parent = &id
+		return "set parent " + b.cfg.FormatID(id), nil
+	})
+}
+
+// updateDetailTask rereads the detail task, applies change, writes it, and
+// reloads the board so blocked states reflect the new dependencies.
+func (b *Board) updateDetailTask(change func(t *task.Task) (string, error)) {
+	id := b.detailTask.ID
+	path, err := task.FindByIDIn(b.cfg.TasksPaths(), id)
+	if err != nil {
+		b.depsErr = err
+		return
+	}
+	t, err := task.Read(path)
+	if err != nil {
+		b.depsErr = err
+		return
+	}
+	detail, err := change(t)
+	if err != nil {
+		b.depsErr = err
+		return
+	}
+	t.Updated = b.now()
+	if err := task.Write(path, t); err != nil {
+		b.depsErr = fmt.Errorf("writing task %s: %w", b.cfg.FormatID(id), err)
+		return
+	}
+	b.depsErr = nil
+	board.LogMutation(b.cfg.Dir(), "edit", id, detail)
+
+	b.loadTasks()
+	for _, t := range b.tasks {
+		if t.ID == id {
+			b.detailTask = revealed(t)
+		}
+	}
+}
+
+func (b *Board) viewDepsDialog() string {
+	t := b.detailTask
+	header := lipgloss.NewStyle().Bold(true).Render(
+		fmt.Sprintf("Dependencies of %s: %s", b.cfg.FormatID(t.ID), truncate(t.Title, b.createInputWidth(""))))
+
+	parent := "none"
+	if t.Parent != nil {
+		parent = b.cfg.FormatID(*t.Parent)
+	}
+	deps := "none"
+	if len(t.DependsOn) > 0 {
+		ids := make([]string, len(t.DependsOn))
+		for i, d := range t.DependsOn {
+			ids[i] = b.cfg.FormatID(d)
+		}
+		deps = strings.Join(ids, ", ")
+	}
+	current := dimStyle.Render("Parent: " + parent + "   Depends on: " + deps)
+
+	lines := []string{header, current, "", b.renderLabeledCreateInput("Search: ", b.depsInput.View()), ""}
+	lines = append(lines, b.depsListLines()...)
+	if b.depsErr != nil {
+		lines = append(lines, "", errorStyle.Render(b.depsErr.Error()))
+	}
+	lines = append(lines, "", dimStyle.Render("↑/↓:select  enter:toggle dependency  ctrl+p:set/clear parent  esc:back"))
+	return dialogStyle.Render(strings.Join(lines, "\n"))
+}
+
+// depsListLines lists the matching tasks around the cursor, marking the
+// detail task's dependencies and parent.
+func (b *Board) depsListLines() []string {
+	matches := b.depsMatches()
+	if len(matches) == 0 {
+		return []string{dimStyle.Render("  no matching tasks")}
+	}
+	t := b.detailTask
+	start := max(0, min(b.depsCursor-depsListRows/2, len(matches)-depsListRows)) //nolint:mnd // center the cursor
+	end := min(start+depsListRows, len(matches))
+
+	var lines []string
+	for i := start; i < end; i++ {
+		m := matches[i]
+		cursor := "  "
+		if i == b.depsCursor {
+			cursor = "> "
+		}
+		mark := "         "
+		switch {
+		case t.Parent != nil && *t.Parent == m.ID:
+			mark = "[parent] "
+		case containsInt(t.DependsOn, m.ID):
+			mark = "[dep]    "
+		}
+		line := cursor + mark + b.cfg.FormatID(m.ID) + " " + truncate(m.Title, b.createInputWidth("")) +
+			dimStyle.Render(" ("+m.Status+")")
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/tui"
+)
+
+// readTestTask reads a task of the test board by ID.
+func readTestTask(t *testing.T, cfg *config.Config, id int) *task.Task {
+	t.Helper()
+	path, err := task.FindByID(cfg.TasksPath(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tk
+}
+
+// openDeps opens the dependency dialog of the selected task (#1, Task A).
+func openDeps(b *tui.Board) *tui.Board {
+	b = sendSpecialKey(b, tea.KeyEnter)
+	return sendKey(b, "D")
+}
+
+func TestDeps_ToggleDependency(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	b = openDeps(b)
+	if v := b.View(); !containsStr(v, "Dependencies of #1") {
+		t.Fatalf("expected dependency dialog, got:\n%s", v)
+	}
+	b = typeText(b, "task c")
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if tk := readTestTask(t, cfg, 1); len(tk.DependsOn) != 1 || tk.DependsOn[0] != 3 {
+		t.Fatalf("DependsOn = %v, want [3]", tk.DependsOn)
+	}
+	if v := b.View(); !containsStr(v, "[dep]") || !containsStr(v, "Depends on: #3") {
+		t.Errorf("expected #3 marked as a dependency, got:\n%s", v)
+	}
+
+	// Task C is in progress, so the detail view shows #1 waiting on it.
+	b = sendSpecialKey(b, tea.KeyEscape)
+	if v := b.View(); !containsStr(v, "#3") || !containsStr(v, "(waiting)") {
+		t.Errorf("expected detail to show the open dependency, got:\n%s", v)
+	}
+
+	b = sendKey(b, "D")
+	b = typeText(b, "3")
+	b = sendSpecialKey(b, tea.KeyEnter)
+	if tk := readTestTask(t, cfg, 1); len(tk.DependsOn) != 0 {
+		t.Errorf("DependsOn = %v, want none after the second toggle", tk.DependsOn)
+	}
+}
+
+func TestDeps_SetParentRejectsCycle(t *testing.T) {
+	b, cfg := setupTestBoard(t)
+
+	b = openDeps(b)
+	b = typeText(b, "task b")
+	b = sendSpecialKey(b, tea.KeyCtrlP)
+	if tk := readTestTask(t, cfg, 1); tk.Parent == nil || *tk.Parent != 2 {
+		t.Fatalf("Parent = %v, want #2", tk.Parent)
+	}
+
+	// Making #1 the parent of #2 would loop.
+	b = sendSpecialKey(b, tea.KeyEscape)
+	b = sendKey(b, "q")
+	b = sendKey(b, "j") // Task B
+	b = openDeps(b)
+	b = typeText(b, "task a")
+	b = sendSpecialKey(b, tea.KeyCtrlP)
+	if v := b.View(); !containsStr(v, "would loop") {
+		t.Errorf("expected a parent cycle error, got:\n%s", v)
+	}
+	if tk := readTestTask(t, cfg, 2); tk.Parent != nil {
+		t.Errorf("Parent of #2 = %d, want none", *tk.Parent)
+	}
+}
+
+func TestDeps_ReadOnly(t *testing.T) {
+	b, _ := setupTestBoard(t)
+	b.SetReadOnly(true)
+
+	b = openDeps(b)
+	if containsStr(b.View(), "Dependencies of") {
+		t.Error("dependency dialog should not open on a read-only board")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/antopolskiy/kanban-md/internal/crypt"
 	"github.com/antopolskiy/kanban-md/internal/task"
 )
 
@@ -177,3 +178,46 @@ func TestEdit_PrefillsMultilineBody(t *testing.T) {
 		t.Errorf("expected 'line two' in body view, got:\n%s", v)
 	}
 }
+
+func TestEdit_PrivateTaskWithoutKeyIsRefused(t *testing.T) {
+	t.Setenv(crypt.IdentityEnv, "")
+	b, cfg := setupTestBoard(t)
+
+	path, err := task.FindByID(cfg.TasksPath(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed := "-----BEGIN AGE ENCRYPTED FILE-----\nYWJj\n-----END AGE ENCRYPTED FILE-----\n"
+	tk.Private = true
+	tk.Body = sealed
+	if err := task.Write(path, tk); err != nil {
+		t.Fatal(err)
+	}
+
+	b = sendKey(b, "r")
+	b = sendKey(b, "e")
+
+	v := b.View()
+	if containsStr(v, "Edit task #1") {
+		t.Fatalf("edit dialog should not open for an undecryptable task:\n%s", v)
+	}
+	if !containsStr(v, "private") {
+		t.Errorf("expected private task error, got:\n%s", v)
+	}
+
+	b = sendSpecialKey(b, tea.KeyEnter) // detail view shows the redacted body
+	if !containsStr(b.View(), crypt.Redacted) {
+		t.Errorf("expected redacted body in detail view, got:\n%s", b.View())
+	}
+	got, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Body != sealed {
+		t.Errorf("body = %q, want ciphertext unchanged", got.Body)
+	}
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/i18n"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// focusTask returns the task whose running focus timer, started with
+// kanban-md focus, ends first, or nil when none is running.
+func (b *Board) focusTask() *task.Task {
+	now := b.now()
+	var found *task.Task
+	for _, t := range b.tasks {
+		if task.Focusing(t, now) && (found == nil || t.FocusUntil.Before(*found.FocusUntil)) {
+			found = t
+		}
+	}
+	return found
+}
+
+// focusLabel is the status bar countdown of the running focus timer, or ""
+// when none is running.
+func (b *Board) focusLabel() string {
+	t := b.focusTask()
+	if t == nil {
+		return ""
+	}
+	left := t.FocusUntil.Sub(b.now()).Round(time.Second)
+	mins, secs := int(left/time.Minute), int(left%time.Minute/time.Second)
+	return i18n.Sprintf("focus %s %02d:%02d left", b.cfg.FormatID(t.ID), mins, secs)
+}
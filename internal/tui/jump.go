@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxJumpDigits bounds the ID typed after # so it always fits an int.
+const maxJumpDigits = 9
+
+func (b *Board) handleJumpStart() {
+	b.jumpDigits = ""
+	b.view = viewJump
+}
+
+// handleJumpKey collects the digits of a task ID; enter jumps to the task
+// and esc cancels.
+func (b *Board) handleJumpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type { //nolint:exhaustive // other keys are ignored
+	case tea.KeyEscape:
+		b.view = viewBoard
+	case tea.KeyEnter:
+		b.view = viewBoard
+		if b.jumpDigits != "" {
+			id, _ := strconv.Atoi(b.jumpDigits)
+			b.jumpToTask(id)
+		}
+	case tea.KeyBackspace:
+		if b.jumpDigits != "" {
+			b.jumpDigits = b.jumpDigits[:len(b.jumpDigits)-1]
+		}
+	case tea.KeyRunes:
+		for _, r := range msg.Runes {
+			if r >= '0' && r <= '9' && len(b.jumpDigits) < maxJumpDigits {
+				b.jumpDigits += string(r)
+			}
+		}
+	}
+	return b, nil
+}
+
+// jumpPrompt is the status bar while an ID is being typed.
+func (b *Board) jumpPrompt() string {
+	prefix := "#"
+	if b.cfg.Board.IDPrefix != "" {
+		prefix = b.cfg.Board.IDPrefix
+	}
+	return " Jump to " + prefix + b.jumpDigits + "_  enter:go  esc:cancel"
+}
+
+// jumpToTask moves the cursor to task id in whichever column holds it,
+// expanding and scrolling as needed, or reports that no column shows it.
+func (b *Board) jumpToTask(id int) {
+	for colIdx := range b.columns {
+		for _, t := range b.columns[colIdx].tasks {
+			if t.ID == id {
+				b.err = nil
+				delete(b.collapsed, b.columns[colIdx].status) // for this session only
+				b.selectTaskByID(id)
+				return
+			}
+		}
+	}
+	b.err = fmt.Errorf("task %s is not on the board", b.cfg.FormatID(id))
+}
@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/antopolskiy/kanban-md/internal/i18n"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// sampleTag marks the tasks added by the sample_tasks action, so they are
+// easy to find and delete.
+const sampleTag = "sample"
+
+// selectDefaultColumn selects the column of the default status, where the
+// first tasks of an empty board are created.
+func (b *Board) selectDefaultColumn() {
+	for i, col := range b.columns {
+		if col.status == b.cfg.Defaults.Status {
+			b.activeCol = i
+			return
+		}
+	}
+}
+
+// keyName is the first key bound to action, or the action's name when the
+// action has no keys.
+func (b *Board) keyName(action string) string {
+	if keys := b.keys[action]; len(keys) > 0 {
+		return keyLabel(keys[0])
+	}
+	return action
+}
+
+// viewEmptyState renders the onboarding screen shown in place of the
+// columns while the board has no tasks: the keys that add tasks and the
+// commands that import them or connect agents.
+func (b *Board) viewEmptyState(height int) string {
+	keyStyle := lipgloss.NewStyle().Bold(true).Width(6) //nolint:mnd // key column width
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(i18n.Sprintf("Welcome to %s", b.cfg.Board.Name)), "")
+	if b.readOnly {
+		lines = append(lines, i18n.T("This board has no tasks yet, and it is read-only."))
+	} else {
+		lines = append(lines, i18n.T("This board has no tasks yet. To get started:"), "")
+		for _, h := range []struct{ action, desc string }{
+			{"quick_add", "Quick add a task (!prio #tag @user due:fri)"},
+			{"create", "Create a task step by step"},
+			{"sample_tasks", "Add sample tasks to try the board out"},
+			{"help", "Show all keys"},
+		} {
+			if keys := b.keys[h.action]; len(keys) > 0 {
+				lines = append(lines, "  "+keyStyle.Render(keyLabel(keys[0]))+i18n.T(h.desc))
+			}
+		}
+	}
+	lines = append(lines, "", i18n.T("From the command line:"), "")
+	for _, c := range []struct{ cmd, desc string }{
+		{`kanban-md create "Write docs"`, "Add a task"},
+		{"kanban-md apply tasks.yml", "Import tasks from a file"},
+		{"kanban-md skill install", "Teach your coding agents to use the board"},
+	} {
+		lines = append(lines, "  "+c.cmd, dimStyle.Render("    "+i18n.T(c.desc)))
+	}
+
+	panel := dialogStyle.Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(b.width, max(height, 1), lipgloss.Center, lipgloss.Center, panel)
+}
+
+// addSampleTasks fills an empty board with a few tasks, in the default
+// status, that walk through the main keys.
+func (b *Board) addSampleTasks() {
+	if !b.emptyBoard {
+		b.notice = i18n.T("Sample tasks can only be added to an empty board")
+		return
+	}
+	b.initCreateInputs() // createTask resets them
+	samples := []struct{ title, body string }{
+		{
+			fmt.Sprintf("Open this task with %s", b.keyName("open")),
+			"Every task is a Markdown file in the board's tasks directory. " +
+				"The detail view shows its fields and body; press esc to return to the board.",
+		},
+		{
+			fmt.Sprintf("Move this task to the next column with %s", b.keyName("next_status")),
+			fmt.Sprintf("%s moves a task back, and %s picks any status.", b.keyName("prev_status"), b.keyName("move")),
+		},
+		{
+			fmt.Sprintf("Raise my priority with %s", b.keyName("raise_priority")),
+			fmt.Sprintf("Cards are sorted by priority within a column; %s lowers it again.", b.keyName("lower_priority")),
+		},
+		{
+			fmt.Sprintf("Delete these samples with %s when you are done", b.keyName("delete")),
+			"From the command line, kanban-md list --tag " + sampleTag + " finds them.",
+		},
+	}
+	for _, s := range samples {
+		b.createTask(&task.Task{
+			Title:    s.title,
+			Status:   b.cfg.Defaults.Status,
+			Priority: b.cfg.Defaults.Priority,
+			Tags:     []string{sampleTag},
+			Body:     s.body,
+		})
+		if b.err != nil {
+			return
+		}
+	}
+	b.notice = i18n.Sprintf("Added %d sample tasks", len(samples))
+}
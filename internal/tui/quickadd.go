@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/date"
+	"github.com/antopolskiy/kanban-md/internal/task"
+)
+
+// quickFields are the task fields parsed from a quick-add line.
+type quickFields struct {
+	title    string
+	priority string
+	tags     []string
+	assignee string
+	due      *date.Date
+}
+
+// parseQuickAdd splits a quick-add line into a title and inline tokens:
+// !priority, #tag, @assignee, and due:<date>, where the date is anything
+// date.ParseNaturalAt accepts as one word (fri, tomorrow, +2w, 2026-05-01).
+// Words that are not tokens, in order, form the title. A lone !, #, or @
+// is kept in the title.
+func parseQuickAdd(cfg *config.Config, line string, now time.Time) (quickFields, error) {
+	var f quickFields
+	var words []string
+	for _, w := range strings.Fields(line) {
+		switch {
+		case len(w) > 1 && w[0] == '!':
+			p := strings.ToLower(w[1:])
+			if cfg.PriorityIndex(p) < 0 {
+				return f, fmt.Errorf("unknown priority %q (want one of %s)", w[1:], strings.Join(cfg.Priorities, ", "))
+			}
+			f.priority = p
+		case len(w) > 1 && w[0] == '#':
+			f.tags = appendUnique(f.tags, w[1:])
+		case len(w) > 1 && w[0] == '@':
+			f.assignee = w[1:]
+		case strings.HasPrefix(strings.ToLower(w), "due:") && len(w) > len("due:"):
+			d, err := date.ParseNaturalAt(w[len("due:"):], now)
+			if err != nil {
+				return f, err
+			}
+			f.due = &d
+		default:
+			words = append(words, w)
+		}
+	}
+	f.title = strings.Join(words, " ")
+	return f, nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+func (b *Board) handleQuickAddStart() {
+	col := b.currentColumn()
+	if col == nil {
+		return
+	}
+	b.initCreateInputs()
+	b.createStatus = col.status
+	b.quickErr = nil
+	b.view = viewQuickAdd
+	b.createTitleInput.Focus()
+}
+
+func (b *Board) handleQuickAddKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type { //nolint:exhaustive // all other keys edit the line
+	case tea.KeyEscape:
+		b.resetCreateState()
+		b.view = viewBoard
+		return b, nil
+	case tea.KeyEnter:
+		return b.executeQuickAdd()
+	}
+	b.quickErr = nil
+	return b, b.applyCreateTextInput(msg, &b.createTitleInput)
+}
+
+// executeQuickAdd creates a task from the quick-add line. A line that does
+// not parse stays open with the error so it can be fixed.
+func (b *Board) executeQuickAdd() (tea.Model, tea.Cmd) {
+	f, err := parseQuickAdd(b.cfg, b.createTitleInput.Value(), b.now().In(b.loc))
+	if err != nil {
+		b.quickErr = err
+		return b, nil
+	}
+	if f.title == "" {
+		if strings.TrimSpace(b.createTitleInput.Value()) == "" {
+			b.resetCreateState()
+			b.view = viewBoard
+			return b, nil
+		}
+		b.quickErr = fmt.Errorf("title is empty")
+		return b, nil
+	}
+
+	priority := f.priority
+	if priority == "" {
+		priority = b.cfg.Defaults.Priority
+	}
+	b.createTask(&task.Task{
+		Title:    f.title,
+		Status:   b.createStatus,
+		Priority: priority,
+		Tags:     f.tags,
+		Assignee: f.assignee,
+		Due:      f.due,
+	})
+	return b, nil
+}
+
+func (b *Board) viewQuickAddDialog() string {
+	header := lipgloss.NewStyle().Bold(true).Render("Quick add to " + b.createStatus)
+	b.applyCreateInputLayout()
+	content := header + "\n\n" + b.renderLabeledCreateInput("Task: ", b.createTitleInput.View())
+	if b.quickErr != nil {
+		content += "\n\n" + errorStyle.Render(b.quickErr.Error())
+	}
+	content += "\n\n" + dimStyle.Render("!priority #tag @assignee due:date") +
+		"\n" + dimStyle.Render("enter:create  esc:cancel")
+	return dialogStyle.Render(content)
+}
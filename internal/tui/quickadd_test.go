@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC) // a Thursday
+
+	f, err := parseQuickAdd(cfg, "fix login !high #backend @alice due:fri #backend", now)
+	if err != nil {
+		t.Fatalf("parseQuickAdd: %v", err)
+	}
+	if f.title != "fix login" {
+		t.Errorf("title = %q, want %q", f.title, "fix login")
+	}
+	if f.priority != "high" {
+		t.Errorf("priority = %q, want high", f.priority)
+	}
+	if len(f.tags) != 1 || f.tags[0] != "backend" {
+		t.Errorf("tags = %v, want [backend]", f.tags)
+	}
+	if f.assignee != "alice" {
+		t.Errorf("assignee = %q, want alice", f.assignee)
+	}
+	if f.due == nil || f.due.String() != "2026-01-16" {
+		t.Errorf("due = %v, want 2026-01-16", f.due)
+	}
+}
+
+func TestParseQuickAddKeepsLoneMarkers(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	f, err := parseQuickAdd(cfg, "ship it ! # @", time.Now())
+	if err != nil {
+		t.Fatalf("parseQuickAdd: %v", err)
+	}
+	if f.title != "ship it ! # @" {
+		t.Errorf("title = %q, want the whole line", f.title)
+	}
+}
+
+func TestParseQuickAddErrors(t *testing.T) {
+	cfg := config.NewDefault("Test")
+	for _, line := range []string{"task !urgentest", "task due:someday"} {
+		if _, err := parseQuickAdd(cfg, line, time.Now()); err == nil {
+			t.Errorf("parseQuickAdd(%q) succeeded, want error", line)
+		}
+	}
+	_, err := parseQuickAdd(cfg, "task !nope", time.Now())
+	if err == nil || !strings.Contains(err.Error(), "medium") {
+		t.Errorf("error = %v, want it to list the priorities", err)
+	}
+}
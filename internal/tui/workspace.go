@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxTabKeys is how many tabs have a number key (1-9).
+const maxTabKeys = 9
+
+var (
+	tabStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Padding(0, 1)
+	activeTabStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("229")).
+			Background(lipgloss.Color("62")).Padding(0, 1)
+)
+
+// TabReloadMsg asks the board in one workspace tab to reload from disk.
+type TabReloadMsg struct{ Tab int }
+
+// Workspace shows several boards as tabs, one visible at a time. Keys go to
+// the visible board, except tab, shift+tab, and 1-9 on its board view,
+// which switch tabs unless tui.keys binds them there. Hidden boards keep
+// reloading so a switch is instant.
+type Workspace struct {
+	boards []*Board
+	active int
+	width  int
+	height int
+}
+
+// NewWorkspace creates a Workspace with one tab per board, in order.
+func NewWorkspace(boards []*Board) *Workspace {
+	return &Workspace{boards: boards}
+}
+
+// Active returns the index of the visible tab.
+func (w *Workspace) Active() int {
+	return w.active
+}
+
+// Init implements tea.Model.
+func (w *Workspace) Init() tea.Cmd {
+	return tickCmd(w.focusing())
+}
+
+// Update implements tea.Model.
+func (w *Workspace) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		b := w.boards[w.active]
+		if b.view == viewBoard && !b.bindsKey(msg.String()) && w.switchTab(msg.String()) {
+			return w, nil
+		}
+		return w, w.forward(w.active, msg)
+	case tea.WindowSizeMsg:
+		w.width, w.height = msg.Width, msg.Height
+		inner := tea.WindowSizeMsg{Width: msg.Width, Height: max(msg.Height-1, 1)} // 1 = tab bar
+		for i := range w.boards {
+			w.forward(i, inner)
+		}
+		return w, nil
+	case TabReloadMsg:
+		if msg.Tab >= 0 && msg.Tab < len(w.boards) {
+			w.forward(msg.Tab, ReloadMsg{})
+		}
+		return w, nil
+	case ReloadMsg:
+		for i := range w.boards {
+			w.forward(i, msg)
+		}
+		return w, nil
+	case TickMsg:
+		return w, tickCmd(w.focusing())
+	}
+	return w, w.forward(w.active, msg)
+}
+
+// focusing reports whether a focus timer runs on any tab's board.
+func (w *Workspace) focusing() bool {
+	for _, b := range w.boards {
+		if b.focusTask() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// switchTab handles the tab-switching keys, reporting whether k was one.
+func (w *Workspace) switchTab(k string) bool {
+	n := len(w.boards)
+	switch k {
+	case "tab":
+		w.active = (w.active + 1) % n
+		return true
+	case "shift+tab":
+		w.active = (w.active + n - 1) % n
+		return true
+	}
+	if i, err := strconv.Atoi(k); err == nil && i >= 1 && i <= min(n, maxTabKeys) {
+		w.active = i - 1
+		return true
+	}
+	return false
+}
+
+// forward passes msg to the board in tab i and returns its command.
+func (w *Workspace) forward(i int, msg tea.Msg) tea.Cmd {
+	_, cmd := w.boards[i].Update(msg)
+	return cmd
+}
+
+// View implements tea.Model.
+func (w *Workspace) View() string {
+	if w.width == 0 {
+		return "Loading..."
+	}
+	return w.renderTabs() + "\n" + w.boards[w.active].View()
+}
+
+func (w *Workspace) renderTabs() string {
+	tabs := make([]string, len(w.boards))
+	for i, b := range w.boards {
+		label := b.cfg.Board.Name
+		if i < maxTabKeys {
+			label = strconv.Itoa(i+1) + " " + label
+		}
+		if i == w.active {
+			tabs[i] = activeTabStyle.Render(label)
+		} else {
+			tabs[i] = tabStyle.Render(label)
+		}
+	}
+	return lipgloss.NewStyle().MaxWidth(w.width).Render(strings.Join(tabs, ""))
+}
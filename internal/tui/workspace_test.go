@@ -0,0 +1,105 @@
+package tui_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/antopolskiy/kanban-md/internal/config"
+	"github.com/antopolskiy/kanban-md/internal/task"
+	"github.com/antopolskiy/kanban-md/internal/tui"
+)
+
+// setupTestWorkspace opens two test boards as tabs and returns the
+// workspace and the second board's config.
+func setupTestWorkspace(t *testing.T) (*tui.Workspace, *config.Config) {
+	t.Helper()
+	first, _ := setupTestBoard(t)
+	second, cfg := setupTestBoard(t)
+	cfg.Board.Name = "Second Board"
+
+	w := tui.NewWorkspace([]*tui.Board{first, second})
+	w.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return w, cfg
+}
+
+func sendWorkspaceKey(w *tui.Workspace, msg tea.KeyMsg) *tui.Workspace {
+	m, _ := w.Update(msg)
+	return m.(*tui.Workspace)
+}
+
+func TestWorkspace_TabBar(t *testing.T) {
+	w, _ := setupTestWorkspace(t)
+	v := stripANSI(w.View())
+	if !containsStr(v, "1 Test Board") || !containsStr(v, "2 Second Board") {
+		t.Errorf("tab bar missing board names:\n%s", v)
+	}
+}
+
+func TestWorkspace_SwitchTabs(t *testing.T) {
+	w, _ := setupTestWorkspace(t)
+
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if w.Active() != 1 {
+		t.Fatalf("after 2: active = %d, want 1", w.Active())
+	}
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyTab})
+	if w.Active() != 0 {
+		t.Errorf("tab from the last tab: active = %d, want 0", w.Active())
+	}
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyShiftTab})
+	if w.Active() != 1 {
+		t.Errorf("shift+tab from the first tab: active = %d, want 1", w.Active())
+	}
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+	if w.Active() != 1 {
+		t.Errorf("9 with two tabs: active = %d, want 1 (unchanged)", w.Active())
+	}
+}
+
+func TestWorkspace_DigitsGoToBoardOutsideBoardView(t *testing.T) {
+	w, _ := setupTestWorkspace(t)
+
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")}) // help view
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if w.Active() != 0 {
+		t.Errorf("2 in help view switched tabs: active = %d, want 0", w.Active())
+	}
+}
+
+func TestWorkspace_TabReloadOnlyReloadsItsBoard(t *testing.T) {
+	w, cfg := setupTestWorkspace(t)
+
+	tk := &task.Task{ID: 5, Title: "External Task", Status: "todo", Priority: "medium"}
+	if err := task.Write(filepath.Join(cfg.TasksPath(), task.GenerateFilename(5, tk.Title)), tk); err != nil {
+		t.Fatal(err)
+	}
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+
+	w.Update(tui.TabReloadMsg{Tab: 0})
+	if containsStr(w.View(), "External Task") {
+		t.Error("reloading tab 1 reloaded tab 2")
+	}
+	w.Update(tui.TabReloadMsg{Tab: 1})
+	if !containsStr(w.View(), "External Task") {
+		t.Error("expected External Task after reloading tab 2")
+	}
+}
+
+func TestWorkspace_BoundKeyStaysOnBoard(t *testing.T) {
+	_, cfg := setupTestBoard(t)
+	cfg.TUI.Keys = map[string][]string{"help": {"2"}}
+	first := tui.NewBoard(cfg)
+	second, _ := setupTestBoard(t)
+	w := tui.NewWorkspace([]*tui.Board{first, second})
+	w.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	w = sendWorkspaceKey(w, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if w.Active() != 0 {
+		t.Errorf("active = %d, want 0 (2 is bound to help)", w.Active())
+	}
+	if !containsStr(w.View(), "Keyboard Shortcuts") {
+		t.Error("expected 2 to open help on the board")
+	}
+}
@@ -4,6 +4,7 @@ package watcher
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -21,6 +22,7 @@ type Watcher struct {
 	mu       sync.Mutex
 	timer    *time.Timer
 	callback func()
+	running  atomic.Bool
 }
 
 // New creates a Watcher that monitors the given paths for changes.
@@ -47,6 +49,8 @@ func New(paths []string, callback func()) (*Watcher, error) {
 // Run starts the watch loop. It blocks until the context is canceled.
 // Errors from the underlying watcher are passed to the optional errFn callback.
 func (w *Watcher) Run(ctx context.Context, errFn func(error)) {
+	w.running.Store(true)
+	defer w.running.Store(false)
 	for {
 		select {
 		case <-ctx.Done():
@@ -76,6 +80,12 @@ func (w *Watcher) Run(ctx context.Context, errFn func(error)) {
 	}
 }
 
+// Alive reports whether the watch loop is running: Run has been called and
+// has not returned.
+func (w *Watcher) Alive() bool {
+	return w.running.Load()
+}
+
 // Close stops the underlying filesystem watcher.
 func (w *Watcher) Close() error {
 	return w.fsw.Close()
@@ -149,6 +149,37 @@ func TestWatcher_StopsOnContextCancel(t *testing.T) {
 	}
 }
 
+func TestWatcher_Alive(t *testing.T) {
+	w, err := watcher.New([]string{t.TempDir()}, func() {})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer w.Close()
+	if w.Alive() {
+		t.Error("Alive() before Run = true, want false")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx, nil)
+		close(done)
+	}()
+	deadline := time.Now().Add(2 * time.Second)
+	for !w.Alive() && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !w.Alive() {
+		t.Fatal("Alive() while running = false, want true")
+	}
+
+	cancel()
+	<-done
+	if w.Alive() {
+		t.Error("Alive() after Run returned = true, want false")
+	}
+}
+
 func TestWatcher_DetectsFileDelete(t *testing.T) {
 	dir := t.TempDir()
 